@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const defaultURL = "http://localhost:8080"
+
+// apiVersion is the path prefix of the API version the client speaks
+const apiVersion = "/v1"
+
+// Client calls the API with the stored or configured bearer token
+type Client struct {
+	baseURL   string
+	token     string
+	tokenPath string
+	http      *http.Client
+}
+
+func NewClient() (*Client, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate the config directory: %w", err)
+	}
+
+	c := &Client{
+		baseURL:   strings.TrimRight(getEnv("KANBAN_URL", defaultURL), "/"),
+		tokenPath: filepath.Join(configDir, "kanban", "token"),
+		http:      &http.Client{Timeout: 30 * time.Second},
+	}
+
+	c.token = os.Getenv("KANBAN_TOKEN")
+	if c.token == "" {
+		stored, err := os.ReadFile(c.tokenPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read the stored token: %w", err)
+		}
+		c.token = strings.TrimSpace(string(stored))
+	}
+	return c, nil
+}
+
+// SaveToken stores the token of a login for later commands, readable by the user only
+func (c *Client) SaveToken(token string) error {
+	if err := os.MkdirAll(filepath.Dir(c.tokenPath), 0o700); err != nil {
+		return err
+	}
+	c.token = token
+	return os.WriteFile(c.tokenPath, []byte(token+"\n"), 0o600)
+}
+
+// Do sends body as JSON and decodes the JSON response into out, if not nil. Responses with an
+// error status are returned as errors with the message of the API.
+func (c *Client) Do(method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+apiVersion+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 400 {
+		return apiError(resp.StatusCode, data)
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// apiError turns an error response into an error with the message of the API
+func apiError(status int, data []byte) error {
+	if status == http.StatusUnauthorized {
+		return errors.New("not authenticated, run kanban-cli login or set KANBAN_TOKEN")
+	}
+
+	var body struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(data, &body) == nil && body.Error != "" {
+		return fmt.Errorf("%s (HTTP %d)", body.Error, status)
+	}
+	return fmt.Errorf("HTTP %d: %s", status, strings.TrimSpace(string(data)))
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
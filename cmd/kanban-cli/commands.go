@@ -0,0 +1,196 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// maxImportPartTasks matches the limit of tasks per import part of the API
+const maxImportPartTasks = 1000
+
+func runLogin(client *Client, args []string) error {
+	flags := flag.NewFlagSet("login", flag.ExitOnError)
+	email := flags.String("email", "", "account email")
+	flags.Parse(args)
+	if *email == "" {
+		return errors.New("login needs -email")
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && password == "" {
+		return fmt.Errorf("failed to read the password: %w", err)
+	}
+
+	var resp struct {
+		Token string `json:"token"`
+		User  struct {
+			Name string `json:"name"`
+		} `json:"user"`
+	}
+	body := map[string]string{"email": *email, "password": strings.TrimRight(password, "\r\n")}
+	if err := client.Do(http.MethodPost, "/login", body, &resp); err != nil {
+		return err
+	}
+	if err := client.SaveToken(resp.Token); err != nil {
+		return fmt.Errorf("failed to store the token: %w", err)
+	}
+
+	fmt.Printf("Logged in as %s\n", resp.User.Name)
+	return nil
+}
+
+func runBoards(client *Client, args []string) error {
+	flags := flag.NewFlagSet("boards", flag.ExitOnError)
+	flags.Parse(args)
+
+	var boards []struct {
+		ID    string `json:"id"`
+		Key   string `json:"key"`
+		Title string `json:"title"`
+	}
+	if err := client.Do(http.MethodGet, "/boards", nil, &boards); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKEY\tTITLE")
+	for _, board := range boards {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", board.ID, board.Key, board.Title)
+	}
+	return w.Flush()
+}
+
+func runCreateTask(client *Client, args []string) error {
+	flags := flag.NewFlagSet("create-task", flag.ExitOnError)
+	columnID := flags.String("column", "", "column ID")
+	title := flags.String("title", "", "task title")
+	description := flags.String("description", "", "task description")
+	due := flags.String("due", "", "due date, YYYY-MM-DD")
+	flags.Parse(args)
+	if *columnID == "" || *title == "" {
+		return errors.New("create-task needs -column and -title")
+	}
+
+	body := map[string]interface{}{
+		"column_id":   *columnID,
+		"title":       *title,
+		"description": *description,
+	}
+	if *due != "" {
+		dueDate, err := time.Parse("2006-01-02", *due)
+		if err != nil {
+			return fmt.Errorf("invalid -due %q, expected YYYY-MM-DD", *due)
+		}
+		body["due_date"] = dueDate
+	}
+
+	var task struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+	if err := client.Do(http.MethodPost, "/tasks", body, &task); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\t%s\n", task.ID, task.Key)
+	return nil
+}
+
+func runMoveTask(client *Client, args []string) error {
+	flags := flag.NewFlagSet("move-task", flag.ExitOnError)
+	columnID := flags.String("column", "", "target column ID")
+	position := flags.Int("position", -1, "position in the target column, from 0")
+	flags.Parse(args)
+	if flags.NArg() != 1 || *columnID == "" || *position < 0 {
+		return errors.New("move-task needs -column, -position and a task ID")
+	}
+
+	body := map[string]interface{}{"column_id": *columnID, "position": *position}
+	if err := client.Do(http.MethodPost, "/tasks/"+flags.Arg(0)+"/move", body, nil); err != nil {
+		return err
+	}
+
+	fmt.Println("Task moved")
+	return nil
+}
+
+func runImport(client *Client, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	boardID := flags.String("board", "", "board ID")
+	wait := flags.Bool("wait", false, "wait until the import is done")
+	flags.Parse(args)
+	if flags.NArg() != 1 || *boardID == "" {
+		return errors.New("import needs -board and a CSV file")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	rows, err := parseImportCSV(file)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return errors.New("the CSV file has no tasks")
+	}
+
+	var taskImport struct {
+		ID string `json:"id"`
+	}
+	if err := client.Do(http.MethodPost, "/boards/"+*boardID+"/imports", nil, &taskImport); err != nil {
+		return err
+	}
+
+	// Части загружаются по очереди; при ошибке импорт остаётся незавершённым и истечёт сам
+	for i, part := range importParts(rows, maxImportPartTasks) {
+		path := fmt.Sprintf("/imports/%s/parts/%d", taskImport.ID, i+1)
+		if err := client.Do(http.MethodPut, path, map[string]interface{}{"tasks": part}, nil); err != nil {
+			return fmt.Errorf("part %d: %w", i+1, err)
+		}
+	}
+
+	var op operation
+	if err := client.Do(http.MethodPost, "/imports/"+taskImport.ID+"/commit", nil, &op); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Importing %d tasks, operation %s\n", len(rows), op.ID)
+	if !*wait {
+		return nil
+	}
+
+	for op.Status == "pending" || op.Status == "running" {
+		time.Sleep(time.Second)
+		if err := client.Do(http.MethodGet, "/operations/"+op.ID, nil, &op); err != nil {
+			return err
+		}
+	}
+	if op.Status != "succeeded" {
+		return fmt.Errorf("import %s: %s", op.Status, strings.Join(op.Errors, "; "))
+	}
+
+	// Отклонённые строки не прерывают импорт и перечислены в ошибках операции
+	for _, message := range op.Errors {
+		fmt.Fprintln(os.Stderr, message)
+	}
+	fmt.Printf("Imported %d of %d tasks\n", op.Completed-len(op.Errors), len(rows))
+	return nil
+}
+
+// operation is the state of a background operation of the API
+type operation struct {
+	ID        string   `json:"id"`
+	Status    string   `json:"status"`
+	Completed int      `json:"completed"`
+	Errors    []string `json:"errors"`
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// importRow is a task of an import part, like the rows accepted by PUT /imports/{id}/parts/{number}
+type importRow struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Column      string   `json:"column,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+	DueDate     string   `json:"due_date,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+}
+
+// importColumns are the CSV columns read into import rows; other columns are ignored
+var importColumns = []string{"title", "description", "column", "priority", "due_date", "labels"}
+
+// parseImportCSV reads import rows from a CSV file whose header names its columns, in any order.
+// Only the title column is required; labels are separated by semicolons.
+func parseImportCSV(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		for _, column := range importColumns {
+			if name == column {
+				index[column] = i
+			}
+		}
+	}
+	if _, ok := index["title"]; !ok {
+		return nil, fmt.Errorf("the CSV header has no title column")
+	}
+
+	var rows []importRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(column string) string {
+			i, ok := index[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		row := importRow{
+			Title:       field("title"),
+			Description: field("description"),
+			Column:      field("column"),
+			Priority:    field("priority"),
+			DueDate:     field("due_date"),
+		}
+		if row.Title == "" {
+			return nil, fmt.Errorf("line %d has no title", line)
+		}
+		for _, label := range strings.Split(field("labels"), ";") {
+			if label = strings.TrimSpace(label); label != "" {
+				row.Labels = append(row.Labels, label)
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// importParts splits rows into parts of at most size rows
+func importParts(rows []importRow, size int) [][]importRow {
+	var parts [][]importRow
+	for len(rows) > size {
+		parts = append(parts, rows[:size])
+		rows = rows[size:]
+	}
+	if len(rows) > 0 {
+		parts = append(parts, rows)
+	}
+	return parts
+}
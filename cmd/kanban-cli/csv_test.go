@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImportCSV(t *testing.T) {
+	input := "Labels,Title,Column,Extra\n" +
+		"bug; ui ,Fix login,To Do,ignored\n" +
+		"\n" +
+		",\"Write, docs\",Done\n"
+
+	rows, err := parseImportCSV(strings.NewReader(input))
+	require.NoError(t, err)
+
+	assert.Equal(t, []importRow{
+		{Title: "Fix login", Column: "To Do", Labels: []string{"bug", "ui"}},
+		{Title: "Write, docs", Column: "Done"},
+	}, rows)
+}
+
+func TestParseImportCSV_Errors(t *testing.T) {
+	_, err := parseImportCSV(strings.NewReader(""))
+	assert.Error(t, err)
+
+	// Без колонки title строки не импортировать
+	_, err = parseImportCSV(strings.NewReader("name,column\nTask,To Do\n"))
+	assert.EqualError(t, err, "the CSV header has no title column")
+
+	_, err = parseImportCSV(strings.NewReader("title,column\nTask,To Do\n,Done\n"))
+	assert.EqualError(t, err, "line 3 has no title")
+}
+
+func TestImportParts(t *testing.T) {
+	rows := make([]importRow, 5)
+
+	parts := importParts(rows, 2)
+	require.Len(t, parts, 3)
+	assert.Len(t, parts[0], 2)
+	assert.Len(t, parts[2], 1)
+
+	assert.Empty(t, importParts(nil, 2))
+}
@@ -0,0 +1,59 @@
+// Command kanban-cli is a command line client of the Kanban API for scripting and power users.
+//
+// It authenticates with the bearer token of a login, stored in the user's config directory, or
+// the token in KANBAN_TOKEN. KANBAN_URL sets the API address, http://localhost:8080 by default.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `Usage: kanban-cli <command> [flags]
+
+Commands:
+  login        -email EMAIL            Log in and store the token; reads the password from stdin
+  boards                               List the boards you own or are a member of
+  create-task  -column ID -title TITLE [-description TEXT] [-due YYYY-MM-DD]
+                                       Create a task at the end of a column
+  move-task    -column ID -position N TASK_ID
+                                       Move a task to a position (from 0) of a column
+  import       -board ID [-wait] FILE.csv
+                                       Import tasks from a CSV file with a header row of
+                                       title, description, column, priority, due_date, labels;
+                                       labels are separated by semicolons
+
+Environment:
+  KANBAN_URL     API address (default http://localhost:8080)
+  KANBAN_TOKEN   Bearer token to use instead of the stored login
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	commands := map[string]func(*Client, []string) error{
+		"login":       runLogin,
+		"boards":      runBoards,
+		"create-task": runCreateTask,
+		"move-task":   runMoveTask,
+		"import":      runImport,
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	client, err := NewClient()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "kanban-cli: %v\n", err)
+		os.Exit(1)
+	}
+	if err := run(client, os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "kanban-cli: %v\n", err)
+		os.Exit(1)
+	}
+}
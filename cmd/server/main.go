@@ -2,12 +2,15 @@ package main
 
 import (
 	"log"
+	"os"
 
 	_ "kanban/docs"
 	"kanban/internal/config"
 	"kanban/internal/server"
 )
 
+//go:generate go run github.com/swaggo/swag/cmd/swag@v1.16.4 init -d ../../ -g cmd/server/main.go -o ../../docs
+
 // @title           Kanban API
 // @version         1.0
 // @description     API for managing Kanban boards.
@@ -18,7 +21,7 @@ import (
 
 
 // @host      localhost:8080
-// @BasePath  /
+// @BasePath  /v1
 
 // @securityDefinitions.apikey BearerAuth
 // @in header
@@ -27,7 +30,35 @@ import (
 
 // @schemes http
 func main() {
-	cfg := config.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("❌ Failed to load configuration: %v", err)
+	}
+
+	// kanban migrate [up|down N|version|force V]
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := server.Migrate(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("❌ Migration failed: %v", err)
+		}
+		return
+	}
+
+	// kanban admin grant|revoke EMAIL
+	if len(os.Args) > 1 && os.Args[1] == "admin" {
+		if err := server.Admin(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("❌ Admin command failed: %v", err)
+		}
+		log.Printf("✅ Admin role of %s updated", os.Args[3])
+		return
+	}
+
+	// kanban seed FILE
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		if err := server.Seed(cfg, os.Args[2:]); err != nil {
+			log.Fatalf("❌ Seeding failed: %v", err)
+		}
+		return
+	}
 
 	s, err := server.Init(cfg)
 	if err != nil {
@@ -19,28 +19,28 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/boards": {
+        "/actions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all boards that the authenticated user owns or has access to",
+                "description": "Lists the current user's task deletions, moves and label removals that can still be undone, latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Boards"
+                    "Actions"
                 ],
-                "summary": "Get all accessible boards",
+                "summary": "List undoable actions",
                 "responses": {
                     "200": {
-                        "description": "List of boards",
+                        "description": "Undoable actions",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/handler.BoardResponse"
+                                "$ref": "#/definitions/handler.ActionResponse"
                             }
                         }
                     },
@@ -63,44 +63,162 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/actions/{id}/undo": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new Kanban board for the authenticated user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Reverts a task deletion, move or label removal of the current user made in the last 10 minutes.\nA deleted task comes back at its position with its labels and relations; a moved task goes back\nto where it was unless it was moved again since. Each action can be undone once.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Boards"
+                    "Actions"
                 ],
-                "summary": "Create a new board",
+                "summary": "Undo an action",
                 "parameters": [
                     {
-                        "description": "Board creation details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Action ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Action undone",
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateBoardRequest"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid action ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Action not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
+                    },
+                    "409": {
+                        "description": "Action already undone, expired or overtaken by later changes",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/audit-log": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Streams the changes made to the tasks of all boards in a time range, oldest first, as NDJSON (one JSON\nobject per line) or CSV with a header row. from and to are RFC 3339 times or dates in UTC; to is exclusive\nas a time and includes the whole day as a date, and defaults to now. Entries removed by the retention\npolicy are not included. A failure after the response started aborts the connection, so a truncated\nexport never ends like a complete one. Administrators only.",
+                "produces": [
+                    "application/x-ndjson",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Export the activity log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2026-01-01",
+                        "description": "Start of the range",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "2026-03-31",
+                        "description": "End of the range",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ndjson",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "ndjson",
+                        "description": "Output format",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Board created successfully",
+                    "200": {
+                        "description": "Activity log entries",
                         "schema": {
-                            "$ref": "#/definitions/handler.BoardResponse"
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid range or format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -118,7 +236,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Maximum number of boards reached",
+                        "description": "Administrator access required",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -138,24 +256,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{id}": {
-            "get": {
+        "/admin/boards/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific board by its ID if the authenticated user has access",
+                "description": "Deletes any board with all its columns, tasks and shares, whoever owns it. Administrators only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Boards"
+                    "Admin"
                 ],
-                "summary": "Get a board by ID",
+                "summary": "Delete a board",
                 "parameters": [
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Board ID",
                         "name": "id",
                         "in": "path",
@@ -164,13 +283,16 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Board details",
+                        "description": "Board deleted",
                         "schema": {
-                            "$ref": "#/definitions/handler.BoardResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid board ID format",
+                        "description": "Invalid board ID",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -188,7 +310,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Administrator access required",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -215,14 +337,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/boards/{id}/owner": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update a board's title and/or description if the authenticated user has permission",
+                "description": "Makes another user the owner of a board, regardless of the number of boards they own. The previous\nowner loses access unless keep_previous_owner is set, which makes them an editor. Workspace labels of\nthe previous owner used on the board become board labels. Administrators only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -230,36 +354,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Boards"
+                    "Admin"
                 ],
-                "summary": "Update a board",
+                "summary": "Reassign a board",
                 "parameters": [
                     {
                         "type": "string",
+                        "format": "uuid",
                         "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Board update details",
+                        "description": "New owner",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateBoardRequest"
+                            "$ref": "#/definitions/handler.ReassignBoardRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated board details",
+                        "description": "Reassigned board",
                         "schema": {
                             "$ref": "#/definitions/handler.BoardResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or board ID format",
+                        "description": "Invalid request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -277,7 +402,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Administrator access required",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -286,7 +411,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Board not found",
+                        "description": "Board or user not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -306,85 +431,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/boards/{id}/columns": {
+        "/admin/settings": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves all columns for the specified board, sorted by position",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the runtime settings in effect, the configured defaults and the overrides set by administrators.\nAdministrators only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Columns"
-                ],
-                "summary": "Get all columns for a board",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Bearer {token}",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Board ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Admin"
                 ],
+                "summary": "Get runtime settings",
                 "responses": {
                     "200": {
-                        "description": "Board columns",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.ColumnResponse"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid board ID",
+                        "description": "Settings",
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/handler.SettingsResponse"
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/boards/{id}/columns/reorder": {
-            "post": {
+        "/admin/settings/{key}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Changes the order of columns on a board",
+                "description": "Overrides a runtime setting without a restart. The body holds the fields that differ from the\nconfigured defaults, like {\"export\": {\"per_hour\": 10, \"burst\": 3}} for rate_limits or\n{\"boards\": 20} for limits; feature_flags maps flag names to whether they are enabled, and\ndefault_labels is the whole list of labels new boards start with, like [{\"name\": \"bug\", \"color\":\n\"#d73a4a\"}]. It replaces an earlier override of the setting. Other server instances apply it within their refresh interval.\nAdministrators only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -392,368 +498,410 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Columns"
+                    "Admin"
                 ],
-                "summary": "Reorder board columns",
+                "summary": "Override a runtime setting",
                 "parameters": [
                     {
+                        "enum": [
+                            "rate_limits",
+                            "limits",
+                            "feature_flags",
+                            "default_labels"
+                        ],
                         "type": "string",
-                        "description": "Bearer {token}",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Board ID",
-                        "name": "id",
+                        "description": "Setting",
+                        "name": "key",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Column reordering data",
-                        "name": "request",
+                        "description": "Overridden fields of the setting",
+                        "name": "value",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.ReorderColumnsRequest"
+                            "type": "object"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Settings",
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/handler.SettingsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Invalid value",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Unknown setting",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/boards/{id}/labels": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all labels for a specific board",
+                "description": "Removes the override of a runtime setting, returning it to the configured default. Administrators\nonly.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Admin"
                 ],
-                "summary": "Get board labels",
+                "summary": "Reset a runtime setting",
                 "parameters": [
                     {
+                        "enum": [
+                            "rate_limits",
+                            "limits",
+                            "feature_flags",
+                            "default_labels"
+                        ],
                         "type": "string",
-                        "description": "Board ID",
-                        "name": "id",
+                        "description": "Setting",
+                        "name": "key",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.LabelResponse"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid board ID",
+                        "description": "Settings",
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/handler.SettingsResponse"
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Board not found",
+                        "description": "Unknown setting",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/boards/{id}/share": {
+        "/admin/users": {
             "get": {
                 "security": [
                     {
-                        "ApiKeyAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get list of users with access to board (owner or at least viewer)",
+                "description": "Lists the users of the instance in order of registration, with the number of boards each owns and is a\nmember of. Administrators only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "board-sharing"
+                    "Admin"
                 ],
-                "summary": "Get board shares",
+                "summary": "List users",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Board ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Part of the email address or name",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Users per page, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Users to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.BoardShareResponse"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid board ID",
+                        "description": "Users",
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/handler.AdminUserListResponse"
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "No access rights",
-                        "schema": {
-                            "type": "object"
-                        }
-                    },
-                    "404": {
-                        "description": "Board not found",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/admin/users/{id}/disable": {
             "post": {
                 "security": [
                     {
-                        "ApiKeyAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Share board access with another user by email (owner only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Disables an account: the user can no longer sign in and their tokens stop working. Their boards and\nmemberships are kept. Administrators only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "board-sharing"
+                    "Admin"
                 ],
-                "summary": "Share board",
+                "summary": "Disable a user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Board ID",
+                        "format": "uuid",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Share data",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.ShareBoardRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "User disabled",
                         "schema": {
                             "type": "object",
-                            "properties": {
-                                "message": {
-                                    "type": "string"
-                                },
-                                "share": {
-                                    "$ref": "#/definitions/handler.BoardShareResponse"
-                                }
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid user ID or own account",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Not board owner",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Board or user not found",
+                        "description": "User not found",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/boards/{id}/share/{user_id}": {
-            "delete": {
+        "/admin/users/{id}/enable": {
+            "post": {
                 "security": [
                     {
-                        "ApiKeyAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Remove board access from user (owner only)",
+                "description": "Re-enables a disabled account. Administrators only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "board-sharing"
+                    "Admin"
                 ],
-                "summary": "Remove share",
+                "summary": "Enable a user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Board ID",
+                        "format": "uuid",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID to remove access",
-                        "name": "user_id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "User enabled",
                         "schema": {
                             "type": "object",
-                            "properties": {
-                                "message": {
-                                    "type": "string"
-                                }
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid ID format",
+                        "description": "Invalid user ID",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Not board owner",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Board not found",
+                        "description": "User not found",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/columns": {
-            "post": {
+        "/admin/users/{id}/limits": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new column on a board",
+                "description": "Sets the limits on boards, columns per board and tasks per column of a user, replacing earlier\noverrides. Omitted limits use the instance defaults; 0 removes a limit. Columns and tasks count against\nthe limits of the board owner. Administrators only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -761,90 +909,106 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Columns"
+                    "Admin"
                 ],
-                "summary": "Create a new column",
+                "summary": "Override the limits of a user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bearer {token}",
-                        "name": "Authorization",
-                        "in": "header",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Column creation data",
-                        "name": "request",
+                        "description": "Limit overrides",
+                        "name": "limits",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateColumnRequest"
+                            "$ref": "#/definitions/handler.UserLimitsRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created column",
+                    "200": {
+                        "description": "Limits updated",
                         "schema": {
-                            "$ref": "#/definitions/handler.ColumnResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Invalid user ID or limits",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Administrator access required",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object"
-                        }
-                    }
-                }
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
             }
         },
-        "/columns/{id}": {
-            "get": {
+        "/archived-tasks/{id}/restore": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a column by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Puts an archived task back into its column with its ID, number, labels, relations and history.\nLabels, swimlanes, sprints, assignees and related tasks deleted in the meantime are left out. A restored\ntask stays on the board, it is not archived again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Columns"
+                    "Tasks"
                 ],
-                "summary": "Get column by ID",
+                "summary": "Restore an archived task",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bearer {token}",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Column ID",
+                        "format": "uuid",
+                        "description": "Task ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -852,237 +1016,261 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Column data",
+                        "description": "Task restored",
                         "schema": {
-                            "$ref": "#/definitions/handler.ColumnResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid column ID",
+                        "description": "Invalid task ID format",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Permission denied or task limit of the column reached",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Column not found",
+                        "description": "Archived task not found",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Task already restored or its column no longer exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Task archive is not configured",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Updates a column's details",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            }
+        },
+        "/auth/oauth/{provider}": {
+            "get": {
+                "description": "Redirects to the provider's consent page to start the OAuth2 authorization code flow",
                 "tags": [
-                    "Columns"
+                    "Users"
                 ],
-                "summary": "Update a column",
+                "summary": "Start OAuth login",
                 "parameters": [
                     {
+                        "enum": [
+                            "google",
+                            "github"
+                        ],
                         "type": "string",
-                        "description": "Bearer {token}",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Column ID",
-                        "name": "id",
+                        "description": "OAuth provider",
+                        "name": "provider",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Column update data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.UpdateColumnRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Updated column",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ColumnResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request data",
-                        "schema": {
-                            "type": "object"
-                        }
-                    },
-                    "401": {
-                        "description": "Not authenticated",
-                        "schema": {
-                            "type": "object"
-                        }
-                    },
-                    "403": {
-                        "description": "Insufficient permissions",
-                        "schema": {
-                            "type": "object"
-                        }
+                    "307": {
+                        "description": "Redirect to the provider"
                     },
                     "404": {
-                        "description": "Column not found",
+                        "description": "Unknown or disabled provider",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Deletes a column by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/auth/oauth/{provider}/callback": {
+            "get": {
+                "description": "Handles the provider callback, creates or links the user by verified email and issues a JWT",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Columns"
+                    "Users"
                 ],
-                "summary": "Delete a column",
+                "summary": "Complete OAuth login",
                 "parameters": [
                     {
+                        "enum": [
+                            "google",
+                            "github"
+                        ],
                         "type": "string",
-                        "description": "Bearer {token}",
-                        "name": "Authorization",
-                        "in": "header",
+                        "description": "OAuth provider",
+                        "name": "provider",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OAuth state",
+                        "name": "state",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Login successful with auth token",
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/handler.AuthResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid column ID",
+                        "description": "Invalid OAuth state or code",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
-                        "description": "Not authenticated",
+                        "description": "Provider authentication failed",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Account is disabled",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Column not found",
+                        "description": "Unknown or disabled provider",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/columns/{id}/tasks": {
+        "/boards": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves all tasks for a specific column",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get all boards that the authenticated user owns or has access to, flagging the boards the user starred.\nWith starred=true only starred boards are returned. Boards come in the order the user set with\nPUT /me/board-order, followed by the boards left out of it: owned boards first, then shared ones.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Get tasks by column ID",
+                "summary": "Get all accessible boards",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Column ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Return only starred boards",
+                        "name": "starred",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of tasks in the column",
+                        "description": "List of boards",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/handler.TaskResponse"
+                                "$ref": "#/definitions/handler.BoardResponse"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid column ID format",
+                        "description": "Invalid starred filter",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1099,24 +1287,6 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "403": {
-                        "description": "Permission denied",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Column not found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
@@ -1127,16 +1297,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/labels": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new label for a board",
+                "description": "Create a new Kanban board for the authenticated user. The board starts with the default labels\nset by the administrators, except those named like one of the user's workspace labels.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1144,119 +1312,149 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Boards"
                 ],
-                "summary": "Create label",
+                "summary": "Create a new board",
                 "parameters": [
                     {
-                        "description": "Label data",
-                        "name": "input",
+                        "description": "Board creation details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CreateLabelRequest"
+                            "$ref": "#/definitions/handler.CreateBoardRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created",
+                        "description": "Board created successfully",
                         "schema": {
-                            "$ref": "#/definitions/handler.LabelResponse"
+                            "$ref": "#/definitions/handler.BoardResponse"
                         }
                     },
                     "400": {
                         "description": "Invalid request",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
-                        "schema": {
-                            "type": "object"
-                        }
-                    },
-                    "404": {
-                        "description": "Board not found",
+                        "description": "Maximum number of boards reached",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/labels/{id}": {
+        "/boards/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific label by its ID",
+                "description": "Get a specific board by its ID if the authenticated user has access, together with the user's saved board view",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Boards"
                 ],
-                "summary": "Get label by ID",
+                "summary": "Get a board by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Board details",
                         "schema": {
-                            "$ref": "#/definitions/handler.LabelResponse"
+                            "$ref": "#/definitions/handler.BoardResponse"
                         }
                     },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
                     "400": {
-                        "description": "Invalid label ID",
+                        "description": "Invalid board ID format",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Permission denied",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Label not found",
+                        "description": "Board not found",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
@@ -1267,7 +1465,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing label",
+                "description": "Update a board's title and/or description if the authenticated user has permission",
                 "consumes": [
                     "application/json"
                 ],
@@ -1275,62 +1473,86 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Boards"
                 ],
-                "summary": "Update label",
+                "summary": "Update a board",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated label data",
-                        "name": "input",
+                        "description": "Board update details",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UpdateLabelRequest"
+                            "$ref": "#/definitions/handler.UpdateBoardRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Updated board details",
                         "schema": {
-                            "$ref": "#/definitions/handler.LabelResponse"
+                            "$ref": "#/definitions/handler.BoardResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid request or board ID format",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Permission denied",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Label not found",
+                        "description": "Board not found",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
@@ -1341,182 +1563,174 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing label",
+                "description": "Deletes a board with all its columns, tasks, labels, swimlanes, sprints, shares and links in a single\ntransaction. With transfer_to the board is handed over to one of its editors instead, who becomes the\nowner; workspace labels used on it are copied to the board and the previous owner loses access.\nOnly the owner can delete or transfer a board.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Boards"
                 ],
-                "summary": "Delete label",
+                "summary": "Delete a board",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "format": "uuid",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Editor to transfer the board to instead of deleting it",
+                        "name": "transfer_to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Board deleted or transferred",
                         "schema": {
                             "type": "object",
-                            "properties": {
-                                "message": {
-                                    "type": "string"
-                                }
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid label ID",
+                        "description": "Invalid board ID or new owner",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Not the owner of the board",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Label not found",
+                        "description": "Board not found",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "New owner has reached the board limit",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/labels/{id}/tasks": {
+        "/boards/{id}/analytics": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all tasks that have a specific label",
+                "description": "Returns flow metrics of a board over the last weeks: lead time (task created to completed) and\ncycle time (task left the first column to completed) of completed tasks, throughput per week and\nthe number of tasks per column at the end of every day for a cumulative flow diagram. Days are UTC.\nFinished days are read from nightly roll-ups, which also provide the daily activity of the board.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Labels"
+                    "Boards"
                 ],
-                "summary": "Get tasks with label",
+                "summary": "Get board flow analytics",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Label ID",
+                        "format": "uuid",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of weeks to cover (default 12, max 52)",
+                        "name": "weeks",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Board analytics",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "object",
-                                "properties": {
-                                    "column_id": {
-                                        "type": "string"
-                                    },
-                                    "description": {
-                                        "type": "string"
-                                    },
-                                    "id": {
-                                        "type": "string"
-                                    },
-                                    "title": {
-                                        "type": "string"
-                                    }
-                                }
-                            }
+                            "$ref": "#/definitions/handler.BoardAnalyticsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid label ID",
+                        "description": "Invalid board ID format",
                         "schema": {
-                            "type": "object"
-                        }
-                    },
-                    "401": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "403": {
-                        "description": "Insufficient permissions",
+                        "description": "Permission denied",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Label not found",
-                        "schema": {
-                            "type": "object"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "type": "object"
-                        }
-                    }
-                }
-            }
-        },
-        "/login": {
-            "post": {
-                "description": "Log in with email and password to receive an authentication token",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "Authenticate a user",
-                "parameters": [
-                    {
-                        "description": "User login credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.LoginRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Login successful with auth token",
-                        "schema": {
-                            "$ref": "#/definitions/handler.AuthResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1524,13 +1738,11 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "Invalid credentials",
+                    "429": {
+                        "description": "Operation rate limit exceeded",
                         "schema": {
                             "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "additionalProperties": true
                         }
                     },
                     "500": {
@@ -1545,79 +1757,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/me/shared-boards": {
+        "/boards/{id}/archived-tasks": {
             "get": {
                 "security": [
                     {
-                        "ApiKeyAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get list of boards shared with current user",
+                "description": "Returns the tasks of the board moved to the task archive, by number. Tasks completed more than\nTASK_ARCHIVE_AFTER_DAYS days ago are archived with their labels, relations and history, and can be\nrestored from the archive.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "board-sharing"
+                    "Tasks"
+                ],
+                "summary": "List archived tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get shared boards",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Archived tasks",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/handler.BoardResponse"
+                                "$ref": "#/definitions/handler.ArchivedTaskResponse"
                             }
                         }
                     },
-                    "401": {
-                        "description": "Not authenticated",
+                    "400": {
+                        "description": "Invalid board ID format",
                         "schema": {
-                            "type": "object"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "type": "object"
-                        }
-                    }
-                }
-            }
-        },
-        "/register": {
-            "post": {
-                "description": "Create a new user account and return authentication token",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
-                    {
-                        "description": "User registration details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.RegisterRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "User created successfully with auth token",
+                    "401": {
+                        "description": "Not authenticated",
                         "schema": {
-                            "$ref": "#/definitions/handler.AuthResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Invalid request",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1625,8 +1819,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "User with this email already exists",
+                    "404": {
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1646,44 +1840,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates a new task with the given details",
-                "consumes": [
-                    "application/json"
-                ],
+        "/boards/{id}/calendar.ics": {
+            "get": {
+                "description": "Returns the open tasks of a board that have a due date as all-day iCal events. The feed is\nauthenticated by the calendar token of a user who can view the board.",
                 "produces": [
-                    "application/json"
+                    "text/calendar"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Calendar"
                 ],
-                "summary": "Create a new task",
+                "summary": "Get board calendar feed",
                 "parameters": [
                     {
-                        "description": "Task information",
-                        "name": "task",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.TaskRequest"
-                        }
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Calendar feed token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Task created successfully",
+                    "200": {
+                        "description": "iCalendar feed",
                         "schema": {
-                            "$ref": "#/definitions/handler.TaskResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Invalid board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1692,7 +1884,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Not authenticated",
+                        "description": "Invalid calendar token",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1710,7 +1902,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Column not found",
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1730,29 +1922,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{id}": {
+        "/boards/{id}/card-layout": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves a task by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the fields shown on the task cards of a board, in display order. The layout is chosen per board\nso that every client renders cards the same way; boards without one use the default layout.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Get task by ID",
+                "summary": "Get board card layout",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1760,13 +1948,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task details",
+                        "description": "Card layout",
                         "schema": {
-                            "$ref": "#/definitions/handler.TaskResponse"
+                            "$ref": "#/definitions/handler.CardLayoutResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID format",
+                        "description": "Invalid board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1793,7 +1981,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1818,7 +2006,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Updates an existing task with new details",
+                "description": "Set the fields shown on the task cards of a board for all its members. Fields are shown in the given\norder and may not repeat; null fields restore the default layout.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1826,37 +2014,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Update a task",
+                "summary": "Update board card layout",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated task information",
-                        "name": "task",
+                        "description": "Card layout",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.TaskRequest"
+                            "$ref": "#/definitions/handler.CardLayoutRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task updated successfully",
+                        "description": "Updated card layout",
                         "schema": {
-                            "$ref": "#/definitions/handler.TaskResponse"
+                            "$ref": "#/definitions/handler.CardLayoutResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or task ID format",
+                        "description": "Invalid request or board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1883,7 +2070,16 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task or column not found",
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1901,46 +2097,49 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/boards/{id}/changes": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a task by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the columns, tasks, labels and shares of a board that changed since the given point, so polling\nclients can stay in sync without reloading the whole board. since is the cursor of the previous response,\nor an RFC 3339 time to start from; changes are kept for a limited time, after which clients get 410 and\nshould reload the board. Only cursors are exact: a time may miss changes of writes running at that time.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Delete a task",
+                "summary": "Get board changes",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor of a previous response or an RFC 3339 time",
+                        "name": "since",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task deleted successfully",
+                        "description": "Changes since the given point",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.BoardChangesResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID format",
+                        "description": "Invalid board ID format or since",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1967,7 +2166,16 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Changes since then are no longer available",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1987,14 +2195,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{id}/assign": {
-            "post": {
+        "/boards/{id}/columns": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Assigns a user to a specific task",
+                "description": "Retrieves all columns for the specified board, sorted by position, each with a summary of its tasks:\nhow many there are, how many are overdue and their total estimate.\nWith group_by=swimlane each column also counts its tasks per swimlane.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2002,92 +2210,79 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Columns"
                 ],
-                "summary": "Assign user to task",
+                "summary": "Get all columns for a board",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "User assignment information",
-                        "name": "assignment",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.TaskAssignRequest"
-                        }
+                        "enum": [
+                            "swimlane"
+                        ],
+                        "type": "string",
+                        "description": "Count tasks per swimlane",
+                        "name": "group_by",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User assigned to task successfully",
+                        "description": "Board columns",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.ColumnResponse"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid request or task ID format",
+                        "description": "Invalid board ID or grouping",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Task or user not found",
+                        "description": "Insufficient permissions",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/boards/{id}/columns/reorder": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes the assigned user from a task",
+                "description": "Changes the order of columns on a board. The request must list every column of the board exactly once\nwith distinct positions; positions are renumbered from 1 and the columns are returned in their new order.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2095,85 +2290,85 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Columns"
                 ],
-                "summary": "Unassign user from task",
+                "summary": "Reorder board columns",
                 "parameters": [
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Column reordering data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReorderColumnsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User unassigned from task successfully",
+                        "description": "Columns in their new order",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.ColumnResponse"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID format",
+                        "description": "Invalid request data",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "401": {
                         "description": "Not authenticated",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Insufficient permissions",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
-                    "404": {
-                        "description": "Task not found",
+                    "423": {
+                        "description": "Board is frozen",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "object"
                         }
                     }
                 }
             }
         },
-        "/tasks/{id}/due-date": {
+        "/boards/{id}/duplicate": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Sets or updates the due date for a task",
+                "description": "Starts copying a board with its columns, labels and optionally its tasks into a new board owned by the\nauthenticated user. Tasks can be copied without assignees or due dates. The copy is written in a single\ntransaction in the background; poll the returned operation for progress.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2181,37 +2376,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Set task due date",
+                "summary": "Duplicate a board",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Due date information",
-                        "name": "due_date",
+                        "description": "Copy settings",
+                        "name": "board",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.SetDueDateRequest"
+                            "$ref": "#/definitions/handler.DuplicateBoardRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Due date updated successfully",
+                    "202": {
+                        "description": "Duplication started",
                         "schema": {
-                            "$ref": "#/definitions/handler.TaskResponse"
+                            "$ref": "#/definitions/handler.OperationResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or task ID format",
+                        "description": "Invalid request or board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2229,7 +2423,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Permission denied or board limit reached",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2238,7 +2432,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2246,6 +2440,13 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
@@ -2254,33 +2455,39 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "503": {
+                        "description": "Too many operations in progress",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/tasks/{id}/labels": {
+        "/boards/{id}/estimates": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves all labels associated with a specific task",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Sums up the story point estimates of the board's tasks per column and per assignee, for planning\nwithout exporting to a spreadsheet. Each sum also counts the tasks with and without an estimate and\nthe estimate still open, that is of the tasks not completed yet.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Get task labels",
+                "summary": "Get estimate rollups of a board",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2288,16 +2495,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of labels associated with the task",
+                        "description": "Estimate rollups",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.LabelResponse"
-                            }
+                            "$ref": "#/definitions/handler.EstimateRollupResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid task ID format",
+                        "description": "Invalid board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2324,7 +2528,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2332,6 +2536,13 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Server error",
                         "schema": {
@@ -2344,54 +2555,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{id}/labels/{label_id}": {
-            "post": {
+        "/boards/{id}/events": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Adds a label to a specific task",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Streams the changes of a board as server-sent events, so clients stay in sync without polling. Each\n\"changes\" event holds a BoardChangesResponse as JSON and carries its cursor as the event ID; task locks\nare listed again whenever one is taken, released or expires, so clients need not track expiry. The stream\nstarts at the Last-Event-ID header, the since parameter or, without either, now. Streams end after a\nfew minutes, when access to the board is lost or when the server shuts down; clients reconnect with\nthe last event ID, which EventSource does itself. A stream of expired changes is refused with 410\nand the board should be reloaded.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Add label to task",
+                "summary": "Stream board changes",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "description": "Label ID",
-                        "name": "label_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Cursor of a previous response or an RFC 3339 time",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Label added to task successfully",
+                        "description": "Stream of changes events",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.BoardChangesResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid task or label ID format",
+                        "description": "Invalid board ID format or since",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2418,7 +2627,16 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Changes since then are no longer available",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2436,14 +2654,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/boards/{id}/freeze": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes a label from a specific task",
+                "description": "Freezes the board, e.g. during a retrospective or a migration, or unfreezes it. While a board is frozen,\nevery change to its columns, tasks, labels, swimlanes and sprints is rejected with 423 Locked, also for\nthe owner. Members can still view the board, and its sharing and personal views keep working. Only the\nboard owner can freeze a board.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2451,30 +2671,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Boards"
                 ],
-                "summary": "Remove label from task",
+                "summary": "Freeze or unfreeze a board",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "Label ID",
-                        "name": "label_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Whether the board is frozen",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.FreezeBoardRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Label removed from task successfully",
+                        "description": "Board",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not the owner of the board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2482,8 +2736,70 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/full": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the board, its columns and its tasks grouped by swimlane and column, in board order.\nTasks without a swimlane are returned in the default lane, which comes last. The board's card\nlayout tells clients which task fields to show on cards. Columns carry the requesting user's\ncollapsed flags and a summary of their tasks.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Swimlanes"
+                ],
+                "summary": "Get a board with all its tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Also return task descriptions as sanitized HTML",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board with tasks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.FullBoardResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
                     "400": {
-                        "description": "Invalid task or label ID format",
+                        "description": "Invalid board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2510,7 +2826,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task not found",
+                        "description": "Board not found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2530,14 +2846,92 @@ const docTemplate = `{
                 }
             }
         },
-        "/tasks/{id}/move": {
-            "post": {
+        "/boards/{id}/github": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the GitHub integration of a board. Only the board owner can manage it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GitHub"
+                ],
+                "summary": "Get GitHub integration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "GitHub integration",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GitHubIntegrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found or not connected to GitHub",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Moves a task to a different column and/or position",
+                "description": "Connects the board to GitHub or updates the integration. Add the returned webhook path as a webhook of the\nrepository with the returned secret, content type application/json and the push and pull request events.\nCommits and pull requests mentioning task keys of the board (e.g. PROJ-12) in their message, title,\ndescription or branch name are linked to the tasks. Pull requests opened for review move their tasks to\nthe review column and merged ones to the done column, if set. The secret is only returned on creation\nand rotation.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2545,40 +2939,115 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "GitHub"
                 ],
-                "summary": "Move a task",
+                "summary": "Connect a board to GitHub",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "uuid",
-                        "description": "Task ID",
+                        "description": "Board ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Task move information",
-                        "name": "move",
+                        "description": "Integration settings",
+                        "name": "integration",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.TaskMoveRequest"
+                            "$ref": "#/definitions/handler.GitHubIntegrationRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Task moved successfully",
+                        "description": "Integration updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GitHubIntegrationResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Board connected",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GitHubIntegrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or column",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the GitHub integration of a board; further deliveries are rejected. Links already made are kept.",
+                "tags": [
+                    "GitHub"
+                ],
+                "summary": "Disconnect a board from GitHub",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Board disconnected"
                     },
                     "400": {
-                        "description": "Invalid request or task ID format",
+                        "description": "Invalid board ID format",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2605,7 +3074,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Task or column not found",
+                        "description": "Board not found or not connected to GitHub",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -2624,23 +3093,13558 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handler.AuthResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/handler.UserDetails"
-                }
-            }
         },
-        "handler.BoardResponse": {
-            "type": "object",
-            "properties": {
+        "/boards/{id}/guest-links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the guest editor links of a board with their sessions (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "List guest links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Guest links",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.GuestLinkResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a guest editor link: anyone who opens it can edit the tasks and columns of the board without\nan account, until the link expires or is revoked. Changes are made by a guest user with the given\nname, which becomes an editor of the board (owner only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Create guest link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link settings",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateGuestLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Guest link created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GuestLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/guest-links/{link_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Disables a guest editor link. The guest loses access to the board and their sessions end at once;\ntheir changes are kept (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Revoke guest link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Guest link ID",
+                        "name": "link_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Guest link revoked",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board or guest link not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/imports": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts a chunked import of tasks into a board. Upload the tasks in numbered parts of up to 1000 tasks,\nthen commit the import to create them in the background. Parts are validated as they are uploaded and\ncan be re-uploaded until the import is committed, so an interrupted upload can be resumed. An import\nmust be committed within 24 hours; a user can have up to 3 open imports.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Imports"
+                ],
+                "summary": "Start a task import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Import started",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too many open imports",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all labels usable on a board, including workspace labels of the board owner.\nBoard labels sharing a name with a workspace label are hidden.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Get board labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.LabelResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/labels/copy": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy the labels of a board the user owns to the board. Labels named like a label the board already\nhas, ignoring case, are skipped; so are the source board's workspace labels, which both boards share\nwhen they have the same owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Copy labels from another board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Board to copy the labels of",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CopyLabelsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CopyLabelsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/leave": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove your own access to a board shared with you. You are unassigned from its tasks, and your pins of\nits tasks, your saved view, your preferences, notification settings and views of the board are removed.\nThe board is no longer your default or last opened board. The owner can't leave a board; they delete or\ntransfer it instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Leave board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID, or the user owns the board or is not a member",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Appends the columns of the source board with their tasks to this board, maps labels by name\n(creating missing ones), gives members of the source board the same access here and deletes the\nsource board. The merge runs in the background as an operation; with dry_run it is previewed\ninstead. The caller must own both boards.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Merge a board into another",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Target board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Board to merge",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MergeBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Preview of the merge (dry run)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RestructurePreviewResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Merge started",
+                        "schema": {
+                            "$ref": "#/definitions/handler.OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Too many operations in progress",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/quick-add": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a task from a single line of text such as \"Fix login bug #backend @anna !high due:fri\".\n#name adds the board label of that name, @handle assigns the board member with that username or\nemail, !low, !medium, !high and !urgent set the priority and due: takes today, tomorrow, a weekday,\n+Nd or a YYYY-MM-DD date (UTC). The rest of the line is the title. Labels and members that are not\nfound are reported as unmatched instead of failing the request.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Quick-add a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quick-add line",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.QuickAddRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.QuickAddResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, due date or empty title",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board or column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/read-receipts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns how many of the board's viewers and commenters have opened the board and each column since it\nlast changed. Only counts are reported, never who opened the board. Requires editor access.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Read receipts"
+                ],
+                "summary": "Get board read receipts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Read receipts",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReadReceiptsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turns read receipts of the board on or off. Turning them off forgets all recorded views of the board.\nOnly the board owner can change this setting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Read receipts"
+                ],
+                "summary": "Turn board read receipts on or off",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Read receipt settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReadReceiptSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Read receipts",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReadReceiptsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renders a printable report of a board for stakeholders: task counts, the overdue tasks and the\ntasks of each column. The report is an HTML page, or with format=pdf a PDF attachment rendered by\nthe configured rendering service.",
+                "produces": [
+                    "text/html",
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Get board report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html",
+                            "pdf"
+                        ],
+                        "type": "string",
+                        "description": "Report format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board report",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID or format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "501": {
+                        "description": "PDF reports are not configured",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "502": {
+                        "description": "Rendering service failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/share": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get list of users with access to board (owner or at least viewer)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Get board shares",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.BoardShareResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "No access rights",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Share board access with another user by email (owner only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Share board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Share data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ShareBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                },
+                                "share": {
+                                    "$ref": "#/definitions/handler.BoardShareResponse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board or user not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/share-links": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the invitation links of a board with their usage (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "List share links",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Share links",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.ShareLinkResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an invitation link granting the chosen role, comment-only access by default, to any\nauthenticated user who opens it, until it has been used max_uses times or expires (owner only).\nLinks granting edit access should be given few uses and a short expiry.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Create share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link settings",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateShareLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Share link created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ShareLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/share-links/{link_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Disables an invitation link. Access already granted through it is kept (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Revoke share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Share link ID",
+                        "name": "link_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Share link revoked",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board or share link not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/share/{user_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove board access from user (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Remove share",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID to remove access",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/split": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves the selected columns with their tasks, and selected tasks of other columns, into a new board\nowned by the authenticated user. Tasks of other columns land in a column of the same name. Members of\nthe board get the same access to the new board. The split runs in the background as an operation;\nwith dry_run it is previewed instead (owner only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Split a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Selection to move",
+                        "name": "split",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SplitBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Preview of the split (dry run)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RestructurePreviewResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Split started",
+                        "schema": {
+                            "$ref": "#/definitions/handler.OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or selection",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or board limit reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Too many operations in progress",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/sprints": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the sprints of a board, latest first, with the totals of their tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "List board sprints",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board sprints",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.SprintResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a sprint to a board. Sprints may last up to 90 days.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Add a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sprint information",
+                        "name": "sprint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateSprintRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Sprint created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/standup": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Summarizes a board for a standup: tasks completed the day before, in-progress tasks per assignee\n(open tasks outside the first and the done columns) and blocked tasks.\nWith format=text the summary is returned as plain text for chat bots.",
+                "produces": [
+                    "application/json",
+                    "text/plain"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Get daily standup summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Standup day in YYYY-MM-DD (UTC), defaults to today",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "text"
+                        ],
+                        "type": "string",
+                        "description": "Response format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Standup summary",
+                        "schema": {
+                            "$ref": "#/definitions/handler.StandupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID or date",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/star": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stars a board for the authenticated user so it can be found among many boards; other members are not affected",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Star a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board starred successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the authenticated user's star from a board",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Unstar a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board unstarred successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/status-page": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the settings of the board's public status page (owner only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Status pages"
+                ],
+                "summary": "Get board status page settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status page settings",
+                        "schema": {
+                            "$ref": "#/definitions/handler.StatusPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found or status page not published",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publishes the tasks of the selected columns as a read-only status page that anyone with its link\ncan open without signing in, or updates the title and columns of the published page. The page\nshows task keys, titles, labels, due and completion dates only (owner only).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Status pages"
+                ],
+                "summary": "Publish board status page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Status page settings",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.StatusPageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status page updated",
+                        "schema": {
+                            "$ref": "#/definitions/handler.StatusPageResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Status page published",
+                        "schema": {
+                            "$ref": "#/definitions/handler.StatusPageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or column of another board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the board's public status page; its link stops working (owner only)",
+                "tags": [
+                    "Status pages"
+                ],
+                "summary": "Unpublish board status page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Status page removed"
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Not board owner",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found or status page not published",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/swimlanes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the swimlanes of a board ordered by position",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Swimlanes"
+                ],
+                "summary": "List board swimlanes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board swimlanes",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.SwimlaneResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a swimlane after the last swimlane of the board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Swimlanes"
+                ],
+                "summary": "Add a swimlane",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Swimlane information",
+                        "name": "swimlane",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateSwimlaneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Swimlane created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SwimlaneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or too many swimlanes",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/task-templates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the task templates of a board ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task templates"
+                ],
+                "summary": "List board task templates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board task templates",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.TaskTemplateResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a task template to a board. Labels must be available on the board. A board can have up to 50 templates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task templates"
+                ],
+                "summary": "Create a task template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task template created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input, unknown label or too many templates",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/tasks/by-number/{num}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a task of a board by its per-board number, given alone (123) or as the task key (PROJ-123),\nwith the same details as getting it by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get task by number",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task number or key",
+                        "name": "num",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Also return task descriptions as sanitized HTML",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task details",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
+                    "400": {
+                        "description": "Invalid board ID or task number",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board or task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/view": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the authenticated user's saved sorting, filtering and grouping of a board, or the defaults if none was saved",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Get board view",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board view",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardViewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Save the authenticated user's sorting, filtering and grouping of a board. Filters are stored as given and must be a JSON object.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Save board view",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Board view settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardViewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved board view",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardViewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete the authenticated user's saved view of a board so the defaults apply again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Reset board view",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Default board view",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardViewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/boards/{id}/views": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records that the authenticated user opened the board and saw the given columns, or all of them. Views\nonly count for viewers and commenters on boards with read receipts turned on, and never for users who\nopted out; other calls succeed without recording anything.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Read receipts"
+                ],
+                "summary": "Record a board view",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Columns seen",
+                        "name": "view",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ViewEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "View recorded"
+                    },
+                    "400": {
+                        "description": "Invalid request or board ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bootstrap": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user profile, preferences, feature flags and the boards the user can access with\ntheir sizes in a single call, together with the board to open first. A board_id from a deep link\nis opened when accessible; otherwise deep_link_denied is set and the usual fallback applies.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Bootstrap the client",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Deep-linked board ID",
+                        "name": "board_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bootstrap data",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BootstrapResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/columns": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new column on a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Create a new column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Column creation data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateColumnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created column",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ColumnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions or column limit reached",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a column by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Get column by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Column data",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ColumnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid column ID",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates a column's details. Marking a column as done completes the tasks in it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Update a column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Column update data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateColumnRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated column",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ColumnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a column by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Delete a column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid column ID",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns/{id}/collapsed": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Collapses or expands a column for the authenticated user only; other members keep their own setting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Collapse or expand a column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Collapsed flag",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetColumnCollapsedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Column with the new flag",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ColumnResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns/{id}/copy-to-board": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copies a column with all its tasks to a board the user can edit, which may be the column's own board. The\nuser needs view access to the column's board. Copied tasks get new numbers on the target board and keep\ntheir labels, remapped by name to the target board's labels like when moving a column; their comments,\nrelations and sprints are not copied. Without a position the copy is added after the last column.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Copy a column to a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target board and position",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CopyColumnToBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Copied column",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CopyColumnToBoardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions or column or task limit reached",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Column or board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns/{id}/move-to-board": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a column with all its tasks to another board the user can edit, e.g. when splitting a board.\nTasks get new numbers on the target board. Labels are remapped by name to the target board's labels,\nwhich are created when missing. Tasks keep their swimlane when the target board has one with the same\ntitle and move to the default lane otherwise. Relations to tasks left behind are removed and assignees\nwithout access to the target board are unassigned. Without a position the column is added after the last one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Columns"
+                ],
+                "summary": "Move a column to another board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target board and position",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MoveColumnToBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Moved column",
+                        "schema": {
+                            "$ref": "#/definitions/handler.MoveColumnToBoardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions or column limit reached",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Column or board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/columns/{id}/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves all tasks for a specific column",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get tasks by column ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "position",
+                            "priority"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, priority sorts most important first",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated priorities to include, e.g. high,urgent",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only tasks of this swimlane, or none for tasks without a swimlane",
+                        "name": "swimlane_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Also return task descriptions as sanitized HTML",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tasks in the column",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.TaskResponse"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
+                    "400": {
+                        "description": "Invalid column ID format, sort, priority or swimlane",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/columns/{id}/tasks/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates tasks at the end of a column from a CSV file of up to 1000 tasks. The first line names the\ncolumns: title (required), description, due_date (YYYY-MM-DD), priority, labels (comma-separated\nlabel names) and assignee (email of a board member). With dry_run the rows are only validated.\nOtherwise all tasks are created in one transaction, or none when a row is invalid. Row errors give\nthe line of the file, the header being line 1.",
+                "consumes": [
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Imports"
+                ],
+                "summary": "Import tasks from CSV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Column ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the rows",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Validation report (dry run)",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CSVImportResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Tasks created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CSVImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid file or rows",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/guest-links/{token}/session": {
+            "post": {
+                "description": "Starts a guest session through a guest editor link. The returned token authenticates the guest for\nthe board's tasks, columns and labels until the session or the link expires, whichever is first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Open guest link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guest link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Guest session started",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GuestSessionResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Guest link not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Guest link revoked or expired",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns an import with the parts uploaded and not imported yet. A client resuming an interrupted upload\nre-uploads the parts missing from the list; after a failed run, committing again imports the parts left.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Imports"
+                ],
+                "summary": "Get a task import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid import ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Import not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Discards an import and its parts that were not imported. Tasks already imported are kept.",
+                "tags": [
+                    "Imports"
+                ],
+                "summary": "Delete a task import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Import deleted"
+                    },
+                    "400": {
+                        "description": "Invalid import ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Import not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Import is being imported",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/{id}/commit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts creating the uploaded tasks at the end of their columns as a background operation; poll the\nreturned operation for progress. Parts must be numbered 1..N without gaps. Each part is imported in\nits own transaction, so if the operation fails, committing the import again imports only the parts left.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Imports"
+                ],
+                "summary": "Commit a task import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Import started",
+                        "schema": {
+                            "$ref": "#/definitions/handler.OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid import ID format, no parts or missing parts",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Import not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Import already committed or expired",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "Too many operations in progress",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/imports/{id}/parts/{number}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Validates and stores a part of up to 1000 tasks. Columns and labels are matched by name, ignoring\ncase. A part is rejected as a whole when any of its tasks is invalid, listing the errors by row.\nUploading a part again replaces it, so a part whose response was lost can simply be retried.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Imports"
+                ],
+                "summary": "Upload a part of a task import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Part number, from 1",
+                        "name": "number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tasks of the part",
+                        "name": "part",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ImportPartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Part stored",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ImportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid part or tasks",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Import not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Import already committed or expired",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Part too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/labels": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new label for a board",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Create label",
+                "parameters": [
+                    {
+                        "description": "Label data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateLabelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LabelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "409": {
+                        "description": "Workspace label with the same name exists",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/labels/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific label by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Get label by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LabelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid label ID",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing label",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Update label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated label data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateLabelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LabelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "409": {
+                        "description": "Workspace label with the same name exists",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an existing label",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Delete label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid label ID",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/labels/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a label to the target label on every task and task template that has it, then deletes it, in one\ntransaction. Use it to clean up near-duplicates such as \"bug\", \"Bug\" and \"bugs\". The target must be\nusable wherever the label is: a board label merges into a label of the same board or a workspace label\nof the board owner, a workspace label into another workspace label of the same owner.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Merge labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the label to merge and delete",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Label to merge into",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MergeLabelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.MergeLabelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid label ID or target",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/labels/{id}/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all tasks that have a specific label",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Get tasks with label",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "position",
+                            "priority"
+                        ],
+                        "type": "string",
+                        "description": "Sort order, priority sorts most important first",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated priorities to include, e.g. high,urgent",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only tasks of this swimlane, or none for tasks without a swimlane",
+                        "name": "swimlane_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "object",
+                                "properties": {
+                                    "column_id": {
+                                        "type": "string"
+                                    },
+                                    "description": {
+                                        "type": "string"
+                                    },
+                                    "id": {
+                                        "type": "string"
+                                    },
+                                    "priority": {
+                                        "type": "string"
+                                    },
+                                    "title": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid label ID, sort, priority or swimlane",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient permissions",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "404": {
+                        "description": "Label not found",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the limits on boards per user, columns per board and tasks per column that apply to the\nauthenticated user, with the number of boards the user owns. With board_id, the column and task\ncounts of that board are returned against its owner's limits.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Limits"
+                ],
+                "summary": "Get limits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "board_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Limits and usage",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LimitsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "No access to the board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Log in with email and password to receive an authentication token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Authenticate a user",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful with auth token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Account is disabled",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete the authenticated account. Boards listed in transfer_boards are handed over to one of\ntheir editors, who becomes the owner; workspace labels used on them are copied to the board. All other owned\nboards are deleted with their content. Tasks the user created on remaining boards are credited to the board\nowner and tasks assigned to the user become unassigned. Shares, pins, board views and linked sign-in\nproviders are removed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete account",
+                "parameters": [
+                    {
+                        "description": "Deletion confirmation and board transfers",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Account deleted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DeleteAccountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or transfer",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated or wrong password",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "New owner has reached the board limit",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/board-order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the order in which GET /boards lists the boards of the authenticated user, replacing the previous one.\nBoards left out are listed after the ordered ones; an empty list restores the default order. Other\nmembers are not affected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Boards"
+                ],
+                "summary": "Order the boards list",
+                "parameters": [
+                    {
+                        "description": "Boards in order",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Saved order",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardOrderRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or a board listed twice",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "No access to a board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/calendar-token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates the secret token that authenticates the user's iCal feed URLs, so calendar apps can\nsubscribe to them without logging in. An existing token is replaced and its URLs stop working.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Create calendar feed token",
+                "responses": {
+                    "201": {
+                        "description": "Calendar feed token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CalendarTokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Disables the user's calendar feed URLs",
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Revoke calendar feed token",
+                "responses": {
+                    "204": {
+                        "description": "Calendar feeds disabled"
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/calendar.ics": {
+            "get": {
+                "description": "Returns the open tasks assigned to the user on any board that have a due date as all-day iCal\nevents. The feed is authenticated by the user's calendar token.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Get my tasks calendar feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Calendar feed token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar feed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid calendar token",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/export": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download a zip archive with all data stored about the authenticated user: profile, owned boards with their content,\ntasks created by or assigned to the user, shared boards, workspace labels, pinned tasks and board views",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Export personal data",
+                "responses": {
+                    "200": {
+                        "description": "Data archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Operation rate limit exceeded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/mentions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists mentions of the authenticated user by other board members, newest first.\nMentions on boards the user can no longer access, has muted or gets no in-app mention\nnotifications from are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mentions"
+                ],
+                "summary": "List my mentions",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only mentions that have not been read",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of mentions (default and max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mentions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.MentionNotificationResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/mentions/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a mention of the authenticated user as read",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mentions"
+                ],
+                "summary": "Mark a mention as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Mention ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Mention marked as read",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid mention ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Mention not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notification-settings": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the channels (in_app, email) that deliver each event type to the authenticated user by default and\non the boards with settings of their own, the muted boards and the frequency of the email digest.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Get my notification settings",
+                "responses": {
+                    "200": {
+                        "description": "Notification settings",
+                        "schema": {
+                            "$ref": "#/definitions/handler.NotificationSettingsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the notification settings of the authenticated user: the channels of each event type by default,\nper board overrides and mutes, and the email digest. Daily digests are sent every day and weekly digests\nevery Monday, at the hour configured on the server. Boards must be accessible to the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Update my notification settings",
+                "parameters": [
+                    {
+                        "description": "Notification settings",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.NotificationSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated notification settings",
+                        "schema": {
+                            "$ref": "#/definitions/handler.NotificationSettingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Board not accessible",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/pinned-tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the authenticated user's pinned tasks, newest pin first. Tasks on boards the user can no longer access are omitted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pinned tasks"
+                ],
+                "summary": "Get pinned tasks",
+                "responses": {
+                    "200": {
+                        "description": "Pinned tasks",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.PinnedTaskResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/preferences": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the board opened on start; the user needs access to it. A null default_board_id clears it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update user preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.PreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated preferences",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PreferencesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "No access to the board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/read-receipts": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets whether the authenticated user's board views are left out of read receipts on every board. Opting\nout forgets the user's recorded views.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Read receipts"
+                ],
+                "summary": "Opt out of read receipts",
+                "parameters": [
+                    {
+                        "description": "Read receipt preference",
+                        "name": "preference",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReadReceiptPreferenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated preference",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ReadReceiptPreferenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/sample-board": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generate a guided sample board with explanatory cards demonstrating labels, due dates and sharing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create the onboarding sample board",
+                "responses": {
+                    "201": {
+                        "description": "Sample board created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BoardResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Maximum number of boards reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/shared-boards": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get list of boards shared with current user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Get shared boards",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.BoardResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves the open tasks assigned to the authenticated user on every board they can access, soonest\ndue first and tasks without a due date last. Due dates are compared in UTC.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get my tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Only tasks of this board",
+                        "name": "board_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Only tasks with this label",
+                        "name": "label_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Only tasks due on or after this date",
+                        "name": "due_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date",
+                        "description": "Only tasks due on or before this date",
+                        "name": "due_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only tasks whose due date has passed",
+                        "name": "overdue",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include completed tasks",
+                        "name": "include_completed",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tasks assigned to the user",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.MyTaskResponse"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/operations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the status and progress of a long-running operation started by the authenticated user,\nthe errors of items that could not be processed and the resulting board once it exists",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Operations"
+                ],
+                "summary": "Get operation progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Operation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Operation progress",
+                        "schema": {
+                            "$ref": "#/definitions/handler.OperationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid operation ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Operation not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Create a new user account and return authentication token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created successfully with auth token",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "User with this email already exists",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/share-links/{token}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Joins the board of an invitation link with the link's role. Users who already have that role\nor a higher one keep their access and do not use up the link.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-sharing"
+                ],
+                "summary": "Accept share link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share link token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Board access granted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AcceptShareLinkResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Share link not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Share link revoked, expired or used up",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a sprint with the totals of its tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Get a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Sprint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sprint ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Sprint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{id}/burndown": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the story points left at the end of every sprint day next to an ideal straight line.\nDays are in UTC and remaining points are only given for days that have ended or are under way.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Get sprint burndown",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Sprint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint burndown",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintBurndownResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sprint ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Sprint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{id}/close": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes a sprint. Open tasks stay in it until they are planned into another sprint.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Close a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Sprint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sprint closed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sprint ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Sprint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Sprint is already closed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{id}/tasks": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Plans tasks of the sprint's board into the sprint, taking them out of any other sprint.\nTasks of other boards are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Plan tasks into a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Sprint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tasks to plan",
+                        "name": "tasks",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintTasksRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Tasks planned successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or no tasks of the sprint's board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Sprint not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Sprint is closed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/sprints/{id}/tasks/{task_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes a task out of a sprint back to the board's backlog",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sprints"
+                ],
+                "summary": "Take a task out of a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Sprint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "task_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid sprint or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Sprint not found or task not in it",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/status/{slug}": {
+            "get": {
+                "description": "Returns the published columns of a board with their tasks. No authentication is needed; responses\ncarry an ETag and may be cached for a minute.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Status pages"
+                ],
+                "summary": "Get public status page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Status page slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status page",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PublicStatusPageResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
+                    "404": {
+                        "description": "Status page not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/status/{slug}/html": {
+            "get": {
+                "description": "Renders the public status page as a simple HTML page. No authentication is needed.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Status pages"
+                ],
+                "summary": "Get public status page as HTML",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Status page slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status page",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
+                    "404": {
+                        "description": "Status page not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/swimlanes/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renames a swimlane and/or moves it to a 1-based position; other swimlanes shift to make room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Swimlanes"
+                ],
+                "summary": "Update a swimlane",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Swimlane ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Swimlane changes",
+                        "name": "swimlane",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateSwimlaneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Swimlane updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SwimlaneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Swimlane not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a swimlane; its tasks move to the default lane",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Swimlanes"
+                ],
+                "summary": "Delete a swimlane",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Swimlane ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Swimlane deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid swimlane ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Swimlane not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/task-templates/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a task template",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task templates"
+                ],
+                "summary": "Get a task template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task template",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task template ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task template not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces all fields of a task template; tasks created from it are not changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task templates"
+                ],
+                "summary": "Replace a task template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task template updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or unknown label",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task template not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a task template; tasks created from it are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Task templates"
+                ],
+                "summary": "Delete a task template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task template ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task template deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task template ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task template not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new task with the given details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "description": "Task information",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/batch-get": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves up to 100 tasks in one request, in the requested order.\nTasks that do not exist or are on boards the user cannot view are listed in missing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get tasks by IDs",
+                "parameters": [
+                    {
+                        "description": "Task IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BatchGetTasksRequest"
+                        }
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Also return task descriptions as sanitized HTML",
+                        "name": "render",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Requested tasks",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BatchGetTasksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/from-template/{template_id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a task pre-filled from a task template of the board: the title pattern with its placeholders\nfilled in, the description followed by the checklist as a Markdown task list, the priority and the\nlabels that still exist. The task goes to the end of the given column, the first column by default.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Create a task from a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task template ID",
+                        "name": "template_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target column and title",
+                        "name": "task",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateFromTemplateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Task created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task template or column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves a task by its ID with its creator, assignee, labels, references, mentions and GitHub links",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get task by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html"
+                        ],
+                        "type": "string",
+                        "description": "Also return task descriptions as sanitized HTML",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a cached copy",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task details",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Cached copy is current"
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates an existing task with new details. The description can't be changed while another user holds\na lock on the task.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Update a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated task information",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen or the description is locked by another user",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a task by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Delete a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task deleted successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/assign": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Assigns a user to a specific task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Assign user to task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User assignment information",
+                        "name": "assignment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskAssignRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User assigned to task successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or user not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the assigned user from a task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Unassign user from task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User unassigned from task successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/assignee-suggestions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ranks the board members by their recent work on the board: tasks with the same labels count three times,\ntasks in the same column once. Open tasks and tasks completed in the last 90 days are considered.\nTies go to the member with fewer open tasks; the current assignee is left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Suggest assignees for a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of suggestions (default 5, max 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suggested assignees, best first",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.AssigneeSuggestionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/blocked": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a task as blocked with an optional reason, or clears the flag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Set task blocked flag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blocked flag information",
+                        "name": "blocked",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetBlockedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Blocked flag updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a task as completed without moving it. Tasks moved into a done column are completed automatically.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Complete a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task completed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Clears the completion of a task. Tasks in a done column stay completed until they are moved out of it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Reopen a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task reopened successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Task is in a done column",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/cover": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the cover color shown on the task card, or removes the cover when the color is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Set task cover",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cover information",
+                        "name": "cover",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetCoverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cover updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/description": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the text of the task description with its version, to start editing it collaboratively.\nWith since, returns the edits made after that version instead, up to 500 at a time, so editors can\npoll for each other's edits and apply them to their copy. A since that is no longer available,\nfor instance after a long time offline, is rejected with 409; the client then reloads the text.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get the live description of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Return the edits after this version",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Text or edits of the description",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DescriptionDocResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID or version",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Version is not available; the current version is returned",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/description/ops": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Applies an edit made on a version of the description. Edits others made since that version are\ntransformed against, so concurrent edits are merged instead of overwriting each other; when two\nedits insert at the same place, the earlier one comes first. The edit is returned as it was\napplied, with the new version. The text is saved to the task every 10 seconds while it is being\nedited and shortly after the last edit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Edit a task description collaboratively",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Edit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.DescriptionOpRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Edit applied",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DescriptionOpResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or the edit does not match its version",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Version is not available; the current version is returned",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen or the description is locked by another user",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/due-date": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets or updates the due date for a task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Set task due date",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Due date information",
+                        "name": "due_date",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetDueDateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Due date updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the changes made to the fields of a task and who made them, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get task history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Changes of the task",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.TaskChangeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves all labels associated with a specific task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get task labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of labels associated with the task",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.LabelResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/labels/{label_id}": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a label to a specific task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Add label to task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Label added to task successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task or label ID format, or label not available on the board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or label not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a label from a specific task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Remove label from task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Label ID",
+                        "name": "label_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Label removed from task successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task or label ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/lock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Takes a soft lock on the task's description for TaskLockTTL (2 minutes), or renews the lock the user\nalready holds. While the lock is active only its holder can change the description, so editors\nshould renew it while they type and release it when done. Lock changes are published in the board's\nchange feed and event stream, which also reports locks that expire.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Lock a task for editing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lock taken or renewed",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskLockResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Task is locked by another user; the lock is returned",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Releases the user's lock of the task, so others can edit its description",
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Release a task lock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Lock released"
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found or not locked by the user",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a task to a different column and/or position. Positions in the source and target columns are\nrenumbered without gaps and returned, so clients can reconcile their state without refetching.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Move a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task move information",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskMoveRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved, with the new positions of all tasks in the affected columns",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskMoveResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/move-to-board": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a task to a column of another board; the user needs edit access to both boards. The task gets a new\nnumber on the target board. Its labels are replaced by the target board's labels of the same name and\nremoved when the board has none. The task keeps its swimlane when the target board has one with the same\ntitle and goes to the default lane otherwise. It leaves its sprint, its relations are removed, and its\nassignee is unassigned without access to the target board. The move is recorded in the task history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Move a task to another board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target column and position",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskMoveToBoardRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskMoveToBoardResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request, or the column is on the task's board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or task limit of the column reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or column not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/pin": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a task to the authenticated user's personal pinned tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pinned tasks"
+                ],
+                "summary": "Pin a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task pinned successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or pin limit reached",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a task from the authenticated user's pinned tasks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pinned tasks"
+                ],
+                "summary": "Unpin a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task unpinned successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/relations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the tasks this task blocks, is blocked by or relates to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "List task relations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task relations",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.TaskRelationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Links the task to another task of the same board. blocked_by is stored as the inverse blocks relation.\nA blocking relation that would make a task (transitively) block itself is rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Link two tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Relation information",
+                        "name": "relation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateTaskRelationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Relation created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TaskRelationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or tasks on different boards",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Relation already exists or would create a cycle",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/relations/{relation_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a relation the task takes part in, from either side",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Remove a task relation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Relation ID",
+                        "name": "relation_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Relation removed successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or relation not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}/swimlane": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a task to a swimlane of its board, or to the default lane when swimlane_id is null.\nThe task keeps its column and position.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Swimlanes"
+                ],
+                "summary": "Move a task to a swimlane",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target swimlane",
+                        "name": "swimlane",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetTaskSwimlaneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Task moved successfully",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or swimlane of another board",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Task or swimlane not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/github/{board_id}": {
+            "post": {
+                "description": "Receives the push and pull request events of a repository connected to the board. Deliveries must be\nsigned with the webhook secret in X-Hub-Signature-256. Other events are acknowledged and ignored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "GitHub"
+                ],
+                "summary": "Receive a GitHub webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Board ID",
+                        "name": "board_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event name",
+                        "name": "X-GitHub-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HMAC SHA-256 signature of the payload",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event processed",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GitHubWebhookResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Event ignored",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid board ID format or payload",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Board not connected to GitHub",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Payload too large",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "423": {
+                        "description": "Board is frozen",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/labels": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all workspace labels owned by the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Get workspace labels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.LabelResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a label available on every board owned by the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Labels"
+                ],
+                "summary": "Create workspace label",
+                "parameters": [
+                    {
+                        "description": "Label data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateWorkspaceLabelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LabelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "401": {
+                        "description": "Not authenticated",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "409": {
+                        "description": "Workspace label with the same name exists",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handler.AcceptShareLinkResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ActionResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "description": "ExpiresAt is when the action can no longer be undone",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "task_delete",
+                        "task_move",
+                        "task_label_remove"
+                    ]
+                },
+                "task_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AdminUserListResponse": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "description": "Total is the number of users matching the search across all pages",
+                    "type": "integer"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AdminUserResponse"
+                    }
+                }
+            }
+        },
+        "handler.AdminUserResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "disabled_at": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_admin": {
+                    "type": "boolean"
+                },
+                "is_guest": {
+                    "type": "boolean"
+                },
+                "max_boards": {
+                    "description": "Limits the user overrides; unset limits are the instance defaults and 0 is unlimited",
+                    "type": "integer"
+                },
+                "max_columns_per_board": {
+                    "type": "integer"
+                },
+                "max_tasks_per_column": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owned_boards": {
+                    "description": "OwnedBoards is the number of boards the user owns, SharedBoards the number they are a member of",
+                    "type": "integer"
+                },
+                "shared_boards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ArchivedTaskResponse": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "type": "string"
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AssigneeEstimateResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "type": "integer"
+                },
+                "estimated_tasks": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "open_estimate": {
+                    "description": "OpenEstimate sums the estimates of the tasks not completed yet",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "description": "Tasks counts all tasks of the group, EstimatedTasks those with an estimate",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AssigneeSuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "open_tasks": {
+                    "type": "integer"
+                },
+                "same_column": {
+                    "description": "SameColumn counts the member's recent tasks in the task's column",
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "shared_labels": {
+                    "description": "SharedLabels counts the labels of the task on the member's recent tasks",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "sample_board_id": {
+                    "description": "SampleBoardID is set when the onboarding sample board was generated by this request",
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.UserDetails"
+                }
+            }
+        },
+        "handler.BatchGetTasksRequest": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.BatchGetTasksResponse": {
+            "type": "object",
+            "properties": {
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskResponse"
+                    }
+                }
+            }
+        },
+        "handler.BoardAnalyticsResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "completed": {
+                    "description": "Completed counts the tasks with a lead time completed in the period",
+                    "type": "integer"
+                },
+                "cumulative_flow": {
+                    "$ref": "#/definitions/handler.CumulativeFlowResponse"
+                },
+                "cycle_time": {
+                    "$ref": "#/definitions/handler.FlowTimeResponse"
+                },
+                "daily": {
+                    "description": "Daily lists the activity of the days of the period rolled up so far, which excludes today",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.DailyMetricsResponse"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "lead_time": {
+                    "$ref": "#/definitions/handler.FlowTimeResponse"
+                },
+                "throughput": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ThroughputWeekResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BoardChangesResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ColumnResponse"
+                    }
+                },
+                "cursor": {
+                    "description": "Cursor is passed as since to get the changes after this response",
+                    "type": "string"
+                },
+                "deleted_column_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "deleted_task_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LabelResponse"
+                    }
+                },
+                "locks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskLockResponse"
+                    }
+                },
+                "shares": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BoardShareResponse"
+                    }
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskResponse"
+                    }
+                }
+            }
+        },
+        "handler.BoardLimitsResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "columns": {
+                    "type": "integer"
+                },
+                "limits": {
+                    "$ref": "#/definitions/limits.Limits"
+                },
+                "tasks_per_column": {
+                    "description": "TasksPerColumn maps column IDs to the number of their tasks",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handler.BoardNotificationSettings": {
+            "type": "object",
+            "required": [
+                "board_id"
+            ],
+            "properties": {
+                "board_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.NotificationEventSettings"
+                    }
+                },
+                "muted": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.BoardOrderRequest": {
+            "type": "object",
+            "required": [
+                "board_ids"
+            ],
+            "properties": {
+                "board_ids": {
+                    "description": "BoardIDs are the boards in the order they are listed; boards left out follow them",
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.BoardResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "frozen_at": {
+                    "description": "FrozenAt is set while the board is frozen and rejects changes to its content",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "starred": {
+                    "description": "Starred is set when the authenticated user starred the board, returned by GET /boards",
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "view": {
+                    "description": "View is the authenticated user's saved view of the board, returned by GET /boards/{id}",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.BoardViewResponse"
+                        }
+                    ]
+                }
+            }
+        },
+        "handler.BoardShareResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "is_owner": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BoardTransferRequest": {
+            "type": "object",
+            "required": [
+                "board_id",
+                "new_owner_id"
+            ],
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "new_owner_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BoardViewRequest": {
+            "type": "object",
+            "properties": {
+                "filters": {
+                    "type": "object"
+                },
+                "group_by": {
+                    "type": "string",
+                    "example": "assignee"
+                },
+                "sort_by": {
+                    "type": "string",
+                    "example": "due_date"
+                },
+                "sort_order": {
+                    "type": "string",
+                    "example": "asc"
+                }
+            }
+        },
+        "handler.BoardViewResponse": {
+            "type": "object",
+            "properties": {
+                "filters": {
+                    "type": "object"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "sort_by": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BootstrapBoard": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "open_tasks": {
+                    "type": "integer"
+                },
+                "owner_id": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "editor",
+                        "commenter",
+                        "viewer"
+                    ]
+                },
+                "tasks": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BootstrapResponse": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BootstrapBoard"
+                    }
+                },
+                "deep_link_denied": {
+                    "description": "DeepLinkDenied is set when the requested board does not exist or is not accessible",
+                    "type": "boolean"
+                },
+                "features": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "last_board_id": {
+                    "type": "string"
+                },
+                "open_board_id": {
+                    "description": "OpenBoardID is the board to show first: the deep-linked board, then the default board,\nthe last opened board and the first board, whichever the user can still access",
+                    "type": "string"
+                },
+                "preferences": {
+                    "$ref": "#/definitions/handler.PreferencesResponse"
+                },
+                "user": {
+                    "$ref": "#/definitions/handler.UserDetails"
+                }
+            }
+        },
+        "handler.CSVImportResponse": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ImportRowError"
+                    }
+                },
+                "tasks": {
+                    "description": "Tasks is the number of tasks created, or that a dry run found valid",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CalendarTokenResponse": {
+            "type": "object",
+            "properties": {
+                "board_path": {
+                    "description": "BoardPath is the feed of a board's open tasks; {id} is replaced by the board ID",
+                    "type": "string",
+                    "example": "/boards/{id}/calendar.ics?token=..."
+                },
+                "my_tasks_path": {
+                    "description": "MyTasksPath is the feed of the open tasks assigned to the user",
+                    "type": "string",
+                    "example": "/me/calendar.ics?token=..."
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CardLayoutRequest": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "description": "Fields in display order; null restores the default layout, an empty list shows titles only",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "number",
+                        "labels",
+                        "due_date"
+                    ]
+                }
+            }
+        },
+        "handler.CardLayoutResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default": {
+                    "description": "Default is set when the board uses the default layout",
+                    "type": "boolean"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.ColumnEstimateResponse": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "type": "integer"
+                },
+                "estimated_tasks": {
+                    "type": "integer"
+                },
+                "open_estimate": {
+                    "description": "OpenEstimate sums the estimates of the tasks not completed yet",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "description": "Tasks counts all tasks of the group, EstimatedTasks those with an estimate",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ColumnPositionRequest": {
+            "type": "object",
+            "required": [
+                "id",
+                "position"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ColumnReadReceipts": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "opened": {
+                    "description": "Opened counts the readers who ever opened it",
+                    "type": "integer"
+                },
+                "seen": {
+                    "description": "Seen counts the readers who opened it since it last changed",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt is when the board or column, or a task in it, last changed",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ColumnResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "dark_color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_collapsed": {
+                    "type": "boolean"
+                },
+                "is_done": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "summary": {
+                    "description": "Summary sums up the column's tasks; returned with the columns of a board",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.ColumnSummaryResponse"
+                        }
+                    ]
+                },
+                "swimlane_tasks": {
+                    "description": "SwimlaneTasks counts the column's tasks per swimlane ID, \"none\" for tasks without\na swimlane; returned by GET /boards/{id}/columns?group_by=swimlane",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ColumnSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "estimate": {
+                    "description": "Estimate is the total estimate of the tasks in story points",
+                    "type": "integer"
+                },
+                "overdue": {
+                    "description": "Overdue counts the open tasks whose due date has passed",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CopyColumnToBoardRequest": {
+            "type": "object",
+            "required": [
+                "board_id"
+            ],
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CopyColumnToBoardResponse": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "$ref": "#/definitions/handler.ColumnResponse"
+                },
+                "labels_created": {
+                    "type": "integer"
+                },
+                "tasks_copied": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CopyLabelsRequest": {
+            "type": "object",
+            "required": [
+                "source_board_id"
+            ],
+            "properties": {
+                "source_board_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CopyLabelsResponse": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LabelResponse"
+                    }
+                },
+                "skipped": {
+                    "description": "Skipped counts the labels of the source board named like a label the board already has",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CreateBoardRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateColumnRequest": {
+            "type": "object",
+            "required": [
+                "board_id",
+                "title"
+            ],
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#4caf50"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "is_done": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateFromTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "description": "ColumnID is the column to add the task to, the first column of the board by default",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "Title replaces the title made from the template's pattern",
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "handler.CreateGuestLinkRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "expires_in_hours": {
+                    "type": "integer",
+                    "maximum": 720,
+                    "minimum": 1
+                },
+                "name": {
+                    "description": "Name of the guest, shown as the author of their changes",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.CreateLabelRequest": {
+            "type": "object",
+            "required": [
+                "board_id",
+                "color",
+                "name"
+            ],
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CreateShareLinkRequest": {
+            "type": "object",
+            "required": [
+                "max_uses"
+            ],
+            "properties": {
+                "expires_in_hours": {
+                    "type": "integer",
+                    "maximum": 720,
+                    "minimum": 1
+                },
+                "max_uses": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1
+                },
+                "role": {
+                    "description": "Role granted by the link, commenter by default",
+                    "type": "string",
+                    "enum": [
+                        "viewer",
+                        "commenter",
+                        "editor"
+                    ]
+                }
+            }
+        },
+        "handler.CreateSprintRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "name",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string",
+                    "example": "2024-06-14"
+                },
+                "goal": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "start_date": {
+                    "type": "string",
+                    "example": "2024-06-03"
+                }
+            }
+        },
+        "handler.CreateSwimlaneRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "handler.CreateTaskRelationRequest": {
+            "type": "object",
+            "required": [
+                "task_id",
+                "type"
+            ],
+            "properties": {
+                "task_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blocks",
+                        "blocked_by",
+                        "relates_to"
+                    ]
+                }
+            }
+        },
+        "handler.CreateWorkspaceLabelRequest": {
+            "type": "object",
+            "required": [
+                "color",
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CumulativeFlowColumn": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CumulativeFlowDay": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handler.CumulativeFlowResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CumulativeFlowColumn"
+                    }
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.CumulativeFlowDay"
+                    }
+                }
+            }
+        },
+        "handler.DailyMetricsResponse": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "cycle_time_median_days": {
+                    "type": "number"
+                },
+                "cycle_time_p85_days": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "moved": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.DeleteAccountRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "Password confirms the deletion; accounts created through OAuth have no password",
+                    "type": "string"
+                },
+                "transfer_boards": {
+                    "description": "TransferBoards lists owned boards to hand over instead of deleting them",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BoardTransferRequest"
+                    }
+                }
+            }
+        },
+        "handler.DeleteAccountResponse": {
+            "type": "object",
+            "properties": {
+                "deleted_boards": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "transferred_boards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.DescriptionDocResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "Content is the text at Version, returned when no since is given",
+                    "type": "string"
+                },
+                "ops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.DescriptionOpResponse"
+                    }
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.DescriptionOpRequest": {
+            "type": "object",
+            "required": [
+                "ops"
+            ],
+            "properties": {
+                "ops": {
+                    "description": "Ops are the retain, insert and delete steps of the edit, covering the whole description;\nlengths count Unicode code points",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ot.Component"
+                    }
+                },
+                "version": {
+                    "description": "Version is the version of the description the edit was made on",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "handler.DescriptionOpResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "ops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ot.Component"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the description after the edit",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.DuplicateBoardRequest": {
+            "type": "object",
+            "properties": {
+                "include_assignees": {
+                    "description": "IncludeAssignees keeps tasks assigned to the caller assigned, defaults to true",
+                    "type": "boolean"
+                },
+                "include_due_dates": {
+                    "description": "IncludeDueDates keeps the due dates of tasks, defaults to true",
+                    "type": "boolean"
+                },
+                "include_tasks": {
+                    "description": "IncludeTasks copies the tasks, defaults to true",
+                    "type": "boolean"
+                },
+                "title": {
+                    "description": "Title of the copy, defaults to \"Copy of \u003csource title\u003e\"",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.EstimateRollupResponse": {
+            "type": "object",
+            "properties": {
+                "assignees": {
+                    "description": "Assignees lists the assignees with the largest open estimate first, unassigned tasks last",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.AssigneeEstimateResponse"
+                    }
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "columns": {
+                    "description": "Columns lists every column in board order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ColumnEstimateResponse"
+                    }
+                },
+                "total": {
+                    "$ref": "#/definitions/handler.EstimateSumResponse"
+                }
+            }
+        },
+        "handler.EstimateSumResponse": {
+            "type": "object",
+            "properties": {
+                "estimate": {
+                    "type": "integer"
+                },
+                "estimated_tasks": {
+                    "type": "integer"
+                },
+                "open_estimate": {
+                    "description": "OpenEstimate sums the estimates of the tasks not completed yet",
+                    "type": "integer"
+                },
+                "tasks": {
+                    "description": "Tasks counts all tasks of the group, EstimatedTasks those with an estimate",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.FlowTimeResponse": {
+            "type": "object",
+            "properties": {
+                "average_days": {
+                    "type": "number"
+                },
+                "median_days": {
+                    "type": "number"
+                },
+                "p85_days": {
+                    "type": "number"
+                }
+            }
+        },
+        "handler.FreezeBoardRequest": {
+            "type": "object",
+            "required": [
+                "frozen"
+            ],
+            "properties": {
+                "frozen": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.FullBoardCell": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskResponse"
+                    }
+                }
+            }
+        },
+        "handler.FullBoardLane": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FullBoardCell"
+                    }
+                },
+                "default": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.FullBoardResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "$ref": "#/definitions/handler.BoardResponse"
+                },
+                "card_layout": {
+                    "description": "CardLayout is the board's choice of fields to show on task cards",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.CardLayoutResponse"
+                        }
+                    ]
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ColumnResponse"
+                    }
+                },
+                "swimlanes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.FullBoardLane"
+                    }
+                }
+            }
+        },
+        "handler.GitHubIntegrationRequest": {
+            "type": "object",
+            "properties": {
+                "done_column_id": {
+                    "description": "DoneColumnID receives the tasks of merged pull requests; null turns this off",
+                    "type": "string"
+                },
+                "review_column_id": {
+                    "description": "ReviewColumnID receives the tasks of pull requests opened for review; null turns this off",
+                    "type": "string"
+                },
+                "rotate_secret": {
+                    "description": "RotateSecret replaces the webhook secret; deliveries signed with the old one are rejected",
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.GitHubIntegrationResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "done_column_id": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "review_column_id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Secret of the webhook, only returned when the integration is created or the secret rotated",
+                    "type": "string"
+                },
+                "webhook_path": {
+                    "description": "WebhookPath is the payload URL to configure in the GitHub repository, with content type application/json",
+                    "type": "string",
+                    "example": "/webhooks/github/{board_id}"
+                }
+            }
+        },
+        "handler.GitHubWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "linked": {
+                    "type": "integer"
+                },
+                "moved": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.GuestLinkResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_session_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "sessions": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID is the guest user that edits the board through the link",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.GuestSessionResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "description": "Token authenticates the guest like a login token, limited to the link's board",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ImportPartRequest": {
+            "type": "object",
+            "required": [
+                "tasks"
+            ],
+            "properties": {
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/jobs.ImportRow"
+                    }
+                }
+            }
+        },
+        "handler.ImportPartResponse": {
+            "type": "object",
+            "properties": {
+                "number": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ImportResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "imported_tasks": {
+                    "type": "integer"
+                },
+                "operation_id": {
+                    "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ImportPartResponse"
+                    }
+                },
+                "pending_tasks": {
+                    "description": "PendingTasks is the number of uploaded tasks not imported yet",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "uploading",
+                        "committed",
+                        "imported"
+                    ]
+                }
+            }
+        },
+        "handler.ImportRowError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "row": {
+                    "description": "Row is the 1-based index of the task in the part, or the line of a CSV file",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.LabelResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string"
+                },
+                "dark_color": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.LimitsResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "description": "Board is the usage of the board requested with board_id",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.BoardLimitsResponse"
+                        }
+                    ]
+                },
+                "limits": {
+                    "description": "Limits of the user; 0 means unlimited",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/limits.Limits"
+                        }
+                    ]
+                },
+                "owned_boards": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.MentionNotificationResponse": {
+            "type": "object",
+            "properties": {
+                "author_name": {
+                    "type": "string"
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "board_title": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mentioned_by": {
+                    "type": "string"
+                },
+                "origin": {
+                    "type": "string"
+                },
+                "read_at": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "task_key": {
+                    "type": "string"
+                },
+                "task_title": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.MergeBoardRequest": {
+            "type": "object",
+            "required": [
+                "source_board_id"
+            ],
+            "properties": {
+                "dry_run": {
+                    "description": "DryRun returns a preview of the merge without changing anything",
+                    "type": "boolean"
+                },
+                "source_board_id": {
+                    "description": "SourceBoardID is the board merged into this one and deleted afterwards",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.MergeLabelRequest": {
+            "type": "object",
+            "required": [
+                "target_id"
+            ],
+            "properties": {
+                "target_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.MergeLabelResponse": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "$ref": "#/definitions/handler.LabelResponse"
+                },
+                "tasks_updated": {
+                    "description": "TasksUpdated counts the tasks that had the merged label",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.MoveColumnToBoardRequest": {
+            "type": "object",
+            "required": [
+                "board_id"
+            ],
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.MoveColumnToBoardResponse": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "$ref": "#/definitions/handler.ColumnResponse"
+                },
+                "labels_created": {
+                    "type": "integer"
+                },
+                "tasks_moved": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.MyTaskResponse": {
+            "type": "object",
+            "properties": {
+                "assigned_to": {
+                    "type": "string"
+                },
+                "assignee_name": {
+                    "type": "string"
+                },
+                "blocked": {
+                    "type": "boolean"
+                },
+                "blocked_reason": {
+                    "type": "string"
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "board_title": {
+                    "type": "string"
+                },
+                "column_id": {
+                    "type": "string"
+                },
+                "column_title": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "cover": {
+                    "description": "Cover is omitted for tasks without a cover",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.TaskCoverResponse"
+                        }
+                    ]
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "creator_name": {
+                    "type": "string"
+                },
+                "dependency_blocked": {
+                    "description": "DependencyBlocked is set while a task blocking this one is not completed",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_html": {
+                    "description": "DescriptionHTML is the description rendered as sanitized HTML, returned with render=html",
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "type": "integer"
+                },
+                "github_links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskGitHubLinkResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LabelResponse"
+                    }
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskMentionResponse"
+                    }
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "overdue": {
+                    "description": "Overdue is set for open tasks whose due date has passed",
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskReferenceResponse"
+                    }
+                },
+                "sprint_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "swimlane_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.NotificationEventSettings": {
+            "type": "object",
+            "required": [
+                "event"
+            ],
+            "properties": {
+                "email": {
+                    "type": "boolean"
+                },
+                "event": {
+                    "type": "string",
+                    "example": "mention"
+                },
+                "in_app": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.NotificationSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BoardNotificationSettings"
+                    }
+                },
+                "digest": {
+                    "description": "Digest is how often an email digest of overdue tasks, new assignments and mentions is sent,\noff when empty. Muted boards are left out of digests.",
+                    "type": "string",
+                    "enum": [
+                        "off",
+                        "daily",
+                        "weekly"
+                    ],
+                    "example": "daily"
+                },
+                "events": {
+                    "description": "Events are the defaults for all boards; events left out are delivered in-app only",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.NotificationEventSettings"
+                    }
+                }
+            }
+        },
+        "handler.NotificationSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "description": "Boards are the boards with settings of their own or muted",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.BoardNotificationSettings"
+                    }
+                },
+                "digest": {
+                    "type": "string",
+                    "enum": [
+                        "off",
+                        "daily",
+                        "weekly"
+                    ]
+                },
+                "events": {
+                    "description": "Events are the defaults for all boards, one per event type",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.NotificationEventSettings"
+                    }
+                }
+            }
+        },
+        "handler.OperationResponse": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "board_duplicate",
+                        "board_split",
+                        "board_merge",
+                        "task_import"
+                    ]
+                },
+                "progress": {
+                    "type": "integer"
+                },
+                "request_id": {
+                    "description": "RequestID identifies the request that started the operation in the server logs",
+                    "type": "string"
+                },
+                "result_board_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "succeeded",
+                        "failed"
+                    ]
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PinnedTaskResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "board_title": {
+                    "type": "string"
+                },
+                "column_id": {
+                    "type": "string"
+                },
+                "column_title": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "pinned_at": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PreferencesRequest": {
+            "type": "object",
+            "properties": {
+                "default_board_id": {
+                    "description": "DefaultBoardID is the board opened on start; null clears it",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PreferencesResponse": {
+            "type": "object",
+            "properties": {
+                "default_board_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PublicStatusColumn": {
+            "type": "object",
+            "properties": {
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PublicStatusTask"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PublicStatusLabel": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PublicStatusPageResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PublicStatusColumn"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.PublicStatusTask": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.PublicStatusLabel"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.QuickAddParsed": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.QuickAddRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "column_id": {
+                    "description": "ColumnID is the column to add the task to, the first column of the board by default",
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Fix login bug #backend @anna !high due:fri"
+                }
+            }
+        },
+        "handler.QuickAddResponse": {
+            "type": "object",
+            "properties": {
+                "parsed": {
+                    "$ref": "#/definitions/handler.QuickAddParsed"
+                },
+                "task": {
+                    "$ref": "#/definitions/handler.TaskResponse"
+                },
+                "unmatched": {
+                    "description": "Unmatched lists the #label and @assignee tokens that name no label or member of the board;\nthe task was created without them",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.ReadReceiptCount": {
+            "type": "object",
+            "properties": {
+                "opened": {
+                    "description": "Opened counts the readers who ever opened it",
+                    "type": "integer"
+                },
+                "seen": {
+                    "description": "Seen counts the readers who opened it since it last changed",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt is when the board or column, or a task in it, last changed",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ReadReceiptPreferenceRequest": {
+            "type": "object",
+            "required": [
+                "hide"
+            ],
+            "properties": {
+                "hide": {
+                    "description": "Hide leaves the user's board views out of read receipts on every board",
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ReadReceiptPreferenceResponse": {
+            "type": "object",
+            "properties": {
+                "hide": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ReadReceiptSettingsRequest": {
+            "type": "object",
+            "required": [
+                "enabled"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.ReadReceiptsResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "$ref": "#/definitions/handler.ReadReceiptCount"
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ColumnReadReceipts"
+                    }
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "readers": {
+                    "description": "Readers counts the viewers and commenters of the board who did not opt out",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ReassignBoardRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "keep_previous_owner": {
+                    "description": "KeepPreviousOwner makes the previous owner an editor of the board instead of removing their access",
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
+                }
+            }
+        },
+        "handler.ReorderColumnsRequest": {
+            "type": "object",
+            "required": [
+                "columns"
+            ],
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.ColumnPositionRequest"
+                    }
+                }
+            }
+        },
+        "handler.RestructurePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "integer"
+                },
+                "labels_created": {
+                    "type": "integer"
+                },
+                "shares_changed": {
+                    "type": "integer"
+                },
+                "tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.SetBlockedRequest": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SetColumnCollapsedRequest": {
+            "type": "object",
+            "required": [
+                "collapsed"
+            ],
+            "properties": {
+                "collapsed": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.SetCoverRequest": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "description": "Color is a hex color such as #4caf50; an empty string removes the cover",
+                    "type": "string",
+                    "example": "#4caf50"
+                }
+            }
+        },
+        "handler.SetDueDateRequest": {
+            "type": "object",
+            "properties": {
+                "due_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SetTaskSwimlaneRequest": {
+            "type": "object",
+            "properties": {
+                "swimlane_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SettingOverrideResponse": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string",
+                    "enum": [
+                        "rate_limits",
+                        "limits",
+                        "feature_flags",
+                        "default_labels"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "description": "UpdatedBy is the administrator who changed the setting last, unless their account was deleted",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value holds the fields that replace the defaults",
+                    "type": "object"
+                }
+            }
+        },
+        "handler.SettingsResponse": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "description": "Current are the settings in effect: the defaults with the overrides applied",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/settings.Settings"
+                        }
+                    ]
+                },
+                "defaults": {
+                    "description": "Defaults are the settings from the server configuration",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/settings.Settings"
+                        }
+                    ]
+                },
+                "overrides": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SettingOverrideResponse"
+                    }
+                }
+            }
+        },
+        "handler.ShareBoardRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "role"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "viewer",
+                        "commenter",
+                        "editor"
+                    ]
+                }
+            }
+        },
+        "handler.ShareLinkResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_uses": {
+                    "type": "integer"
+                },
+                "revoked_at": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "uses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.SplitBoardRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "column_ids": {
+                    "description": "ColumnIDs are moved to the new board with all their tasks",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dry_run": {
+                    "description": "DryRun returns a preview of the split without changing anything",
+                    "type": "boolean"
+                },
+                "task_ids": {
+                    "description": "TaskIDs of other columns are moved into a column of the same name on the new board",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "description": "Title of the new board",
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SprintBurndownDay": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "ideal": {
+                    "type": "number"
+                },
+                "remaining": {
+                    "description": "Remaining is null for days that have not ended yet and days after the sprint was closed",
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.SprintBurndownResponse": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.SprintBurndownDay"
+                    }
+                },
+                "sprint_id": {
+                    "type": "string"
+                },
+                "total_points": {
+                    "type": "integer"
+                },
+                "unestimated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.SprintResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "completed_points": {
+                    "type": "integer"
+                },
+                "completed_tasks": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "goal": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.SprintTasksRequest": {
+            "type": "object",
+            "required": [
+                "task_ids"
+            ],
+            "properties": {
+                "task_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.StandupAssignee": {
+            "type": "object",
+            "properties": {
+                "assigned_to": {
+                    "type": "string"
+                },
+                "assignee_name": {
+                    "type": "string"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.StandupTask"
+                    }
+                }
+            }
+        },
+        "handler.StandupResponse": {
+            "type": "object",
+            "properties": {
+                "blockers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.StandupTask"
+                    }
+                },
+                "board_id": {
+                    "type": "string"
+                },
+                "board_title": {
+                    "type": "string"
+                },
+                "completed_yesterday": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.StandupTask"
+                    }
+                },
+                "date": {
+                    "type": "string"
+                },
+                "in_progress": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.StandupAssignee"
+                    }
+                }
+            }
+        },
+        "handler.StandupTask": {
+            "type": "object",
+            "properties": {
+                "assigned_to": {
+                    "type": "string"
+                },
+                "assignee_name": {
+                    "type": "string"
+                },
+                "blocked_reason": {
+                    "type": "string"
+                },
+                "column": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.StatusPageRequest": {
+            "type": "object",
+            "required": [
+                "column_ids"
+            ],
+            "properties": {
+                "column_ids": {
+                    "description": "ColumnIDs are the published columns; they are shown in board order",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "description": "Title shown on the page, the board title by default",
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "handler.StatusPageResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "column_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path of the public JSON page; the HTML page is served under Path + \"/html\"",
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.SwimlaneResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskAssignRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskChangeResponse": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "type": "string"
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "title",
+                        "description",
+                        "column",
+                        "board",
+                        "assignee",
+                        "start_date",
+                        "due_date",
+                        "priority",
+                        "blocked",
+                        "blocked_reason",
+                        "estimate",
+                        "cover",
+                        "completed"
+                    ]
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserID and UserName are empty once the user who made the change is deleted",
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskCoverResponse": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "dark_color": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskGitHubLinkResponse": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "commit",
+                        "pull_request"
+                    ]
+                },
+                "ref": {
+                    "description": "Ref is the commit SHA or the pull request number",
+                    "type": "string"
+                },
+                "repository": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "closed",
+                        "merged"
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskLockResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "ExpiresAt is when the lock ends unless it is renewed",
+                    "type": "string"
+                },
+                "locked_at": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskMentionResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "origin": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskMoveRequest": {
+            "type": "object",
+            "required": [
+                "column_id",
+                "position"
+            ],
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "handler.TaskMoveResponse": {
+            "type": "object",
+            "properties": {
+                "action_id": {
+                    "description": "ActionID undoes the move with POST /actions/{id}/undo",
+                    "type": "string"
+                },
+                "affected": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskPositionResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "task": {
+                    "$ref": "#/definitions/handler.TaskPositionResponse"
+                }
+            }
+        },
+        "handler.TaskMoveToBoardRequest": {
+            "type": "object",
+            "required": [
+                "column_id"
+            ],
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "handler.TaskMoveToBoardResponse": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "Key is the new key of the task on the target board",
+                    "type": "string"
+                },
+                "labels_dropped": {
+                    "type": "integer"
+                },
+                "labels_remapped": {
+                    "description": "LabelsRemapped counts the labels replaced by the target board's labels of the same name,\nLabelsDropped the labels removed because the target board has none",
+                    "type": "integer"
+                },
+                "task": {
+                    "$ref": "#/definitions/handler.TaskPositionResponse"
+                }
+            }
+        },
+        "handler.TaskPositionResponse": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.TaskReferenceResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "origin": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskRelationResponse": {
+            "type": "object",
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "completed": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "task_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskRequest": {
+            "type": "object",
+            "required": [
+                "column_id",
+                "title"
+            ],
+            "properties": {
+                "column_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "description": "Estimate in story points; null leaves the task unestimated",
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ]
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskResponse": {
+            "type": "object",
+            "properties": {
+                "assigned_to": {
+                    "type": "string"
+                },
+                "assignee_name": {
+                    "type": "string"
+                },
+                "blocked": {
+                    "type": "boolean"
+                },
+                "blocked_reason": {
+                    "type": "string"
+                },
+                "column_id": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "cover": {
+                    "description": "Cover is omitted for tasks without a cover",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.TaskCoverResponse"
+                        }
+                    ]
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "creator_name": {
+                    "type": "string"
+                },
+                "dependency_blocked": {
+                    "description": "DependencyBlocked is set while a task blocking this one is not completed",
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_html": {
+                    "description": "DescriptionHTML is the description rendered as sanitized HTML, returned with render=html",
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "estimate": {
+                    "type": "integer"
+                },
+                "github_links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskGitHubLinkResponse"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LabelResponse"
+                    }
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskMentionResponse"
+                    }
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "overdue": {
+                    "description": "Overdue is set for open tasks whose due date has passed",
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.TaskReferenceResponse"
+                    }
+                },
+                "sprint_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "swimlane_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.TaskTemplateRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "title_pattern"
+            ],
+            "properties": {
+                "checklist": {
+                    "description": "Checklist items are added to the task description as a Markdown task list",
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "label_ids": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Bug report"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ]
+                },
+                "title_pattern": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Bug: "
+                }
+            }
+        },
+        "handler.TaskTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "board_id": {
+                    "type": "string"
+                },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -2650,56 +16654,39 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
-                "owner_id": {
-                    "type": "string"
+                "label_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "title": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.BoardShareResponse": {
-            "type": "object",
-            "properties": {
-                "email": {
+                "name": {
                     "type": "string"
                 },
-                "is_owner": {
-                    "type": "boolean"
-                },
-                "name": {
+                "priority": {
                     "type": "string"
                 },
-                "role": {
+                "title_pattern": {
                     "type": "string"
                 },
-                "user_id": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "handler.ColumnResponse": {
+        "handler.ThroughputWeekResponse": {
             "type": "object",
             "properties": {
-                "board_id": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "position": {
+                "completed": {
                     "type": "integer"
                 },
-                "title": {
+                "week": {
                     "type": "string"
                 }
             }
         },
-        "handler.CreateBoardRequest": {
+        "handler.UpdateBoardRequest": {
             "type": "object",
-            "required": [
-                "title"
-            ],
             "properties": {
                 "description": {
                     "type": "string"
@@ -2709,15 +16696,20 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.CreateColumnRequest": {
+        "handler.UpdateColumnRequest": {
             "type": "object",
-            "required": [
-                "board_id",
-                "title"
-            ],
             "properties": {
-                "board_id": {
-                    "type": "string"
+                "color": {
+                    "description": "Color and Description are kept when omitted; an empty string clears them",
+                    "type": "string",
+                    "example": "#4caf50"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "is_done": {
+                    "type": "boolean"
                 },
                 "position": {
                     "type": "integer"
@@ -2727,17 +16719,13 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.CreateLabelRequest": {
+        "handler.UpdateLabelRequest": {
             "type": "object",
             "required": [
-                "board_id",
                 "color",
                 "name"
             ],
             "properties": {
-                "board_id": {
-                    "type": "string"
-                },
                 "color": {
                     "type": "string"
                 },
@@ -2746,143 +16734,65 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.LabelResponse": {
+        "handler.UpdateSwimlaneRequest": {
             "type": "object",
             "properties": {
-                "color": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "position": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "handler.LoginRequest": {
+        "handler.UserDetails": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
                 "email": {
                     "type": "string"
                 },
-                "password": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "handler.RegisterRequest": {
+        "handler.UserLimitsRequest": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
-            ],
             "properties": {
-                "email": {
-                    "type": "string"
+                "max_boards": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "name": {
-                    "type": "string"
+                "max_columns_per_board": {
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "max_tasks_per_column": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "handler.ReorderColumnsRequest": {
+        "handler.ViewEventRequest": {
             "type": "object",
-            "required": [
-                "columns"
-            ],
             "properties": {
-                "columns": {
+                "column_ids": {
+                    "description": "ColumnIDs are the columns the user saw; omitted means all columns of the board",
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "required": [
-                            "id",
-                            "position"
-                        ],
-                        "properties": {
-                            "id": {
-                                "type": "string"
-                            },
-                            "position": {
-                                "type": "integer"
-                            }
-                        }
+                        "type": "string"
                     }
                 }
             }
         },
-        "handler.SetDueDateRequest": {
-            "type": "object",
-            "properties": {
-                "due_date": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.ShareBoardRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "role"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "role": {
-                    "type": "string",
-                    "enum": [
-                        "viewer",
-                        "editor"
-                    ]
-                }
-            }
-        },
-        "handler.TaskAssignRequest": {
-            "type": "object",
-            "required": [
-                "user_id"
-            ],
-            "properties": {
-                "user_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.TaskMoveRequest": {
-            "type": "object",
-            "required": [
-                "column_id",
-                "position"
-            ],
-            "properties": {
-                "column_id": {
-                    "type": "string"
-                },
-                "position": {
-                    "type": "integer",
-                    "minimum": 0
-                }
-            }
-        },
-        "handler.TaskRequest": {
+        "jobs.ImportRow": {
             "type": "object",
-            "required": [
-                "column_id",
-                "title"
-            ],
             "properties": {
-                "column_id": {
+                "column": {
                     "type": "string"
                 },
                 "description": {
@@ -2891,83 +16801,67 @@ const docTemplate = `{
                 "due_date": {
                     "type": "string"
                 },
-                "position": {
-                    "type": "integer"
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "priority": {
+                    "type": "string"
                 },
                 "title": {
                     "type": "string"
                 }
             }
         },
-        "handler.TaskResponse": {
+        "limits.Limits": {
             "type": "object",
             "properties": {
-                "assigned_to": {
-                    "type": "string"
-                },
-                "assignee_name": {
-                    "type": "string"
-                },
-                "column_id": {
-                    "type": "string"
-                },
-                "created_by": {
-                    "type": "string"
-                },
-                "creator_name": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "due_date": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.LabelResponse"
-                    }
+                "boards": {
+                    "type": "integer"
                 },
-                "position": {
+                "columns_per_board": {
                     "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "tasks_per_column": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.UpdateBoardRequest": {
+        "ot.Component": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "delete": {
+                    "description": "Delete removes that many characters",
+                    "type": "integer"
                 },
-                "title": {
+                "insert": {
+                    "description": "Insert inserts the text at the current position",
                     "type": "string"
+                },
+                "retain": {
+                    "description": "Retain skips over that many characters, keeping them",
+                    "type": "integer"
                 }
             }
         },
-        "handler.UpdateColumnRequest": {
+        "ratelimit.Limit": {
             "type": "object",
             "properties": {
-                "position": {
+                "burst": {
                     "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "per_hour": {
+                    "type": "integer"
+                },
+                "per_minute": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.UpdateLabelRequest": {
+        "settings.DefaultLabel": {
             "type": "object",
-            "required": [
-                "color",
-                "name"
-            ],
             "properties": {
                 "color": {
                     "type": "string"
@@ -2977,17 +16871,48 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.UserDetails": {
+        "settings.RateLimits": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "analytics": {
+                    "$ref": "#/definitions/ratelimit.Limit"
                 },
-                "id": {
-                    "type": "string"
+                "api": {
+                    "$ref": "#/definitions/ratelimit.Limit"
                 },
-                "name": {
-                    "type": "string"
+                "auth": {
+                    "$ref": "#/definitions/ratelimit.Limit"
+                },
+                "clone": {
+                    "$ref": "#/definitions/ratelimit.Limit"
+                },
+                "export": {
+                    "$ref": "#/definitions/ratelimit.Limit"
+                }
+            }
+        },
+        "settings.Settings": {
+            "type": "object",
+            "properties": {
+                "default_labels": {
+                    "description": "DefaultLabels are the labels new boards start with",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/settings.DefaultLabel"
+                    }
+                },
+                "feature_flags": {
+                    "description": "FeatureFlags are the client feature flags reported by GET /bootstrap",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "limits": {
+                    "$ref": "#/definitions/limits.Limits"
+                },
+                "rate_limits": {
+                    "$ref": "#/definitions/settings.RateLimits"
                 }
             }
         }
@@ -3006,7 +16931,7 @@ const docTemplate = `{
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
 	Host:             "localhost:8080",
-	BasePath:         "/",
+	BasePath:         "/v1",
 	Schemes:          []string{"http"},
 	Title:            "Kanban API",
 	Description:      "API for managing Kanban boards.",
@@ -0,0 +1,155 @@
+// Package apiversion serves the API under versioned path prefixes like /v1 with one set of
+// handlers. Handlers always answer in the shape of the latest version; an older version
+// registers response mappers for the routes whose shape changed since. The unversioned routes
+// from before versioning are kept as deprecated aliases of v1.
+package apiversion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// versionKey is the gin context key of the version of the request
+	versionKey = "api_version"
+	// prefixKey is the gin context key of the path prefix the request's route is registered under
+	prefixKey = "api_version_prefix"
+)
+
+// Mapper rewrites a decoded JSON response of the latest version into the shape of an older one
+type Mapper func(status int, body interface{}) interface{}
+
+// Version is an API version served under /<Name>
+type Version struct {
+	// Name is the path segment of the version, like "v1"
+	Name string
+	// Mappers rewrite responses by route, given as method and route pattern like "GET /boards/:id"
+	Mappers map[string]Mapper
+}
+
+// Prefix returns the path prefix of the version
+func (v Version) Prefix() string {
+	return "/" + v.Name
+}
+
+// Middleware marks the requests of a route group registered under the version's prefix
+func Middleware(v Version) gin.HandlerFunc {
+	return serve(v, v.Prefix())
+}
+
+// Alias marks the requests of a route group registered without a prefix as requests of the
+// version, answered with a Deprecation header (RFC 9745) of the time the alias was deprecated
+// and a Link to the same path under the version's prefix
+func Alias(v Version, deprecatedAt time.Time) gin.HandlerFunc {
+	deprecation := fmt.Sprintf("@%d", deprecatedAt.Unix())
+	next := serve(v, "")
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		c.Header("Link", fmt.Sprintf(`<%s%s>; rel="successor-version"`, v.Prefix(), c.Request.URL.Path))
+		next(c)
+	}
+}
+
+func serve(v Version, prefix string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(versionKey, v.Name)
+		c.Set(prefixKey, prefix)
+
+		mapper := v.Mappers[c.Request.Method+" "+Route(c)]
+		if mapper == nil {
+			c.Next()
+			return
+		}
+
+		w := &bufferedWriter{ResponseWriter: c.Writer, status: http.StatusOK}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+		w.flush(mapper)
+	}
+}
+
+// FromContext returns the version of the request, or "" outside versioned route groups
+func FromContext(c *gin.Context) string {
+	return c.GetString(versionKey)
+}
+
+// Route returns the route pattern of the request without the version prefix, like
+// /boards/:id for both /v1/boards/:id and its unversioned alias
+func Route(c *gin.Context) string {
+	return strings.TrimPrefix(c.FullPath(), c.GetString(prefixKey))
+}
+
+// Path returns an API path under the prefix the request came in with, so links handed out to
+// clients stay in the version they use
+func Path(c *gin.Context, path string) string {
+	return c.GetString(prefixKey) + path
+}
+
+// bufferedWriter holds back the response of a handler so a mapper can rewrite it
+type bufferedWriter struct {
+	gin.ResponseWriter
+	status  int
+	written bool
+	body    bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	w.status = code
+	w.written = true
+}
+
+func (w *bufferedWriter) WriteHeaderNow() {
+	w.written = true
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	w.written = true
+	return w.body.Write(data)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	w.written = true
+	return w.body.WriteString(s)
+}
+
+func (w *bufferedWriter) Status() int {
+	return w.status
+}
+
+func (w *bufferedWriter) Size() int {
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *bufferedWriter) Written() bool {
+	return w.written
+}
+
+// flush writes the held back response, with JSON bodies rewritten by the mapper
+func (w *bufferedWriter) flush(mapper Mapper) {
+	if !w.written {
+		return
+	}
+
+	body := w.body.Bytes()
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") && len(body) > 0 {
+		var decoded interface{}
+		if err := json.Unmarshal(body, &decoded); err == nil {
+			if mapped, err := json.Marshal(mapper(w.status, decoded)); err == nil {
+				body = mapped
+			}
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(body)
+}
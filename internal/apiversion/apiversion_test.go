@@ -0,0 +1,70 @@
+package apiversion_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"kanban/internal/apiversion"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRouter(v apiversion.Version) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	routes := func(api *gin.RouterGroup) {
+		api.GET("/boards/:id", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{
+				"route":   apiversion.Route(c),
+				"version": apiversion.FromContext(c),
+				"self":    apiversion.Path(c, "/boards/"+c.Param("id")),
+			})
+		})
+	}
+	routes(r.Group(v.Prefix(), apiversion.Middleware(v)))
+	routes(r.Group("/", apiversion.Alias(v, time.Unix(1760572800, 0))))
+	return r
+}
+
+func TestVersionedAndAliasRoutes(t *testing.T) {
+	r := newRouter(apiversion.Version{Name: "v1"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/boards/42", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"route": "/boards/:id", "version": "v1", "self": "/v1/boards/42"}`, w.Body.String())
+	assert.Empty(t, w.Header().Get("Deprecation"))
+
+	// Старый путь без версии отвечает так же, но помечен устаревшим
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/boards/42", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"route": "/boards/:id", "version": "v1", "self": "/boards/42"}`, w.Body.String())
+	assert.Equal(t, "@1760572800", w.Header().Get("Deprecation"))
+	assert.Equal(t, `</v1/boards/42>; rel="successor-version"`, w.Header().Get("Link"))
+}
+
+func TestMappers(t *testing.T) {
+	r := newRouter(apiversion.Version{
+		Name: "v1",
+		Mappers: map[string]apiversion.Mapper{
+			"GET /boards/:id": func(status int, body interface{}) interface{} {
+				fields := body.(map[string]interface{})
+				delete(fields, "self")
+				fields["status"] = status
+				return fields
+			},
+		},
+	})
+
+	for _, path := range []string{"/v1/boards/42", "/boards/42"} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.JSONEq(t, `{"route": "/boards/:id", "version": "v1", "status": 200}`, w.Body.String(), path)
+		assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"), path)
+	}
+}
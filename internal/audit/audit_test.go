@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEntry() Entry {
+	userID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	email := "anna@example.com"
+	newValue := "Buy oat milk"
+	return Entry{
+		ID:        uuid.MustParse("00000000-0000-0000-0000-000000000001"),
+		ChangedAt: time.Date(2026, time.March, 2, 10, 30, 0, 0, time.UTC),
+		BoardID:   uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+		TaskID:    uuid.MustParse("00000000-0000-0000-0000-000000000003"),
+		UserID:    &userID,
+		UserEmail: &email,
+		Field:     "title",
+		NewValue:  &newValue,
+	}
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatNDJSON)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testEntry()))
+	require.NoError(t, w.Write(testEntry()))
+	require.NoError(t, w.Flush())
+
+	// Одна запись на строку
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var entry Entry
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, testEntry(), entry)
+	assert.Contains(t, lines[0], `"old_value":null`)
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatCSV)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(testEntry()))
+	require.NoError(t, w.Flush())
+
+	assert.Equal(t,
+		"id,changed_at,board_id,task_id,user_id,user_email,field,old_value,new_value\n"+
+			"00000000-0000-0000-0000-000000000001,2026-03-02T10:30:00Z,00000000-0000-0000-0000-000000000002,"+
+			"00000000-0000-0000-0000-000000000003,00000000-0000-0000-0000-000000000004,anna@example.com,title,,Buy oat milk\n",
+		buf.String())
+
+	// Пустой экспорт всё равно содержит заголовок
+	buf.Reset()
+	w, err = NewWriter(&buf, FormatCSV)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	assert.Equal(t, strings.Join(csvHeader, ",")+"\n", buf.String())
+
+	_, err = NewWriter(&buf, "xml")
+	assert.Error(t, err)
+}
@@ -9,9 +9,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var secret = []byte(os.Getenv("JWT_SECRET"))
-
-func GenerateToken(userID string) (string, error) {
+// GenerateToken signs a token of the user with secret, the JWT secret of the configuration
+func GenerateToken(secret []byte, userID string) (string, error) {
 	expiryHours, _ := strconv.Atoi(os.Getenv("JWT_EXPIRY_HOURS"))
 	claims := jwt.MapClaims{
 		"user_id": userID,
@@ -22,7 +21,8 @@ func GenerateToken(userID string) (string, error) {
 	return token.SignedString(secret)
 }
 
-func ParseToken(tokenStr string) (string, error) {
+// ParseToken checks a token signed with secret and returns the ID of its user
+func ParseToken(secret []byte, tokenStr string) (string, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		return secret, nil
 	})
@@ -13,19 +13,18 @@ import (
 
 func TestGenerateAndParseToken(t *testing.T) {
 	// Устанавливаем переменные окружения для тестов
-	os.Setenv("JWT_SECRET", "test-secret-key")
 	os.Setenv("JWT_EXPIRY_HOURS", "24")
 
 	// Генерируем токен
 	userID := "test-user-id"
-	token, err := auth.GenerateToken(userID)
+	token, err := auth.GenerateToken([]byte("test-secret-key"), userID)
 	
 	// Проверяем, что токен создан без ошибок
 	assert.NoError(t, err)
 	assert.NotEmpty(t, token)
 	
 	// Парсим токен
-	parsedUserID, err := auth.ParseToken(token)
+	parsedUserID, err := auth.ParseToken([]byte("test-secret-key"), token)
 	
 	// Проверяем, что токен был успешно проверен и из него извлечен правильный ID пользователя
 	assert.NoError(t, err)
@@ -33,11 +32,8 @@ func TestGenerateAndParseToken(t *testing.T) {
 }
 
 func TestParseToken_InvalidToken(t *testing.T) {
-	// Устанавливаем переменные окружения для тестов
-	os.Setenv("JWT_SECRET", "test-secret-key")
-	
 	// Пытаемся парсить неверный токен
-	_, err := auth.ParseToken("invalid-token")
+	_, err := auth.ParseToken([]byte("test-secret-key"), "invalid-token")
 	
 	// Проверяем, что возникла ошибка
 	assert.Error(t, err)
@@ -45,9 +41,6 @@ func TestParseToken_InvalidToken(t *testing.T) {
 }
 
 func TestParseToken_ExpiredToken(t *testing.T) {
-	// Устанавливаем переменные окружения для тестов
-	os.Setenv("JWT_SECRET", "test-secret-key")
-	
 	// Создаем токен с истекшим сроком действия
 	claims := jwt.MapClaims{
 		"user_id": "test-user-id",
@@ -58,7 +51,7 @@ func TestParseToken_ExpiredToken(t *testing.T) {
 	expiredToken, _ := token.SignedString([]byte("test-secret-key"))
 	
 	// Пытаемся парсить истекший токен
-	_, err := auth.ParseToken(expiredToken)
+	_, err := auth.ParseToken([]byte("test-secret-key"), expiredToken)
 	
 	// Проверяем, что возникла ошибка
 	assert.Error(t, err)
@@ -66,9 +59,6 @@ func TestParseToken_ExpiredToken(t *testing.T) {
 }
 
 func TestParseToken_MissingClaims(t *testing.T) {
-	// Устанавливаем переменные окружения для тестов
-	os.Setenv("JWT_SECRET", "test-secret-key")
-	
 	// Создаем токен без ID пользователя
 	claims := jwt.MapClaims{
 		"exp": time.Now().Add(24 * time.Hour).Unix(),
@@ -79,7 +69,7 @@ func TestParseToken_MissingClaims(t *testing.T) {
 	tokenWithoutUserID, _ := token.SignedString([]byte("test-secret-key"))
 	
 	// Пытаемся парсить токен
-	_, err := auth.ParseToken(tokenWithoutUserID)
+	_, err := auth.ParseToken([]byte("test-secret-key"), tokenWithoutUserID)
 	
 	// Проверяем, что возникла ошибка
 	assert.Error(t, err)
@@ -0,0 +1,103 @@
+// Package boardtemplate describes boards that can be instantiated with predefined
+// columns, labels and tasks.
+package boardtemplate
+
+// Template is a blueprint for a complete board
+type Template struct {
+	Title       string
+	Description string
+	Labels      []Label
+	Columns     []Column
+}
+
+// Label is a board label created with the board
+type Label struct {
+	Name  string
+	Color string
+}
+
+// Column is a board column with its initial tasks, in order.
+// Tasks in a Done column are created as completed.
+type Column struct {
+	Title string
+	Done  bool
+	Tasks []Task
+}
+
+// Task is an initial task. Labels refer to template labels by name.
+// DueInDays, when set, makes the due date relative to the instantiation time.
+type Task struct {
+	Title       string
+	Description string
+	Labels      []string
+	DueInDays   *int
+}
+
+func days(n int) *int {
+	return &n
+}
+
+// Onboarding is the guided sample board created for new users
+var Onboarding = Template{
+	Title:       "Welcome to Kanban",
+	Description: "A sample board that walks you through the basics. Feel free to edit or delete it.",
+	Labels: []Label{
+		{Name: "Getting started", Color: "#3b82f6"},
+		{Name: "Tip", Color: "#f59e0b"},
+		{Name: "Important", Color: "#ef4444"},
+	},
+	Columns: []Column{
+		{
+			Title: "To Do",
+			Tasks: []Task{
+				{
+					Title: "Welcome! Start here",
+					Description: "Boards are split into columns, and columns hold tasks. " +
+						"Move this card to \"In Progress\" to see how tasks flow across the board.",
+					Labels: []string{"Getting started"},
+				},
+				{
+					Title: "Organize tasks with labels",
+					Description: "Labels like the ones on this card help you group related work. " +
+						"Create board labels for this board or workspace labels that every board of yours can use.",
+					Labels: []string{"Tip"},
+				},
+				{
+					Title: "Never miss a deadline",
+					Description: "This card is due in three days. Set or change a due date on any task " +
+						"to keep track of what needs attention first.",
+					Labels:    []string{"Tip"},
+					DueInDays: days(3),
+				},
+			},
+		},
+		{
+			Title: "In Progress",
+			Tasks: []Task{
+				{
+					Title: "Invite your team",
+					Description: "Share a board by email and pick a role: viewers can look around, " +
+						"editors can change columns, tasks and labels.",
+					Labels: []string{"Important"},
+				},
+				{
+					Title: "Link related tasks",
+					Description: "Every task gets a number. Mention #1 in a description and it becomes a link " +
+						"to the first card on this board.",
+					Labels: []string{"Tip"},
+				},
+			},
+		},
+		{
+			Title: "Done",
+			Done:  true,
+			Tasks: []Task{
+				{
+					Title:       "Create your account",
+					Description: "You're all set. Delete this board whenever you're ready to start your own.",
+					Labels:      []string{"Getting started"},
+				},
+			},
+		},
+	},
+}
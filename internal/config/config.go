@@ -1,37 +1,358 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	DBHost         string
-	DBPort         string
-	DBUser         string
-	DBPassword     string
-	DBName         string
-	ServerPort     string
-	JWTSecret      string
+	// DBDriver is the database the server keeps its data in: "postgres", or "sqlite" for the
+	// SQLite file at DBPath. The other DB settings only apply to PostgreSQL.
+	DBDriver   string
+	DBPath     string
+	DBHost     string
+	DBPort     string
+	DBUser     string
+	DBPassword string
+	DBName     string
+	ServerPort string
+	JWTSecret  string
+	// ShutdownGracePeriodSec is how long requests and queued jobs may run on after a shutdown signal
+	ShutdownGracePeriodSec int
+	// DebugAddr is the internal address serving pprof profiles and expvar variables, e.g.
+	// localhost:6060; empty disables them
+	DebugAddr string
+	// DBReplicaDSN is the connection string of a read replica for listings; empty reads from the primary
+	DBReplicaDSN string
+	// MigrateOnStartup applies pending schema migrations when the server starts
+	MigrateOnStartup bool
+	// IDStrategy is how keys of new entities are generated: "uuidv4" or the time-sortable "uuidv7"
+	IDStrategy string
+
+	// CORS settings for browser clients served from other origins
+	CORSAllowedOrigins   []string
+	CORSAllowedHeaders   []string
+	CORSAllowCredentials bool
+	CORSMaxAge           int
+
+	// TrustedProxies are the addresses or CIDR ranges of proxies whose X-Forwarded-For headers
+	// give the client IP. With none, the client IP is the remote address of the connection.
+	TrustedProxies []string
+
+	// Requests get a deadline of RequestTimeoutSec, 0 for none, and bodies of up to MaxRequestBodyBytes
+	RequestTimeoutSec   int
+	MaxRequestBodyBytes int64
+
+	// Responses of CompressionContentTypes of at least CompressionMinBytes are compressed with
+	// brotli or gzip when the client accepts it
+	CompressionEnabled      bool
+	CompressionMinBytes     int
+	CompressionContentTypes []string
+
+	// RequestValidationEnabled rejects requests that don't match the generated API document
+	RequestValidationEnabled bool
+
+	// Rate limiting: RateLimitBackend is "memory" or "redis". The limits, like the instance
+	// limits and feature flags below, are defaults of the runtime settings administrators can
+	// change through the admin API.
+	RateLimitEnabled    bool
+	RateLimitBackend    string
+	RateLimitAuthPerMin int
+	RateLimitAuthBurst  int
+	RateLimitAPIPerMin  int
+	RateLimitAPIBurst   int
+
+	// Separate per-user limits for expensive operations
+	RateLimitExportPerHour   int
+	RateLimitExportBurst     int
+	RateLimitClonePerHour    int
+	RateLimitCloneBurst      int
+	RateLimitAnalyticsPerMin int
+	RateLimitAnalyticsBurst  int
+
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+
+	// Cache of board membership and board and column metadata for task requests:
+	// PermissionCacheBackend is "memory", "redis" or "off"
+	PermissionCacheBackend string
+	PermissionCacheTTLSec  int
+
+	// Instance limits on boards per user, columns per board and tasks per column; 0 is unlimited.
+	// Administrators can override them per user.
+	MaxBoardsPerUser   int
+	MaxColumnsPerBoard int
+	MaxTasksPerColumn  int
+
+	// OnboardingSampleBoard generates a sample board on a user's first login
+	OnboardingSampleBoard bool
+
+	// OAuth login providers; a provider is enabled when its client ID is set
+	OAuthRedirectBaseURL    string
+	OAuthSuccessRedirectURL string
+	GoogleClientID          string
+	GoogleClientSecret      string
+	GitHubClientID          string
+	GitHubClientSecret      string
+
+	// Background compaction of fragmented task and column positions during the
+	// low-traffic window [CompactionWindowStart, CompactionWindowEnd) in UTC hours
+	CompactionEnabled     bool
+	CompactionIntervalMin int
+	CompactionWindowStart int
+	CompactionWindowEnd   int
+	CompactionBatchSize   int
+
+	// Nightly roll-up of daily board metrics during [MetricsWindowStart, MetricsWindowEnd) in UTC hours;
+	// the first roll-up also covers the last MetricsBackfillDays days
+	MetricsRollupEnabled     bool
+	MetricsRollupIntervalMin int
+	MetricsWindowStart       int
+	MetricsWindowEnd         int
+	MetricsBackfillDays      int
+
+	// Delivery of outbox events: posted to OutboxWebhookURL, signed with OutboxWebhookSecret,
+	// or logged when no URL is set
+	OutboxWebhookURL    string
+	OutboxWebhookSecret string
+	OutboxIntervalSec   int
+	OutboxBatchSize     int
+
+	// ReportPDFRendererURL is the endpoint of an HTML-to-PDF service, e.g. Gotenberg's
+	// /forms/chromium/convert/html, that renders PDF board reports; empty disables them
+	ReportPDFRendererURL string
+
+	// Daily and weekly email digests go out at DigestHour (UTC), checked every DigestIntervalMin
+	// minutes. Emails are sent through the SMTP server at SMTPAddr (host:port) from MailFrom, or
+	// logged when no server is set.
+	DigestEnabled     bool
+	DigestIntervalMin int
+	DigestHour        int
+	DigestBatchSize   int
+	SMTPAddr          string
+	SMTPUsername      string
+	SMTPPassword      string
+	MailFrom          string
+
+	// Descriptions edited collaboratively are saved to their tasks by the edits themselves; the
+	// last edits are saved every DescriptionSaveIntervalSec seconds once nobody edits them anymore
+	DescriptionSaveIntervalSec int
+	DescriptionSaveBatchSize   int
+
+	// BoardChangeRetentionHours is how long the change feed of boards is kept for polling clients
+	BoardChangeRetentionHours int
+
+	// The activity log, the history of task changes, is kept for AuditRetentionDays days, 0 keeping
+	// it forever. Older entries are removed in batches of AuditRetentionBatchSize, after being
+	// written to gzipped NDJSON files in AuditArchiveDir when it is set.
+	AuditRetentionDays      int
+	AuditRetentionBatchSize int
+	AuditArchiveDir         string
+
+	// Tasks completed more than TaskArchiveAfterDays days ago are moved, TaskArchiveBatchSize at a
+	// time, to gzipped NDJSON files in TaskArchiveDir, from which they can be restored. Tasks are
+	// not archived when TaskArchiveDir is not set.
+	TaskArchiveDir       string
+	TaskArchiveAfterDays int
+	TaskArchiveBatchSize int
+
+	// Background job queue for long-running operations such as board duplication
+	JobWorkers   int
+	JobQueueSize int
+
+	// Anomaly alerts for admins; alerts go to the log when no Slack webhook is configured
+	AlertsEnabled         bool
+	AlertSlackWebhookURL  string
+	AlertIntervalSec      int
+	AlertCooldownMin      int
+	AlertErrorRatePercent int
+	AlertMinRequests      int
+	AlertLoginFailures    int
+
+	// Panics and server errors are reported to ErrorReportingDSN, a Sentry DSN, when it is set
+	ErrorReportingDSN         string
+	ErrorReportingEnvironment string
+
+	// OpenTelemetry tracing: spans are exported over OTLP/HTTP to TracingEndpoint, and
+	// TracingSamplePercent of the traces started here are recorded
+	TracingEnabled       bool
+	TracingEndpoint      string
+	TracingServiceName   string
+	TracingSamplePercent int
+
+	// FeatureFlags are extra client feature flags reported as enabled by GET /bootstrap
+	FeatureFlags []string
+
+	// SettingsRefreshSec is how often runtime settings changed through other instances are reloaded
+	SettingsRefreshSec int
 }
 
-func Load() *Config {
+// Load reads the configuration from the environment and a .env file. Secrets can also be read
+// from the file named by their variable with a _FILE suffix, like JWT_SECRET_FILE, as Docker and
+// Kubernetes mount them, or from Vault when VAULT_ADDR is set. Extra secret stores can be passed
+// as providers; they are asked in order after Vault.
+func Load(providers ...SecretProvider) (*Config, error) {
 	err := godotenv.Load()
 	if err != nil {
 		log.Println("⚠️  No .env file found, using system environment variables")
 	}
 
-	return &Config{
-		DBHost:         getEnv("DB_HOST", "localhost"),
-		DBPort:         getEnv("DB_PORT", "5431"),
-		DBUser:         getEnv("DB_USER", "kanban_user"),
-		DBPassword:     getEnv("DB_PASSWORD", "kanban_pass"),
-		DBName:         getEnv("DB_NAME", "kanban_db"),
-		ServerPort:     getEnv("SERVER_PORT", "8080"),
-		JWTSecret:      getEnv("JWT_SECRET", "supersecretkey"),
+	secrets := &secretLoader{}
+	if addr := getEnv("VAULT_ADDR", ""); addr != "" {
+		token := secrets.get("VAULT_TOKEN", "")
+		secrets.providers = append(secrets.providers, NewVaultProvider(addr, token, getEnv("VAULT_SECRET_PATH", "secret/data/kanban")))
+	}
+	secrets.providers = append(secrets.providers, providers...)
+
+	cfg := &Config{
+		DBDriver:   getEnv("DB_DRIVER", "postgres"),
+		DBPath:     getEnv("DB_PATH", "kanban.db"),
+		DBHost:     getEnv("DB_HOST", "localhost"),
+		DBPort:     getEnv("DB_PORT", "5431"),
+		DBUser:     getEnv("DB_USER", "kanban_user"),
+		DBPassword: secrets.get("DB_PASSWORD", "kanban_pass"),
+		DBName:     getEnv("DB_NAME", "kanban_db"),
+		ServerPort: getEnv("SERVER_PORT", "8080"),
+		JWTSecret:  secrets.get("JWT_SECRET", "supersecretkey"),
+		DebugAddr:  getEnv("DEBUG_ADDR", ""),
+
+		ShutdownGracePeriodSec: getEnvInt("SHUTDOWN_GRACE_PERIOD_SECONDS", 25),
+
+		DBReplicaDSN:     secrets.get("DB_REPLICA_DSN", ""),
+		MigrateOnStartup: getEnv("DB_MIGRATE_ON_STARTUP", "true") == "true",
+		IDStrategy:       getEnv("ID_STRATEGY", "uuidv4"),
+
+		CORSAllowedOrigins:   getEnvList("CORS_ALLOWED_ORIGINS", "*"),
+		CORSAllowedHeaders:   getEnvList("CORS_ALLOWED_HEADERS", "Authorization,Content-Type"),
+		CORSAllowCredentials: getEnv("CORS_ALLOW_CREDENTIALS", "false") == "true",
+		CORSMaxAge:           getEnvInt("CORS_MAX_AGE", 600),
+
+		TrustedProxies: getEnvList("TRUSTED_PROXIES", ""),
+
+		RequestTimeoutSec:   getEnvInt("REQUEST_TIMEOUT_SECONDS", 30),
+		MaxRequestBodyBytes: int64(getEnvInt("MAX_REQUEST_BODY_BYTES", 1<<20)),
+
+		CompressionEnabled:      getEnv("COMPRESSION_ENABLED", "true") == "true",
+		CompressionMinBytes:     getEnvInt("COMPRESSION_MIN_BYTES", 1024),
+		CompressionContentTypes: getEnvList("COMPRESSION_CONTENT_TYPES", "application/json,text/html,text/plain,text/calendar"),
+
+		RequestValidationEnabled: getEnv("REQUEST_VALIDATION_ENABLED", "true") == "true",
+
+		RateLimitEnabled:    getEnv("RATE_LIMIT_ENABLED", "true") == "true",
+		RateLimitBackend:    getEnv("RATE_LIMIT_BACKEND", "memory"),
+		RateLimitAuthPerMin: getEnvInt("RATE_LIMIT_AUTH_PER_MINUTE", 10),
+		RateLimitAuthBurst:  getEnvInt("RATE_LIMIT_AUTH_BURST", 5),
+		RateLimitAPIPerMin:  getEnvInt("RATE_LIMIT_API_PER_MINUTE", 300),
+		RateLimitAPIBurst:   getEnvInt("RATE_LIMIT_API_BURST", 60),
+
+		RateLimitExportPerHour:   getEnvInt("RATE_LIMIT_EXPORT_PER_HOUR", 4),
+		RateLimitExportBurst:     getEnvInt("RATE_LIMIT_EXPORT_BURST", 2),
+		RateLimitClonePerHour:    getEnvInt("RATE_LIMIT_CLONE_PER_HOUR", 20),
+		RateLimitCloneBurst:      getEnvInt("RATE_LIMIT_CLONE_BURST", 5),
+		RateLimitAnalyticsPerMin: getEnvInt("RATE_LIMIT_ANALYTICS_PER_MINUTE", 10),
+		RateLimitAnalyticsBurst:  getEnvInt("RATE_LIMIT_ANALYTICS_BURST", 5),
+
+		RedisAddr:     getEnv("REDIS_ADDR", "localhost:6379"),
+		RedisPassword: secrets.get("REDIS_PASSWORD", ""),
+		RedisDB:       getEnvInt("REDIS_DB", 0),
+
+		PermissionCacheBackend: getEnv("PERMISSION_CACHE_BACKEND", "memory"),
+		PermissionCacheTTLSec:  getEnvInt("PERMISSION_CACHE_TTL_SECONDS", 30),
+
+		MaxBoardsPerUser:   getEnvInt("MAX_BOARDS_PER_USER", 5),
+		MaxColumnsPerBoard: getEnvInt("MAX_COLUMNS_PER_BOARD", 0),
+		MaxTasksPerColumn:  getEnvInt("MAX_TASKS_PER_COLUMN", 0),
+
+		OnboardingSampleBoard: getEnv("ONBOARDING_SAMPLE_BOARD", "true") == "true",
+
+		OAuthRedirectBaseURL:    getEnv("OAUTH_REDIRECT_BASE_URL", "http://localhost:8080"),
+		OAuthSuccessRedirectURL: getEnv("OAUTH_SUCCESS_REDIRECT_URL", ""),
+		GoogleClientID:          getEnv("GOOGLE_CLIENT_ID", ""),
+		GoogleClientSecret:      secrets.get("GOOGLE_CLIENT_SECRET", ""),
+		GitHubClientID:          getEnv("GITHUB_CLIENT_ID", ""),
+		GitHubClientSecret:      secrets.get("GITHUB_CLIENT_SECRET", ""),
+
+		CompactionEnabled:     getEnv("COMPACTION_ENABLED", "true") == "true",
+		CompactionIntervalMin: getEnvInt("COMPACTION_INTERVAL_MINUTES", 15),
+		CompactionWindowStart: getEnvInt("COMPACTION_WINDOW_START_HOUR", 2),
+		CompactionWindowEnd:   getEnvInt("COMPACTION_WINDOW_END_HOUR", 5),
+		CompactionBatchSize:   getEnvInt("COMPACTION_BATCH_SIZE", 500),
+
+		MetricsRollupEnabled:     getEnv("METRICS_ROLLUP_ENABLED", "true") == "true",
+		MetricsRollupIntervalMin: getEnvInt("METRICS_ROLLUP_INTERVAL_MINUTES", 30),
+		MetricsWindowStart:       getEnvInt("METRICS_ROLLUP_WINDOW_START_HOUR", 0),
+		MetricsWindowEnd:         getEnvInt("METRICS_ROLLUP_WINDOW_END_HOUR", 6),
+		MetricsBackfillDays:      getEnvInt("METRICS_BACKFILL_DAYS", 364),
+
+		OutboxWebhookURL:    getEnv("OUTBOX_WEBHOOK_URL", ""),
+		OutboxWebhookSecret: secrets.get("OUTBOX_WEBHOOK_SECRET", ""),
+		OutboxIntervalSec:   getEnvInt("OUTBOX_INTERVAL_SECONDS", 5),
+		OutboxBatchSize:     getEnvInt("OUTBOX_BATCH_SIZE", 100),
+
+		ReportPDFRendererURL: getEnv("REPORT_PDF_RENDERER_URL", ""),
+
+		DigestEnabled:     getEnv("DIGEST_ENABLED", "true") == "true",
+		DigestIntervalMin: getEnvInt("DIGEST_INTERVAL_MINUTES", 10),
+		DigestHour:        getEnvInt("DIGEST_HOUR", 7),
+		DigestBatchSize:   getEnvInt("DIGEST_BATCH_SIZE", 200),
+		SMTPAddr:          getEnv("SMTP_ADDR", ""),
+		SMTPUsername:      getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:      secrets.get("SMTP_PASSWORD", ""),
+		MailFrom:          getEnv("MAIL_FROM", "Kanban <noreply@localhost>"),
+
+		DescriptionSaveIntervalSec: getEnvInt("DESCRIPTION_SAVE_INTERVAL_SECONDS", 10),
+		DescriptionSaveBatchSize:   getEnvInt("DESCRIPTION_SAVE_BATCH_SIZE", 100),
+
+		BoardChangeRetentionHours: getEnvInt("BOARD_CHANGE_RETENTION_HOURS", 168),
+
+		AuditRetentionDays:      getEnvInt("AUDIT_RETENTION_DAYS", 0),
+		AuditRetentionBatchSize: getEnvInt("AUDIT_RETENTION_BATCH_SIZE", 1000),
+		AuditArchiveDir:         getEnv("AUDIT_ARCHIVE_DIR", ""),
+
+		TaskArchiveDir:       getEnv("TASK_ARCHIVE_DIR", ""),
+		TaskArchiveAfterDays: getEnvInt("TASK_ARCHIVE_AFTER_DAYS", 365),
+		TaskArchiveBatchSize: getEnvInt("TASK_ARCHIVE_BATCH_SIZE", 100),
+
+		JobWorkers:   getEnvInt("JOB_WORKERS", 2),
+		JobQueueSize: getEnvInt("JOB_QUEUE_SIZE", 100),
+
+		AlertsEnabled:         getEnv("ALERTS_ENABLED", "true") == "true",
+		AlertSlackWebhookURL:  secrets.get("ALERT_SLACK_WEBHOOK_URL", ""),
+		AlertIntervalSec:      getEnvInt("ALERT_INTERVAL_SECONDS", 60),
+		AlertCooldownMin:      getEnvInt("ALERT_COOLDOWN_MINUTES", 15),
+		AlertErrorRatePercent: getEnvInt("ALERT_ERROR_RATE_PERCENT", 5),
+		AlertMinRequests:      getEnvInt("ALERT_MIN_REQUESTS", 50),
+		AlertLoginFailures:    getEnvInt("ALERT_LOGIN_FAILURES", 20),
+
+		ErrorReportingDSN:         secrets.get("SENTRY_DSN", ""),
+		ErrorReportingEnvironment: getEnv("SENTRY_ENVIRONMENT", "production"),
+
+		TracingEnabled:       getEnv("TRACING_ENABLED", "false") == "true",
+		TracingEndpoint:      getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318"),
+		TracingServiceName:   getEnv("OTEL_SERVICE_NAME", "kanban"),
+		TracingSamplePercent: getEnvInt("TRACING_SAMPLE_PERCENT", 100),
+
+		FeatureFlags: getEnvList("FEATURE_FLAGS", ""),
+
+		SettingsRefreshSec: getEnvInt("SETTINGS_REFRESH_SECONDS", 30),
 	}
+	if secrets.err != nil {
+		return nil, secrets.err
+	}
+	return cfg, nil
+}
+
+// DatabaseDSN returns the PostgreSQL connection string for the configured database
+func (c *Config) DatabaseDSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		c.DBHost, c.DBPort, c.DBUser, c.DBPassword, c.DBName,
+	)
 }
 
 func getEnv(key, defaultVal string) string {
@@ -40,3 +361,22 @@ func getEnv(key, defaultVal string) string {
 	}
 	return defaultVal
 }
+
+// getEnvList reads a comma-separated list, skipping empty entries
+func getEnvList(key, defaultVal string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, defaultVal), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getEnvInt(key string, defaultVal int) int {
+	value, err := strconv.Atoi(getEnv(key, ""))
+	if err != nil {
+		return defaultVal
+	}
+	return value
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SecretProvider looks up secrets in an external store
+type SecretProvider interface {
+	// Secret returns the secret named like its environment variable, e.g. JWT_SECRET, and
+	// false when the store doesn't have it
+	Secret(key string) (string, bool, error)
+}
+
+// secretLoader resolves secrets from the environment, files and providers, keeping the first error
+type secretLoader struct {
+	providers []SecretProvider
+	err       error
+}
+
+// get returns the secret key from, in order: the environment variable, the file named by
+// key_FILE, the providers, or defaultVal. Secrets found elsewhere are not put into the
+// environment, where child processes and crash dumps would see them; code gets them from Config.
+func (l *secretLoader) get(key, defaultVal string) string {
+	value, fromEnv := os.LookupEnv(key)
+	path, fromFile := os.LookupEnv(key + "_FILE")
+	if fromEnv && fromFile {
+		l.fail(fmt.Errorf("both %s and %s_FILE are set", key, key))
+		return ""
+	}
+	if fromEnv {
+		return value
+	}
+
+	found := false
+	if fromFile {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			l.fail(fmt.Errorf("failed to read %s_FILE: %w", key, err))
+			return ""
+		}
+		// Файлы секретов обычно заканчиваются переводом строки
+		value, found = strings.TrimRight(string(content), "\r\n"), true
+	}
+	for i := 0; i < len(l.providers) && !found; i++ {
+		var err error
+		value, found, err = l.providers[i].Secret(key)
+		if err != nil {
+			l.fail(fmt.Errorf("failed to look up %s: %w", key, err))
+			return ""
+		}
+	}
+	if !found {
+		return defaultVal
+	}
+	return value
+}
+
+func (l *secretLoader) fail(err error) {
+	if l.err == nil {
+		l.err = err
+	}
+}
+
+// VaultProvider reads secrets from a key/value version 2 secret in HashiCorp Vault, whose keys
+// are the names of the environment variables. The secret is read once, on the first lookup.
+type VaultProvider struct {
+	addr   string
+	token  string
+	path   string
+	client *http.Client
+
+	once    sync.Once
+	secrets map[string]string
+	err     error
+}
+
+// NewVaultProvider reads the secret at path, the API path like "secret/data/kanban", from the
+// Vault server at addr
+func NewVaultProvider(addr, token, path string) *VaultProvider {
+	return &VaultProvider{
+		addr:   strings.TrimRight(addr, "/"),
+		token:  token,
+		path:   strings.Trim(path, "/"),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *VaultProvider) Secret(key string) (string, bool, error) {
+	p.once.Do(func() {
+		p.secrets, p.err = p.fetch(context.Background())
+	})
+	if p.err != nil {
+		return "", false, p.err
+	}
+	value, ok := p.secrets[key]
+	return value, ok, nil
+}
+
+func (p *VaultProvider) fetch(ctx context.Context) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.addr+"/v1/"+p.path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault returned %s for %s", resp.Status, p.path)
+	}
+	var body struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode vault secret %s: %w", p.path, err)
+	}
+	return body.Data.Data, nil
+}
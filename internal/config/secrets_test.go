@@ -0,0 +1,72 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticProvider map[string]string
+
+func (p staticProvider) Secret(key string) (string, bool, error) {
+	value, ok := p[key]
+	return value, ok, nil
+}
+
+func TestSecretLoader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt_secret")
+	require.NoError(t, os.WriteFile(path, []byte("from-file\n"), 0o600))
+	t.Setenv("JWT_SECRET_FILE", path)
+	t.Setenv("DB_PASSWORD", "from-env")
+	// Переменные без значения; t.Setenv восстановит их после теста
+	for _, key := range []string{"JWT_SECRET", "REDIS_PASSWORD", "GITHUB_CLIENT_SECRET"} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+
+	secrets := &secretLoader{providers: []SecretProvider{staticProvider{"JWT_SECRET": "from-provider", "REDIS_PASSWORD": "from-provider"}}}
+	assert.Equal(t, "from-file", secrets.get("JWT_SECRET", "default"))
+	assert.Equal(t, "from-env", secrets.get("DB_PASSWORD", "default"))
+	assert.Equal(t, "from-provider", secrets.get("REDIS_PASSWORD", "default"))
+	assert.Equal(t, "default", secrets.get("GITHUB_CLIENT_SECRET", "default"))
+	require.NoError(t, secrets.err)
+	// Секреты из файлов и хранилищ не попадают в окружение
+	_, inEnv := os.LookupEnv("JWT_SECRET")
+	assert.False(t, inEnv)
+
+	// Секрет задан дважды
+	t.Setenv("DB_PASSWORD_FILE", path)
+	secrets.get("DB_PASSWORD", "")
+	assert.EqualError(t, secrets.err, "both DB_PASSWORD and DB_PASSWORD_FILE are set")
+}
+
+func TestVaultProvider(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v1/secret/data/kanban" || r.Header.Get("X-Vault-Token") != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(`{"data": {"data": {"JWT_SECRET": "from-vault"}, "metadata": {"version": 3}}}`))
+	}))
+	defer server.Close()
+
+	provider := NewVaultProvider(server.URL+"/", "s.token", "/secret/data/kanban")
+	value, found, err := provider.Secret("JWT_SECRET")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "from-vault", value)
+	_, found, err = provider.Secret("DB_PASSWORD")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, 1, requests)
+
+	_, _, err = NewVaultProvider(server.URL, "wrong", "secret/data/kanban").Secret("JWT_SECRET")
+	assert.EqualError(t, err, "vault returned 403 Forbidden for secret/data/kanban")
+}
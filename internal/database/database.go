@@ -0,0 +1,76 @@
+// Package database opens the database the server keeps its data in: PostgreSQL, or a SQLite file
+// for small self-hosted installs that don't want to run a database server. SQLite is built with
+// cgo when it is enabled; the purego build tag, or building with CGO_ENABLED=0, selects a pure-Go
+// driver instead.
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Database drivers
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Drivers lists the supported database drivers
+var Drivers = []string{DriverPostgres, DriverSQLite}
+
+// Open returns the dialector of a driver: dsn is the connection string of a PostgreSQL database
+// or the path of a SQLite database file, which is created if it does not exist
+func Open(driver, dsn string) (gorm.Dialector, error) {
+	switch driver {
+	case DriverPostgres, "":
+		return postgres.Open(dsn), nil
+	case DriverSQLite:
+		return sqliteDialector{Dialector: openSQLite(dsn)}, nil
+	default:
+		return nil, fmt.Errorf("unknown database driver %q, expected one of %v", driver, Drivers)
+	}
+}
+
+// IsSQLite reports whether db is a SQLite database
+func IsSQLite(db *gorm.DB) bool {
+	return db.Dialector.Name() == DriverSQLite
+}
+
+// sqliteDialector binds times in UTC. SQLite keeps times as text and compares them as text, which
+// only orders them correctly when they are all in the same zone.
+type sqliteDialector struct {
+	gorm.Dialector
+}
+
+func (d sqliteDialector) BindVarTo(writer clause.Writer, stmt *gorm.Statement, v interface{}) {
+	switch t := v.(type) {
+	case time.Time:
+		stmt.Vars[len(stmt.Vars)-1] = t.UTC()
+	case *time.Time:
+		if t != nil {
+			stmt.Vars[len(stmt.Vars)-1] = t.UTC()
+		}
+	}
+	d.Dialector.BindVarTo(writer, stmt, v)
+}
+
+// SavePoint and RollbackTo keep nested transactions working through the embedded dialector
+
+func (d sqliteDialector) SavePoint(tx *gorm.DB, name string) error {
+	return d.Dialector.(gorm.SavePointerDialectorInterface).SavePoint(tx, name)
+}
+
+func (d sqliteDialector) RollbackTo(tx *gorm.DB, name string) error {
+	return d.Dialector.(gorm.SavePointerDialectorInterface).RollbackTo(tx, name)
+}
+
+func (d sqliteDialector) Translate(err error) error {
+	if translator, ok := d.Dialector.(gorm.ErrorTranslator); ok {
+		return translator.Translate(err)
+	}
+	return err
+}
@@ -0,0 +1,15 @@
+//go:build cgo && !purego
+
+package database
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// openSQLite opens the database file with the cgo driver. Writers wait for each other instead of
+// failing with "database is locked", and transactions take the write lock when they begin, since
+// SQLite can't upgrade a read transaction once another one has written.
+func openSQLite(path string) gorm.Dialector {
+	return sqlite.Open(path + "?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=10000&_txlock=immediate")
+}
@@ -0,0 +1,13 @@
+//go:build !cgo || purego
+
+package database
+
+import (
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// openSQLite opens the database file with the pure-Go driver, configured like the cgo one
+func openSQLite(path string) gorm.Dialector {
+	return sqlite.Open(path + "?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(10000)&_txlock=immediate")
+}
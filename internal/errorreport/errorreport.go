@@ -0,0 +1,111 @@
+// Package errorreport reports panics and server errors of HTTP requests to an error tracker,
+// with the request they happened in.
+package errorreport
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// Report describes a panic or a server error response of a request
+type Report struct {
+	// Panic is the recovered value, or nil for an error response
+	Panic interface{}
+	// Stack is the stack trace of a panic
+	Stack []byte
+	// Err holds the errors attached to the request by its handlers, if any
+	Err error
+
+	Request   *http.Request
+	Route     string
+	Status    int
+	RequestID string
+	UserID    string
+}
+
+// Message summarises the report in one line
+func (r Report) Message() string {
+	if r.Panic != nil {
+		return fmt.Sprintf("panic in %s %s: %v", r.Request.Method, r.Route, r.Panic)
+	}
+	message := fmt.Sprintf("%s %s returned %d", r.Request.Method, r.Route, r.Status)
+	if r.Err != nil {
+		message += ": " + r.Err.Error()
+	}
+	return message
+}
+
+// Reporter sends reports to an error tracker. Reports of panics are sent from the panicking
+// goroutine, so reporters can capture its stack.
+type Reporter interface {
+	Report(ctx context.Context, report Report)
+}
+
+// LogReporter writes reports to the server log. It is used when no error tracker is configured.
+type LogReporter struct{}
+
+func (LogReporter) Report(_ context.Context, report Report) {
+	message := report.Message()
+	if report.RequestID != "" {
+		message += " (request " + report.RequestID + ")"
+	}
+	if report.Panic != nil {
+		log.Printf("🔥 %s\n%s", message, report.Stack)
+		return
+	}
+	log.Printf("🔥 %s", message)
+}
+
+// SentryReporter sends reports to Sentry or a service that accepts Sentry DSNs. Reports are
+// also written to the log, so they are not lost when the tracker is unreachable.
+type SentryReporter struct {
+	hub *sentry.Hub
+}
+
+func NewSentryReporter(dsn, environment string) (*SentryReporter, error) {
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		Dsn:         dsn,
+		Environment: environment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SentryReporter{hub: sentry.NewHub(client, sentry.NewScope())}, nil
+}
+
+func (s *SentryReporter) Report(ctx context.Context, report Report) {
+	LogReporter{}.Report(ctx, report)
+
+	// Каждому отчёту свой hub: область видимости hub не рассчитана на параллельные запросы
+	hub := s.hub.Clone()
+	hub.ConfigureScope(func(scope *sentry.Scope) {
+		scope.SetRequest(report.Request)
+		scope.SetTag("route", report.Route)
+		scope.SetTag("status", fmt.Sprint(report.Status))
+		if report.RequestID != "" {
+			scope.SetTag("request_id", report.RequestID)
+		}
+		if report.UserID != "" {
+			scope.SetUser(sentry.User{ID: report.UserID})
+		}
+	})
+
+	switch {
+	case report.Panic != nil:
+		hub.RecoverWithContext(ctx, report.Panic)
+	case report.Err != nil:
+		hub.CaptureException(report.Err)
+	default:
+		hub.CaptureMessage(report.Message())
+	}
+}
+
+// Flush waits up to timeout for reports still being sent
+func (s *SentryReporter) Flush(timeout time.Duration) bool {
+	return s.hub.Flush(timeout)
+}
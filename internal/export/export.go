@@ -0,0 +1,299 @@
+// Package export builds the personal data archive a user can download from their account.
+package export
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"time"
+
+	"kanban/internal/model"
+)
+
+// Data is everything stored about a user
+type Data struct {
+	User            model.User
+	Identities      []model.UserIdentity
+	OwnedBoards     []Board
+	CreatedTasks    []model.Task // tasks the user created on any board, with Column.Board loaded
+	AssignedTasks   []model.Task // tasks assigned to the user on any board, with Column.Board loaded
+	Shares          []model.BoardShare
+	WorkspaceLabels []model.Label
+	PinnedTasks     []model.PinnedTask
+	BoardViews      []model.BoardView
+}
+
+// Board is an owned board with its content
+type Board struct {
+	Board   model.Board
+	Columns []model.Column
+	Labels  []model.Label
+	Tasks   []model.Task // with Column and Labels loaded
+}
+
+type profileFile struct {
+	ID          string           `json:"id"`
+	Email       string           `json:"email"`
+	Name        string           `json:"name"`
+	CreatedAt   time.Time        `json:"created_at"`
+	Identities  []identityRecord `json:"linked_accounts"`
+	GeneratedAt time.Time        `json:"export_generated_at"`
+}
+
+type identityRecord struct {
+	Provider  string    `json:"provider"`
+	LinkedAt  time.Time `json:"linked_at"`
+	AccountID string    `json:"account_id"`
+}
+
+type boardRecord struct {
+	ID          string         `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Key         string         `json:"key"`
+	CreatedAt   time.Time      `json:"created_at"`
+	Columns     []columnRecord `json:"columns"`
+	Labels      []labelRecord  `json:"labels"`
+}
+
+type columnRecord struct {
+	ID       string       `json:"id"`
+	Title    string       `json:"title"`
+	Position int          `json:"position"`
+	IsDone   bool         `json:"is_done"`
+	Tasks    []taskRecord `json:"tasks"`
+}
+
+type labelRecord struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type taskRecord struct {
+	ID            string     `json:"id"`
+	BoardID       string     `json:"board_id,omitempty"`
+	BoardTitle    string     `json:"board_title,omitempty"`
+	Column        string     `json:"column,omitempty"`
+	Number        int        `json:"number"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	StartDate     *time.Time `json:"start_date,omitempty"`
+	DueDate       *time.Time `json:"due_date,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	Blocked       bool       `json:"blocked"`
+	BlockedReason string     `json:"blocked_reason,omitempty"`
+	Labels        []string   `json:"labels,omitempty"`
+}
+
+type shareRecord struct {
+	BoardID    string    `json:"board_id"`
+	BoardTitle string    `json:"board_title"`
+	Role       string    `json:"role"`
+	SharedAt   time.Time `json:"shared_at"`
+}
+
+type pinRecord struct {
+	TaskID   string    `json:"task_id"`
+	Title    string    `json:"title"`
+	PinnedAt time.Time `json:"pinned_at"`
+}
+
+type viewRecord struct {
+	BoardID   string          `json:"board_id"`
+	SortBy    string          `json:"sort_by"`
+	SortOrder string          `json:"sort_order"`
+	GroupBy   string          `json:"group_by"`
+	Filters   json.RawMessage `json:"filters"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+const readme = `This archive contains the personal data stored about your account.
+
+profile.json           your profile and linked sign-in providers
+boards.json            boards you own, with their columns, labels and tasks
+tasks_created.json     tasks you created on any board
+tasks_assigned.json    tasks assigned to you on any board
+shared_boards.json     boards other users shared with you and your role
+workspace_labels.json  labels available on all of your boards
+pinned_tasks.json      tasks you pinned
+board_views.json       your saved sorting, filtering and grouping of boards
+
+Passwords are never exported.
+`
+
+// WriteArchive writes data as a zip archive of JSON files to w
+func WriteArchive(w io.Writer, data Data, generatedAt time.Time) error {
+	zw := zip.NewWriter(w)
+
+	files := []struct {
+		name    string
+		content any
+	}{
+		{"profile.json", profile(data, generatedAt)},
+		{"boards.json", boards(data.OwnedBoards)},
+		{"tasks_created.json", tasks(data.CreatedTasks)},
+		{"tasks_assigned.json", tasks(data.AssignedTasks)},
+		{"shared_boards.json", shares(data.Shares)},
+		{"workspace_labels.json", labels(data.WorkspaceLabels)},
+		{"pinned_tasks.json", pins(data.PinnedTasks)},
+		{"board_views.json", views(data.BoardViews)},
+	}
+
+	if err := writeFile(zw, "README.txt", generatedAt, []byte(readme)); err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		content, err := json.MarshalIndent(f.content, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := writeFile(zw, f.name, generatedAt, content); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeFile(zw *zip.Writer, name string, modified time.Time, content []byte) error {
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return err
+	}
+	_, err = fw.Write(content)
+	return err
+}
+
+func profile(data Data, generatedAt time.Time) profileFile {
+	p := profileFile{
+		ID:          data.User.ID.String(),
+		Email:       data.User.Email,
+		Name:        data.User.Name,
+		CreatedAt:   data.User.CreatedAt,
+		Identities:  []identityRecord{},
+		GeneratedAt: generatedAt,
+	}
+	for _, identity := range data.Identities {
+		p.Identities = append(p.Identities, identityRecord{
+			Provider:  identity.Provider,
+			AccountID: identity.ProviderID,
+			LinkedAt:  identity.CreatedAt,
+		})
+	}
+	return p
+}
+
+func boards(owned []Board) []boardRecord {
+	records := make([]boardRecord, 0, len(owned))
+	for _, b := range owned {
+		record := boardRecord{
+			ID:          b.Board.ID.String(),
+			Title:       b.Board.Title,
+			Description: b.Board.Description,
+			Key:         b.Board.Key,
+			CreatedAt:   b.Board.CreatedAt,
+			Columns:     make([]columnRecord, 0, len(b.Columns)),
+			Labels:      labels(b.Labels),
+		}
+
+		for _, column := range b.Columns {
+			columnTasks := []taskRecord{}
+			for _, task := range b.Tasks {
+				if task.ColumnID == column.ID {
+					columnTasks = append(columnTasks, newTaskRecord(task, false))
+				}
+			}
+			record.Columns = append(record.Columns, columnRecord{
+				ID:       column.ID.String(),
+				Title:    column.Title,
+				Position: column.Position,
+				IsDone:   column.IsDone,
+				Tasks:    columnTasks,
+			})
+		}
+
+		records = append(records, record)
+	}
+	return records
+}
+
+func tasks(list []model.Task) []taskRecord {
+	records := make([]taskRecord, 0, len(list))
+	for _, task := range list {
+		records = append(records, newTaskRecord(task, true))
+	}
+	return records
+}
+
+func newTaskRecord(task model.Task, withBoard bool) taskRecord {
+	record := taskRecord{
+		ID:            task.ID.String(),
+		Number:        task.Number,
+		Title:         task.Title,
+		Description:   task.Description,
+		StartDate:     task.StartDate,
+		DueDate:       task.DueDate,
+		CompletedAt:   task.CompletedAt,
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+	}
+	if withBoard {
+		record.BoardID = task.Column.BoardID.String()
+		record.BoardTitle = task.Column.Board.Title
+		record.Column = task.Column.Title
+	}
+	for _, label := range task.Labels {
+		record.Labels = append(record.Labels, label.Name)
+	}
+	return record
+}
+
+func labels(list []model.Label) []labelRecord {
+	records := make([]labelRecord, 0, len(list))
+	for _, label := range list {
+		records = append(records, labelRecord{ID: label.ID.String(), Name: label.Name, Color: label.Color})
+	}
+	return records
+}
+
+func shares(list []model.BoardShare) []shareRecord {
+	records := make([]shareRecord, 0, len(list))
+	for _, share := range list {
+		records = append(records, shareRecord{
+			BoardID:    share.BoardID.String(),
+			BoardTitle: share.Board.Title,
+			Role:       share.Role,
+			SharedAt:   share.CreatedAt,
+		})
+	}
+	return records
+}
+
+func pins(list []model.PinnedTask) []pinRecord {
+	records := make([]pinRecord, 0, len(list))
+	for _, pin := range list {
+		records = append(records, pinRecord{TaskID: pin.TaskID.String(), Title: pin.Task.Title, PinnedAt: pin.CreatedAt})
+	}
+	return records
+}
+
+func views(list []model.BoardView) []viewRecord {
+	records := make([]viewRecord, 0, len(list))
+	for _, view := range list {
+		filters := view.Filters
+		if len(filters) == 0 {
+			filters = json.RawMessage("{}")
+		}
+		records = append(records, viewRecord{
+			BoardID:   view.BoardID.String(),
+			SortBy:    view.SortBy,
+			SortOrder: view.SortOrder,
+			GroupBy:   view.GroupBy,
+			Filters:   filters,
+			UpdatedAt: view.UpdatedAt,
+		})
+	}
+	return records
+}
@@ -0,0 +1,92 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"kanban/internal/export"
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readArchive(t *testing.T, data []byte) map[string][]byte {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = content
+	}
+	return files
+}
+
+func TestWriteArchive(t *testing.T) {
+	user := model.User{ID: uuid.New(), Email: "anna@example.com", Name: "Anna", HashedPassword: "$2a$10$secret-hash"}
+	board := model.Board{ID: uuid.New(), Title: "Personal", Key: "PERS", OwnerID: user.ID}
+	column := model.Column{ID: uuid.New(), BoardID: board.ID, Title: "To Do", Position: 1, Board: board}
+	task := model.Task{
+		ID:        uuid.New(),
+		ColumnID:  column.ID,
+		Column:    column,
+		Title:     "Buy milk",
+		Number:    1,
+		CreatedBy: user.ID,
+		Labels:    []model.Label{{ID: uuid.New(), Name: "home"}},
+	}
+
+	data := export.Data{
+		User:         user,
+		OwnedBoards:  []export.Board{{Board: board, Columns: []model.Column{column}, Tasks: []model.Task{task}}},
+		CreatedTasks: []model.Task{task},
+		BoardViews:   []model.BoardView{{BoardID: board.ID, SortBy: "position", SortOrder: "asc"}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.WriteArchive(&buf, data, time.Now()))
+	files := readArchive(t, buf.Bytes())
+
+	for _, name := range []string{"README.txt", "profile.json", "boards.json", "tasks_created.json", "tasks_assigned.json",
+		"shared_boards.json", "workspace_labels.json", "pinned_tasks.json", "board_views.json"} {
+		assert.Contains(t, files, name)
+	}
+
+	// Хеш пароля не должен попасть в архив
+	for name, content := range files {
+		assert.NotContains(t, string(content), "secret-hash", name)
+	}
+
+	var boards []struct {
+		Title   string `json:"title"`
+		Columns []struct {
+			Tasks []struct {
+				Title  string   `json:"title"`
+				Labels []string `json:"labels"`
+			} `json:"tasks"`
+		} `json:"columns"`
+	}
+	require.NoError(t, json.Unmarshal(files["boards.json"], &boards))
+	require.Len(t, boards, 1)
+	require.Len(t, boards[0].Columns, 1)
+	require.Len(t, boards[0].Columns[0].Tasks, 1)
+	assert.Equal(t, "Buy milk", boards[0].Columns[0].Tasks[0].Title)
+	assert.Equal(t, []string{"home"}, boards[0].Columns[0].Tasks[0].Labels)
+
+	var created []struct {
+		BoardTitle string `json:"board_title"`
+	}
+	require.NoError(t, json.Unmarshal(files["tasks_created.json"], &created))
+	require.Len(t, created, 1)
+	assert.Equal(t, "Personal", created[0].BoardTitle)
+}
@@ -0,0 +1,122 @@
+// Package github verifies and decodes the GitHub webhook events used to link commits and
+// pull requests to tasks.
+package github
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+
+	"kanban/internal/reference"
+)
+
+// Events handled by the integration, as sent in the X-GitHub-Event header
+const (
+	EventPing        = "ping"
+	EventPush        = "push"
+	EventPullRequest = "pull_request"
+)
+
+// Repository is the repository an event happened in
+type Repository struct {
+	FullName string `json:"full_name"`
+}
+
+// Commit is a commit of a push event
+type Commit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Author  struct {
+		Name     string `json:"name"`
+		Username string `json:"username"`
+	} `json:"author"`
+}
+
+// Title returns the first line of the commit message
+func (c *Commit) Title() string {
+	title, _, _ := strings.Cut(c.Message, "\n")
+	return strings.TrimSpace(title)
+}
+
+// PushEvent is the payload of a push event
+type PushEvent struct {
+	Ref        string     `json:"ref"`
+	Repository Repository `json:"repository"`
+	Commits    []Commit   `json:"commits"`
+}
+
+// PullRequest is the pull request of a pull_request event
+type PullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	State   string `json:"state"`
+	Merged  bool   `json:"merged"`
+	Draft   bool   `json:"draft"`
+	User    struct {
+		Login string `json:"login"`
+	} `json:"user"`
+	Head struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+}
+
+// PullRequestEvent is the payload of a pull_request event
+type PullRequestEvent struct {
+	Action      string      `json:"action"`
+	PullRequest PullRequest `json:"pull_request"`
+	Repository  Repository  `json:"repository"`
+}
+
+// Pull request actions that put a pull request up for review
+var reviewActions = []string{"opened", "reopened", "ready_for_review"}
+
+// ReadyForReview reports whether the event put a non-draft pull request up for review
+func (e *PullRequestEvent) ReadyForReview() bool {
+	return slices.Contains(reviewActions, e.Action) && !e.PullRequest.Draft
+}
+
+// MergedNow reports whether the event is the merge of the pull request
+func (e *PullRequestEvent) MergedNow() bool {
+	return e.Action == "closed" && e.PullRequest.Merged
+}
+
+// VerifySignature checks the X-Hub-Signature-256 header of a payload against the webhook secret
+func VerifySignature(secret string, payload []byte, signature string) bool {
+	digest, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false
+	}
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// BranchName returns the branch of a ref such as refs/heads/PROJ-12-fix-login
+func BranchName(ref string) string {
+	return strings.TrimPrefix(ref, "refs/heads/")
+}
+
+// TaskNumbers returns the distinct numbers of the tasks of the board with the given key that
+// are mentioned in the texts, in order of first mention. Only qualified keys such as PROJ-12
+// count, since #12 refers to GitHub's own issues. Branch names are matched ignoring case.
+func TaskNumbers(boardKey, branch string, texts ...string) []int {
+	var numbers []int
+	for _, text := range append([]string{strings.ToUpper(branch)}, texts...) {
+		for _, ref := range reference.Parse(text) {
+			if ref.BoardKey == boardKey && !slices.Contains(numbers, ref.Number) {
+				numbers = append(numbers, ref.Number)
+			}
+		}
+	}
+	return numbers
+}
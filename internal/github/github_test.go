@@ -0,0 +1,49 @@
+package github_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"kanban/internal/github"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"zen":"Keep it logically awesome."}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, github.VerifySignature("secret", payload, signature))
+	assert.False(t, github.VerifySignature("other", payload, signature))
+	assert.False(t, github.VerifySignature("secret", []byte(`{}`), signature))
+	assert.False(t, github.VerifySignature("secret", payload, ""))
+	assert.False(t, github.VerifySignature("secret", payload, "sha256=zz"))
+}
+
+func TestTaskNumbers(t *testing.T) {
+	numbers := github.TaskNumbers("PROJ", "feature/proj-12-login",
+		"Fix login (PROJ-12), see PROJ-7",
+		"Closes #3 and OPS-5, also PROJ-7",
+	)
+
+	// Ссылки на другие доски и на задачи GitHub (#3) не учитываются
+	assert.Equal(t, []int{12, 7}, numbers)
+	assert.Empty(t, github.TaskNumbers("PROJ", "main", "No task here"))
+}
+
+func TestPullRequestEvent(t *testing.T) {
+	event := github.PullRequestEvent{Action: "opened"}
+	assert.True(t, event.ReadyForReview())
+
+	event.PullRequest.Draft = true
+	assert.False(t, event.ReadyForReview())
+
+	event = github.PullRequestEvent{Action: "closed"}
+	assert.False(t, event.MergedNow())
+	event.PullRequest.Merged = true
+	assert.True(t, event.MergedNow())
+}
@@ -0,0 +1,364 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/export"
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type AccountHandler struct {
+	userRepo       *repository.UserRepository
+	identityRepo   *repository.UserIdentityRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	boardViewRepo  *repository.BoardViewRepository
+	columnRepo     repository.ColumnRepositoryInterface
+	taskRepo       repository.TaskRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	pinRepo        *repository.PinnedTaskRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+	limits         *limits.Service
+}
+
+func NewAccountHandler(
+	userRepo *repository.UserRepository,
+	identityRepo *repository.UserIdentityRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	boardViewRepo *repository.BoardViewRepository,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	pinRepo *repository.PinnedTaskRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+	limitService *limits.Service,
+) *AccountHandler {
+	return &AccountHandler{
+		userRepo:       userRepo,
+		identityRepo:   identityRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		boardViewRepo:  boardViewRepo,
+		columnRepo:     columnRepo,
+		taskRepo:       taskRepo,
+		labelRepo:      labelRepo,
+		pinRepo:        pinRepo,
+		txManager:      txManager,
+		perms:          perms,
+		limits:         limitService,
+	}
+}
+
+// DeleteAccountRequest represents the request body for deleting the authenticated account
+// @name DeleteAccountRequest
+type DeleteAccountRequest struct {
+	// Password confirms the deletion; accounts created through OAuth have no password
+	Password string `json:"password"`
+	// TransferBoards lists owned boards to hand over instead of deleting them
+	TransferBoards []BoardTransferRequest `json:"transfer_boards" binding:"dive"`
+}
+
+// BoardTransferRequest hands an owned board over to one of its editors
+// @name BoardTransferRequest
+type BoardTransferRequest struct {
+	BoardID    string `json:"board_id" binding:"required,uuid"`
+	NewOwnerID string `json:"new_owner_id" binding:"required,uuid"`
+}
+
+// DeleteAccountResponse summarizes what happened to the data of a deleted account
+// @name DeleteAccountResponse
+type DeleteAccountResponse struct {
+	Message           string `json:"message"`
+	TransferredBoards int    `json:"transferred_boards"`
+	DeletedBoards     int64  `json:"deleted_boards"`
+}
+
+// Export godoc
+// @Summary Export personal data
+// @Description Download a zip archive with all data stored about the authenticated user: profile, owned boards with their content,
+// @Description tasks created by or assigned to the user, shared boards, workspace labels, pinned tasks and board views
+// @Tags Users
+// @Produce application/zip
+// @Success 200 {file} file "Data archive"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 404 {object} map[string]string "User not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/export [post]
+func (h *AccountHandler) Export(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	user, err := h.userRepo.GetByID(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	data, err := h.collectData(c.Request.Context(), user)
+	if err != nil {
+		log.Printf("⚠️  Failed to collect export data for user %s: %v", user.ID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to collect account data"})
+		return
+	}
+
+	now := time.Now().UTC()
+	var archive bytes.Buffer
+	if err := export.WriteArchive(&archive, *data, now); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to build export archive"})
+		return
+	}
+
+	filename := fmt.Sprintf("kanban-export-%s.zip", now.Format("2006-01-02"))
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/zip", archive.Bytes())
+}
+
+// collectData gathers everything stored about the user
+func (h *AccountHandler) collectData(ctx context.Context, user *model.User) (*export.Data, error) {
+	data := &export.Data{User: *user}
+	var err error
+
+	if data.Identities, err = h.identityRepo.GetByUserID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	boards, err := h.boardRepo.GetOwned(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, board := range boards {
+		owned := export.Board{Board: board}
+		if owned.Columns, err = h.columnRepo.GetByBoardID(ctx, board.ID); err != nil {
+			return nil, err
+		}
+		if owned.Labels, err = h.labelRepo.GetByBoardID(ctx, board.ID); err != nil {
+			return nil, err
+		}
+		if owned.Tasks, err = h.taskRepo.GetByBoardID(ctx, board.ID); err != nil {
+			return nil, err
+		}
+		data.OwnedBoards = append(data.OwnedBoards, owned)
+	}
+
+	if data.CreatedTasks, err = h.taskRepo.GetByCreator(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.AssignedTasks, err = h.taskRepo.GetByAssignee(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.Shares, err = h.boardShareRepo.GetByUserID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.WorkspaceLabels, err = h.labelRepo.GetByOwnerID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.PinnedTasks, err = h.pinRepo.GetByUserID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if data.BoardViews, err = h.boardViewRepo.GetByUserID(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Delete godoc
+// @Summary Delete account
+// @Description Permanently delete the authenticated account. Boards listed in transfer_boards are handed over to one of
+// @Description their editors, who becomes the owner; workspace labels used on them are copied to the board. All other owned
+// @Description boards are deleted with their content. Tasks the user created on remaining boards are credited to the board
+// @Description owner and tasks assigned to the user become unassigned. Shares, pins, board views and linked sign-in
+// @Description providers are removed.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param request body DeleteAccountRequest true "Deletion confirmation and board transfers"
+// @Success 200 {object} DeleteAccountResponse "Account deleted"
+// @Failure 400 {object} map[string]string "Invalid request or transfer"
+// @Failure 401 {object} map[string]string "Not authenticated or wrong password"
+// @Failure 404 {object} map[string]string "User not found"
+// @Failure 409 {object} map[string]string "New owner has reached the board limit"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me [delete]
+func (h *AccountHandler) Delete(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	user, err := h.userRepo.GetByID(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+
+	if user == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	if user.HashedPassword != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(req.Password)); err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Password is incorrect"})
+			return
+		}
+	}
+
+	transfers, status, err := h.validateTransfers(c.Request.Context(), user.ID, req.TransferBoards)
+	if err != nil {
+		c.JSON(status, gin.H{"error": err.Error()})
+		return
+	}
+
+	var deleted int64
+	// Все доски пользователя передаются или удаляются
+	var owned []model.Board
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		if owned, err = h.boardRepo.GetOwned(ctx, user.ID); err != nil {
+			return err
+		}
+
+		for boardID, newOwnerID := range transfers {
+			if err := h.labelRepo.CopyWorkspaceLabelsToBoard(ctx, user.ID, boardID); err != nil {
+				return err
+			}
+			if err := h.boardRepo.TransferOwnership(ctx, boardID, newOwnerID); err != nil {
+				return err
+			}
+		}
+
+		if deleted, err = h.boardRepo.DeleteOwned(ctx, user.ID); err != nil {
+			return err
+		}
+		if err := h.taskRepo.ReassignCreator(ctx, user.ID); err != nil {
+			return err
+		}
+		if err := h.taskRepo.UnassignEverywhere(ctx, user.ID); err != nil {
+			return err
+		}
+		return h.userRepo.Delete(ctx, user.ID)
+	})
+	if err != nil {
+		log.Printf("⚠️  Failed to delete account %s: %v", user.ID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete account"})
+		return
+	}
+	ownedIDs := make([]uuid.UUID, len(owned))
+	for i, board := range owned {
+		ownedIDs[i] = board.ID
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), ownedIDs...)
+	h.perms.InvalidateUser(c.Request.Context(), user.ID)
+
+	log.Printf("🗑️  Deleted account %s (%d boards transferred, %d deleted)", user.ID, len(transfers), deleted)
+	c.JSON(http.StatusOK, DeleteAccountResponse{
+		Message:           "Account deleted",
+		TransferredBoards: len(transfers),
+		DeletedBoards:     deleted,
+	})
+}
+
+// validateTransfers checks that every transferred board is owned by the user and goes to one of its
+// editors who still has room for another board. It returns the transfers keyed by board ID, or the
+// HTTP status and error to report.
+func (h *AccountHandler) validateTransfers(ctx context.Context, userID uuid.UUID, requests []BoardTransferRequest) (map[uuid.UUID]uuid.UUID, int, error) {
+	transfers := make(map[uuid.UUID]uuid.UUID, len(requests))
+	received := make(map[uuid.UUID]int64)
+
+	for _, req := range requests {
+		boardID := uuid.MustParse(req.BoardID)
+		newOwnerID := uuid.MustParse(req.NewOwnerID)
+
+		if _, dup := transfers[boardID]; dup {
+			return nil, http.StatusBadRequest, fmt.Errorf("Board %s is listed more than once", boardID)
+		}
+
+		board, err := h.boardRepo.GetByID(ctx, boardID)
+		if err != nil && err != repository.ErrBoardNotFound {
+			return nil, http.StatusInternalServerError, errors.New("Failed to retrieve board")
+		}
+		if board == nil || board.OwnerID != userID {
+			return nil, http.StatusBadRequest, fmt.Errorf("You don't own board %s", boardID)
+		}
+
+		role, err := h.boardShareRepo.GetUserRole(ctx, boardID, newOwnerID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.New("Failed to check board access")
+		}
+		if role != model.RoleEditor {
+			return nil, http.StatusBadRequest, fmt.Errorf("Board %s can only be transferred to one of its editors", boardID)
+		}
+		newOwner, err := h.userRepo.GetByID(ctx, newOwnerID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.New("Failed to retrieve user")
+		}
+		if newOwner == nil || newOwner.IsGuest {
+			return nil, http.StatusBadRequest, fmt.Errorf("Board %s cannot be transferred to a guest", boardID)
+		}
+
+		ownerLimits, err := h.limits.ForUser(ctx, newOwnerID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.New("Failed to retrieve limits")
+		}
+		owned, err := h.boardRepo.CountOwned(ctx, newOwnerID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, errors.New("Failed to check board count")
+		}
+		received[newOwnerID]++
+		if limits.Exceeds(ownerLimits.Boards, owned+received[newOwnerID]) {
+			return nil, http.StatusConflict, fmt.Errorf("User %s cannot own more than %d boards", newOwnerID, ownerLimits.Boards)
+		}
+
+		transfers[boardID] = newOwnerID
+	}
+
+	return transfers, http.StatusOK, nil
+}
@@ -0,0 +1,454 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxAdminUsersPerPage limits the users listed per page of the admin API
+const MaxAdminUsersPerPage = 100
+
+// AdminHandler serves the instance administration API. Its routes must be restricted to
+// administrators with middleware.RequireAdmin.
+type AdminHandler struct {
+	userRepo       *repository.UserRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	txManager      *repository.TxManager
+	perms          *permission.Service
+}
+
+func NewAdminHandler(
+	userRepo *repository.UserRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+) *AdminHandler {
+	return &AdminHandler{
+		userRepo:       userRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		columnRepo:     columnRepo,
+		labelRepo:      labelRepo,
+		txManager:      txManager,
+		perms:          perms,
+	}
+}
+
+// AdminUserResponse represents a user of the instance with their board counts
+// @name AdminUserResponse
+type AdminUserResponse struct {
+	ID         string  `json:"id"`
+	Email      string  `json:"email"`
+	Name       string  `json:"name"`
+	IsAdmin    bool    `json:"is_admin"`
+	IsGuest    bool    `json:"is_guest"`
+	Disabled   bool    `json:"disabled"`
+	DisabledAt *string `json:"disabled_at,omitempty"`
+	// OwnedBoards is the number of boards the user owns, SharedBoards the number they are a member of
+	OwnedBoards  int64 `json:"owned_boards"`
+	SharedBoards int64 `json:"shared_boards"`
+	// Limits the user overrides; unset limits are the instance defaults and 0 is unlimited
+	MaxBoards          *int   `json:"max_boards,omitempty"`
+	MaxColumnsPerBoard *int   `json:"max_columns_per_board,omitempty"`
+	MaxTasksPerColumn  *int   `json:"max_tasks_per_column,omitempty"`
+	CreatedAt          string `json:"created_at"`
+}
+
+// AdminUserListResponse represents a page of users
+// @name AdminUserListResponse
+type AdminUserListResponse struct {
+	Users []AdminUserResponse `json:"users"`
+	// Total is the number of users matching the search across all pages
+	Total int64 `json:"total"`
+}
+
+// ReassignBoardRequest represents the request body for reassigning the ownership of a board
+// @name ReassignBoardRequest
+type ReassignBoardRequest struct {
+	UserID string `json:"user_id" binding:"required,uuid"`
+	// KeepPreviousOwner makes the previous owner an editor of the board instead of removing their access
+	KeepPreviousOwner bool `json:"keep_previous_owner"`
+}
+
+// UserLimitsRequest represents the request body for overriding the limits of a user. Omitted
+// or null limits are reset to the instance defaults; 0 removes a limit.
+// @name UserLimitsRequest
+type UserLimitsRequest struct {
+	MaxBoards          *int `json:"max_boards" binding:"omitempty,min=0"`
+	MaxColumnsPerBoard *int `json:"max_columns_per_board" binding:"omitempty,min=0"`
+	MaxTasksPerColumn  *int `json:"max_tasks_per_column" binding:"omitempty,min=0"`
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description Lists the users of the instance in order of registration, with the number of boards each owns and is a
+// @Description member of. Administrators only.
+// @Tags Admin
+// @Produce json
+// @Param search query string false "Part of the email address or name"
+// @Param limit query int false "Users per page, at most 100" default(100)
+// @Param offset query int false "Users to skip" default(0)
+// @Success 200 {object} AdminUserListResponse "Users"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/users [get]
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(MaxAdminUsersPerPage)))
+	if err != nil || limit < 1 || limit > MaxAdminUsersPerPage {
+		limit = MaxAdminUsersPerPage
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+
+	users, total, err := h.userRepo.ListSummaries(c.Request.Context(), strings.TrimSpace(c.Query("search")), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve users"})
+		return
+	}
+
+	response := AdminUserListResponse{Users: make([]AdminUserResponse, len(users)), Total: total}
+	for i, user := range users {
+		response.Users[i] = newAdminUserResponse(user)
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// DisableUser godoc
+// @Summary Disable a user
+// @Description Disables an account: the user can no longer sign in and their tokens stop working. Their boards and
+// @Description memberships are kept. Administrators only.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "User ID" format(uuid)
+// @Success 200 {object} map[string]string "User disabled"
+// @Failure 400 {object} map[string]string "Invalid user ID or own account"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "User not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/users/{id}/disable [post]
+func (h *AdminHandler) DisableUser(c *gin.Context) {
+	h.setDisabled(c, true)
+}
+
+// EnableUser godoc
+// @Summary Enable a user
+// @Description Re-enables a disabled account. Administrators only.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "User ID" format(uuid)
+// @Success 200 {object} map[string]string "User enabled"
+// @Failure 400 {object} map[string]string "Invalid user ID"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "User not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/users/{id}/enable [post]
+func (h *AdminHandler) EnableUser(c *gin.Context) {
+	h.setDisabled(c, false)
+}
+
+func (h *AdminHandler) setDisabled(c *gin.Context, disabled bool) {
+	userIDValue, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	adminID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	// Отключив себя, администратор потерял бы доступ к API
+	if disabled && userID == adminID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "You cannot disable your own account"})
+		return
+	}
+
+	found, err := h.userRepo.SetDisabled(c.Request.Context(), userID, disabled)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		return
+	}
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	h.perms.InvalidateUser(c.Request.Context(), userID)
+
+	if disabled {
+		log.Printf("🛡️  Admin %s disabled user %s", adminID, userID)
+		c.JSON(http.StatusOK, gin.H{"message": "User disabled"})
+	} else {
+		log.Printf("🛡️  Admin %s enabled user %s", adminID, userID)
+		c.JSON(http.StatusOK, gin.H{"message": "User enabled"})
+	}
+}
+
+// SetUserLimits godoc
+// @Summary Override the limits of a user
+// @Description Sets the limits on boards, columns per board and tasks per column of a user, replacing earlier
+// @Description overrides. Omitted limits use the instance defaults; 0 removes a limit. Columns and tasks count against
+// @Description the limits of the board owner. Administrators only.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID" format(uuid)
+// @Param limits body UserLimitsRequest true "Limit overrides"
+// @Success 200 {object} map[string]string "Limits updated"
+// @Failure 400 {object} map[string]string "Invalid user ID or limits"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "User not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/users/{id}/limits [put]
+func (h *AdminHandler) SetUserLimits(c *gin.Context) {
+	userIDValue, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	adminID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req UserLimitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	found, err := h.userRepo.SetLimits(c.Request.Context(), userID, req.MaxBoards, req.MaxColumnsPerBoard, req.MaxTasksPerColumn)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update user"})
+		return
+	}
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+
+	log.Printf("🛡️  Admin %s changed the limits of user %s", adminID, userID)
+	c.JSON(http.StatusOK, gin.H{"message": "Limits updated"})
+}
+
+// DeleteBoard godoc
+// @Summary Delete a board
+// @Description Deletes any board with all its columns, tasks and shares, whoever owns it. Administrators only.
+// @Tags Admin
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {object} map[string]string "Board deleted"
+// @Failure 400 {object} map[string]string "Invalid board ID"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/boards/{id} [delete]
+func (h *AdminHandler) DeleteBoard(c *gin.Context) {
+	adminID, board, ok := h.board(c)
+	if !ok {
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	if err := h.boardRepo.Delete(c.Request.Context(), board.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete board"})
+		return
+	}
+
+	columnIDs := make([]uuid.UUID, len(columns))
+	for i, column := range columns {
+		columnIDs[i] = column.ID
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+	h.perms.InvalidateColumns(c.Request.Context(), columnIDs...)
+
+	log.Printf("🛡️  Admin %s deleted board %s of user %s", adminID, board.ID, board.OwnerID)
+	c.JSON(http.StatusOK, gin.H{"message": "Board deleted"})
+}
+
+// ReassignBoard godoc
+// @Summary Reassign a board
+// @Description Makes another user the owner of a board, regardless of the number of boards they own. The previous
+// @Description owner loses access unless keep_previous_owner is set, which makes them an editor. Workspace labels of
+// @Description the previous owner used on the board become board labels. Administrators only.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param request body ReassignBoardRequest true "New owner"
+// @Success 200 {object} BoardResponse "Reassigned board"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "Board or user not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/boards/{id}/owner [put]
+func (h *AdminHandler) ReassignBoard(c *gin.Context) {
+	var req ReassignBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	adminID, board, ok := h.board(c)
+	if !ok {
+		return
+	}
+
+	newOwnerID := uuid.MustParse(req.UserID)
+	if newOwnerID == board.OwnerID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The user already owns this board"})
+		return
+	}
+
+	newOwner, err := h.userRepo.GetByID(c.Request.Context(), newOwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+	if newOwner == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
+	}
+	if newOwner.IsGuest {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Guest users cannot own boards"})
+		return
+	}
+
+	previousOwnerID := board.OwnerID
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.labelRepo.CopyWorkspaceLabelsToBoard(ctx, previousOwnerID, board.ID); err != nil {
+			return err
+		}
+		if err := h.boardRepo.TransferOwnership(ctx, board.ID, newOwnerID); err != nil {
+			return err
+		}
+		if req.KeepPreviousOwner {
+			return h.boardShareRepo.ShareBoard(ctx, board.ID, previousOwnerID, model.RoleEditor)
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reassign board"})
+		return
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+
+	log.Printf("🛡️  Admin %s reassigned board %s from user %s to user %s", adminID, board.ID, previousOwnerID, newOwnerID)
+	c.JSON(http.StatusOK, BoardResponse{
+		ID:          board.ID.String(),
+		Title:       board.Title,
+		Description: board.Description,
+		OwnerID:     newOwnerID.String(),
+		Key:         board.Key,
+		CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
+	})
+}
+
+// board loads the board from the path. It writes the error response and returns false if the
+// ID is invalid or the board does not exist.
+func (h *AdminHandler) board(c *gin.Context) (uuid.UUID, *model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, false
+	}
+
+	adminID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBoardNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, nil, false
+	}
+	return adminID, board, true
+}
+
+func newAdminUserResponse(user repository.UserSummary) AdminUserResponse {
+	response := AdminUserResponse{
+		ID:           user.ID.String(),
+		Email:        user.Email,
+		Name:         user.Name,
+		IsAdmin:      user.IsAdmin,
+		IsGuest:      user.IsGuest,
+		Disabled:     user.DisabledAt != nil,
+		OwnedBoards:  user.OwnedBoards,
+		SharedBoards: user.SharedBoards,
+
+		MaxBoards:          user.MaxBoards,
+		MaxColumnsPerBoard: user.MaxColumnsPerBoard,
+		MaxTasksPerColumn:  user.MaxTasksPerColumn,
+		CreatedAt:          user.CreatedAt.Format(time.RFC3339),
+	}
+	if user.DisabledAt != nil {
+		disabledAt := user.DisabledAt.Format(time.RFC3339)
+		response.DisabledAt = &disabledAt
+	}
+	return response
+}
@@ -0,0 +1,505 @@
+package handler
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxAnalyticsWeeks limits how far back board analytics look
+const MaxAnalyticsWeeks = 52
+
+type AnalyticsHandler struct {
+	analyticsRepo  *repository.AnalyticsRepository
+	columnRepo     repository.ColumnRepositoryInterface
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+}
+
+func NewAnalyticsHandler(
+	analyticsRepo *repository.AnalyticsRepository,
+	columnRepo repository.ColumnRepositoryInterface,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsRepo:  analyticsRepo,
+		columnRepo:     columnRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+	}
+}
+
+// FlowTimeResponse summarizes a flow time in days; the values are null without completed tasks
+// @name FlowTimeResponse
+type FlowTimeResponse struct {
+	AverageDays *float64 `json:"average_days"`
+	MedianDays  *float64 `json:"median_days"`
+	P85Days     *float64 `json:"p85_days"`
+}
+
+// ThroughputWeekResponse is the number of tasks completed in the week starting on Week (Monday)
+// @name ThroughputWeekResponse
+type ThroughputWeekResponse struct {
+	Week      string `json:"week"`
+	Completed int    `json:"completed"`
+}
+
+// CumulativeFlowColumn identifies a column of the cumulative flow diagram
+// @name CumulativeFlowColumn
+type CumulativeFlowColumn struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// CumulativeFlowDay holds the number of tasks per column at the end of a day, in column order
+// @name CumulativeFlowDay
+type CumulativeFlowDay struct {
+	Date  string `json:"date"`
+	Tasks []int  `json:"tasks"`
+}
+
+// CumulativeFlowResponse represents the data of a cumulative flow diagram
+// @name CumulativeFlowResponse
+type CumulativeFlowResponse struct {
+	Columns []CumulativeFlowColumn `json:"columns"`
+	Days    []CumulativeFlowDay    `json:"days"`
+}
+
+// DailyMetricsResponse is the activity of a board on a day: tasks created, completed and moved
+// to another column, and the cycle time of the completed ones
+// @name DailyMetricsResponse
+type DailyMetricsResponse struct {
+	Date                string   `json:"date"`
+	Created             int      `json:"created"`
+	Completed           int      `json:"completed"`
+	Moved               int      `json:"moved"`
+	CycleTimeMedianDays *float64 `json:"cycle_time_median_days"`
+	CycleTimeP85Days    *float64 `json:"cycle_time_p85_days"`
+}
+
+// BoardAnalyticsResponse represents the flow metrics of a board over a period
+// @name BoardAnalyticsResponse
+type BoardAnalyticsResponse struct {
+	BoardID string `json:"board_id"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	// Completed counts the tasks with a lead time completed in the period
+	Completed      int                      `json:"completed"`
+	LeadTime       FlowTimeResponse         `json:"lead_time"`
+	CycleTime      FlowTimeResponse         `json:"cycle_time"`
+	Throughput     []ThroughputWeekResponse `json:"throughput"`
+	CumulativeFlow CumulativeFlowResponse   `json:"cumulative_flow"`
+	// Daily lists the activity of the days of the period rolled up so far, which excludes today
+	Daily []DailyMetricsResponse `json:"daily"`
+}
+
+// GetAnalytics godoc
+// @Summary Get board flow analytics
+// @Description Returns flow metrics of a board over the last weeks: lead time (task created to completed) and
+// @Description cycle time (task left the first column to completed) of completed tasks, throughput per week and
+// @Description the number of tasks per column at the end of every day for a cumulative flow diagram. Days are UTC.
+// @Description Finished days are read from nightly roll-ups, which also provide the daily activity of the board.
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param weeks query int false "Number of weeks to cover (default 12, max 52)"
+// @Success 200 {object} BoardAnalyticsResponse "Board analytics"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/analytics [get]
+func (h *AnalyticsHandler) GetAnalytics(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	weeks, err := strconv.Atoi(c.DefaultQuery("weeks", "12"))
+	if err != nil || weeks < 1 {
+		weeks = 12
+	}
+	weeks = min(weeks, MaxAnalyticsWeeks)
+
+	if _, err := h.boardRepo.GetByID(c.Request.Context(), boardID); err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+		return
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := today.AddDate(0, 0, -7*weeks+1)
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	times, err := h.analyticsRepo.GetFlowTimes(c.Request.Context(), boardID, from)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compute flow times"})
+		return
+	}
+
+	rollups, err := h.analyticsRepo.GetRollupRange(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read metrics roll-ups"})
+		return
+	}
+
+	// Свернутые ночной задачей дни читаются из сводных таблиц, остальные считаются по истории
+	var daily []model.BoardDailyMetrics
+	var throughput []repository.WeeklyThroughput
+	var flow []repository.ColumnDayCount
+	liveFrom := from
+	rolledUpTo := rolledUpThrough(rollups, from, today)
+	if rolledUpTo != nil {
+		daily, err = h.analyticsRepo.GetDailyMetrics(c.Request.Context(), boardID, from, *rolledUpTo)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read daily metrics"})
+			return
+		}
+		for _, metrics := range daily {
+			throughput = append(throughput, repository.WeeklyThroughput{Week: startOfWeek(metrics.Day), Completed: metrics.Completed})
+		}
+
+		flow, err = h.analyticsRepo.GetRolledUpCumulativeFlow(c.Request.Context(), boardID, from, *rolledUpTo)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read cumulative flow"})
+			return
+		}
+		liveFrom = rolledUpTo.AddDate(0, 0, 1)
+	}
+
+	liveThroughput, err := h.analyticsRepo.GetWeeklyThroughput(c.Request.Context(), boardID, liveFrom)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compute throughput"})
+		return
+	}
+	throughput = append(throughput, liveThroughput...)
+
+	liveFlow, err := h.analyticsRepo.GetCumulativeFlow(c.Request.Context(), boardID, liveFrom)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to compute cumulative flow"})
+		return
+	}
+	flow = append(flow, liveFlow...)
+
+	c.JSON(http.StatusOK, BoardAnalyticsResponse{
+		BoardID:        boardID.String(),
+		From:           from.Format("2006-01-02"),
+		To:             today.Format("2006-01-02"),
+		Completed:      times.Completed,
+		LeadTime:       FlowTimeResponse{AverageDays: times.LeadAvg, MedianDays: times.LeadMedian, P85Days: times.LeadP85},
+		CycleTime:      FlowTimeResponse{AverageDays: times.CycleAvg, MedianDays: times.CycleMedian, P85Days: times.CycleP85},
+		Throughput:     buildThroughput(throughput, from, today),
+		CumulativeFlow: buildCumulativeFlow(columns, flow, from, today),
+		Daily:          buildDailyMetrics(daily, from, rolledUpTo),
+	})
+}
+
+// EstimateSumResponse sums up the estimates of a group of tasks
+// @name EstimateSumResponse
+type EstimateSumResponse struct {
+	// Tasks counts all tasks of the group, EstimatedTasks those with an estimate
+	Tasks          int `json:"tasks"`
+	EstimatedTasks int `json:"estimated_tasks"`
+	Estimate       int `json:"estimate"`
+	// OpenEstimate sums the estimates of the tasks not completed yet
+	OpenEstimate int `json:"open_estimate"`
+}
+
+// ColumnEstimateResponse sums up the estimates of the tasks in a column
+// @name ColumnEstimateResponse
+type ColumnEstimateResponse struct {
+	ColumnID string `json:"column_id"`
+	Title    string `json:"title"`
+	EstimateSumResponse
+}
+
+// AssigneeEstimateResponse sums up the estimates of the tasks assigned to a user; the user is
+// null for unassigned tasks
+// @name AssigneeEstimateResponse
+type AssigneeEstimateResponse struct {
+	UserID *string `json:"user_id"`
+	Name   *string `json:"name"`
+	Email  *string `json:"email"`
+	EstimateSumResponse
+}
+
+// EstimateRollupResponse sums up the estimates of a board's tasks in total, per column and per assignee
+// @name EstimateRollupResponse
+type EstimateRollupResponse struct {
+	BoardID string              `json:"board_id"`
+	Total   EstimateSumResponse `json:"total"`
+	// Columns lists every column in board order
+	Columns []ColumnEstimateResponse `json:"columns"`
+	// Assignees lists the assignees with the largest open estimate first, unassigned tasks last
+	Assignees []AssigneeEstimateResponse `json:"assignees"`
+}
+
+// GetEstimates godoc
+// @Summary Get estimate rollups of a board
+// @Description Sums up the story point estimates of the board's tasks per column and per assignee, for planning
+// @Description without exporting to a spreadsheet. Each sum also counts the tasks with and without an estimate and
+// @Description the estimate still open, that is of the tasks not completed yet.
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {object} EstimateRollupResponse "Estimate rollups"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/estimates [get]
+func (h *AnalyticsHandler) GetEstimates(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	if _, err := h.boardRepo.GetByID(c.Request.Context(), boardID); err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	rollups, err := h.analyticsRepo.GetEstimateRollups(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sum up estimates"})
+		return
+	}
+
+	c.JSON(http.StatusOK, buildEstimateRollup(boardID, columns, rollups))
+}
+
+// buildEstimateRollup adds up the sums per column and assignee to sums per column, per assignee
+// and in total. Every column is listed, even without tasks.
+func buildEstimateRollup(boardID uuid.UUID, columns []model.Column, rollups []repository.EstimateRollup) EstimateRollupResponse {
+	response := EstimateRollupResponse{
+		BoardID:   boardID.String(),
+		Columns:   make([]ColumnEstimateResponse, len(columns)),
+		Assignees: []AssigneeEstimateResponse{},
+	}
+	columnIndex := make(map[uuid.UUID]int, len(columns))
+	for i, column := range columns {
+		response.Columns[i] = ColumnEstimateResponse{ColumnID: column.ID.String(), Title: column.Title}
+		columnIndex[column.ID] = i
+	}
+
+	add := func(sum *EstimateSumResponse, rollup repository.EstimateRollup) {
+		sum.Tasks += rollup.Tasks
+		sum.EstimatedTasks += rollup.Estimated
+		sum.Estimate += rollup.Estimate
+		sum.OpenEstimate += rollup.OpenEstimate
+	}
+	assigneeIndex := make(map[uuid.UUID]int)
+	for _, rollup := range rollups {
+		add(&response.Total, rollup)
+		if i, ok := columnIndex[rollup.ColumnID]; ok {
+			add(&response.Columns[i].EstimateSumResponse, rollup)
+		}
+
+		// Задачи без исполнителя собираются под uuid.Nil
+		key := uuid.Nil
+		if rollup.AssignedTo != nil {
+			key = *rollup.AssignedTo
+		}
+		i, ok := assigneeIndex[key]
+		if !ok {
+			i = len(response.Assignees)
+			assigneeIndex[key] = i
+			var assignee AssigneeEstimateResponse
+			if rollup.AssignedTo != nil {
+				id, name, email := rollup.AssignedTo.String(), rollup.Name, rollup.Email
+				assignee.UserID, assignee.Name, assignee.Email = &id, &name, &email
+			}
+			response.Assignees = append(response.Assignees, assignee)
+		}
+		add(&response.Assignees[i].EstimateSumResponse, rollup)
+	}
+
+	slices.SortStableFunc(response.Assignees, func(a, b AssigneeEstimateResponse) int {
+		// Запись без исполнителя одна и идёт последней
+		switch {
+		case a.UserID == nil:
+			return 1
+		case b.UserID == nil:
+			return -1
+		case a.OpenEstimate != b.OpenEstimate:
+			return cmp.Compare(b.OpenEstimate, a.OpenEstimate)
+		}
+		return cmp.Compare(*a.Name, *b.Name)
+	})
+	return response
+}
+
+// rolledUpThrough returns the last day of the period from from through today that is read from
+// roll-ups, or nil if the roll-ups do not cover its start. Today is never rolled up.
+func rolledUpThrough(rollups *repository.RollupRange, from, today time.Time) *time.Time {
+	if rollups == nil || rollups.First.After(from) || rollups.Last.Before(from) {
+		return nil
+	}
+	last := rollups.Last
+	if yesterday := today.AddDate(0, 0, -1); last.After(yesterday) {
+		last = yesterday
+	}
+	return &last
+}
+
+// buildDailyMetrics lists every day from from through to, if set; days without activity count zero
+func buildDailyMetrics(metrics []model.BoardDailyMetrics, from time.Time, to *time.Time) []DailyMetricsResponse {
+	days := []DailyMetricsResponse{}
+	if to == nil {
+		return days
+	}
+
+	byDay := make(map[string]model.BoardDailyMetrics, len(metrics))
+	for _, m := range metrics {
+		byDay[m.Day.Format("2006-01-02")] = m
+	}
+	for day := from; !day.After(*to); day = day.AddDate(0, 0, 1) {
+		key := day.Format("2006-01-02")
+		m := byDay[key]
+		days = append(days, DailyMetricsResponse{
+			Date:                key,
+			Created:             m.Created,
+			Completed:           m.Completed,
+			Moved:               m.Moved,
+			CycleTimeMedianDays: m.CycleMedian,
+			CycleTimeP85Days:    m.CycleP85,
+		})
+	}
+	return days
+}
+
+// buildThroughput lists every week from the week of from through the week of to, including
+// weeks without completed tasks. Weeks start on Monday; counts of the same week are added up.
+func buildThroughput(counts []repository.WeeklyThroughput, from, to time.Time) []ThroughputWeekResponse {
+	byWeek := make(map[string]int, len(counts))
+	for _, c := range counts {
+		byWeek[c.Week.Format("2006-01-02")] += c.Completed
+	}
+
+	weeks := []ThroughputWeekResponse{}
+	for week := startOfWeek(from); !week.After(to); week = week.AddDate(0, 0, 7) {
+		key := week.Format("2006-01-02")
+		weeks = append(weeks, ThroughputWeekResponse{Week: key, Completed: byWeek[key]})
+	}
+	return weeks
+}
+
+// buildCumulativeFlow lays out the daily counts as one row per day from from through to with
+// a count per column in board order; days and columns without tasks count zero
+func buildCumulativeFlow(columns []model.Column, counts []repository.ColumnDayCount, from, to time.Time) CumulativeFlowResponse {
+	response := CumulativeFlowResponse{
+		Columns: make([]CumulativeFlowColumn, len(columns)),
+		Days:    []CumulativeFlowDay{},
+	}
+	index := make(map[uuid.UUID]int, len(columns))
+	for i, column := range columns {
+		response.Columns[i] = CumulativeFlowColumn{ID: column.ID.String(), Title: column.Title}
+		index[column.ID] = i
+	}
+
+	rows := make(map[string][]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		key := day.Format("2006-01-02")
+		rows[key] = make([]int, len(columns))
+		response.Days = append(response.Days, CumulativeFlowDay{Date: key, Tasks: rows[key]})
+	}
+
+	for _, c := range counts {
+		row, ok := rows[c.Day.Format("2006-01-02")]
+		if !ok {
+			continue
+		}
+		if i, ok := index[c.ColumnID]; ok {
+			row[i] = c.Tasks
+		}
+	}
+	return response
+}
+
+// startOfWeek returns the Monday of the day's week
+func startOfWeek(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildThroughput(t *testing.T) {
+	// Среда 15 мая — неделя начинается с понедельника 13 мая
+	from := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 28, 0, 0, 0, 0, time.UTC)
+	counts := []repository.WeeklyThroughput{
+		{Week: time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC), Completed: 3},
+		// Свернутые дни и текущий день одной недели складываются
+		{Week: time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC), Completed: 1},
+	}
+
+	weeks := buildThroughput(counts, from, to)
+
+	// Недели без завершённых задач тоже возвращаются
+	assert.Equal(t, []ThroughputWeekResponse{
+		{Week: "2024-05-13", Completed: 0},
+		{Week: "2024-05-20", Completed: 4},
+		{Week: "2024-05-27", Completed: 0},
+	}, weeks)
+}
+
+func TestBuildCumulativeFlow(t *testing.T) {
+	todo := model.Column{ID: uuid.New(), Title: "To Do"}
+	done := model.Column{ID: uuid.New(), Title: "Done"}
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+
+	counts := []repository.ColumnDayCount{
+		{Day: from, ColumnID: todo.ID, Tasks: 3},
+		{Day: from.AddDate(0, 0, 1), ColumnID: todo.ID, Tasks: 2},
+		{Day: from.AddDate(0, 0, 1), ColumnID: done.ID, Tasks: 1},
+		// Колонка, перенесённая на другую доску, пропускается
+		{Day: from, ColumnID: uuid.New(), Tasks: 7},
+	}
+
+	flow := buildCumulativeFlow([]model.Column{todo, done}, counts, from, to)
+
+	require.Len(t, flow.Columns, 2)
+	assert.Equal(t, "To Do", flow.Columns[0].Title)
+	assert.Equal(t, []CumulativeFlowDay{
+		{Date: "2024-05-01", Tasks: []int{3, 0}},
+		{Date: "2024-05-02", Tasks: []int{2, 1}},
+		{Date: "2024-05-03", Tasks: []int{0, 0}},
+	}, flow.Days)
+}
+
+func TestRolledUpThrough(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	// Без сверток и при пропуске начала периода все дни считаются по истории
+	assert.Nil(t, rolledUpThrough(nil, from, today))
+	assert.Nil(t, rolledUpThrough(&repository.RollupRange{First: from.AddDate(0, 0, 1), Last: today}, from, today))
+	assert.Nil(t, rolledUpThrough(&repository.RollupRange{First: from.AddDate(0, 0, -9), Last: from.AddDate(0, 0, -1)}, from, today))
+
+	last := rolledUpThrough(&repository.RollupRange{First: from.AddDate(0, 0, -30), Last: from.AddDate(0, 0, 5)}, from, today)
+	require.NotNil(t, last)
+	assert.Equal(t, from.AddDate(0, 0, 5), *last)
+
+	// Текущий день всегда считается по истории
+	last = rolledUpThrough(&repository.RollupRange{First: from, Last: today}, from, today)
+	require.NotNil(t, last)
+	assert.Equal(t, today.AddDate(0, 0, -1), *last)
+}
+
+func TestBuildDailyMetrics(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+	median := 2.5
+	metrics := []model.BoardDailyMetrics{
+		{Day: to, Created: 3, Completed: 1, Moved: 4, CycleMedian: &median, CycleP85: &median},
+	}
+
+	assert.Empty(t, buildDailyMetrics(metrics, from, nil))
+	assert.Equal(t, []DailyMetricsResponse{
+		{Date: "2024-05-01"},
+		{Date: "2024-05-02", Created: 3, Completed: 1, Moved: 4, CycleTimeMedianDays: &median, CycleTimeP85Days: &median},
+	}, buildDailyMetrics(metrics, from, &to))
+}
+
+func TestBuildEstimateRollup(t *testing.T) {
+	todo := model.Column{ID: uuid.New(), Title: "To Do"}
+	doing := model.Column{ID: uuid.New(), Title: "Doing"}
+	done := model.Column{ID: uuid.New(), Title: "Done"}
+	anna, boris := uuid.New(), uuid.New()
+	rollups := []repository.EstimateRollup{
+		{ColumnID: todo.ID, Tasks: 2, Estimated: 1, Estimate: 3, OpenEstimate: 3},
+		{ColumnID: todo.ID, AssignedTo: &boris, Name: "Boris", Email: "boris@example.com", Tasks: 1, Estimated: 1, Estimate: 2, OpenEstimate: 2},
+		{ColumnID: todo.ID, AssignedTo: &anna, Name: "Anna", Email: "anna@example.com", Tasks: 1, Estimated: 1, Estimate: 5, OpenEstimate: 5},
+		{ColumnID: done.ID, AssignedTo: &boris, Name: "Boris", Email: "boris@example.com", Tasks: 3, Estimated: 2, Estimate: 8},
+	}
+
+	response := buildEstimateRollup(uuid.New(), []model.Column{todo, doing, done}, rollups)
+
+	assert.Equal(t, EstimateSumResponse{Tasks: 7, EstimatedTasks: 5, Estimate: 18, OpenEstimate: 10}, response.Total)
+
+	// Колонки без задач тоже перечисляются, в порядке доски
+	require.Len(t, response.Columns, 3)
+	assert.Equal(t, "To Do", response.Columns[0].Title)
+	assert.Equal(t, EstimateSumResponse{Tasks: 4, EstimatedTasks: 3, Estimate: 10, OpenEstimate: 10}, response.Columns[0].EstimateSumResponse)
+	assert.Equal(t, EstimateSumResponse{}, response.Columns[1].EstimateSumResponse)
+	assert.Equal(t, EstimateSumResponse{Tasks: 3, EstimatedTasks: 2, Estimate: 8}, response.Columns[2].EstimateSumResponse)
+
+	// Сначала больший открытый объём, задачи без исполнителя — в конце
+	require.Len(t, response.Assignees, 3)
+	assert.Equal(t, anna.String(), *response.Assignees[0].UserID)
+	assert.Equal(t, EstimateSumResponse{Tasks: 1, EstimatedTasks: 1, Estimate: 5, OpenEstimate: 5}, response.Assignees[0].EstimateSumResponse)
+	assert.Equal(t, "Boris", *response.Assignees[1].Name)
+	assert.Equal(t, EstimateSumResponse{Tasks: 4, EstimatedTasks: 3, Estimate: 10, OpenEstimate: 2}, response.Assignees[1].EstimateSumResponse)
+	assert.Nil(t, response.Assignees[2].UserID)
+	assert.Nil(t, response.Assignees[2].Name)
+	assert.Equal(t, 2, response.Assignees[2].Tasks)
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/audit"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditExportBatchSize is how many activity log entries an export reads at a time
+const AuditExportBatchSize = 1000
+
+// AuditHandler exports the activity log of the instance. Its routes must be restricted to
+// administrators with middleware.RequireAdmin.
+type AuditHandler struct {
+	changeRepo *repository.TaskChangeRepository
+}
+
+func NewAuditHandler(changeRepo *repository.TaskChangeRepository) *AuditHandler {
+	return &AuditHandler{changeRepo: changeRepo}
+}
+
+// ExportLog godoc
+// @Summary Export the activity log
+// @Description Streams the changes made to the tasks of all boards in a time range, oldest first, as NDJSON (one JSON
+// @Description object per line) or CSV with a header row. from and to are RFC 3339 times or dates in UTC; to is exclusive
+// @Description as a time and includes the whole day as a date, and defaults to now. Entries removed by the retention
+// @Description policy are not included. A failure after the response started aborts the connection, so a truncated
+// @Description export never ends like a complete one. Administrators only.
+// @Tags Admin
+// @Produce application/x-ndjson
+// @Produce text/csv
+// @Param from query string true "Start of the range" example(2026-01-01)
+// @Param to query string false "End of the range" example(2026-03-31)
+// @Param format query string false "Output format" Enums(ndjson, csv) default(ndjson)
+// @Success 200 {file} file "Activity log entries"
+// @Failure 400 {object} map[string]string "Invalid range or format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/audit-log [get]
+func (h *AuditHandler) ExportLog(c *gin.Context) {
+	now := time.Now().UTC()
+	from, to, err := parseAuditRange(c.Query("from"), c.Query("to"), now)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	format := c.DefaultQuery("format", audit.FormatNDJSON)
+	writer, err := audit.NewWriter(c.Writer, format)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be ndjson or csv"})
+		return
+	}
+
+	// Первая страница читается до ответа, чтобы ошибка базы ещё могла вернуть 500
+	entries, err := h.changeRepo.ListLog(c.Request.Context(), from, to, nil, AuditExportBatchSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve the activity log"})
+		return
+	}
+
+	filename := fmt.Sprintf("activity-log-%s-%s.%s", from.Format("20060102T150405Z"), to.Format("20060102T150405Z"), format)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("Content-Type", audit.ContentType(format))
+	c.Status(http.StatusOK)
+
+	streamAuditLog(c, writer, entries, func(after audit.Entry) ([]audit.Entry, error) {
+		return h.changeRepo.ListLog(c.Request.Context(), from, to, &after, AuditExportBatchSize)
+	})
+}
+
+// streamAuditLog writes the first page of entries and the pages next reads after the last entry
+// of the previous one, until a page comes back short. The response has started by then, so a
+// failure aborts the connection instead of ending the body cleanly.
+func streamAuditLog(c *gin.Context, writer audit.Writer, entries []audit.Entry, next func(after audit.Entry) ([]audit.Entry, error)) {
+	for {
+		for _, entry := range entries {
+			if err := writer.Write(entry); err != nil {
+				panic(http.ErrAbortHandler)
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		c.Writer.Flush()
+
+		if len(entries) < AuditExportBatchSize {
+			return
+		}
+		last := entries[len(entries)-1]
+		var err error
+		if entries, err = next(last); err != nil {
+			// Ответ уже начат: соединение обрывается, чтобы клиент не принял неполный экспорт за целый
+			log.Printf("⚠️  Activity log export failed after %s: %v", last.ID, err)
+			panic(http.ErrAbortHandler)
+		}
+	}
+}
+
+// parseAuditRange parses the range of an activity log export. Dates stand for whole days in UTC.
+func parseAuditRange(fromValue, toValue string, now time.Time) (time.Time, time.Time, error) {
+	if fromValue == "" {
+		return time.Time{}, time.Time{}, errors.New("from is required")
+	}
+	from, _, err := parseAuditTime(fromValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("from %w", err)
+	}
+
+	to := now
+	if toValue != "" {
+		var isDate bool
+		if to, isDate, err = parseAuditTime(toValue); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to %w", err)
+		}
+		if isDate {
+			to = to.AddDate(0, 0, 1)
+		}
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errors.New("from must be before to")
+	}
+	return from.UTC(), to.UTC(), nil
+}
+
+// parseAuditTime parses an RFC 3339 time or a date, reporting which one it was
+func parseAuditTime(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, errors.New("must be an RFC 3339 time or a date like 2026-01-31")
+}
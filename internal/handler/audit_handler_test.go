@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"kanban/internal/audit"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuditRange(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	// Дата в to включает весь день
+	from, to, err := parseAuditRange("2026-03-01", "2026-03-05", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC), to)
+
+	// Без to диапазон идёт до текущего момента, время переводится в UTC
+	from, to, err = parseAuditRange("2026-03-10T10:00:00+02:00", "", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, now, to)
+
+	for _, r := range [][2]string{{"", ""}, {"yesterday", ""}, {"2026-03-05", "2026-03-01"}, {"2026-03-01", "03/05/2026"}} {
+		_, _, err := parseAuditRange(r[0], r[1], now)
+		assert.Error(t, err, r)
+	}
+}
+
+func TestStreamAuditLog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	page := make([]audit.Entry, AuditExportBatchSize)
+	for i := range page {
+		page[i] = audit.Entry{ID: uuid.New(), Field: "title"}
+	}
+	stream := func(next func(audit.Entry) ([]audit.Entry, error)) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		writer, err := audit.NewWriter(c.Writer, audit.FormatNDJSON)
+		require.NoError(t, err)
+		streamAuditLog(c, writer, page, next)
+		return w
+	}
+
+	// Следующая страница читается после последней записи предыдущей
+	var after audit.Entry
+	w := stream(func(last audit.Entry) ([]audit.Entry, error) {
+		after = last
+		return page[:1], nil
+	})
+	assert.Equal(t, page[len(page)-1].ID, after.ID)
+	assert.Len(t, strings.Split(strings.TrimSpace(w.Body.String()), "\n"), AuditExportBatchSize+1)
+
+	// Ошибка посреди выгрузки обрывает соединение, а не завершает ответ как полный
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+		stream(func(audit.Entry) ([]audit.Entry, error) {
+			return nil, errors.New("context deadline exceeded")
+		})
+	})
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// accessibleBoard loads the board from the path and checks that the user has the role on it,
+// both through the cached permission service. On failure the response is already written.
+func accessibleBoard(c *gin.Context, perms *permission.Service, role string) (*model.Board, bool) {
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return nil, false
+	}
+
+	return accessibleBoardByID(c, perms, boardID, role)
+}
+
+// accessibleBoardByID is accessibleBoard for a board that is not named in the path
+func accessibleBoardByID(c *gin.Context, perms *permission.Service, boardID uuid.UUID, role string) (*model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return nil, false
+	}
+
+	board, err := perms.GetBoard(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return nil, false
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		hasAccess, err := perms.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, role)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+			return nil, false
+		}
+
+		if !hasAccess {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this board"})
+			return nil, false
+		}
+	}
+
+	return board, true
+}
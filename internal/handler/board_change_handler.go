@@ -0,0 +1,342 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// errChangesExpired is returned for a since older than the retained change feed
+var errChangesExpired = errors.New("changes are no longer available")
+
+type BoardChangeHandler struct {
+	changeRepo     *repository.BoardChangeRepository
+	boardShareRepo repository.BoardShareRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	taskRepo       repository.TaskRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	relationRepo   *repository.TaskRelationRepository
+	prefsRepo      *repository.UserBoardPrefsRepository
+	lockRepo       *repository.TaskLockRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+	streams        *EventStreams
+	// retention is how long changes are kept before they are pruned
+	retention time.Duration
+}
+
+func NewBoardChangeHandler(
+	changeRepo *repository.BoardChangeRepository,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	relationRepo *repository.TaskRelationRepository,
+	prefsRepo *repository.UserBoardPrefsRepository,
+	lockRepo *repository.TaskLockRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+	streams *EventStreams,
+	retention time.Duration,
+) *BoardChangeHandler {
+	return &BoardChangeHandler{
+		changeRepo:     changeRepo,
+		boardShareRepo: boardShareRepo,
+		columnRepo:     columnRepo,
+		taskRepo:       taskRepo,
+		labelRepo:      labelRepo,
+		relationRepo:   relationRepo,
+		prefsRepo:      prefsRepo,
+		lockRepo:       lockRepo,
+		txManager:      txManager,
+		perms:          perms,
+		streams:        streams,
+		retention:      retention,
+	}
+}
+
+// BoardChangesResponse represents what changed on a board since a cursor or time. Columns and
+// tasks are the changed ones as they are now; columns and tasks deleted or moved to another board
+// are listed by ID. Labels, shares and active task locks, when any of them changed, are listed in
+// full and are null otherwise. Locks past their expiry are not reported as changes, so polling
+// clients drop them by expires_at; event streams list the locks again when one expires. A change
+// may be reported more than once, so clients should apply it idempotently.
+// @name BoardChangesResponse
+type BoardChangesResponse struct {
+	// Cursor is passed as since to get the changes after this response
+	Cursor           string               `json:"cursor"`
+	Columns          []ColumnResponse     `json:"columns"`
+	Tasks            []TaskResponse       `json:"tasks"`
+	DeletedColumnIDs []string             `json:"deleted_column_ids"`
+	DeletedTaskIDs   []string             `json:"deleted_task_ids"`
+	Labels           []LabelResponse      `json:"labels"`
+	Shares           []BoardShareResponse `json:"shares"`
+	Locks            []TaskLockResponse   `json:"locks"`
+	// locksExpireAt is when the first of Locks expires, zero without locks
+	locksExpireAt time.Time
+}
+
+// GetChanges godoc
+// @Summary Get board changes
+// @Description Returns the columns, tasks, labels and shares of a board that changed since the given point, so polling
+// @Description clients can stay in sync without reloading the whole board. since is the cursor of the previous response,
+// @Description or an RFC 3339 time to start from; changes are kept for a limited time, after which clients get 410 and
+// @Description should reload the board. Only cursors are exact: a time may miss changes of writes running at that time.
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param since query string true "Cursor of a previous response or an RFC 3339 time"
+// @Success 200 {object} BoardChangesResponse "Changes since the given point"
+// @Failure 400 {object} map[string]string "Invalid board ID format or since"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 410 {object} map[string]string "Changes since then are no longer available"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/changes [get]
+func (h *BoardChangeHandler) GetChanges(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	since, err := parseChangesSince(c.Query("since"), time.Now(), h.retention)
+	if err != nil {
+		if err == errChangesExpired {
+			c.JSON(http.StatusGone, gin.H{"error": "Changes since then are no longer available, reload the board"})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+
+	var response *BoardChangesResponse
+	// Журнал и сами записи читаются из одного снимка, иначе курсор мог бы пропустить изменения
+	err = h.txManager.WithinSnapshot(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		response, _, err = h.buildChanges(ctx, board, userID, since)
+		return err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board changes"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// buildChanges loads the entities changed since the given point as they are now, and returns
+// the point the next changes start at
+func (h *BoardChangeHandler) buildChanges(ctx context.Context, board *model.Board, userID uuid.UUID, since changesSince) (*BoardChangesResponse, changesSince, error) {
+	completedBefore, err := h.changeRepo.CompletedBefore(ctx)
+	if err != nil {
+		return nil, since, err
+	}
+
+	var changes []model.BoardChange
+	if since.time.IsZero() {
+		changes, err = h.changeRepo.GetSinceTx(ctx, board.ID, since.txID)
+	} else {
+		changes, err = h.changeRepo.GetSinceTime(ctx, board.ID, since.time)
+	}
+	if err != nil {
+		return nil, since, err
+	}
+
+	changed := groupBoardChanges(changes)
+	response := &BoardChangesResponse{
+		Cursor:           encodeChangeCursor(completedBefore, time.Now()),
+		Columns:          []ColumnResponse{},
+		Tasks:            []TaskResponse{},
+		DeletedColumnIDs: []string{},
+		DeletedTaskIDs:   []string{},
+	}
+
+	if len(changed[model.ChangedColumn]) > 0 {
+		columns, err := h.columnRepo.GetByBoardID(ctx, board.ID)
+		if err != nil {
+			return nil, since, err
+		}
+		prefs, err := h.prefsRepo.Get(ctx, userID, board.ID)
+		if err != nil {
+			return nil, since, err
+		}
+
+		present := make(map[uuid.UUID]bool, len(columns))
+		for i := range columns {
+			present[columns[i].ID] = true
+			if slices.Contains(changed[model.ChangedColumn], columns[i].ID) {
+				response.Columns = append(response.Columns, newColumnResponse(&columns[i], prefs))
+			}
+		}
+		for _, id := range changed[model.ChangedColumn] {
+			if !present[id] {
+				response.DeletedColumnIDs = append(response.DeletedColumnIDs, id.String())
+			}
+		}
+	}
+
+	if len(changed[model.ChangedTask]) > 0 {
+		tasks, err := h.taskRepo.GetByIDsOnBoard(ctx, board.ID, changed[model.ChangedTask])
+		if err != nil {
+			return nil, since, err
+		}
+
+		taskIDs := make([]uuid.UUID, len(tasks))
+		present := make(map[uuid.UUID]bool, len(tasks))
+		for i := range tasks {
+			taskIDs[i] = tasks[i].ID
+			present[tasks[i].ID] = true
+		}
+
+		blockers, err := h.relationRepo.OpenBlockerCounts(ctx, taskIDs)
+		if err != nil {
+			return nil, since, err
+		}
+
+		for i := range tasks {
+			response.Tasks = append(response.Tasks, newTaskListResponse(&tasks[i], board, blockers[tasks[i].ID] > 0))
+		}
+		for _, id := range changed[model.ChangedTask] {
+			if !present[id] {
+				response.DeletedTaskIDs = append(response.DeletedTaskIDs, id.String())
+			}
+		}
+	}
+
+	if len(changed[model.ChangedLabel]) > 0 {
+		labels, err := h.labelRepo.GetAvailableForBoard(ctx, board.ID, board.OwnerID)
+		if err != nil {
+			return nil, since, err
+		}
+		response.Labels = make([]LabelResponse, len(labels))
+		for i, label := range labels {
+			response.Labels[i] = newLabelResponse(label)
+		}
+	}
+
+	if len(changed[model.ChangedShare]) > 0 {
+		shares, err := h.boardShareRepo.GetBoardShares(ctx, board.ID)
+		if err != nil {
+			return nil, since, err
+		}
+		response.Shares = make([]BoardShareResponse, len(shares))
+		for i, share := range shares {
+			response.Shares[i] = BoardShareResponse{
+				UserID: share.UserID.String(),
+				Email:  share.User.Email,
+				Name:   share.User.Name,
+				Role:   share.Role,
+			}
+		}
+	}
+
+	if len(changed[model.ChangedLock]) > 0 {
+		if response.Locks, response.locksExpireAt, err = h.activeLocks(ctx, board.ID); err != nil {
+			return nil, since, err
+		}
+	}
+
+	return response, changesSince{txID: completedBefore}, nil
+}
+
+// activeLocks lists the active task locks of a board and returns when the first of them expires
+func (h *BoardChangeHandler) activeLocks(ctx context.Context, boardID uuid.UUID) ([]TaskLockResponse, time.Time, error) {
+	locks, err := h.lockRepo.GetActiveForBoard(ctx, boardID, time.Now())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var expireAt time.Time
+	response := make([]TaskLockResponse, len(locks))
+	for i := range locks {
+		response[i] = newTaskLockResponse(&locks[i])
+		if expireAt.IsZero() || locks[i].ExpiresAt.Before(expireAt) {
+			expireAt = locks[i].ExpiresAt
+		}
+	}
+	return response, expireAt, nil
+}
+
+// empty reports whether nothing changed
+func (r *BoardChangesResponse) empty() bool {
+	return len(r.Columns) == 0 && len(r.Tasks) == 0 && len(r.DeletedColumnIDs) == 0 && len(r.DeletedTaskIDs) == 0 &&
+		r.Labels == nil && r.Shares == nil && r.Locks == nil
+}
+
+// changesSince is where a client's view of a board's changes ends: a transaction ID from a
+// cursor, or a time when time is set
+type changesSince struct {
+	txID int64
+	time time.Time
+}
+
+// encodeChangeCursor builds a cursor from the transaction ID the next changes start at and the
+// time the cursor is issued, which tells when the changes after it are pruned
+func encodeChangeCursor(txID int64, issuedAt time.Time) string {
+	return fmt.Sprintf("%d.%d", txID, issuedAt.Unix())
+}
+
+// parseChangesSince reads the since parameter, either a cursor or an RFC 3339 time. Points older
+// than the retention fail with errChangesExpired.
+func parseChangesSince(value string, now time.Time, retention time.Duration) (changesSince, error) {
+	if value == "" {
+		return changesSince{}, errors.New("since is required")
+	}
+
+	var since changesSince
+	var at time.Time
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		since.time = t
+		at = t
+	} else {
+		txPart, issuedPart, found := strings.Cut(value, ".")
+		txID, txErr := strconv.ParseInt(txPart, 10, 64)
+		issued, issuedErr := strconv.ParseInt(issuedPart, 10, 64)
+		if !found || txErr != nil || issuedErr != nil || txID < 0 {
+			return changesSince{}, errors.New("since must be a cursor or an RFC 3339 time")
+		}
+		since.txID = txID
+		at = time.Unix(issued, 0)
+	}
+
+	if at.Before(now.Add(-retention)) {
+		return changesSince{}, errChangesExpired
+	}
+	return since, nil
+}
+
+// groupBoardChanges collects the distinct IDs of the changed entities by entity. Locks are
+// logged under the ID of their task, so IDs are only distinct within an entity.
+func groupBoardChanges(changes []model.BoardChange) map[string][]uuid.UUID {
+	type key struct {
+		entity string
+		id     uuid.UUID
+	}
+	grouped := make(map[string][]uuid.UUID)
+	seen := make(map[key]bool, len(changes))
+	for _, change := range changes {
+		if seen[key{change.Entity, change.EntityID}] {
+			continue
+		}
+		seen[key{change.Entity, change.EntityID}] = true
+		grouped[change.Entity] = append(grouped[change.Entity], change.EntityID)
+	}
+	return grouped
+}
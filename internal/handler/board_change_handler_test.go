@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangesSince(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	retention := 24 * time.Hour
+
+	// Курсор разбирается обратно в номер транзакции
+	since, err := parseChangesSince(encodeChangeCursor(4711, now.Add(-time.Hour)), now, retention)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4711), since.txID)
+	assert.True(t, since.time.IsZero())
+
+	since, err = parseChangesSince("2024-05-10T09:30:00Z", now, retention)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-150*time.Minute), since.time)
+
+	for _, value := range []string{"", "abc", "12", "12.x", "-1.1715342000"} {
+		_, err = parseChangesSince(value, now, retention)
+		assert.Error(t, err, value)
+		assert.NotEqual(t, errChangesExpired, err, value)
+	}
+
+	// И курсор, и время старше срока хранения устарели
+	_, err = parseChangesSince(encodeChangeCursor(4711, now.Add(-25*time.Hour)), now, retention)
+	assert.Equal(t, errChangesExpired, err)
+	_, err = parseChangesSince("2024-05-09T11:00:00Z", now, retention)
+	assert.Equal(t, errChangesExpired, err)
+}
+
+func TestGroupBoardChanges(t *testing.T) {
+	task, column := uuid.New(), uuid.New()
+	grouped := groupBoardChanges([]model.BoardChange{
+		{Entity: model.ChangedTask, EntityID: task},
+		{Entity: model.ChangedColumn, EntityID: column},
+		{Entity: model.ChangedTask, EntityID: task},
+		// Блокировка записывается с ID своей задачи
+		{Entity: model.ChangedLock, EntityID: task},
+	})
+
+	assert.Equal(t, []uuid.UUID{task}, grouped[model.ChangedTask])
+	assert.Equal(t, []uuid.UUID{task}, grouped[model.ChangedLock])
+	assert.Equal(t, []uuid.UUID{column}, grouped[model.ChangedColumn])
+	assert.Empty(t, grouped[model.ChangedLabel])
+}
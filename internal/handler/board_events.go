@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// BoardEventPollInterval is how often an event stream reads the change feed of its board
+	BoardEventPollInterval = time.Second
+	// BoardEventKeepaliveInterval is how often an idle event stream writes a comment, so proxies
+	// keep the connection open
+	BoardEventKeepaliveInterval = 15 * time.Second
+	// BoardEventStreamDuration bounds an event stream; clients reconnect and continue from the
+	// last event ID, which also checks their token again
+	BoardEventStreamDuration = 5 * time.Minute
+)
+
+// EventStreams ends the open event streams when the server shuts down, as the server waits for
+// every request to finish before it stops
+type EventStreams struct {
+	done chan struct{}
+	once sync.Once
+}
+
+func NewEventStreams() *EventStreams {
+	return &EventStreams{done: make(chan struct{})}
+}
+
+// Close ends every open event stream; clients reconnect to another instance with their last
+// event ID and miss no changes
+func (s *EventStreams) Close() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// StreamEvents godoc
+// @Summary Stream board changes
+// @Description Streams the changes of a board as server-sent events, so clients stay in sync without polling. Each
+// @Description "changes" event holds a BoardChangesResponse as JSON and carries its cursor as the event ID; task locks
+// @Description are listed again whenever one is taken, released or expires, so clients need not track expiry. The stream
+// @Description starts at the Last-Event-ID header, the since parameter or, without either, now. Streams end after a
+// @Description few minutes, when access to the board is lost or when the server shuts down; clients reconnect with
+// @Description the last event ID, which EventSource does itself. A stream of expired changes is refused with 410
+// @Description and the board should be reloaded.
+// @Tags Boards
+// @Produce text/event-stream
+// @Param id path string true "Board ID" format(uuid)
+// @Param since query string false "Cursor of a previous response or an RFC 3339 time"
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Success 200 {object} BoardChangesResponse "Stream of changes events"
+// @Failure 400 {object} map[string]string "Invalid board ID format or since"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 410 {object} map[string]string "Changes since then are no longer available"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/events [get]
+func (h *BoardChangeHandler) StreamEvents(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	ctx := c.Request.Context()
+
+	// Переподключившийся клиент продолжает с последнего полученного события
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("since")
+	}
+
+	var since changesSince
+	var err error
+	if value == "" {
+		var txID int64
+		txID, err = h.changeRepo.CompletedBefore(ctx)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board changes"})
+			return
+		}
+		since.txID = txID
+	} else {
+		since, err = parseChangesSince(value, time.Now(), h.retention)
+		if err != nil {
+			if err == errChangesExpired {
+				c.JSON(http.StatusGone, gin.H{"error": "Changes since then are no longer available, reload the board"})
+			} else {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			}
+			return
+		}
+	}
+
+	// Истечение блокировок не попадает в журнал, поток сам следит за ближайшим
+	_, locksExpireAt, err := h.activeLocks(ctx, board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task locks"})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	// Буферизующие прокси задержали бы события до конца потока
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	poll := time.NewTicker(BoardEventPollInterval)
+	defer poll.Stop()
+	keepalive := time.NewTicker(BoardEventKeepaliveInterval)
+	defer keepalive.Stop()
+	end := time.NewTimer(BoardEventStreamDuration)
+	defer end.Stop()
+
+	cursor := value
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.streams.done:
+			return
+		case <-end.C:
+			return
+		case <-keepalive.C:
+			// Курсор в комментарии-пинге обновляет ID последнего события без самого события, так что
+			// клиент тихой доски не переподключается с истекшим курсором
+			event := ": keepalive\n\n"
+			if cursor != "" {
+				event = fmt.Sprintf(": keepalive\nid: %s\n\n", cursor)
+			}
+			if _, err := c.Writer.WriteString(event); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-poll.C:
+			if board, ok = h.streamBoard(ctx, board.ID, userID); !ok {
+				return
+			}
+
+			locksExpired := !locksExpireAt.IsZero() && !time.Now().Before(locksExpireAt)
+			var response *BoardChangesResponse
+			err := h.txManager.WithinSnapshot(ctx, func(ctx context.Context) error {
+				var err error
+				response, since, err = h.buildChanges(ctx, board, userID, since)
+				if err != nil || response.Locks != nil || !locksExpired {
+					return err
+				}
+				response.Locks, response.locksExpireAt, err = h.activeLocks(ctx, board.ID)
+				return err
+			})
+			if err != nil {
+				if ctx.Err() == nil {
+					requestid.Logf(ctx, "⚠️  Failed to stream changes of board %s: %v", board.ID, err)
+				}
+				return
+			}
+			cursor = response.Cursor
+			if response.Locks != nil {
+				locksExpireAt = response.locksExpireAt
+			}
+			if response.empty() {
+				continue
+			}
+
+			data, err := json.Marshal(response)
+			if err != nil {
+				requestid.Logf(ctx, "⚠️  Failed to encode changes of board %s: %v", board.ID, err)
+				return
+			}
+			if _, err := fmt.Fprintf(c.Writer, "event: changes\nid: %s\ndata: %s\n\n", cursor, data); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// streamBoard reloads the board of a stream and checks again that the user may view it, as
+// shares are revoked, boards deleted and users disabled while their streams are open
+func (h *BoardChangeHandler) streamBoard(ctx context.Context, boardID, userID uuid.UUID) (*model.Board, bool) {
+	active, err := h.perms.UserActive(ctx, userID)
+	if err != nil || !active {
+		return nil, false
+	}
+	board, err := h.perms.GetBoard(ctx, boardID)
+	if err != nil {
+		return nil, false
+	}
+	if board.OwnerID != userID {
+		hasAccess, err := h.perms.CheckAccess(ctx, boardID, userID, model.RoleViewer)
+		if err != nil || !hasAccess {
+			return nil, false
+		}
+	}
+	return board, true
+}
@@ -1,27 +1,61 @@
 package handler
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
 	"net/http"
+	"slices"
+	"strconv"
+	"time"
 
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
 	"kanban/internal/model"
+	"kanban/internal/permission"
 	"kanban/internal/repository"
 	"kanban/internal/middleware"
+	"kanban/internal/requestid"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
-const MaxBoardsPerUser = 5
+// MaxBoardViewFiltersSize limits the size of the saved filters JSON in bytes
+const MaxBoardViewFiltersSize = 4096
 
 type BoardHandler struct {
-	boardRepo      *repository.BoardRepository
-	boardShareRepo *repository.BoardShareRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	boardViewRepo  *repository.BoardViewRepository
+	prefsRepo      *repository.UserBoardPrefsRepository
+	userRepo       *repository.UserRepository
+	txManager      *repository.TxManager
+	outboxRepo     *repository.OutboxRepository
+	perms          *permission.Service
+	limits         *limits.Service
+	queue          *jobs.Queue
+	defaultLabels  func() []model.Label
 }
 
-func NewBoardHandler(boardRepo *repository.BoardRepository, boardShareRepo *repository.BoardShareRepository) *BoardHandler {
+func NewBoardHandler(boardRepo repository.BoardRepositoryInterface, boardShareRepo repository.BoardShareRepositoryInterface, columnRepo repository.ColumnRepositoryInterface, labelRepo repository.LabelRepositoryInterface, boardViewRepo *repository.BoardViewRepository, prefsRepo *repository.UserBoardPrefsRepository, userRepo *repository.UserRepository, txManager *repository.TxManager, outboxRepo *repository.OutboxRepository, perms *permission.Service, limitService *limits.Service, queue *jobs.Queue, defaultLabels func() []model.Label) *BoardHandler {
 	return &BoardHandler{
 		boardRepo:      boardRepo,
 		boardShareRepo: boardShareRepo,
+		columnRepo:     columnRepo,
+		labelRepo:      labelRepo,
+		boardViewRepo:  boardViewRepo,
+		prefsRepo:      prefsRepo,
+		userRepo:       userRepo,
+		txManager:      txManager,
+		outboxRepo:     outboxRepo,
+		perms:          perms,
+		limits:         limitService,
+		queue:          queue,
+		defaultLabels:  defaultLabels,
 	}
 }
 
@@ -35,7 +69,50 @@ type BoardResponse struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	OwnerID     string `json:"owner_id"`
+	Key         string `json:"key"`
 	CreatedAt   string `json:"created_at"`
+	// FrozenAt is set while the board is frozen and rejects changes to its content
+	FrozenAt *string `json:"frozen_at,omitempty"`
+
+	// View is the authenticated user's saved view of the board, returned by GET /boards/{id}
+	View *BoardViewResponse `json:"view,omitempty"`
+	// Starred is set when the authenticated user starred the board, returned by GET /boards
+	Starred bool `json:"starred,omitempty"`
+}
+
+// BoardViewRequest represents the sorting, filtering and grouping of a board view
+// @name BoardViewRequest
+type BoardViewRequest struct {
+	SortBy    string          `json:"sort_by" example:"due_date"`
+	SortOrder string          `json:"sort_order" example:"asc"`
+	GroupBy   string          `json:"group_by" example:"assignee"`
+	Filters   json.RawMessage `json:"filters" swaggertype:"object"`
+}
+
+// BoardViewResponse represents a user's saved view of a board
+// @name BoardViewResponse
+type BoardViewResponse struct {
+	SortBy    string          `json:"sort_by"`
+	SortOrder string          `json:"sort_order"`
+	GroupBy   string          `json:"group_by"`
+	Filters   json.RawMessage `json:"filters" swaggertype:"object"`
+	UpdatedAt *string         `json:"updated_at,omitempty"`
+}
+
+// CardLayoutRequest represents the fields to show on the task cards of a board
+// @name CardLayoutRequest
+type CardLayoutRequest struct {
+	// Fields in display order; null restores the default layout, an empty list shows titles only
+	Fields []string `json:"fields" example:"number,labels,due_date"`
+}
+
+// CardLayoutResponse represents the card layout of a board shared by all its members
+// @name CardLayoutResponse
+type CardLayoutResponse struct {
+	Fields []string `json:"fields"`
+	// Default is set when the board uses the default layout
+	Default   bool     `json:"default"`
+	Available []string `json:"available"`
 }
 
 type UpdateBoardRequest struct {
@@ -43,9 +120,16 @@ type UpdateBoardRequest struct {
 	Description string `json:"description"`
 }
 
+// FreezeBoardRequest represents the request to freeze or unfreeze a board
+// @name FreezeBoardRequest
+type FreezeBoardRequest struct {
+	Frozen *bool `json:"frozen" binding:"required"`
+}
+
 // Create godoc
 // @Summary Create a new board
-// @Description Create a new Kanban board for the authenticated user
+// @Description Create a new Kanban board for the authenticated user. The board starts with the default labels
+// @Description set by the administrators, except those named like one of the user's workspace labels.
 // @Tags Boards
 // @Accept json
 // @Produce json
@@ -70,14 +154,7 @@ func (h *BoardHandler) Create(c *gin.Context) {
 		return
 	}
 
-	count, err := h.boardRepo.CountOwned(c.Request.Context(), ownerID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board count"})
-		return
-	}
-
-	if count >= MaxBoardsPerUser {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Maximum number of boards reached (5)"})
+	if !checkBoardLimit(c, h.limits, h.boardRepo, ownerID) {
 		return
 	}
 
@@ -91,9 +168,23 @@ func (h *BoardHandler) Create(c *gin.Context) {
 		Title:       req.Title,
 		Description: req.Description,
 		OwnerID:     ownerID,
+		Key:         model.DeriveBoardKey(req.Title),
 	}
 
-	if err := h.boardRepo.Create(c.Request.Context(), board); err != nil {
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.boardRepo.Create(ctx, board); err != nil {
+			return err
+		}
+		if h.defaultLabels == nil {
+			return nil
+		}
+		if labels := h.defaultLabels(); len(labels) > 0 {
+			_, err := h.labelRepo.AddMissingToBoard(ctx, board.ID, ownerID, labels)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create board"})
 		return
 	}
@@ -103,16 +194,21 @@ func (h *BoardHandler) Create(c *gin.Context) {
 		Title:       board.Title,
 		Description: board.Description,
 		OwnerID:     board.OwnerID.String(),
+		Key:         board.Key,
 		CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
 	})
 }
 
 // GetAll godoc
 // @Summary Get all accessible boards
-// @Description Get all boards that the authenticated user owns or has access to
+// @Description Get all boards that the authenticated user owns or has access to, flagging the boards the user starred.
+// @Description With starred=true only starred boards are returned. Boards come in the order the user set with
+// @Description PUT /me/board-order, followed by the boards left out of it: owned boards first, then shared ones.
 // @Tags Boards
 // @Produce json
+// @Param starred query bool false "Return only starred boards"
 // @Success 200 {array} BoardResponse "List of boards"
+// @Failure 400 {object} map[string]string "Invalid starred filter"
 // @Failure 401 {object} map[string]string "Not authenticated"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
@@ -130,6 +226,12 @@ func (h *BoardHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	onlyStarred, err := strconv.ParseBool(c.DefaultQuery("starred", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid starred filter, expected true or false"})
+		return
+	}
+
 	ownedBoards, err := h.boardRepo.GetOwned(c.Request.Context(), ownerID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve owned boards"})
@@ -142,37 +244,84 @@ func (h *BoardHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	starredIDs, err := h.prefsRepo.StarredBoardIDs(c.Request.Context(), ownerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve starred boards"})
+		return
+	}
+
+	order, err := h.prefsRepo.BoardOrder(c.Request.Context(), ownerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board order"})
+		return
+	}
+
 	allBoards := append(ownedBoards, sharedBoards...)
-	response := make([]BoardResponse, len(allBoards))
-	
-	for i, board := range allBoards {
-		response[i] = BoardResponse{
+	slices.SortStableFunc(allBoards, func(a, b model.Board) int {
+		return compareBoardOrder(order, a.ID, b.ID)
+	})
+	response := make([]BoardResponse, 0, len(allBoards))
+
+	for _, board := range allBoards {
+		starred := slices.Contains(starredIDs, board.ID)
+		if onlyStarred && !starred {
+			continue
+		}
+		response = append(response, BoardResponse{
 			ID:          board.ID.String(),
 			Title:       board.Title,
 			Description: board.Description,
 			OwnerID:     board.OwnerID.String(),
+			Key:         board.Key,
 			CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
-		}
+			FrozenAt:    frozenAt(&board),
+			Starred:     starred,
+		})
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
-// GetByID godoc
-// @Summary Get a board by ID
-// @Description Get a specific board by its ID if the authenticated user has access
+// compareBoardOrder compares two boards by their position in the user's order, placing boards
+// without one after the ordered boards
+func compareBoardOrder(order map[uuid.UUID]int, a, b uuid.UUID) int {
+	posA, orderedA := order[a]
+	posB, orderedB := order[b]
+	switch {
+	case orderedA && orderedB:
+		return posA - posB
+	case orderedA:
+		return -1
+	case orderedB:
+		return 1
+	}
+	return 0
+}
+
+// BoardOrderRequest represents the order of the authenticated user's boards list
+// @name BoardOrderRequest
+type BoardOrderRequest struct {
+	// BoardIDs are the boards in the order they are listed; boards left out follow them
+	BoardIDs []string `json:"board_ids" binding:"required,max=1000,dive,uuid"`
+}
+
+// SetOrder godoc
+// @Summary Order the boards list
+// @Description Sets the order in which GET /boards lists the boards of the authenticated user, replacing the previous one.
+// @Description Boards left out are listed after the ordered ones; an empty list restores the default order. Other
+// @Description members are not affected.
 // @Tags Boards
+// @Accept json
 // @Produce json
-// @Param id path string true "Board ID"
-// @Success 200 {object} BoardResponse "Board details"
-// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Param request body BoardOrderRequest true "Boards in order"
+// @Success 200 {object} BoardOrderRequest "Saved order"
+// @Failure 400 {object} map[string]string "Invalid input or a board listed twice"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 403 {object} map[string]string "No access to a board"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /boards/{id} [get]
-func (h *BoardHandler) GetByID(c *gin.Context) {
+// @Router /me/board-order [put]
+func (h *BoardHandler) SetOrder(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -185,35 +334,161 @@ func (h *BoardHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	boardIDStr := c.Param("id")
-	boardID, err := uuid.Parse(boardIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+	var req BoardOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	ownedBoards, err := h.boardRepo.GetOwned(c.Request.Context(), authenticatedUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve owned boards"})
 		return
 	}
 
-	if board == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+	sharedBoards, err := h.boardShareRepo.GetSharedBoards(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve shared boards"})
 		return
 	}
 
-	if board.OwnerID != authenticatedUserID {
-		hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+	accessible := make(map[uuid.UUID]bool, len(ownedBoards)+len(sharedBoards))
+	for _, board := range append(ownedBoards, sharedBoards...) {
+		accessible[board.ID] = true
+	}
+
+	boardIDs := make([]uuid.UUID, len(req.BoardIDs))
+	seen := make(map[uuid.UUID]bool, len(req.BoardIDs))
+	for i, id := range req.BoardIDs {
+		boardID := uuid.MustParse(id)
+		if seen[boardID] {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Board %s is listed more than once", id)})
 			return
 		}
-		
-		if !hasAccess {
-			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this board"})
+		if !accessible[boardID] {
+			c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("You don't have access to board %s", id)})
 			return
 		}
+		seen[boardID] = true
+		boardIDs[i] = boardID
+	}
+
+	if err := h.prefsRepo.SetBoardOrder(c.Request.Context(), authenticatedUserID, boardIDs); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save board order"})
+		return
+	}
+
+	c.JSON(http.StatusOK, req)
+}
+
+// Star godoc
+// @Summary Star a board
+// @Description Stars a board for the authenticated user so it can be found among many boards; other members are not affected
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} map[string]string "Board starred successfully"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/star [post]
+func (h *BoardHandler) Star(c *gin.Context) {
+	h.setStarred(c, true)
+}
+
+// Unstar godoc
+// @Summary Unstar a board
+// @Description Removes the authenticated user's star from a board
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} map[string]string "Board unstarred successfully"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/star [delete]
+func (h *BoardHandler) Unstar(c *gin.Context) {
+	h.setStarred(c, false)
+}
+
+func (h *BoardHandler) setStarred(c *gin.Context, starred bool) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	if err := h.prefsRepo.SetStarred(c.Request.Context(), userID, board.ID, starred); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update starred boards"})
+		return
+	}
+
+	if starred {
+		c.JSON(http.StatusOK, gin.H{"message": "Board starred successfully"})
+	} else {
+		c.JSON(http.StatusOK, gin.H{"message": "Board unstarred successfully"})
+	}
+}
+
+// recordLastBoard saves the board as the user's last opened board in a background job, so
+// reading a board does not write. A full queue or a failed write only loses the preference.
+func (h *BoardHandler) recordLastBoard(ctx context.Context, userID, boardID uuid.UUID) {
+	err := h.queue.Enqueue(ctx, func(ctx context.Context) {
+		if err := h.userRepo.SetLastBoard(ctx, userID, boardID); err != nil {
+			requestid.Logf(ctx, "⚠️  Failed to record last board of user %s: %v", userID, err)
+		}
+	})
+	if err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to queue last board of user %s: %v", userID, err)
+	}
+}
+
+// GetByID godoc
+// @Summary Get a board by ID
+// @Description Get a specific board by its ID if the authenticated user has access, together with the user's saved board view
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} BoardResponse "Board details"
+// @Success 304 "Cached copy is current"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id} [get]
+func (h *BoardHandler) GetByID(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+	authenticatedUserID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	boardID := board.ID
+
+	view, err := h.boardViewRepo.Get(c.Request.Context(), authenticatedUserID, boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board view"})
+		return
+	}
+
+	// Последняя открытая доска нужна только для /bootstrap, поэтому записывается в фоне
+	h.recordLastBoard(c.Request.Context(), authenticatedUserID, boardID)
+
+	// Заморозка не сдвигает updated_at доски, поэтому входит в версию отдельно
+	var viewUpdatedAt *time.Time
+	if view != nil {
+		viewUpdatedAt = &view.UpdatedAt
+	}
+	if notModified(c, privateCacheControl, authenticatedUserID, board.UpdatedAt, board.FrozenAt, viewUpdatedAt) {
+		return
 	}
 
 	c.JSON(http.StatusOK, BoardResponse{
@@ -221,7 +496,10 @@ func (h *BoardHandler) GetByID(c *gin.Context) {
 		Title:       board.Title,
 		Description: board.Description,
 		OwnerID:     board.OwnerID.String(),
+		Key:         board.Key,
 		CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
+		FrozenAt:    frozenAt(board),
+		View:        newBoardViewResponse(view),
 	})
 }
 
@@ -238,6 +516,7 @@ func (h *BoardHandler) GetByID(c *gin.Context) {
 // @Failure 401 {object} map[string]string "Not authenticated"
 // @Failure 403 {object} map[string]string "Permission denied"
 // @Failure 404 {object} map[string]string "Board not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
 // @Router /boards/{id} [put]
@@ -285,6 +564,10 @@ func (h *BoardHandler) Update(c *gin.Context) {
 		}
 	}
 
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
 	var req UpdateBoardRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
@@ -298,16 +581,545 @@ func (h *BoardHandler) Update(c *gin.Context) {
 		board.Description = req.Description
 	}
 
-	if err := h.boardRepo.Update(c.Request.Context(), board); err != nil {
+	err = h.updateSettings(c.Request.Context(), board, authenticatedUserID, func(ctx context.Context) error {
+		return h.boardRepo.Update(ctx, board)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update board"})
 		return
 	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
 
 	c.JSON(http.StatusOK, BoardResponse{
 		ID:          board.ID.String(),
 		Title:       board.Title,
 		Description: board.Description,
 		OwnerID:     board.OwnerID.String(),
+		Key:         board.Key,
 		CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
 	})
-}
\ No newline at end of file
+}
+
+// Delete godoc
+// @Summary Delete a board
+// @Description Deletes a board with all its columns, tasks, labels, swimlanes, sprints, shares and links in a single
+// @Description transaction. With transfer_to the board is handed over to one of its editors instead, who becomes the
+// @Description owner; workspace labels used on it are copied to the board and the previous owner loses access.
+// @Description Only the owner can delete or transfer a board.
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param transfer_to query string false "Editor to transfer the board to instead of deleting it" format(uuid)
+// @Success 200 {object} map[string]string "Board deleted or transferred"
+// @Failure 400 {object} map[string]string "Invalid board ID or new owner"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not the owner of the board"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 409 {object} map[string]string "New owner has reached the board limit"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id} [delete]
+func (h *BoardHandler) Delete(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	if board.OwnerID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the owner can delete this board"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	if transferTo := c.Query("transfer_to"); transferTo != "" {
+		newOwnerID, err := uuid.Parse(transferTo)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transfer_to user ID format"})
+			return
+		}
+		h.transfer(c, board, newOwnerID)
+		return
+	}
+
+	var columns []model.Column
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		if columns, err = h.columnRepo.GetByBoardID(ctx, board.ID); err != nil {
+			return err
+		}
+		// Колонки, задачи, метки, доступы и ссылки доски удаляются каскадом в базе
+		return h.boardRepo.Delete(ctx, board.ID)
+	})
+	if err != nil {
+		log.Printf("⚠️  Failed to delete board %s: %v", board.ID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete board"})
+		return
+	}
+
+	columnIDs := make([]uuid.UUID, len(columns))
+	for i, column := range columns {
+		columnIDs[i] = column.ID
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+	h.perms.InvalidateColumns(c.Request.Context(), columnIDs...)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Board deleted"})
+}
+
+// transfer hands the board over to one of its editors and answers the request
+func (h *BoardHandler) transfer(c *gin.Context, board *model.Board, newOwnerID uuid.UUID) {
+	if newOwnerID == board.OwnerID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "You already own this board"})
+		return
+	}
+
+	role, err := h.boardShareRepo.GetUserRole(c.Request.Context(), board.ID, newOwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board access"})
+		return
+	}
+	if role != model.RoleEditor {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The board can only be transferred to one of its editors"})
+		return
+	}
+
+	newOwner, err := h.userRepo.GetByID(c.Request.Context(), newOwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+	if newOwner == nil || newOwner.IsGuest {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The board cannot be transferred to a guest"})
+		return
+	}
+
+	ownerLimits, err := h.limits.ForUser(c.Request.Context(), newOwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return
+	}
+	owned, err := h.boardRepo.CountOwned(c.Request.Context(), newOwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board count"})
+		return
+	}
+	if limits.Reached(ownerLimits.Boards, owned) {
+		c.JSON(http.StatusConflict, gin.H{"error": fmt.Sprintf("The new owner cannot own more than %d boards", ownerLimits.Boards)})
+		return
+	}
+
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.labelRepo.CopyWorkspaceLabelsToBoard(ctx, board.OwnerID, board.ID); err != nil {
+			return err
+		}
+		return h.boardRepo.TransferOwnership(ctx, board.ID, newOwnerID)
+	})
+	if err != nil {
+		log.Printf("⚠️  Failed to transfer board %s to user %s: %v", board.ID, newOwnerID, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to transfer board"})
+		return
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Board transferred"})
+}
+
+// Freeze godoc
+// @Summary Freeze or unfreeze a board
+// @Description Freezes the board, e.g. during a retrospective or a migration, or unfreezes it. While a board is frozen,
+// @Description every change to its columns, tasks, labels, swimlanes and sprints is rejected with 423 Locked, also for
+// @Description the owner. Members can still view the board, and its sharing and personal views keep working. Only the
+// @Description board owner can freeze a board.
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param request body FreezeBoardRequest true "Whether the board is frozen"
+// @Success 200 {object} BoardResponse "Board"
+// @Failure 400 {object} map[string]string "Invalid request or board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not the owner of the board"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/freeze [put]
+func (h *BoardHandler) Freeze(c *gin.Context) {
+	var req FreezeBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	authenticatedUserID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can freeze the board"})
+		return
+	}
+
+	// Повторная заморозка сохраняет исходное время
+	if *req.Frozen != board.Frozen() {
+		board.FrozenAt = nil
+		if *req.Frozen {
+			now := time.Now()
+			board.FrozenAt = &now
+		}
+		err := h.updateSettings(c.Request.Context(), board, authenticatedUserID, func(ctx context.Context) error {
+			return h.boardRepo.SetFrozen(ctx, board.ID, board.FrozenAt)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to freeze board"})
+			return
+		}
+		h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+	}
+
+	c.JSON(http.StatusOK, BoardResponse{
+		ID:          board.ID.String(),
+		Title:       board.Title,
+		Description: board.Description,
+		OwnerID:     board.OwnerID.String(),
+		Key:         board.Key,
+		CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
+		FrozenAt:    frozenAt(board),
+	})
+}
+
+// GetView godoc
+// @Summary Get board view
+// @Description Get the authenticated user's saved sorting, filtering and grouping of a board, or the defaults if none was saved
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} BoardViewResponse "Board view"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/view [get]
+func (h *BoardHandler) GetView(c *gin.Context) {
+	userID, boardID, ok := h.viewBoardAccess(c)
+	if !ok {
+		return
+	}
+
+	view, err := h.boardViewRepo.Get(c.Request.Context(), userID, boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board view"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoardViewResponse(view))
+}
+
+// SaveView godoc
+// @Summary Save board view
+// @Description Save the authenticated user's sorting, filtering and grouping of a board. Filters are stored as given and must be a JSON object.
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Param request body BoardViewRequest true "Board view settings"
+// @Success 200 {object} BoardViewResponse "Saved board view"
+// @Failure 400 {object} map[string]string "Invalid request or board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/view [put]
+func (h *BoardHandler) SaveView(c *gin.Context) {
+	userID, boardID, ok := h.viewBoardAccess(c)
+	if !ok {
+		return
+	}
+
+	var req BoardViewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	view := &model.BoardView{
+		UserID:    userID,
+		BoardID:   boardID,
+		SortBy:    req.SortBy,
+		SortOrder: req.SortOrder,
+		GroupBy:   req.GroupBy,
+		Filters:   req.Filters,
+		UpdatedAt: time.Now(),
+	}
+	if view.SortBy == "" {
+		view.SortBy = "position"
+	}
+	if view.SortOrder == "" {
+		view.SortOrder = "asc"
+	}
+	if len(view.Filters) == 0 || string(view.Filters) == "null" {
+		view.Filters = json.RawMessage("{}")
+	}
+
+	if !slices.Contains(model.BoardViewSortFields, view.SortBy) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported sort field", "allowed": model.BoardViewSortFields})
+		return
+	}
+	if !slices.Contains(model.BoardViewSortOrders, view.SortOrder) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Sort order must be asc or desc"})
+		return
+	}
+	if !slices.Contains(model.BoardViewGroupings, view.GroupBy) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported grouping", "allowed": model.BoardViewGroupings})
+		return
+	}
+
+	var filters map[string]any
+	if err := json.Unmarshal(view.Filters, &filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Filters must be a JSON object"})
+		return
+	}
+	if len(view.Filters) > MaxBoardViewFiltersSize {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Filters are too large"})
+		return
+	}
+
+	if err := h.boardViewRepo.Save(c.Request.Context(), view); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save board view"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoardViewResponse(view))
+}
+
+// ResetView godoc
+// @Summary Reset board view
+// @Description Delete the authenticated user's saved view of a board so the defaults apply again
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} BoardViewResponse "Default board view"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/view [delete]
+func (h *BoardHandler) ResetView(c *gin.Context) {
+	userID, boardID, ok := h.viewBoardAccess(c)
+	if !ok {
+		return
+	}
+
+	if err := h.boardViewRepo.Delete(c.Request.Context(), userID, boardID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reset board view"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newBoardViewResponse(nil))
+}
+
+// viewBoardAccess resolves the authenticated user and the board from the request and checks
+// that the user can view the board. It writes the error response and returns false otherwise.
+func (h *BoardHandler) viewBoardAccess(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if _, err := h.boardRepo.GetByID(c.Request.Context(), boardID); err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this board"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return authenticatedUserID, boardID, true
+}
+
+// newBoardViewResponse converts a saved view; a nil view yields the defaults
+func newBoardViewResponse(view *model.BoardView) *BoardViewResponse {
+	if view == nil {
+		return &BoardViewResponse{
+			SortBy:    "position",
+			SortOrder: "asc",
+			GroupBy:   "",
+			Filters:   json.RawMessage("{}"),
+		}
+	}
+
+	updatedAt := view.UpdatedAt.Format(time.RFC3339)
+	return &BoardViewResponse{
+		SortBy:    view.SortBy,
+		SortOrder: view.SortOrder,
+		GroupBy:   view.GroupBy,
+		Filters:   view.Filters,
+		UpdatedAt: &updatedAt,
+	}
+}
+
+// GetCardLayout godoc
+// @Summary Get board card layout
+// @Description Get the fields shown on the task cards of a board, in display order. The layout is chosen per board
+// @Description so that every client renders cards the same way; boards without one use the default layout.
+// @Tags Boards
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} CardLayoutResponse "Card layout"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/card-layout [get]
+func (h *BoardHandler) GetCardLayout(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, newCardLayoutResponse(board))
+}
+
+// UpdateCardLayout godoc
+// @Summary Update board card layout
+// @Description Set the fields shown on the task cards of a board for all its members. Fields are shown in the given
+// @Description order and may not repeat; null fields restore the default layout.
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Param request body CardLayoutRequest true "Card layout"
+// @Success 200 {object} CardLayoutResponse "Updated card layout"
+// @Failure 400 {object} map[string]string "Invalid request or board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/card-layout [put]
+func (h *BoardHandler) UpdateCardLayout(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req CardLayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	for i, field := range req.Fields {
+		if !slices.Contains(model.BoardCardFields, field) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported card field", "allowed": model.BoardCardFields})
+			return
+		}
+		if slices.Contains(req.Fields[:i], field) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Card fields must not repeat"})
+			return
+		}
+	}
+
+	board.CardFields = req.Fields
+	err := h.updateSettings(c.Request.Context(), board, c.MustGet(middleware.UserIDKey).(uuid.UUID), func(ctx context.Context) error {
+		return h.boardRepo.SetCardFields(ctx, board.ID, req.Fields)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update card layout"})
+		return
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+
+	c.JSON(http.StatusOK, newCardLayoutResponse(board))
+}
+
+// updateSettings saves changed settings of the board with update and records the board as
+// changed in the outbox in the same transaction
+func (h *BoardHandler) updateSettings(ctx context.Context, board *model.Board, userID uuid.UUID, update func(ctx context.Context) error) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := update(ctx); err != nil {
+			return err
+		}
+		return h.outboxRepo.Add(ctx, model.EventBoardUpdated, model.NewBoardUpdatedPayload(board, userID))
+	})
+}
+
+// checkBoardWritable answers the request with 423 Locked and returns false when the board is
+// frozen. Handlers changing the content of a board call it once the user's access is checked.
+func checkBoardWritable(c *gin.Context, board *model.Board) bool {
+	if board.Frozen() {
+		c.JSON(http.StatusLocked, gin.H{"error": "The board is frozen"})
+		return false
+	}
+	return true
+}
+
+// checkBoardIDWritable is checkBoardWritable for handlers that have not loaded the board, which
+// it gets from the permission service
+func checkBoardIDWritable(c *gin.Context, perms *permission.Service, boardID uuid.UUID) bool {
+	board, err := perms.GetBoard(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return false
+	}
+	return checkBoardWritable(c, board)
+}
+
+func frozenAt(board *model.Board) *string {
+	if board.FrozenAt == nil {
+		return nil
+	}
+	value := board.FrozenAt.UTC().Format(time.RFC3339)
+	return &value
+}
+
+func newCardLayoutResponse(board *model.Board) CardLayoutResponse {
+	return CardLayoutResponse{
+		Fields:    board.CardLayout(),
+		Default:   board.CardFields == nil,
+		Available: model.BoardCardFields,
+	}
+}
@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
 	"kanban/internal/middleware"
 	"kanban/internal/model"
+	"kanban/internal/permission"
 	"kanban/internal/repository"
 
 	"github.com/gin-gonic/gin"
@@ -12,28 +15,85 @@ import (
 )
 
 type BoardShareHandler struct {
-	boardRepo      *repository.BoardRepository
+	boardRepo      repository.BoardRepositoryInterface
 	userRepo       *repository.UserRepository
-	boardShareRepo *repository.BoardShareRepository
+	boardShareRepo repository.BoardShareRepositoryInterface
+	taskRepo       repository.TaskRepositoryInterface
+	pinnedTaskRepo *repository.PinnedTaskRepository
+	boardViewRepo  *repository.BoardViewRepository
+	prefsRepo      *repository.UserBoardPrefsRepository
+	settingRepo    *repository.NotificationSettingRepository
+	receiptRepo    *repository.ReadReceiptRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+	outboxRepo     *repository.OutboxRepository
 }
 
 func NewBoardShareHandler(
-	boardRepo *repository.BoardRepository,
+	boardRepo repository.BoardRepositoryInterface,
 	userRepo *repository.UserRepository,
-	boardShareRepo *repository.BoardShareRepository,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	pinnedTaskRepo *repository.PinnedTaskRepository,
+	boardViewRepo *repository.BoardViewRepository,
+	prefsRepo *repository.UserBoardPrefsRepository,
+	settingRepo *repository.NotificationSettingRepository,
+	receiptRepo *repository.ReadReceiptRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+	outboxRepo *repository.OutboxRepository,
 ) *BoardShareHandler {
 	return &BoardShareHandler{
 		boardRepo:      boardRepo,
 		userRepo:       userRepo,
 		boardShareRepo: boardShareRepo,
+		taskRepo:       taskRepo,
+		pinnedTaskRepo: pinnedTaskRepo,
+		boardViewRepo:  boardViewRepo,
+		prefsRepo:      prefsRepo,
+		settingRepo:    settingRepo,
+		receiptRepo:    receiptRepo,
+		txManager:      txManager,
+		perms:          perms,
+		outboxRepo:     outboxRepo,
 	}
 }
 
+// setRole gives the user the role on the board, or removes their access for an empty role, and
+// records the change in the outbox in the same transaction
+func (h *BoardShareHandler) setRole(ctx context.Context, boardID, userID uuid.UUID, role string, changedBy uuid.UUID) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		previous, err := h.boardShareRepo.GetUserRole(ctx, boardID, userID)
+		if err != nil {
+			return err
+		}
+		if role == "" {
+			err = h.boardShareRepo.RemoveShare(ctx, boardID, userID)
+		} else {
+			err = h.boardShareRepo.ShareBoard(ctx, boardID, userID, role)
+		}
+		if err != nil {
+			return err
+		}
+		return addShareEvent(ctx, h.outboxRepo, boardID, userID, previous, role, changedBy)
+	})
+}
+
+// addShareEvent records the change of a user's role on a board from previous to role, either of
+// them empty for no access, in the outbox. Nothing is recorded when the role is unchanged.
+func addShareEvent(ctx context.Context, outboxRepo *repository.OutboxRepository, boardID, userID uuid.UUID, previous, role string, changedBy uuid.UUID) error {
+	event, payload := model.ShareEvent(boardID, userID, previous, role, changedBy)
+	if event == "" {
+		return nil
+	}
+	return outboxRepo.Add(ctx, event, payload)
+}
+
 // ShareBoardRequest represents request for sharing board access
 // @name ShareBoardRequest
 type ShareBoardRequest struct {
 	Email string `json:"email" binding:"required,email"`
-	Role  string `json:"role" binding:"required,oneof=viewer editor"`
+	Role  string `json:"role" binding:"required,oneof=viewer commenter editor"`
 }
 
 // BoardShareResponse represents board share information
@@ -110,7 +170,8 @@ func (h *BoardShareHandler) ShareBoard(c *gin.Context) {
 		return
 	}
 
-	if targetUser == nil {
+	// Гости получают доступ только к доске своей гостевой ссылки
+	if targetUser == nil || targetUser.IsGuest {
 		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
 		return
 	}
@@ -120,10 +181,11 @@ func (h *BoardShareHandler) ShareBoard(c *gin.Context) {
 		return
 	}
 
-	if err := h.boardShareRepo.ShareBoard(c.Request.Context(), boardID, targetUser.ID, req.Role); err != nil {
+	if err := h.setRole(c.Request.Context(), boardID, targetUser.ID, req.Role, authenticatedUserID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to share board"})
 		return
 	}
+	h.perms.InvalidateBoard(c.Request.Context(), boardID)
 
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Board shared successfully",
@@ -195,14 +257,111 @@ func (h *BoardShareHandler) RemoveShare(c *gin.Context) {
 		return
 	}
 
-	if err := h.boardShareRepo.RemoveShare(c.Request.Context(), boardID, targetUserID); err != nil {
+	if err := h.setRole(c.Request.Context(), boardID, targetUserID, "", authenticatedUserID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove share"})
 		return
 	}
+	h.perms.InvalidateBoard(c.Request.Context(), boardID)
 
 	c.JSON(http.StatusOK, gin.H{"message": "Board access removed successfully"})
 }
 
+// Leave removes the authenticated user's own access to a board
+// @Summary Leave board
+// @Description Remove your own access to a board shared with you. You are unassigned from its tasks, and your pins of
+// @Description its tasks, your saved view, your preferences, notification settings and views of the board are removed.
+// @Description The board is no longer your default or last opened board. The owner can't leave a board; they delete or
+// @Description transfer it instead.
+// @Tags board-sharing
+// @Produce json
+// @Param id path string true "Board ID"
+// @Success 200 {object} object{message=string}
+// @Failure 400 {object} object "Invalid board ID, or the user owns the board or is not a member"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 404 {object} object "Board not found"
+// @Failure 500 {object} object "Internal server error"
+// @Security ApiKeyAuth
+// @Router /boards/{id}/leave [post]
+func (h *BoardShareHandler) Leave(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBoardNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	if board.OwnerID == authenticatedUserID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The owner can't leave the board, delete or transfer it instead"})
+		return
+	}
+
+	role, err := h.boardShareRepo.GetUserRole(c.Request.Context(), boardID, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+	if role == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "You are not a member of this board"})
+		return
+	}
+
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.boardShareRepo.RemoveShare(ctx, boardID, authenticatedUserID); err != nil {
+			return err
+		}
+		if err := addShareEvent(ctx, h.outboxRepo, boardID, authenticatedUserID, role, "", authenticatedUserID); err != nil {
+			return err
+		}
+		if err := h.taskRepo.UnassignOnBoard(ctx, boardID, authenticatedUserID); err != nil {
+			return err
+		}
+		if err := h.pinnedTaskRepo.UnpinBoard(ctx, authenticatedUserID, boardID); err != nil {
+			return err
+		}
+		if err := h.boardViewRepo.Delete(ctx, authenticatedUserID, boardID); err != nil {
+			return err
+		}
+		if err := h.prefsRepo.Delete(ctx, authenticatedUserID, boardID); err != nil {
+			return err
+		}
+		if err := h.settingRepo.DeleteForBoard(ctx, authenticatedUserID, boardID); err != nil {
+			return err
+		}
+		if err := h.receiptRepo.DeleteByBoardAndUser(ctx, boardID, authenticatedUserID); err != nil {
+			return err
+		}
+		return h.userRepo.ForgetBoard(ctx, authenticatedUserID, boardID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to leave board"})
+		return
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), boardID)
+
+	c.JSON(http.StatusOK, gin.H{"message": "You left the board"})
+}
+
 // GetBoardShares gets list of users with board access
 // @Summary Get board shares
 // @Description Get list of users with access to board (owner or at least viewer)
@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type BootstrapHandler struct {
+	userRepo       *repository.UserRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	features       func() map[string]bool
+}
+
+// NewBootstrapHandler creates the handler; features returns the feature flags reported to clients,
+// which can change while the server runs
+func NewBootstrapHandler(
+	userRepo *repository.UserRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	features func() map[string]bool,
+) *BootstrapHandler {
+	return &BootstrapHandler{
+		userRepo:       userRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		features:       features,
+	}
+}
+
+// PreferencesRequest represents the request body for updating user preferences
+// @name PreferencesRequest
+type PreferencesRequest struct {
+	// DefaultBoardID is the board opened on start; null clears it
+	DefaultBoardID *string `json:"default_board_id" binding:"omitempty,uuid"`
+}
+
+// PreferencesResponse represents the preferences of the authenticated user
+// @name PreferencesResponse
+type PreferencesResponse struct {
+	DefaultBoardID *string `json:"default_board_id"`
+}
+
+// BootstrapBoard represents a board in the bootstrap board list
+// @name BootstrapBoard
+type BootstrapBoard struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Key         string `json:"key"`
+	OwnerID     string `json:"owner_id"`
+	Role        string `json:"role" enums:"owner,editor,commenter,viewer"`
+	Columns     int    `json:"columns"`
+	Tasks       int    `json:"tasks"`
+	OpenTasks   int    `json:"open_tasks"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// BootstrapResponse holds everything a client needs to render its first screen
+// @name BootstrapResponse
+type BootstrapResponse struct {
+	User        UserDetails         `json:"user"`
+	Preferences PreferencesResponse `json:"preferences"`
+	Features    map[string]bool     `json:"features"`
+	Boards      []BootstrapBoard    `json:"boards"`
+	// OpenBoardID is the board to show first: the deep-linked board, then the default board,
+	// the last opened board and the first board, whichever the user can still access
+	OpenBoardID *string `json:"open_board_id"`
+	LastBoardID *string `json:"last_board_id"`
+	// DeepLinkDenied is set when the requested board does not exist or is not accessible
+	DeepLinkDenied bool `json:"deep_link_denied,omitempty"`
+}
+
+// Get godoc
+// @Summary Bootstrap the client
+// @Description Returns the user profile, preferences, feature flags and the boards the user can access with
+// @Description their sizes in a single call, together with the board to open first. A board_id from a deep link
+// @Description is opened when accessible; otherwise deep_link_denied is set and the usual fallback applies.
+// @Tags Users
+// @Produce json
+// @Param board_id query string false "Deep-linked board ID" format(uuid)
+// @Success 200 {object} BootstrapResponse "Bootstrap data"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /bootstrap [get]
+func (h *BootstrapHandler) Get(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	user, err := h.userRepo.GetByID(c.Request.Context(), authenticatedUserID)
+	if err != nil || user == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+
+	summaries, err := h.boardRepo.GetSummaries(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve boards"})
+		return
+	}
+
+	response := BootstrapResponse{
+		User:        UserDetails{ID: user.ID.String(), Email: user.Email, Name: user.Name},
+		Preferences: PreferencesResponse{DefaultBoardID: uuidString(user.DefaultBoardID)},
+		Boards:      make([]BootstrapBoard, len(summaries)),
+		LastBoardID: uuidString(user.LastBoardID),
+	}
+	if h.features != nil {
+		response.Features = h.features()
+	}
+	if response.Features == nil {
+		response.Features = map[string]bool{}
+	}
+
+	accessible := make(map[uuid.UUID]bool, len(summaries))
+	for i, s := range summaries {
+		accessible[s.ID] = true
+		response.Boards[i] = BootstrapBoard{
+			ID:          s.ID.String(),
+			Title:       s.Title,
+			Description: s.Description,
+			Key:         s.Key,
+			OwnerID:     s.OwnerID.String(),
+			Role:        s.Role,
+			Columns:     s.ColumnCount,
+			Tasks:       s.TaskCount,
+			OpenTasks:   s.OpenTaskCount,
+			CreatedAt:   s.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	candidates := []*uuid.UUID{user.DefaultBoardID, user.LastBoardID}
+	if deepLink := c.Query("board_id"); deepLink != "" {
+		boardID, err := uuid.Parse(deepLink)
+		if err == nil && accessible[boardID] {
+			candidates = append([]*uuid.UUID{&boardID}, candidates...)
+		} else {
+			response.DeepLinkDenied = true
+		}
+	}
+	if len(summaries) > 0 {
+		candidates = append(candidates, &summaries[0].ID)
+	}
+
+	for _, candidate := range candidates {
+		if candidate != nil && accessible[*candidate] {
+			response.OpenBoardID = uuidString(candidate)
+			break
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdatePreferences godoc
+// @Summary Update user preferences
+// @Description Sets the board opened on start; the user needs access to it. A null default_board_id clears it.
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param preferences body PreferencesRequest true "Preferences"
+// @Success 200 {object} PreferencesResponse "Updated preferences"
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "No access to the board"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/preferences [put]
+func (h *BootstrapHandler) UpdatePreferences(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req PreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var boardID *uuid.UUID
+	if req.DefaultBoardID != nil {
+		id, _ := uuid.Parse(*req.DefaultBoardID)
+		hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), id, authenticatedUserID, model.RoleViewer)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+			return
+		}
+
+		if !hasAccess {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+			return
+		}
+		boardID = &id
+	}
+
+	if err := h.userRepo.SetDefaultBoard(c.Request.Context(), authenticatedUserID, boardID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update preferences"})
+		return
+	}
+
+	c.JSON(http.StatusOK, PreferencesResponse{DefaultBoardID: uuidString(boardID)})
+}
+
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"kanban/internal/apiversion"
+	"kanban/internal/ical"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CalendarHandler struct {
+	userRepo       *repository.UserRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	taskRepo       repository.TaskRepositoryInterface
+}
+
+func NewCalendarHandler(
+	userRepo *repository.UserRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+) *CalendarHandler {
+	return &CalendarHandler{
+		userRepo:       userRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		taskRepo:       taskRepo,
+	}
+}
+
+// CalendarTokenResponse represents the token of the user's calendar feeds and the feed URLs
+// @name CalendarTokenResponse
+type CalendarTokenResponse struct {
+	Token string `json:"token"`
+	// MyTasksPath is the feed of the open tasks assigned to the user
+	MyTasksPath string `json:"my_tasks_path" example:"/me/calendar.ics?token=..."`
+	// BoardPath is the feed of a board's open tasks; {id} is replaced by the board ID
+	BoardPath string `json:"board_path" example:"/boards/{id}/calendar.ics?token=..."`
+}
+
+// CreateToken godoc
+// @Summary Create calendar feed token
+// @Description Creates the secret token that authenticates the user's iCal feed URLs, so calendar apps can
+// @Description subscribe to them without logging in. An existing token is replaced and its URLs stop working.
+// @Tags Calendar
+// @Produce json
+// @Success 201 {object} CalendarTokenResponse "Calendar feed token"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/calendar-token [post]
+func (h *CalendarHandler) CreateToken(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate calendar token"})
+		return
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	if err := h.userRepo.SetCalendarToken(c.Request.Context(), authenticatedUserID, &token); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save calendar token"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, CalendarTokenResponse{
+		Token:       token,
+		MyTasksPath: apiversion.Path(c, "/me/calendar.ics?token="+token),
+		BoardPath:   apiversion.Path(c, "/boards/{id}/calendar.ics?token="+token),
+	})
+}
+
+// RevokeToken godoc
+// @Summary Revoke calendar feed token
+// @Description Disables the user's calendar feed URLs
+// @Tags Calendar
+// @Success 204 "Calendar feeds disabled"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/calendar-token [delete]
+func (h *CalendarHandler) RevokeToken(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	if err := h.userRepo.SetCalendarToken(c.Request.Context(), authenticatedUserID, nil); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke calendar token"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetBoardFeed godoc
+// @Summary Get board calendar feed
+// @Description Returns the open tasks of a board that have a due date as all-day iCal events. The feed is
+// @Description authenticated by the calendar token of a user who can view the board.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param id path string true "Board ID" format(uuid)
+// @Param token query string true "Calendar feed token"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Invalid calendar token"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /boards/{id}/calendar.ics [get]
+func (h *CalendarHandler) GetBoardFeed(c *gin.Context) {
+	user, ok := h.feedUser(c)
+	if !ok {
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, user.ID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+		return
+	}
+
+	tasks, err := h.taskRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return
+	}
+
+	for i := range tasks {
+		tasks[i].Column.Board = *board
+	}
+	writeCalendar(c, board.Title, dueDateEvents(tasks))
+}
+
+// GetMyFeed godoc
+// @Summary Get my tasks calendar feed
+// @Description Returns the open tasks assigned to the user on any board that have a due date as all-day iCal
+// @Description events. The feed is authenticated by the user's calendar token.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param token query string true "Calendar feed token"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 401 {object} map[string]string "Invalid calendar token"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /me/calendar.ics [get]
+func (h *CalendarHandler) GetMyFeed(c *gin.Context) {
+	user, ok := h.feedUser(c)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.taskRepo.GetByAssignee(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return
+	}
+
+	writeCalendar(c, "My tasks", dueDateEvents(tasks))
+}
+
+// feedUser resolves the user from the token query parameter of a feed URL. It writes the
+// error response and returns false if the token is missing or unknown.
+func (h *CalendarHandler) feedUser(c *gin.Context) (*model.User, bool) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Calendar token required"})
+		return nil, false
+	}
+
+	user, err := h.userRepo.GetByCalendarToken(c.Request.Context(), token)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return nil, false
+	}
+
+	if user == nil || user.DisabledAt != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid calendar token"})
+		return nil, false
+	}
+	return user, true
+}
+
+// dueDateEvents turns the open tasks with a due date into calendar events. The tasks need
+// Column.Board loaded for their keys.
+func dueDateEvents(tasks []model.Task) []ical.Event {
+	events := []ical.Event{}
+	for i := range tasks {
+		task := &tasks[i]
+		if task.DueDate == nil || task.CompletedAt != nil {
+			continue
+		}
+
+		events = append(events, ical.Event{
+			UID:         task.ID.String() + "@kanban",
+			Summary:     fmt.Sprintf("%s %s", taskKey(&task.Column.Board, task), task.Title),
+			Description: task.Description,
+			Date:        *task.DueDate,
+		})
+	}
+	return events
+}
+
+func writeCalendar(c *gin.Context, name string, events []ical.Event) {
+	var body bytes.Buffer
+	if err := ical.Write(&body, name, events, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render calendar"})
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", body.Bytes())
+}
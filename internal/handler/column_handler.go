@@ -1,10 +1,18 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
+	"kanban/internal/limits"
 	"kanban/internal/middleware"
 	"kanban/internal/model"
+	"kanban/internal/palette"
+	"kanban/internal/permission"
 	"kanban/internal/repository"
 
 	"github.com/gin-gonic/gin"
@@ -12,25 +20,53 @@ import (
 )
 
 type ColumnHandler struct {
-	columnRepo     *repository.ColumnRepository
-	boardRepo      *repository.BoardRepository
-	boardShareRepo *repository.BoardShareRepository
+	columnRepo     repository.ColumnRepositoryInterface
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	taskRepo       repository.TaskRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	relationRepo   *repository.TaskRelationRepository
+	prefsRepo      *repository.UserBoardPrefsRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+	limits         *limits.Service
 }
 
-func NewColumnHandler(columnRepo *repository.ColumnRepository, boardRepo *repository.BoardRepository, boardShareRepo *repository.BoardShareRepository) *ColumnHandler {
+func NewColumnHandler(
+	columnRepo repository.ColumnRepositoryInterface,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	relationRepo *repository.TaskRelationRepository,
+	prefsRepo *repository.UserBoardPrefsRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+	limitService *limits.Service,
+) *ColumnHandler {
 	return &ColumnHandler{
 		columnRepo:     columnRepo,
 		boardRepo:      boardRepo,
 		boardShareRepo: boardShareRepo,
+		taskRepo:       taskRepo,
+		labelRepo:      labelRepo,
+		relationRepo:   relationRepo,
+		prefsRepo:      prefsRepo,
+		txManager:      txManager,
+		perms:          perms,
+		limits:         limitService,
 	}
 }
 
 // CreateColumnRequest represents request for creating column
 // @name CreateColumnRequest
 type CreateColumnRequest struct {
-	Title    string `json:"title" binding:"required"`
-	BoardID  string `json:"board_id" binding:"required"`
-	Position int    `json:"position"`
+	Title       string `json:"title" binding:"required"`
+	BoardID     string `json:"board_id" binding:"required"`
+	Position    int    `json:"position"`
+	IsDone      bool   `json:"is_done"`
+	Color       string `json:"color" example:"#4caf50"`
+	Description string `json:"description" binding:"max=1000"`
 }
 
 // UpdateColumnRequest represents request for updating column
@@ -38,24 +74,157 @@ type CreateColumnRequest struct {
 type UpdateColumnRequest struct {
 	Title    string `json:"title"`
 	Position int    `json:"position"`
+	IsDone   *bool  `json:"is_done"`
+	// Color and Description are kept when omitted; an empty string clears them
+	Color       *string `json:"color" example:"#4caf50"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+}
+
+// SetColumnCollapsedRequest represents request for collapsing or expanding a column
+// @name SetColumnCollapsedRequest
+type SetColumnCollapsedRequest struct {
+	Collapsed *bool `json:"collapsed" binding:"required"`
 }
 
-// ColumnResponse represents response for column
+// ColumnResponse represents response for column.
+// DarkColor is the variant of Color for dark themes, derived on the server.
+// IsCollapsed is the requesting user's own preference and does not affect other members.
 // @name ColumnResponse
 type ColumnResponse struct {
-	ID       string `json:"id"`
-	BoardID  string `json:"board_id"`
-	Title    string `json:"title"`
-	Position int    `json:"position"`
+	ID          string `json:"id"`
+	BoardID     string `json:"board_id"`
+	Title       string `json:"title"`
+	Position    int    `json:"position"`
+	IsDone      bool   `json:"is_done"`
+	Color       string `json:"color"`
+	DarkColor   string `json:"dark_color"`
+	Description string `json:"description"`
+	IsCollapsed bool   `json:"is_collapsed"`
+
+	// SwimlaneTasks counts the column's tasks per swimlane ID, "none" for tasks without
+	// a swimlane; returned by GET /boards/{id}/columns?group_by=swimlane
+	SwimlaneTasks map[string]int `json:"swimlane_tasks,omitempty"`
+	// Summary sums up the column's tasks; returned with the columns of a board
+	Summary *ColumnSummaryResponse `json:"summary,omitempty"`
+}
+
+// ColumnSummaryResponse sums up the tasks of a column for its header
+// @name ColumnSummaryResponse
+type ColumnSummaryResponse struct {
+	Tasks int `json:"tasks"`
+	// Overdue counts the open tasks whose due date has passed
+	Overdue int `json:"overdue"`
+	// Estimate is the total estimate of the tasks in story points
+	Estimate int `json:"estimate"`
 }
 
 // ReorderColumnsRequest represents request for reordering columns
 // @name ReorderColumnsRequest
 type ReorderColumnsRequest struct {
-	Columns []struct {
-		ID       string `json:"id" binding:"required"`
-		Position int    `json:"position" binding:"required"`
-	} `json:"columns" binding:"required"`
+	Columns []ColumnPositionRequest `json:"columns" binding:"required"`
+}
+
+// ColumnPositionRequest represents the new position of one column
+// @name ColumnPositionRequest
+type ColumnPositionRequest struct {
+	ID       string `json:"id" binding:"required"`
+	Position int    `json:"position" binding:"required"`
+}
+
+// MoveColumnToBoardRequest represents request for moving a column to another board
+// @name MoveColumnToBoardRequest
+type MoveColumnToBoardRequest struct {
+	BoardID  string `json:"board_id" binding:"required,uuid"`
+	Position int    `json:"position"`
+}
+
+// MoveColumnToBoardResponse represents the moved column and what changed with its tasks
+// @name MoveColumnToBoardResponse
+type MoveColumnToBoardResponse struct {
+	Column        ColumnResponse `json:"column"`
+	TasksMoved    int64          `json:"tasks_moved"`
+	LabelsCreated int            `json:"labels_created"`
+}
+
+// CopyColumnToBoardRequest represents request for copying a column to a board
+// @name CopyColumnToBoardRequest
+type CopyColumnToBoardRequest struct {
+	BoardID  string `json:"board_id" binding:"required,uuid"`
+	Position int    `json:"position"`
+}
+
+// CopyColumnToBoardResponse represents the copy of a column and what was created with it
+// @name CopyColumnToBoardResponse
+type CopyColumnToBoardResponse struct {
+	Column        ColumnResponse `json:"column"`
+	TasksCopied   int64          `json:"tasks_copied"`
+	LabelsCreated int            `json:"labels_created"`
+}
+
+func newColumnResponse(column *model.Column, prefs *model.UserBoardPrefs) ColumnResponse {
+	return ColumnResponse{
+		ID:          column.ID.String(),
+		BoardID:     column.BoardID.String(),
+		Title:       column.Title,
+		Position:    column.Position,
+		IsDone:      column.IsDone,
+		Color:       column.Color,
+		DarkColor:   palette.DarkVariant(column.Color),
+		Description: column.Description,
+		IsCollapsed: prefs.IsCollapsed(column.ID),
+	}
+}
+
+// setColumnSummaries adds the task summaries to the columns, zero for columns without a summary
+func setColumnSummaries(columns []ColumnResponse, summaries []repository.ColumnTaskSummary) {
+	byID := make(map[string]repository.ColumnTaskSummary, len(summaries))
+	for _, summary := range summaries {
+		byID[summary.ColumnID.String()] = summary
+	}
+	for i := range columns {
+		summary := byID[columns[i].ID]
+		columns[i].Summary = &ColumnSummaryResponse{Tasks: summary.Tasks, Overdue: summary.Overdue, Estimate: summary.Estimate}
+	}
+}
+
+// summarizeColumnTasks sums up already loaded tasks per column like TaskRepository.SummarizeColumns
+func summarizeColumnTasks(tasks []model.Task, now time.Time) []repository.ColumnTaskSummary {
+	var summaries []repository.ColumnTaskSummary
+	index := make(map[uuid.UUID]int)
+	for i := range tasks {
+		task := &tasks[i]
+		idx, ok := index[task.ColumnID]
+		if !ok {
+			idx = len(summaries)
+			index[task.ColumnID] = idx
+			summaries = append(summaries, repository.ColumnTaskSummary{ColumnID: task.ColumnID})
+		}
+
+		summary := &summaries[idx]
+		summary.Tasks++
+		if task.Overdue(now) {
+			summary.Overdue++
+		}
+		if task.Estimate != nil {
+			summary.Estimate += *task.Estimate
+		}
+	}
+	return summaries
+}
+
+// validColumnColor reports whether color may be stored as a column color; empty means the default
+func validColumnColor(color string) bool {
+	return color == "" || palette.IsHex(color)
+}
+
+// boardPrefs loads the user's preferences of a board and responds with an error if that fails
+func (h *ColumnHandler) boardPrefs(c *gin.Context, userID, boardID uuid.UUID) (*model.UserBoardPrefs, bool) {
+	prefs, err := h.prefsRepo.Get(c.Request.Context(), userID, boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board preferences"})
+		return nil, false
+	}
+	return prefs, true
 }
 
 func (h *ColumnHandler) checkBoardAccess(c *gin.Context, boardID uuid.UUID, userID uuid.UUID, requiredRole string) (bool, error) {
@@ -86,7 +255,8 @@ func (h *ColumnHandler) checkBoardAccess(c *gin.Context, boardID uuid.UUID, user
 // @Success 201 {object} ColumnResponse "Created column"
 // @Failure 400 {object} object "Invalid request data"
 // @Failure 401 {object} object "Not authenticated"
-// @Failure 403 {object} object "Insufficient permissions"
+// @Failure 403 {object} object "Insufficient permissions or column limit reached"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Server error"
 // @Security BearerAuth
 // @Router /columns [post]
@@ -109,6 +279,11 @@ func (h *ColumnHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if !validColumnColor(req.Color) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Color must be a hex code such as #4caf50"})
+		return
+	}
+
 	boardID, err := uuid.Parse(req.BoardID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
@@ -126,6 +301,20 @@ func (h *ColumnHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if !checkBoardIDWritable(c, h.perms, boardID) {
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !checkColumnLimit(c, h.limits, h.columnRepo, board, 1) {
+		return
+	}
+
 	position := req.Position
 	if position == 0 {
 		maxPosition, err := h.columnRepo.GetMaxPosition(c.Request.Context(), boardID)
@@ -137,9 +326,12 @@ func (h *ColumnHandler) Create(c *gin.Context) {
 	}
 
 	column := &model.Column{
-		BoardID:  boardID,
-		Title:    req.Title,
-		Position: position,
+		BoardID:     boardID,
+		Title:       req.Title,
+		Position:    position,
+		IsDone:      req.IsDone,
+		Color:       req.Color,
+		Description: req.Description,
 	}
 
 	if err := h.columnRepo.Create(c.Request.Context(), column); err != nil {
@@ -147,24 +339,22 @@ func (h *ColumnHandler) Create(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusCreated, ColumnResponse{
-		ID:       column.ID.String(),
-		BoardID:  column.BoardID.String(),
-		Title:    column.Title,
-		Position: column.Position,
-	})
+	c.JSON(http.StatusCreated, newColumnResponse(column, nil))
 }
 
 // GetAll godoc
 // @Summary Get all columns for a board
-// @Description Retrieves all columns for the specified board, sorted by position
+// @Description Retrieves all columns for the specified board, sorted by position, each with a summary of its tasks:
+// @Description how many there are, how many are overdue and their total estimate.
+// @Description With group_by=swimlane each column also counts its tasks per swimlane.
 // @Tags Columns
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer {token}"
 // @Param id path string true "Board ID"
+// @Param group_by query string false "Count tasks per swimlane" Enums(swimlane)
 // @Success 200 {array} ColumnResponse "Board columns"
-// @Failure 400 {object} object "Invalid board ID"
+// @Failure 400 {object} object "Invalid board ID or grouping"
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 500 {object} object "Server error"
@@ -190,6 +380,12 @@ func (h *ColumnHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	groupBy := c.Query("group_by")
+	if groupBy != "" && groupBy != "swimlane" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported grouping, expected swimlane"})
+		return
+	}
+
 	hasAccess, err := h.checkBoardAccess(c, boardID, authenticatedUserID, model.RoleViewer)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board access"})
@@ -207,13 +403,45 @@ func (h *ColumnHandler) GetAll(c *gin.Context) {
 		return
 	}
 
+	prefs, ok := h.boardPrefs(c, authenticatedUserID, boardID)
+	if !ok {
+		return
+	}
+
 	response := make([]ColumnResponse, len(columns))
-	for i, column := range columns {
-		response[i] = ColumnResponse{
-			ID:       column.ID.String(),
-			BoardID:  column.BoardID.String(),
-			Title:    column.Title,
-			Position: column.Position,
+	byID := make(map[uuid.UUID]*ColumnResponse, len(columns))
+	for i := range columns {
+		response[i] = newColumnResponse(&columns[i], prefs)
+		byID[columns[i].ID] = &response[i]
+	}
+
+	summaries, err := h.taskRepo.SummarizeColumns(c.Request.Context(), boardID, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count tasks"})
+		return
+	}
+	setColumnSummaries(response, summaries)
+
+	if groupBy == "swimlane" {
+		counts, err := h.taskRepo.CountBySwimlane(c.Request.Context(), boardID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count tasks"})
+			return
+		}
+
+		for i := range response {
+			response[i].SwimlaneTasks = map[string]int{}
+		}
+		for _, count := range counts {
+			column, ok := byID[count.ColumnID]
+			if !ok {
+				continue
+			}
+			key := "none"
+			if count.SwimlaneID != nil {
+				key = count.SwimlaneID.String()
+			}
+			column.SwimlaneTasks[key] = count.Count
 		}
 	}
 
@@ -278,17 +506,17 @@ func (h *ColumnHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, ColumnResponse{
-		ID:       column.ID.String(),
-		BoardID:  column.BoardID.String(),
-		Title:    column.Title,
-		Position: column.Position,
-	})
+	prefs, ok := h.boardPrefs(c, authenticatedUserID, column.BoardID)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, newColumnResponse(column, prefs))
 }
 
 // Update godoc
 // @Summary Update a column
-// @Description Updates a column's details
+// @Description Updates a column's details. Marking a column as done completes the tasks in it
 // @Tags Columns
 // @Accept json
 // @Produce json
@@ -300,6 +528,7 @@ func (h *ColumnHandler) GetByID(c *gin.Context) {
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 404 {object} object "Column not found"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Server error"
 // @Security BearerAuth
 // @Router /columns/{id} [put]
@@ -345,6 +574,10 @@ func (h *ColumnHandler) Update(c *gin.Context) {
 		return
 	}
 
+	if !checkBoardIDWritable(c, h.perms, column.BoardID) {
+		return
+	}
+
 	var req UpdateColumnRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
@@ -357,18 +590,107 @@ func (h *ColumnHandler) Update(c *gin.Context) {
 	if req.Position != 0 {
 		column.Position = req.Position
 	}
+	if req.IsDone != nil {
+		column.IsDone = *req.IsDone
+	}
+	if req.Color != nil {
+		if !validColumnColor(*req.Color) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Color must be a hex code such as #4caf50"})
+			return
+		}
+		column.Color = *req.Color
+	}
+	if req.Description != nil {
+		column.Description = *req.Description
+	}
 
 	if err := h.columnRepo.Update(c.Request.Context(), column); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update column"})
 		return
 	}
+	h.perms.InvalidateColumns(c.Request.Context(), column.ID)
 
-	c.JSON(http.StatusOK, ColumnResponse{
-		ID:       column.ID.String(),
-		BoardID:  column.BoardID.String(),
-		Title:    column.Title,
-		Position: column.Position,
-	})
+	prefs, ok := h.boardPrefs(c, authenticatedUserID, column.BoardID)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, newColumnResponse(column, prefs))
+}
+
+// SetCollapsed godoc
+// @Summary Collapse or expand a column
+// @Description Collapses or expands a column for the authenticated user only; other members keep their own setting
+// @Tags Columns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer {token}"
+// @Param id path string true "Column ID"
+// @Param request body SetColumnCollapsedRequest true "Collapsed flag"
+// @Success 200 {object} ColumnResponse "Column with the new flag"
+// @Failure 400 {object} object "Invalid request data"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 403 {object} object "Insufficient permissions"
+// @Failure 404 {object} object "Column not found"
+// @Failure 500 {object} object "Server error"
+// @Security BearerAuth
+// @Router /columns/{id}/collapsed [put]
+func (h *ColumnHandler) SetCollapsed(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	columnID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+		return
+	}
+
+	var req SetColumnCollapsedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
+		return
+	}
+
+	// Сворачивание меняет только вид пользователя, поэтому достаточно права на просмотр
+	hasAccess, err := h.checkBoardAccess(c, column.BoardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this column"})
+		return
+	}
+
+	if err := h.prefsRepo.SetColumnCollapsed(c.Request.Context(), authenticatedUserID, column.BoardID, column.ID, *req.Collapsed); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save board preferences"})
+		return
+	}
+
+	response := newColumnResponse(column, nil)
+	response.IsCollapsed = *req.Collapsed
+	c.JSON(http.StatusOK, response)
 }
 
 // Delete godoc
@@ -384,6 +706,7 @@ func (h *ColumnHandler) Update(c *gin.Context) {
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 404 {object} object "Column not found"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Server error"
 // @Security BearerAuth
 // @Router /columns/{id} [delete]
@@ -429,27 +752,34 @@ func (h *ColumnHandler) Delete(c *gin.Context) {
 		return
 	}
 
+	if !checkBoardIDWritable(c, h.perms, column.BoardID) {
+		return
+	}
+
 	if err := h.columnRepo.Delete(c.Request.Context(), columnID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete column"})
 		return
 	}
+	h.perms.InvalidateColumns(c.Request.Context(), columnID)
 
 	c.JSON(http.StatusOK, gin.H{"message": "Column deleted successfully"})
 }
 
 // ReorderColumns godoc
 // @Summary Reorder board columns
-// @Description Changes the order of columns on a board
+// @Description Changes the order of columns on a board. The request must list every column of the board exactly once
+// @Description with distinct positions; positions are renumbered from 1 and the columns are returned in their new order.
 // @Tags Columns
 // @Accept json
 // @Produce json
 // @Param Authorization header string true "Bearer {token}"
 // @Param id path string true "Board ID"
 // @Param request body ReorderColumnsRequest true "Column reordering data"
-// @Success 200 {object} object "Success message"
+// @Success 200 {array} ColumnResponse "Columns in their new order"
 // @Failure 400 {object} object "Invalid request data"
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Server error"
 // @Security BearerAuth
 // @Router /boards/{id}/columns/reorder [post]
@@ -484,55 +814,430 @@ func (h *ColumnHandler) ReorderColumns(c *gin.Context) {
 		return
 	}
 
+	if !checkBoardIDWritable(c, h.perms, boardID) {
+		return
+	}
+
 	var req ReorderColumnsRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
 		return
 	}
 
-	columns := make([]model.Column, len(req.Columns))
-	columnIDs := make([]uuid.UUID, len(req.Columns))
+	existingColumns, err := h.columnRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
 
-	for i, col := range req.Columns {
+	columns, err := normalizeColumnOrder(existingColumns, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.columnRepo.ReorderColumns(c.Request.Context(), columns); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reorder columns"})
+		return
+	}
+
+	columnIDs := make([]uuid.UUID, len(columns))
+	for i := range columns {
+		columnIDs[i] = columns[i].ID
+	}
+	h.perms.InvalidateColumns(c.Request.Context(), columnIDs...)
+
+	prefs, ok := h.boardPrefs(c, authenticatedUserID, boardID)
+	if !ok {
+		return
+	}
+
+	response := make([]ColumnResponse, len(columns))
+	for i := range columns {
+		response[i] = newColumnResponse(&columns[i], prefs)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// normalizeColumnOrder checks that the request lists every column of the board exactly once
+// with distinct positions and returns the columns in the requested order, renumbered 1..n
+func normalizeColumnOrder(existing []model.Column, req ReorderColumnsRequest) ([]model.Column, error) {
+	byID := make(map[uuid.UUID]model.Column, len(existing))
+	for _, column := range existing {
+		byID[column.ID] = column
+	}
+
+	type entry struct {
+		column   model.Column
+		position int
+	}
+	entries := make([]entry, 0, len(req.Columns))
+	seenIDs := make(map[uuid.UUID]bool, len(req.Columns))
+	seenPositions := make(map[int]bool, len(req.Columns))
+
+	for _, col := range req.Columns {
 		columnID, err := uuid.Parse(col.ID)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
-			return
+			return nil, errors.New("Invalid column ID format")
 		}
-		columnIDs[i] = columnID
+
+		column, ok := byID[columnID]
+		if !ok {
+			return nil, fmt.Errorf("Column %s does not belong to this board", columnID)
+		}
+		if seenIDs[columnID] {
+			return nil, fmt.Errorf("Column %s is listed more than once", columnID)
+		}
+		if seenPositions[col.Position] {
+			return nil, fmt.Errorf("Position %d is used more than once", col.Position)
+		}
+		seenIDs[columnID] = true
+		seenPositions[col.Position] = true
+
+		entries = append(entries, entry{column: column, position: col.Position})
+	}
+
+	if len(entries) != len(existing) {
+		return nil, fmt.Errorf("All %d columns of the board must be listed, got %d", len(existing), len(entries))
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].position < entries[j].position
+	})
+
+	columns := make([]model.Column, len(entries))
+	for i, e := range entries {
+		columns[i] = e.column
+		columns[i].Position = i + 1
 	}
+	return columns, nil
+}
 
-	existingColumns, err := h.columnRepo.GetByIDs(c.Request.Context(), columnIDs)
+// MoveToBoard godoc
+// @Summary Move a column to another board
+// @Description Moves a column with all its tasks to another board the user can edit, e.g. when splitting a board.
+// @Description Tasks get new numbers on the target board. Labels are remapped by name to the target board's labels,
+// @Description which are created when missing. Tasks keep their swimlane when the target board has one with the same
+// @Description title and move to the default lane otherwise. Relations to tasks left behind are removed and assignees
+// @Description without access to the target board are unassigned. Without a position the column is added after the last one.
+// @Tags Columns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer {token}"
+// @Param id path string true "Column ID"
+// @Param request body MoveColumnToBoardRequest true "Target board and position"
+// @Success 200 {object} MoveColumnToBoardResponse "Moved column"
+// @Failure 400 {object} object "Invalid request data"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 403 {object} object "Insufficient permissions or column limit reached"
+// @Failure 404 {object} object "Column or board not found"
+// @Failure 423 {object} object "Board is frozen"
+// @Failure 500 {object} object "Server error"
+// @Security BearerAuth
+// @Router /columns/{id}/move-to-board [post]
+func (h *ColumnHandler) MoveToBoard(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	columnID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+		return
+	}
+
+	var req MoveColumnToBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	targetID, _ := uuid.Parse(req.BoardID)
+
+	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
+		return
+	}
+
+	if column.BoardID == targetID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Column is already on this board"})
 		return
 	}
 
-	if len(existingColumns) != len(columnIDs) {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Some columns not found"})
+	target, err := h.boardRepo.GetByID(c.Request.Context(), targetID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBoardNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
 		return
 	}
 
-	for _, column := range existingColumns {
-		if column.BoardID != boardID {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "All columns must belong to the specified board"})
+	for _, boardID := range []uuid.UUID{column.BoardID, target.ID} {
+		hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleEditor)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board access"})
+			return
+		}
+
+		if !hasAccess {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You need edit access to both boards to move this column"})
 			return
 		}
 	}
 
-	for i, col := range req.Columns {
-		columnID, _ := uuid.Parse(col.ID)
-		columns[i] = model.Column{
-			ID:       columnID,
-			Position: col.Position,
-			BoardID:  boardID,
+	if !checkBoardIDWritable(c, h.perms, column.BoardID) || !checkBoardWritable(c, target) {
+		return
+	}
+
+	if !checkColumnLimit(c, h.limits, h.columnRepo, target, 1) {
+		return
+	}
+
+	response := MoveColumnToBoardResponse{}
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		// Связи с задачами, остающимися на старой доске, удаляются до переноса
+		if err := h.relationRepo.DeleteAcrossColumn(ctx, column.ID); err != nil {
+			return err
+		}
+
+		if err := h.columnRepo.MoveToBoard(ctx, column, target.ID, req.Position); err != nil {
+			return err
 		}
+
+		var err error
+		if response.TasksMoved, err = h.taskRepo.RenumberForBoard(ctx, column.ID, target.ID); err != nil {
+			return err
+		}
+
+		if response.LabelsCreated, err = h.labelRepo.RemapColumnLabels(ctx, column.ID, target.ID, target.OwnerID); err != nil {
+			return err
+		}
+
+		if err := h.taskRepo.RemapSwimlanes(ctx, column.ID, target.ID); err != nil {
+			return err
+		}
+
+		if err := h.taskRepo.ClearSprints(ctx, column.ID); err != nil {
+			return err
+		}
+
+		return h.taskRepo.UnassignNonMembers(ctx, column.ID, target.ID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move column"})
+		return
 	}
+	h.perms.InvalidateColumns(c.Request.Context(), column.ID)
 
-	if err := h.columnRepo.ReorderColumns(c.Request.Context(), columns); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reorder columns"})
+	prefs, ok := h.boardPrefs(c, authenticatedUserID, column.BoardID)
+	if !ok {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Columns reordered successfully"})
-}
\ No newline at end of file
+	response.Column = newColumnResponse(column, prefs)
+	c.JSON(http.StatusOK, response)
+}
+
+// CopyToBoard godoc
+// @Summary Copy a column to a board
+// @Description Copies a column with all its tasks to a board the user can edit, which may be the column's own board. The
+// @Description user needs view access to the column's board. Copied tasks get new numbers on the target board and keep
+// @Description their labels, remapped by name to the target board's labels like when moving a column; their comments,
+// @Description relations and sprints are not copied. Without a position the copy is added after the last column.
+// @Tags Columns
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer {token}"
+// @Param id path string true "Column ID"
+// @Param request body CopyColumnToBoardRequest true "Target board and position"
+// @Success 201 {object} CopyColumnToBoardResponse "Copied column"
+// @Failure 400 {object} object "Invalid request data"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 403 {object} object "Insufficient permissions or column or task limit reached"
+// @Failure 404 {object} object "Column or board not found"
+// @Failure 423 {object} object "Board is frozen"
+// @Failure 500 {object} object "Server error"
+// @Security BearerAuth
+// @Router /columns/{id}/copy-to-board [post]
+func (h *ColumnHandler) CopyToBoard(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	columnID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+		return
+	}
+
+	var req CopyColumnToBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	targetID, _ := uuid.Parse(req.BoardID)
+
+	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
+		return
+	}
+
+	target, err := h.boardRepo.GetByID(c.Request.Context(), targetID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBoardNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	if err == nil && hasAccess {
+		hasAccess, err = h.boardShareRepo.CheckAccess(c.Request.Context(), target.ID, authenticatedUserID, model.RoleEditor)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You need view access to the column and edit access to the board to copy this column"})
+		return
+	}
+
+	if !checkBoardWritable(c, target) {
+		return
+	}
+
+	if !checkColumnLimit(c, h.limits, h.columnRepo, target, 1) {
+		return
+	}
+
+	tasks, err := h.taskRepo.GetTasksWithLabels(c.Request.Context(), column.ID, repository.TaskListOptions{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return
+	}
+
+	ownerLimits, err := h.limits.ForUser(c.Request.Context(), target.OwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return
+	}
+
+	if limits.Exceeds(ownerLimits.TasksPerColumn, int64(len(tasks))) {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("The column has more tasks than allowed (%d)", ownerLimits.TasksPerColumn)})
+		return
+	}
+
+	copied := &model.Column{
+		BoardID:     target.ID,
+		Title:       column.Title,
+		IsDone:      column.IsDone,
+		Color:       column.Color,
+		Description: column.Description,
+	}
+	response := CopyColumnToBoardResponse{}
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.columnRepo.CreateAt(ctx, copied, req.Position); err != nil {
+			return err
+		}
+
+		for i := range tasks {
+			if err := h.taskRepo.CreateCopy(ctx, copyColumnTask(&tasks[i].Task, copied.ID), taskLabelIDs(&tasks[i].Task)); err != nil {
+				return err
+			}
+		}
+
+		// Копии получают номера, метки и дорожки целевой доски так же, как при переносе колонки
+		var err error
+		if response.TasksCopied, err = h.taskRepo.RenumberForBoard(ctx, copied.ID, target.ID); err != nil {
+			return err
+		}
+
+		if response.LabelsCreated, err = h.labelRepo.RemapColumnLabels(ctx, copied.ID, target.ID, target.OwnerID); err != nil {
+			return err
+		}
+
+		if err := h.taskRepo.RemapSwimlanes(ctx, copied.ID, target.ID); err != nil {
+			return err
+		}
+
+		return h.taskRepo.UnassignNonMembers(ctx, copied.ID, target.ID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to copy column"})
+		return
+	}
+
+	prefs, ok := h.boardPrefs(c, authenticatedUserID, target.ID)
+	if !ok {
+		return
+	}
+
+	response.Column = newColumnResponse(copied, prefs)
+	c.JSON(http.StatusCreated, response)
+}
+
+// copyColumnTask builds the copy of a task in the given column. The copy keeps the task's
+// number until the column is renumbered for its board, and leaves the sprint behind.
+func copyColumnTask(t *model.Task, columnID uuid.UUID) *model.Task {
+	return &model.Task{
+		ColumnID:      columnID,
+		Title:         t.Title,
+		Description:   t.Description,
+		AssignedTo:    t.AssignedTo,
+		CreatedBy:     t.CreatedBy,
+		StartDate:     t.StartDate,
+		DueDate:       t.DueDate,
+		Position:      t.Position,
+		Number:        t.Number,
+		CompletedAt:   t.CompletedAt,
+		Blocked:       t.Blocked,
+		BlockedReason: t.BlockedReason,
+		Priority:      t.Priority,
+		SwimlaneID:    t.SwimlaneID,
+		CoverColor:    t.CoverColor,
+		Estimate:      t.Estimate,
+	}
+}
+
+// taskLabelIDs lists the IDs of the task's labels
+func taskLabelIDs(t *model.Task) []uuid.UUID {
+	ids := make([]uuid.UUID, len(t.Labels))
+	for i, label := range t.Labels {
+		ids[i] = label.ID
+	}
+	return ids
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func reorderRequest(entries ...any) ReorderColumnsRequest {
+	var req ReorderColumnsRequest
+	for i := 0; i < len(entries); i += 2 {
+		req.Columns = append(req.Columns, ColumnPositionRequest{
+			ID:       entries[i].(uuid.UUID).String(),
+			Position: entries[i+1].(int),
+		})
+	}
+	return req
+}
+
+func TestNormalizeColumnOrder(t *testing.T) {
+	boardID := uuid.New()
+	todo := model.Column{ID: uuid.New(), BoardID: boardID, Title: "To Do", Position: 1}
+	doing := model.Column{ID: uuid.New(), BoardID: boardID, Title: "Doing", Position: 2}
+	done := model.Column{ID: uuid.New(), BoardID: boardID, Title: "Done", Position: 3}
+	existing := []model.Column{todo, doing, done}
+
+	// Пропуски в позициях схлопываются в 1..n
+	columns, err := normalizeColumnOrder(existing, reorderRequest(done.ID, 10, todo.ID, 30, doing.ID, 20))
+	require.NoError(t, err)
+	require.Len(t, columns, 3)
+	assert.Equal(t, []uuid.UUID{done.ID, doing.ID, todo.ID}, []uuid.UUID{columns[0].ID, columns[1].ID, columns[2].ID})
+	assert.Equal(t, []int{1, 2, 3}, []int{columns[0].Position, columns[1].Position, columns[2].Position})
+	assert.Equal(t, "Done", columns[0].Title)
+
+	tests := map[string]ReorderColumnsRequest{
+		"partial":            reorderRequest(todo.ID, 1, doing.ID, 2),
+		"duplicate column":   reorderRequest(todo.ID, 1, todo.ID, 2, done.ID, 3),
+		"duplicate position": reorderRequest(todo.ID, 1, doing.ID, 1, done.ID, 3),
+		"foreign column":     reorderRequest(todo.ID, 1, doing.ID, 2, uuid.New(), 3),
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := normalizeColumnOrder(existing, req)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestColumnSummaries(t *testing.T) {
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.UTC)
+	yesterday, tomorrow := now.AddDate(0, 0, -1), now.AddDate(0, 0, 1)
+	three, five := 3, 5
+	todo, done, empty := uuid.New(), uuid.New(), uuid.New()
+
+	tasks := []model.Task{
+		{ColumnID: todo, DueDate: &yesterday, Estimate: &three},
+		{ColumnID: todo, DueDate: &tomorrow, Estimate: &five},
+		{ColumnID: todo},
+		// Выполненная задача не просрочена
+		{ColumnID: done, DueDate: &yesterday, CompletedAt: &now, Estimate: &three},
+	}
+
+	columns := []ColumnResponse{{ID: todo.String()}, {ID: done.String()}, {ID: empty.String()}}
+	setColumnSummaries(columns, summarizeColumnTasks(tasks, now))
+
+	assert.Equal(t, ColumnSummaryResponse{Tasks: 3, Overdue: 1, Estimate: 8}, *columns[0].Summary)
+	assert.Equal(t, ColumnSummaryResponse{Tasks: 1, Overdue: 0, Estimate: 3}, *columns[1].Summary)
+	assert.Equal(t, ColumnSummaryResponse{}, *columns[2].Summary)
+}
+
+// failingUnassignTaskRepo fails the last step of moving a column to another board
+type failingUnassignTaskRepo struct {
+	repository.TaskRepositoryInterface
+}
+
+func (failingUnassignTaskRepo) UnassignNonMembers(ctx context.Context, columnID, boardID uuid.UUID) error {
+	return errors.New("unassign failed")
+}
+
+func TestColumnHandler_MoveToBoard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := testutil.OpenDB(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	userRepo := repository.NewUserRepository(db)
+	boardRepo := repository.NewBoardRepository(db)
+	boardShareRepo := repository.NewBoardShareRepository(db)
+	columnRepo := repository.NewColumnRepository(db)
+	taskRepo := repository.NewTaskRepository(db)
+	labelRepo := repository.NewLabelRepository(db)
+	perms := permission.NewService(boardRepo, columnRepo, boardShareRepo, userRepo, nil, 0)
+	newHandler := func(taskRepo repository.TaskRepositoryInterface) *ColumnHandler {
+		return NewColumnHandler(
+			columnRepo, boardRepo, boardShareRepo, taskRepo, labelRepo, repository.NewTaskRelationRepository(db),
+			repository.NewUserBoardPrefsRepository(db), repository.NewTxManager(db), perms, limits.NewService(limits.Limits{}, userRepo),
+		)
+	}
+
+	source := &model.Board{Title: "Source", OwnerID: owner.ID}
+	target := &model.Board{Title: "Target", OwnerID: owner.ID}
+	require.NoError(t, db.Create(source).Error)
+	require.NoError(t, db.Create(target).Error)
+	require.NoError(t, db.Create(&model.Column{BoardID: target.ID, Title: "To Do", Position: 1}).Error)
+	sourceBug := &model.Label{BoardID: &source.ID, Name: "bug", Color: "#ff0000"}
+	sourceOps := &model.Label{BoardID: &source.ID, Name: "ops", Color: "#00ff00"}
+	targetBug := &model.Label{BoardID: &target.ID, Name: "bug", Color: "#0000ff"}
+	for _, label := range []*model.Label{sourceBug, sourceOps, targetBug} {
+		require.NoError(t, db.Create(label).Error)
+	}
+
+	// Колонка исходной доски с задачей, помеченной обеими метками
+	newColumn := func(title string, number int) (*model.Column, *model.Task) {
+		column := &model.Column{BoardID: source.ID, Title: title, Position: number}
+		require.NoError(t, db.Create(column).Error)
+		task := &model.Task{ColumnID: column.ID, Title: "Task", CreatedBy: owner.ID, Number: number}
+		require.NoError(t, db.Create(task).Error)
+		for _, label := range []*model.Label{sourceBug, sourceOps} {
+			require.NoError(t, db.Exec("INSERT INTO task_labels (task_id, label_id) VALUES (?, ?)", task.ID, label.ID).Error)
+		}
+		return column, task
+	}
+	taskLabels := func(task *model.Task) map[string]*uuid.UUID {
+		var labels []model.Label
+		require.NoError(t, db.Joins("JOIN task_labels ON task_labels.label_id = labels.id").
+			Where("task_labels.task_id = ?", task.ID).Find(&labels).Error)
+		byName := make(map[string]*uuid.UUID, len(labels))
+		for _, label := range labels {
+			byName[label.Name] = label.BoardID
+		}
+		return byName
+	}
+	move := func(h *ColumnHandler, column *model.Column) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body := `{"board_id":"` + target.ID.String() + `"}`
+		c.Request = httptest.NewRequest(http.MethodPost, "/columns/"+column.ID.String()+"/move-to-board", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: column.ID.String()}}
+		c.Set(middleware.UserIDKey, owner.ID)
+		h.MoveToBoard(c)
+		return w
+	}
+
+	t.Run("labels are remapped", func(t *testing.T) {
+		column, task := newColumn("Doing", 1)
+
+		w := move(newHandler(taskRepo), column)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response MoveColumnToBoardResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, int64(1), response.TasksMoved)
+		assert.Equal(t, 1, response.LabelsCreated)
+
+		// bug берется с целевой доски, ops создается на ней
+		labels := taskLabels(task)
+		require.Len(t, labels, 2)
+		for _, boardID := range labels {
+			require.NotNil(t, boardID)
+			assert.Equal(t, target.ID, *boardID)
+		}
+		var bug int64
+		require.NoError(t, db.Table("task_labels").Where("task_id = ? AND label_id = ?", task.ID, targetBug.ID).Count(&bug).Error)
+		assert.Equal(t, int64(1), bug)
+	})
+
+	t.Run("failed move rolls back", func(t *testing.T) {
+		column, task := newColumn("Review", 2)
+		var labelsBefore int64
+		require.NoError(t, db.Model(&model.Label{}).Where("board_id = ?", target.ID).Count(&labelsBefore).Error)
+
+		w := move(newHandler(failingUnassignTaskRepo{taskRepo}), column)
+		require.Equal(t, http.StatusInternalServerError, w.Code, w.Body.String())
+
+		// Колонка, номера и метки задачи остались прежними
+		var stored model.Column
+		require.NoError(t, db.First(&stored, "id = ?", column.ID).Error)
+		assert.Equal(t, source.ID, stored.BoardID)
+		var storedTask model.Task
+		require.NoError(t, db.First(&storedTask, "id = ?", task.ID).Error)
+		assert.Equal(t, task.Number, storedTask.Number)
+		for _, boardID := range taskLabels(task) {
+			require.NotNil(t, boardID)
+			assert.Equal(t, source.ID, *boardID)
+		}
+		var labelsAfter int64
+		require.NoError(t, db.Model(&model.Label{}).Where("board_id = ?", target.ID).Count(&labelsAfter).Error)
+		assert.Equal(t, labelsBefore, labelsAfter)
+	})
+}
@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxCSVImportBytes limits the body of a CSV import
+const MaxCSVImportBytes = 2 << 20
+
+// csvImportColumns maps the accepted CSV headers to the fields of a row
+var csvImportColumns = map[string]string{
+	"title":       "title",
+	"description": "description",
+	"due date":    "due_date",
+	"due_date":    "due_date",
+	"priority":    "priority",
+	"labels":      "labels",
+	"assignee":    "assignee",
+}
+
+// CSVImportResponse reports the tasks of a CSV import and the rows that are invalid
+// @name CSVImportResponse
+type CSVImportResponse struct {
+	DryRun bool `json:"dry_run"`
+	// Tasks is the number of tasks created, or that a dry run found valid
+	Tasks  int              `json:"tasks"`
+	Errors []ImportRowError `json:"errors"`
+}
+
+// csvImportRow is a task read from a line of a CSV import
+type csvImportRow struct {
+	line     int
+	row      jobs.ImportRow
+	assignee string
+}
+
+// ImportCSV godoc
+// @Summary Import tasks from CSV
+// @Description Creates tasks at the end of a column from a CSV file of up to 1000 tasks. The first line names the
+// @Description columns: title (required), description, due_date (YYYY-MM-DD), priority, labels (comma-separated
+// @Description label names) and assignee (email of a board member). With dry_run the rows are only validated.
+// @Description Otherwise all tasks are created in one transaction, or none when a row is invalid. Row errors give
+// @Description the line of the file, the header being line 1.
+// @Tags Imports
+// @Accept text/csv
+// @Produce json
+// @Param id path string true "Column ID" format(uuid)
+// @Param dry_run query bool false "Only validate the rows"
+// @Param file body string true "CSV file"
+// @Success 200 {object} CSVImportResponse "Validation report (dry run)"
+// @Success 201 {object} CSVImportResponse "Tasks created"
+// @Failure 400 {object} map[string]interface{} "Invalid file or rows"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Column not found"
+// @Failure 413 {object} map[string]string "File too large"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /columns/{id}/tasks/import [post]
+func (h *ImportHandler) ImportCSV(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	columnID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+		return
+	}
+
+	dryRun := false
+	if value := c.Query("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dry_run, expected true or false"})
+			return
+		}
+	}
+
+	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
+		return
+	}
+
+	board, ok := h.editableBoard(c, column.BoardID, authenticatedUserID)
+	if !ok {
+		return
+	}
+
+	rows, err := parseCSVImport(http.MaxBytesReader(c.Writer, c.Request.Body, MaxCSVImportBytes), column.Title)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("File exceeds %d bytes", MaxCSVImportBytes)})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid CSV file: " + err.Error()})
+		}
+		return
+	}
+
+	labels, err := h.labelRepo.GetAvailableForBoard(c.Request.Context(), board.ID, board.OwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+		return
+	}
+
+	members, err := h.boardMemberEmails(c.Request.Context(), board)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board members"})
+		return
+	}
+
+	targets := jobs.NewImportTargets([]model.Column{*column}, labels)
+	tasks := make([]*model.Task, 0, len(rows))
+	taskLabels := make([][]uuid.UUID, 0, len(rows))
+	rowErrors := []ImportRowError{}
+	for _, row := range rows {
+		task, labelIDs, err := targets.Resolve(row.row)
+		if err == nil && row.assignee != "" {
+			if assigneeID, ok := members[strings.ToLower(row.assignee)]; ok {
+				task.AssignedTo = &assigneeID
+			} else {
+				err = fmt.Errorf("%q is not a member of the board", row.assignee)
+			}
+		}
+		if err != nil {
+			if len(rowErrors) < maxReportedRowErrors {
+				rowErrors = append(rowErrors, ImportRowError{Row: row.line, Error: err.Error()})
+			}
+			continue
+		}
+
+		task.CreatedBy = authenticatedUserID
+		tasks = append(tasks, task)
+		taskLabels = append(taskLabels, labelIDs)
+	}
+
+	if dryRun {
+		c.JSON(http.StatusOK, CSVImportResponse{DryRun: true, Tasks: len(tasks), Errors: rowErrors})
+		return
+	}
+
+	if len(rowErrors) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Some rows of the file are invalid", "rows": rowErrors})
+		return
+	}
+
+	ownerLimits, err := h.limits.ForUser(c.Request.Context(), board.OwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return
+	}
+
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		existing, err := h.taskRepo.GetByColumnID(ctx, column.ID)
+		if err != nil {
+			return err
+		}
+		if limits.Exceeds(ownerLimits.TasksPerColumn, int64(len(existing)+len(tasks))) {
+			return errTaskLimit
+		}
+
+		for i, task := range tasks {
+			task.Position = len(existing) + i
+			if err := h.taskRepo.Create(ctx, task); err != nil {
+				return err
+			}
+			for _, labelID := range taskLabels[i] {
+				if err := h.taskRepo.AddLabel(ctx, task.ID, labelID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errTaskLimit) {
+			c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("The column can hold at most %d tasks", ownerLimits.TasksPerColumn)})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import tasks"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, CSVImportResponse{Tasks: len(tasks), Errors: rowErrors})
+}
+
+// errTaskLimit rolls back a CSV import that would take the column over the task limit
+var errTaskLimit = errors.New("task limit of the column reached")
+
+// boardMemberEmails maps the lowercased emails of the board's owner and members to their IDs
+func (h *ImportHandler) boardMemberEmails(ctx context.Context, board *model.Board) (map[string]uuid.UUID, error) {
+	owner, err := h.userRepo.GetByID(ctx, board.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := h.boardShareRepo.GetBoardShares(ctx, board.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]uuid.UUID, len(shares)+1)
+	if owner != nil {
+		members[strings.ToLower(owner.Email)] = owner.ID
+	}
+	for _, share := range shares {
+		members[strings.ToLower(share.User.Email)] = share.UserID
+	}
+	return members, nil
+}
+
+// parseCSVImport reads the tasks of a CSV import into rows for the column. The header names the
+// columns case-insensitively; empty lines are skipped.
+func parseCSVImport(r io.Reader, columnTitle string) ([]csvImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, csvError(err)
+	}
+
+	fields := make([]string, len(header))
+	hasTitle := false
+	for i, name := range header {
+		field, ok := csvImportColumns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q, expected title, description, due_date, priority, labels or assignee", name)
+		}
+		fields[i] = field
+		hasTitle = hasTitle || field == "title"
+	}
+	if !hasTitle {
+		return nil, errors.New("the file has no title column")
+	}
+
+	var rows []csvImportRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, csvError(err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := csvImportRow{line: line, row: jobs.ImportRow{Column: columnTitle}}
+		for i, value := range record {
+			if i >= len(fields) {
+				break
+			}
+			value = strings.TrimSpace(value)
+			switch fields[i] {
+			case "title":
+				row.row.Title = value
+			case "description":
+				row.row.Description = value
+			case "due_date":
+				row.row.DueDate = value
+			case "priority":
+				row.row.Priority = strings.ToLower(value)
+			case "labels":
+				for _, name := range strings.Split(value, ",") {
+					if name = strings.TrimSpace(name); name != "" {
+						row.row.Labels = append(row.row.Labels, name)
+					}
+				}
+			case "assignee":
+				row.assignee = value
+			}
+		}
+
+		rows = append(rows, row)
+		if len(rows) > MaxImportPartTasks {
+			return nil, fmt.Errorf("the file can contain at most %d tasks", MaxImportPartTasks)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, errors.New("the file has no tasks")
+	}
+	return rows, nil
+}
+
+// csvError describes a malformed CSV file, keeping errors of reading the body as they are
+func csvError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return fmt.Errorf("line %d: %v", parseErr.Line, parseErr.Err)
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// privateCacheControl lets clients keep a private response but makes them check it with the
+// server before every use
+const privateCacheControl = "private, no-cache"
+
+// notModified sets the Cache-Control header and a weak ETag of the version, and answers a
+// request whose If-None-Match lists the ETag with 304 Not Modified. Handlers derive the version
+// from update times and the board change feed before they load the response, so an unchanged
+// response is neither loaded nor encoded. It reports whether the response is written.
+func notModified(c *gin.Context, cacheControl string, version ...any) bool {
+	data, err := json.Marshal(version)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode response version"})
+		return true
+	}
+
+	sum := sha256.Sum256(data)
+	etag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+
+	c.Header("Cache-Control", cacheControl)
+	c.Header("ETag", etag)
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// etagMatches reports whether the If-None-Match header lists the ETag. As RFC 9110 requires for
+// If-None-Match, the comparison is weak: a W/ prefix on either side is ignored.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotModified(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	version := "v1"
+	loaded := 0
+	router := gin.New()
+	router.GET("/boards/x", func(c *gin.Context) {
+		if notModified(c, privateCacheControl, version) {
+			return
+		}
+		loaded++
+		c.JSON(http.StatusOK, gin.H{"title": "Roadmap"})
+	})
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/boards/x", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"title":"Roadmap"}`, w.Body.String())
+	assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+	etag := w.Header().Get("ETag")
+	require.True(t, strings.HasPrefix(etag, `W/"`), etag)
+
+	// Слабое сравнение: ETag подходит и без префикса W/, и в списке; ответ не загружается
+	for _, header := range []string{etag, strings.TrimPrefix(etag, "W/"), `"other", ` + etag, "*"} {
+		w = get(header)
+		assert.Equal(t, http.StatusNotModified, w.Code, header)
+		assert.Empty(t, w.Body.String())
+		assert.Equal(t, etag, w.Header().Get("ETag"))
+	}
+	assert.Equal(t, 1, loaded)
+
+	assert.Equal(t, http.StatusOK, get(`W/"other"`).Code)
+
+	// Новая версия дает новый ETag, и старая копия загружается заново
+	version = "v2"
+	w = get(etag)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, etag, w.Header().Get("ETag"))
+	assert.Equal(t, 3, loaded)
+}
@@ -0,0 +1,547 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"kanban/internal/apiversion"
+	"kanban/internal/github"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxGitHubPayloadBytes limits the body of a webhook delivery
+	MaxGitHubPayloadBytes = 5 << 20
+	// maxGitHubTasksPerEvent limits the tasks a single event can link or move
+	maxGitHubTasksPerEvent = 50
+)
+
+type GitHubHandler struct {
+	githubRepo *repository.GitHubRepository
+	boardRepo  repository.BoardRepositoryInterface
+	columnRepo repository.ColumnRepositoryInterface
+	taskRepo   repository.TaskRepositoryInterface
+}
+
+func NewGitHubHandler(
+	githubRepo *repository.GitHubRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+) *GitHubHandler {
+	return &GitHubHandler{
+		githubRepo: githubRepo,
+		boardRepo:  boardRepo,
+		columnRepo: columnRepo,
+		taskRepo:   taskRepo,
+	}
+}
+
+// GitHubIntegrationRequest represents the settings of a board's GitHub integration
+// @name GitHubIntegrationRequest
+type GitHubIntegrationRequest struct {
+	// ReviewColumnID receives the tasks of pull requests opened for review; null turns this off
+	ReviewColumnID *string `json:"review_column_id" binding:"omitempty,uuid"`
+	// DoneColumnID receives the tasks of merged pull requests; null turns this off
+	DoneColumnID *string `json:"done_column_id" binding:"omitempty,uuid"`
+	// RotateSecret replaces the webhook secret; deliveries signed with the old one are rejected
+	RotateSecret bool `json:"rotate_secret"`
+}
+
+// GitHubIntegrationResponse represents the GitHub integration of a board
+// @name GitHubIntegrationResponse
+type GitHubIntegrationResponse struct {
+	BoardID string `json:"board_id"`
+	// WebhookPath is the payload URL to configure in the GitHub repository, with content type application/json
+	WebhookPath string `json:"webhook_path" example:"/webhooks/github/{board_id}"`
+	// Secret of the webhook, only returned when the integration is created or the secret rotated
+	Secret         *string  `json:"secret,omitempty"`
+	Events         []string `json:"events"`
+	ReviewColumnID *string  `json:"review_column_id"`
+	DoneColumnID   *string  `json:"done_column_id"`
+	CreatedAt      string   `json:"created_at"`
+}
+
+// GitHubWebhookResponse reports what a webhook delivery changed
+// @name GitHubWebhookResponse
+type GitHubWebhookResponse struct {
+	Linked int `json:"linked"`
+	Moved  int `json:"moved"`
+}
+
+func newGitHubIntegrationResponse(integration *model.GitHubIntegration, showSecret bool) GitHubIntegrationResponse {
+	response := GitHubIntegrationResponse{
+		BoardID:        integration.BoardID.String(),
+		WebhookPath:    "/webhooks/github/" + integration.BoardID.String(),
+		Events:         []string{github.EventPush, github.EventPullRequest},
+		ReviewColumnID: uuidString(integration.ReviewColumnID),
+		DoneColumnID:   uuidString(integration.DoneColumnID),
+		CreatedAt:      integration.CreatedAt.Format(time.RFC3339),
+	}
+	if showSecret {
+		response.Secret = &integration.Secret
+	}
+	return response
+}
+
+// GetIntegration godoc
+// @Summary Get GitHub integration
+// @Description Returns the GitHub integration of a board. Only the board owner can manage it.
+// @Tags GitHub
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {object} GitHubIntegrationResponse "GitHub integration"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found or not connected to GitHub"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/github [get]
+func (h *GitHubHandler) GetIntegration(c *gin.Context) {
+	_, board, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	integration, err := h.githubRepo.GetIntegration(c.Request.Context(), board.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGitHubIntegrationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board is not connected to GitHub"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve GitHub integration"})
+		}
+		return
+	}
+
+	response := newGitHubIntegrationResponse(integration, false)
+	response.WebhookPath = apiversion.Path(c, response.WebhookPath)
+	c.JSON(http.StatusOK, response)
+}
+
+// SaveIntegration godoc
+// @Summary Connect a board to GitHub
+// @Description Connects the board to GitHub or updates the integration. Add the returned webhook path as a webhook of the
+// @Description repository with the returned secret, content type application/json and the push and pull request events.
+// @Description Commits and pull requests mentioning task keys of the board (e.g. PROJ-12) in their message, title,
+// @Description description or branch name are linked to the tasks. Pull requests opened for review move their tasks to
+// @Description the review column and merged ones to the done column, if set. The secret is only returned on creation
+// @Description and rotation.
+// @Tags GitHub
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param integration body GitHubIntegrationRequest true "Integration settings"
+// @Success 200 {object} GitHubIntegrationResponse "Integration updated"
+// @Success 201 {object} GitHubIntegrationResponse "Board connected"
+// @Failure 400 {object} map[string]string "Invalid request or column"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/github [put]
+func (h *GitHubHandler) SaveIntegration(c *gin.Context) {
+	var req GitHubIntegrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	authenticatedUserID, board, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	reviewColumnID, ok := h.boardColumn(c, board, req.ReviewColumnID)
+	if !ok {
+		return
+	}
+	doneColumnID, ok := h.boardColumn(c, board, req.DoneColumnID)
+	if !ok {
+		return
+	}
+
+	integration, err := h.githubRepo.GetIntegration(c.Request.Context(), board.ID)
+	if err != nil && !errors.Is(err, repository.ErrGitHubIntegrationNotFound) {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve GitHub integration"})
+		return
+	}
+
+	created := integration == nil
+	if created {
+		integration = &model.GitHubIntegration{BoardID: board.ID, CreatedBy: authenticatedUserID}
+	}
+	if created || req.RotateSecret {
+		raw := make([]byte, 32)
+		if _, err := rand.Read(raw); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate webhook secret"})
+			return
+		}
+		integration.Secret = hex.EncodeToString(raw)
+	}
+	integration.ReviewColumnID = reviewColumnID
+	integration.DoneColumnID = doneColumnID
+
+	if created {
+		err = h.githubRepo.CreateIntegration(c.Request.Context(), integration)
+	} else {
+		err = h.githubRepo.UpdateIntegration(c.Request.Context(), integration)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save GitHub integration"})
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	response := newGitHubIntegrationResponse(integration, created || req.RotateSecret)
+	response.WebhookPath = apiversion.Path(c, response.WebhookPath)
+	c.JSON(status, response)
+}
+
+// DeleteIntegration godoc
+// @Summary Disconnect a board from GitHub
+// @Description Removes the GitHub integration of a board; further deliveries are rejected. Links already made are kept.
+// @Tags GitHub
+// @Param id path string true "Board ID" format(uuid)
+// @Success 204 "Board disconnected"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found or not connected to GitHub"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/github [delete]
+func (h *GitHubHandler) DeleteIntegration(c *gin.Context) {
+	_, board, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	if err := h.githubRepo.DeleteIntegration(c.Request.Context(), board.ID); err != nil {
+		if errors.Is(err, repository.ErrGitHubIntegrationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board is not connected to GitHub"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete GitHub integration"})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Webhook godoc
+// @Summary Receive a GitHub webhook
+// @Description Receives the push and pull request events of a repository connected to the board. Deliveries must be
+// @Description signed with the webhook secret in X-Hub-Signature-256. Other events are acknowledged and ignored.
+// @Tags GitHub
+// @Accept json
+// @Produce json
+// @Param board_id path string true "Board ID" format(uuid)
+// @Param X-GitHub-Event header string true "Event name"
+// @Param X-Hub-Signature-256 header string true "HMAC SHA-256 signature of the payload"
+// @Success 200 {object} GitHubWebhookResponse "Event processed"
+// @Success 202 {object} map[string]string "Event ignored"
+// @Failure 400 {object} map[string]string "Invalid board ID format or payload"
+// @Failure 401 {object} map[string]string "Invalid signature"
+// @Failure 404 {object} map[string]string "Board not connected to GitHub"
+// @Failure 413 {object} map[string]string "Payload too large"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /webhooks/github/{board_id} [post]
+func (h *GitHubHandler) Webhook(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("board_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, MaxGitHubPayloadBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Payload too large"})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read payload"})
+		}
+		return
+	}
+
+	integration, err := h.githubRepo.GetIntegration(c.Request.Context(), boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGitHubIntegrationNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board is not connected to GitHub"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve GitHub integration"})
+		}
+		return
+	}
+
+	if !github.VerifySignature(integration.Secret, payload, c.GetHeader("X-Hub-Signature-256")) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid signature"})
+		return
+	}
+
+	event := c.GetHeader("X-GitHub-Event")
+	if event == github.EventPing {
+		c.JSON(http.StatusOK, gin.H{"message": "pong"})
+		return
+	}
+	if event != github.EventPush && event != github.EventPullRequest {
+		c.JSON(http.StatusAccepted, gin.H{"message": "Event ignored"})
+		return
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var result GitHubWebhookResponse
+	switch event {
+	case github.EventPush:
+		var push github.PushEvent
+		if err := json.Unmarshal(payload, &push); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload"})
+			return
+		}
+		result, err = h.handlePush(c.Request.Context(), board, &push)
+	case github.EventPullRequest:
+		var pr github.PullRequestEvent
+		if err := json.Unmarshal(payload, &pr); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid payload"})
+			return
+		}
+		result, err = h.handlePullRequest(c.Request.Context(), board, integration, &pr)
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to process event"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// handlePush links the pushed commits to the tasks mentioned in their messages or the branch name
+func (h *GitHubHandler) handlePush(ctx context.Context, board *model.Board, event *github.PushEvent) (GitHubWebhookResponse, error) {
+	var result GitHubWebhookResponse
+	tasks := newGitHubTaskCache(h.taskRepo, board.ID)
+	branch := github.BranchName(event.Ref)
+
+	for _, commit := range event.Commits {
+		author := commit.Author.Username
+		if author == "" {
+			author = commit.Author.Name
+		}
+
+		for _, number := range github.TaskNumbers(board.Key, branch, commit.Message) {
+			task, err := tasks.get(ctx, number)
+			if err != nil {
+				return result, err
+			}
+			if task == nil {
+				continue
+			}
+
+			err = h.githubRepo.SaveLink(ctx, &model.TaskGitHubLink{
+				TaskID:     task.ID,
+				Kind:       model.GitHubLinkCommit,
+				Repository: event.Repository.FullName,
+				Ref:        commit.ID,
+				URL:        commit.URL,
+				Title:      commit.Title(),
+				Author:     author,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.Linked++
+		}
+	}
+	return result, nil
+}
+
+// handlePullRequest links the pull request to the tasks it mentions and moves them to the
+// review column when it is opened for review, or to the done column when it is merged
+func (h *GitHubHandler) handlePullRequest(ctx context.Context, board *model.Board, integration *model.GitHubIntegration, event *github.PullRequestEvent) (GitHubWebhookResponse, error) {
+	var result GitHubWebhookResponse
+	pr := &event.PullRequest
+
+	state := model.PullRequestOpen
+	if pr.Merged {
+		state = model.PullRequestMerged
+	} else if pr.State == model.PullRequestClosed {
+		state = model.PullRequestClosed
+	}
+
+	var target *uuid.UUID
+	if event.MergedNow() {
+		target = integration.DoneColumnID
+	} else if event.ReadyForReview() {
+		target = integration.ReviewColumnID
+	}
+	if target != nil {
+		// Колонка могла переехать на другую доску при разделении доски
+		column, err := h.columnRepo.GetByID(ctx, *target)
+		if err != nil {
+			return result, err
+		}
+		if column == nil || column.BoardID != board.ID {
+			target = nil
+		}
+	}
+
+	tasks := newGitHubTaskCache(h.taskRepo, board.ID)
+	for _, number := range github.TaskNumbers(board.Key, pr.Head.Ref, pr.Title, pr.Body) {
+		task, err := tasks.get(ctx, number)
+		if err != nil {
+			return result, err
+		}
+		if task == nil {
+			continue
+		}
+
+		err = h.githubRepo.SaveLink(ctx, &model.TaskGitHubLink{
+			TaskID:     task.ID,
+			Kind:       model.GitHubLinkPullRequest,
+			Repository: event.Repository.FullName,
+			Ref:        strconv.Itoa(pr.Number),
+			URL:        pr.HTMLURL,
+			Title:      pr.Title,
+			State:      state,
+			Author:     pr.User.Login,
+		})
+		if err != nil {
+			return result, err
+		}
+		result.Linked++
+
+		// Задачи, уже закрытые вручную, не возвращаются на ревью
+		if target == nil || task.ColumnID == *target || event.ReadyForReview() && task.CompletedAt != nil {
+			continue
+		}
+
+		siblings, err := h.taskRepo.GetByColumnID(ctx, *target)
+		if err != nil {
+			return result, err
+		}
+		if err := h.taskRepo.MoveTask(ctx, task.ID, *target, len(siblings)); err != nil {
+			return result, err
+		}
+		result.Moved++
+	}
+	return result, nil
+}
+
+// gitHubTaskCache resolves task numbers of a board once per event and caps how many tasks
+// an event can touch
+type gitHubTaskCache struct {
+	taskRepo repository.TaskRepositoryInterface
+	boardID  uuid.UUID
+	tasks    map[int]*model.Task
+}
+
+func newGitHubTaskCache(taskRepo repository.TaskRepositoryInterface, boardID uuid.UUID) *gitHubTaskCache {
+	return &gitHubTaskCache{taskRepo: taskRepo, boardID: boardID, tasks: make(map[int]*model.Task)}
+}
+
+// get returns the task with the number, or nil if there is none or the event touched too many tasks
+func (t *gitHubTaskCache) get(ctx context.Context, number int) (*model.Task, error) {
+	if task, ok := t.tasks[number]; ok {
+		return task, nil
+	}
+	if len(t.tasks) >= maxGitHubTasksPerEvent {
+		return nil, nil
+	}
+
+	task, err := t.taskRepo.GetByNumber(ctx, t.boardID, number)
+	if errors.Is(err, repository.ErrTaskNotFound) {
+		task, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.tasks[number] = task
+	return task, nil
+}
+
+// boardColumn parses an optional column ID of the request and checks that the column belongs
+// to the board. It writes the error response and returns false otherwise.
+func (h *GitHubHandler) boardColumn(c *gin.Context, board *model.Board, id *string) (*uuid.UUID, bool) {
+	if id == nil {
+		return nil, true
+	}
+
+	columnID, _ := uuid.Parse(*id)
+	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return nil, false
+	}
+
+	if column == nil || column.BoardID != board.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Column %s does not belong to the board", *id)})
+		return nil, false
+	}
+	return &columnID, true
+}
+
+// ownedBoard resolves the authenticated user and the board from the request and checks that
+// the user owns the board. It writes the error response and returns false otherwise.
+func (h *GitHubHandler) ownedBoard(c *gin.Context) (uuid.UUID, *model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, nil, false
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can manage its GitHub integration"})
+		return uuid.Nil, nil, false
+	}
+
+	return authenticatedUserID, board, true
+}
@@ -0,0 +1,435 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+// DefaultGuestLinkLifetime is used when a guest link is created without an expiry
+const DefaultGuestLinkLifetime = 7 * 24 * time.Hour
+
+// GuestSessionLifetime limits how long the token of a guest session is valid; guests open
+// their link again to continue
+const GuestSessionLifetime = 24 * time.Hour
+
+// GuestRoutes are the routes guest sessions may use: working with the tasks, columns and labels
+// of a board. Access checks keep guests on the board of their link.
+var GuestRoutes = []string{
+	"GET /bootstrap",
+	"GET /boards/:id",
+	"GET /boards/:id/full",
+	"GET /boards/:id/changes",
+	"GET /boards/:id/columns",
+	"GET /boards/:id/labels",
+	"GET /boards/:id/swimlanes",
+	"POST /boards/:id/columns/reorder",
+	"POST /columns",
+	"GET /columns/:id",
+	"PUT /columns/:id",
+	"PUT /columns/:id/collapsed",
+	"DELETE /columns/:id",
+	"GET /columns/:id/tasks",
+	"POST /tasks",
+	"POST /tasks/batch-get",
+	"GET /tasks/:id",
+	"PUT /tasks/:id",
+	"DELETE /tasks/:id",
+	"POST /tasks/:id/lock",
+	"DELETE /tasks/:id/lock",
+	"GET /tasks/:id/description",
+	"POST /tasks/:id/description/ops",
+	"POST /tasks/:id/move",
+	"POST /tasks/:id/assign",
+	"DELETE /tasks/:id/assign",
+	"GET /tasks/:id/assignee-suggestions",
+	"GET /tasks/:id/labels",
+	"POST /tasks/:id/labels/:label_id",
+	"DELETE /tasks/:id/labels/:label_id",
+	"POST /tasks/:id/due-date",
+	"POST /tasks/:id/blocked",
+	"PUT /tasks/:id/cover",
+	"POST /tasks/:id/complete",
+	"DELETE /tasks/:id/complete",
+	"GET /tasks/:id/history",
+	"PUT /tasks/:id/swimlane",
+	"GET /tasks/:id/relations",
+	"POST /tasks/:id/relations",
+	"DELETE /tasks/:id/relations/:relation_id",
+	"GET /actions",
+	"POST /actions/:id/undo",
+}
+
+type GuestLinkHandler struct {
+	boardRepo      repository.BoardRepositoryInterface
+	userRepo       *repository.UserRepository
+	boardShareRepo repository.BoardShareRepositoryInterface
+	guestLinkRepo  *repository.GuestLinkRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+	outboxRepo     *repository.OutboxRepository
+	// jwtSecret signs the tokens of guest sessions
+	jwtSecret string
+}
+
+func NewGuestLinkHandler(
+	boardRepo repository.BoardRepositoryInterface,
+	userRepo *repository.UserRepository,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	guestLinkRepo *repository.GuestLinkRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+	outboxRepo *repository.OutboxRepository,
+	jwtSecret string,
+) *GuestLinkHandler {
+	return &GuestLinkHandler{
+		boardRepo:      boardRepo,
+		userRepo:       userRepo,
+		boardShareRepo: boardShareRepo,
+		guestLinkRepo:  guestLinkRepo,
+		txManager:      txManager,
+		perms:          perms,
+		outboxRepo:     outboxRepo,
+		jwtSecret:      jwtSecret,
+	}
+}
+
+// CreateGuestLinkRequest represents request for creating a guest editor link
+// @name CreateGuestLinkRequest
+type CreateGuestLinkRequest struct {
+	// Name of the guest, shown as the author of their changes
+	Name           string `json:"name" binding:"required,max=100"`
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=1,max=720"`
+}
+
+// GuestLinkResponse represents a guest editor link of a board
+// @name GuestLinkResponse
+type GuestLinkResponse struct {
+	ID      string `json:"id"`
+	BoardID string `json:"board_id"`
+	// UserID is the guest user that edits the board through the link
+	UserID        string  `json:"user_id"`
+	Name          string  `json:"name"`
+	Token         string  `json:"token"`
+	ExpiresAt     string  `json:"expires_at"`
+	RevokedAt     *string `json:"revoked_at,omitempty"`
+	Active        bool    `json:"active"`
+	Sessions      int     `json:"sessions"`
+	LastSessionAt *string `json:"last_session_at,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// GuestSessionResponse represents a session started through a guest link
+// @name GuestSessionResponse
+type GuestSessionResponse struct {
+	// Token authenticates the guest like a login token, limited to the link's board
+	Token     string `json:"token"`
+	BoardID   string `json:"board_id"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+func newGuestLinkResponse(link *model.GuestLink) GuestLinkResponse {
+	response := GuestLinkResponse{
+		ID:        link.ID.String(),
+		BoardID:   link.BoardID.String(),
+		UserID:    link.UserID.String(),
+		Name:      link.Name,
+		Token:     link.Token,
+		ExpiresAt: link.ExpiresAt.Format(time.RFC3339),
+		Active:    link.Active(time.Now()),
+		Sessions:  link.Sessions,
+		CreatedAt: link.CreatedAt.Format(time.RFC3339),
+	}
+
+	if link.RevokedAt != nil {
+		revokedAt := link.RevokedAt.Format(time.RFC3339)
+		response.RevokedAt = &revokedAt
+	}
+	if link.LastSessionAt != nil {
+		lastSessionAt := link.LastSessionAt.Format(time.RFC3339)
+		response.LastSessionAt = &lastSessionAt
+	}
+	return response
+}
+
+// ownedBoard parses the board ID and checks that the authenticated user owns the board.
+// It writes the error response and returns false when the request cannot go on.
+func (h *GuestLinkHandler) ownedBoard(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can manage guest links"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return authenticatedUserID, boardID, true
+}
+
+// Create godoc
+// @Summary Create guest link
+// @Description Creates a guest editor link: anyone who opens it can edit the tasks and columns of the board without
+// @Description an account, until the link expires or is revoked. Changes are made by a guest user with the given
+// @Description name, which becomes an editor of the board (owner only).
+// @Tags board-sharing
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param input body CreateGuestLinkRequest true "Link settings"
+// @Success 201 {object} GuestLinkResponse "Guest link created"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/guest-links [post]
+func (h *GuestLinkHandler) Create(c *gin.Context) {
+	authenticatedUserID, boardID, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	var req CreateGuestLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	lifetime := DefaultGuestLinkLifetime
+	if req.ExpiresInHours > 0 {
+		lifetime = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate link token"})
+		return
+	}
+
+	link := &model.GuestLink{
+		BoardID:   boardID,
+		Token:     base64.RawURLEncoding.EncodeToString(token),
+		Name:      req.Name,
+		ExpiresAt: time.Now().Add(lifetime),
+		CreatedBy: authenticatedUserID,
+	}
+
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		// Гость без пароля и с недоставляемым адресом не может войти иначе как по ссылке
+		guest := &model.User{
+			Email:   "guest-" + uuid.NewString() + "@guests.invalid",
+			Name:    req.Name,
+			IsGuest: true,
+		}
+		if err := h.userRepo.Create(ctx, guest); err != nil {
+			return err
+		}
+		if err := h.boardShareRepo.ShareBoard(ctx, boardID, guest.ID, model.RoleEditor); err != nil {
+			return err
+		}
+		if err := addShareEvent(ctx, h.outboxRepo, boardID, guest.ID, "", model.RoleEditor, authenticatedUserID); err != nil {
+			return err
+		}
+
+		link.UserID = guest.ID
+		return h.guestLinkRepo.Create(ctx, link)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create guest link"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newGuestLinkResponse(link))
+}
+
+// GetByBoardID godoc
+// @Summary List guest links
+// @Description Lists the guest editor links of a board with their sessions (owner only)
+// @Tags board-sharing
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {array} GuestLinkResponse "Guest links"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/guest-links [get]
+func (h *GuestLinkHandler) GetByBoardID(c *gin.Context) {
+	_, boardID, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	links, err := h.guestLinkRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve guest links"})
+		return
+	}
+
+	response := make([]GuestLinkResponse, len(links))
+	for i := range links {
+		response[i] = newGuestLinkResponse(&links[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Revoke godoc
+// @Summary Revoke guest link
+// @Description Disables a guest editor link. The guest loses access to the board and their sessions end at once;
+// @Description their changes are kept (owner only)
+// @Tags board-sharing
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param link_id path string true "Guest link ID" format(uuid)
+// @Success 200 {object} map[string]string "Guest link revoked"
+// @Failure 400 {object} map[string]string "Invalid ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board or guest link not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/guest-links/{link_id} [delete]
+func (h *GuestLinkHandler) Revoke(c *gin.Context) {
+	authenticatedUserID, boardID, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	linkID, err := uuid.Parse(c.Param("link_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid guest link ID format"})
+		return
+	}
+
+	var link *model.GuestLink
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		if link, err = h.guestLinkRepo.Revoke(ctx, boardID, linkID); err != nil {
+			return err
+		}
+		role, err := h.boardShareRepo.GetUserRole(ctx, boardID, link.UserID)
+		if err != nil {
+			return err
+		}
+		if err := h.boardShareRepo.RemoveShare(ctx, boardID, link.UserID); err != nil {
+			return err
+		}
+		return addShareEvent(ctx, h.outboxRepo, boardID, link.UserID, role, "", authenticatedUserID)
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrGuestLinkNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Guest link not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke guest link"})
+		}
+		return
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), boardID)
+	h.perms.InvalidateUser(c.Request.Context(), link.UserID)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Guest link revoked"})
+}
+
+// StartSession godoc
+// @Summary Open guest link
+// @Description Starts a guest session through a guest editor link. The returned token authenticates the guest for
+// @Description the board's tasks, columns and labels until the session or the link expires, whichever is first.
+// @Tags board-sharing
+// @Produce json
+// @Param token path string true "Guest link token"
+// @Success 200 {object} GuestSessionResponse "Guest session started"
+// @Failure 404 {object} map[string]string "Guest link not found"
+// @Failure 410 {object} map[string]string "Guest link revoked or expired"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /guest-links/{token}/session [post]
+func (h *GuestLinkHandler) StartSession(c *gin.Context) {
+	link, err := h.guestLinkRepo.StartSession(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		switch err {
+		case repository.ErrGuestLinkNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Guest link not found"})
+		case repository.ErrGuestLinkInactive:
+			c.JSON(http.StatusGone, gin.H{"error": "Guest link is revoked or expired"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to open guest link"})
+		}
+		return
+	}
+
+	expiresAt := time.Now().Add(GuestSessionLifetime)
+	if link.ExpiresAt.Before(expiresAt) {
+		expiresAt = link.ExpiresAt
+	}
+
+	token, err := h.generateGuestToken(link.UserID, expiresAt)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return
+	}
+
+	log.Printf("🔗 Guest link %s opened a session on board %s", link.ID, link.BoardID)
+	c.JSON(http.StatusOK, GuestSessionResponse{
+		Token:     token,
+		BoardID:   link.BoardID.String(),
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	})
+}
+
+// generateGuestToken signs a token of a guest user that middleware.RestrictGuests limits to GuestRoutes
+func (h *GuestLinkHandler) generateGuestToken(userID uuid.UUID, expiresAt time.Time) (string, error) {
+	if h.jwtSecret == "" {
+		return "", errors.New("JWT secret not configured")
+	}
+
+	claims := jwt.MapClaims{
+		"user_id": userID.String(),
+		"guest":   true,
+		"exp":     expiresAt.Unix(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+	return token.SignedString([]byte(h.jwtSecret))
+}
@@ -0,0 +1,591 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"kanban/internal/apiversion"
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxImportPartTasks limits the tasks uploaded in one part
+	MaxImportPartTasks = 1000
+	// MaxImportPartBytes limits the body of a part upload
+	MaxImportPartBytes = 4 << 20
+	// MaxImportParts limits the part numbers of an import
+	MaxImportParts = 200
+	// MaxImportTasks limits the tasks of an import across all its parts
+	MaxImportTasks = 50000
+	// MaxOpenImportsPerUser limits the imports a user uploads or runs at the same time
+	MaxOpenImportsPerUser = 3
+	// ImportLifetime is how long an import can be uploaded to before it must be committed
+	ImportLifetime = 24 * time.Hour
+	// maxReportedRowErrors limits the row errors returned for a rejected part
+	maxReportedRowErrors = 100
+)
+
+type ImportHandler struct {
+	importRepo     *repository.TaskImportRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	operationRepo  *repository.OperationRepository
+	taskRepo       repository.TaskRepositoryInterface
+	userRepo       *repository.UserRepository
+	txManager      *repository.TxManager
+	importer       *jobs.TaskImporter
+	queue          *jobs.Queue
+	limits         *limits.Service
+}
+
+func NewImportHandler(
+	importRepo *repository.TaskImportRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	operationRepo *repository.OperationRepository,
+	taskRepo repository.TaskRepositoryInterface,
+	userRepo *repository.UserRepository,
+	txManager *repository.TxManager,
+	importer *jobs.TaskImporter,
+	queue *jobs.Queue,
+	limitService *limits.Service,
+) *ImportHandler {
+	return &ImportHandler{
+		importRepo:     importRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		columnRepo:     columnRepo,
+		labelRepo:      labelRepo,
+		operationRepo:  operationRepo,
+		taskRepo:       taskRepo,
+		userRepo:       userRepo,
+		txManager:      txManager,
+		importer:       importer,
+		queue:          queue,
+		limits:         limitService,
+	}
+}
+
+// ImportPartRequest represents an uploaded part of a task import
+// @name ImportPartRequest
+type ImportPartRequest struct {
+	Tasks []jobs.ImportRow `json:"tasks" binding:"required"`
+}
+
+// ImportRowError describes why a task of an uploaded part was rejected
+// @name ImportRowError
+type ImportRowError struct {
+	// Row is the 1-based index of the task in the part, or the line of a CSV file
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// ImportPartResponse describes an uploaded part waiting to be imported
+// @name ImportPartResponse
+type ImportPartResponse struct {
+	Number int `json:"number"`
+	Tasks  int `json:"tasks"`
+}
+
+// ImportResponse represents a task import and the parts it still has to import
+// @name ImportResponse
+type ImportResponse struct {
+	ID      string               `json:"id"`
+	BoardID string               `json:"board_id"`
+	Status  string               `json:"status" enums:"uploading,committed,imported"`
+	Parts   []ImportPartResponse `json:"parts"`
+	// PendingTasks is the number of uploaded tasks not imported yet
+	PendingTasks  int     `json:"pending_tasks"`
+	ImportedTasks int     `json:"imported_tasks"`
+	OperationID   *string `json:"operation_id,omitempty"`
+	ExpiresAt     string  `json:"expires_at"`
+}
+
+func newImportResponse(taskImport *model.TaskImport, parts []repository.ImportPartSummary) ImportResponse {
+	response := ImportResponse{
+		ID:            taskImport.ID.String(),
+		BoardID:       taskImport.BoardID.String(),
+		Status:        taskImport.Status,
+		Parts:         make([]ImportPartResponse, 0, len(parts)),
+		ImportedTasks: taskImport.ImportedTasks,
+		ExpiresAt:     taskImport.ExpiresAt.Format(time.RFC3339),
+	}
+	for _, part := range parts {
+		response.Parts = append(response.Parts, ImportPartResponse{Number: part.PartNumber, Tasks: part.TaskCount})
+		response.PendingTasks += part.TaskCount
+	}
+	if taskImport.OperationID != nil {
+		operationID := taskImport.OperationID.String()
+		response.OperationID = &operationID
+	}
+	return response
+}
+
+// Create godoc
+// @Summary Start a task import
+// @Description Starts a chunked import of tasks into a board. Upload the tasks in numbered parts of up to 1000 tasks,
+// @Description then commit the import to create them in the background. Parts are validated as they are uploaded and
+// @Description can be re-uploaded until the import is committed, so an interrupted upload can be resumed. An import
+// @Description must be committed within 24 hours; a user can have up to 3 open imports.
+// @Tags Imports
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 201 {object} ImportResponse "Import started"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]string "Too many open imports"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/imports [post]
+func (h *ImportHandler) Create(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	if _, ok := h.editableBoard(c, boardID, authenticatedUserID); !ok {
+		return
+	}
+
+	count, err := h.importRepo.CountOpen(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check open imports"})
+		return
+	}
+
+	if count >= MaxOpenImportsPerUser {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": fmt.Sprintf("Too many open imports (%d), commit or delete one first", MaxOpenImportsPerUser)})
+		return
+	}
+
+	taskImport := &model.TaskImport{
+		BoardID:   boardID,
+		UserID:    authenticatedUserID,
+		Status:    model.TaskImportUploading,
+		ExpiresAt: time.Now().Add(ImportLifetime),
+	}
+	if err := h.importRepo.Create(c.Request.Context(), taskImport); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create import"})
+		return
+	}
+
+	c.Header("Location", apiversion.Path(c, "/imports/"+taskImport.ID.String()))
+	c.JSON(http.StatusCreated, newImportResponse(taskImport, nil))
+}
+
+// UploadPart godoc
+// @Summary Upload a part of a task import
+// @Description Validates and stores a part of up to 1000 tasks. Columns and labels are matched by name, ignoring
+// @Description case. A part is rejected as a whole when any of its tasks is invalid, listing the errors by row.
+// @Description Uploading a part again replaces it, so a part whose response was lost can simply be retried.
+// @Tags Imports
+// @Accept json
+// @Produce json
+// @Param id path string true "Import ID" format(uuid)
+// @Param number path int true "Part number, from 1"
+// @Param part body ImportPartRequest true "Tasks of the part"
+// @Success 200 {object} ImportResponse "Part stored"
+// @Failure 400 {object} map[string]interface{} "Invalid part or tasks"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Import not found"
+// @Failure 409 {object} map[string]string "Import already committed or expired"
+// @Failure 413 {object} map[string]string "Part too large"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /imports/{id}/parts/{number} [put]
+func (h *ImportHandler) UploadPart(c *gin.Context) {
+	authenticatedUserID, taskImport, ok := h.ownImport(c)
+	if !ok {
+		return
+	}
+
+	partNumber, err := strconv.Atoi(c.Param("number"))
+	if err != nil || partNumber < 1 || partNumber > MaxImportParts {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Part number must be between 1 and %d", MaxImportParts)})
+		return
+	}
+
+	if !h.uploading(c, taskImport) {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxImportPartBytes)
+	var req ImportPartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("Part exceeds %d bytes", MaxImportPartBytes)})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		}
+		return
+	}
+
+	if len(req.Tasks) == 0 || len(req.Tasks) > MaxImportPartTasks {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("A part must contain between 1 and %d tasks", MaxImportPartTasks)})
+		return
+	}
+
+	board, ok := h.editableBoard(c, taskImport.BoardID, authenticatedUserID)
+	if !ok {
+		return
+	}
+
+	parts, err := h.importRepo.GetPartSummaries(c.Request.Context(), taskImport.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve import parts"})
+		return
+	}
+
+	total := taskImport.ImportedTasks + len(req.Tasks)
+	for _, part := range parts {
+		if part.PartNumber != partNumber {
+			total += part.TaskCount
+		}
+	}
+	if total > MaxImportTasks {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("An import can contain at most %d tasks", MaxImportTasks)})
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	labels, err := h.labelRepo.GetAvailableForBoard(c.Request.Context(), board.ID, board.OwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+		return
+	}
+
+	if rowErrors := validateImportRows(jobs.NewImportTargets(columns, labels), req.Tasks); len(rowErrors) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Some tasks of the part are invalid", "rows": rowErrors})
+		return
+	}
+
+	rows, err := json.Marshal(req.Tasks)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode tasks"})
+		return
+	}
+
+	part := &model.TaskImportPart{
+		ImportID:   taskImport.ID,
+		PartNumber: partNumber,
+		Tasks:      rows,
+		TaskCount:  len(req.Tasks),
+		CreatedAt:  time.Now(),
+	}
+	if err := h.importRepo.SavePart(c.Request.Context(), part); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save part"})
+		return
+	}
+
+	h.respondImport(c, http.StatusOK, taskImport)
+}
+
+// GetByID godoc
+// @Summary Get a task import
+// @Description Returns an import with the parts uploaded and not imported yet. A client resuming an interrupted upload
+// @Description re-uploads the parts missing from the list; after a failed run, committing again imports the parts left.
+// @Tags Imports
+// @Produce json
+// @Param id path string true "Import ID" format(uuid)
+// @Success 200 {object} ImportResponse "Import"
+// @Failure 400 {object} map[string]string "Invalid import ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 404 {object} map[string]string "Import not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /imports/{id} [get]
+func (h *ImportHandler) GetByID(c *gin.Context) {
+	_, taskImport, ok := h.ownImport(c)
+	if !ok {
+		return
+	}
+
+	h.respondImport(c, http.StatusOK, taskImport)
+}
+
+// Commit godoc
+// @Summary Commit a task import
+// @Description Starts creating the uploaded tasks at the end of their columns as a background operation; poll the
+// @Description returned operation for progress. Parts must be numbered 1..N without gaps. Each part is imported in
+// @Description its own transaction, so if the operation fails, committing the import again imports only the parts left.
+// @Tags Imports
+// @Produce json
+// @Param id path string true "Import ID" format(uuid)
+// @Success 202 {object} OperationResponse "Import started"
+// @Failure 400 {object} map[string]string "Invalid import ID format, no parts or missing parts"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Import not found"
+// @Failure 409 {object} map[string]string "Import already committed or expired"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Failure 503 {object} map[string]string "Too many operations in progress"
+// @Security BearerAuth
+// @Router /imports/{id}/commit [post]
+func (h *ImportHandler) Commit(c *gin.Context) {
+	authenticatedUserID, taskImport, ok := h.ownImport(c)
+	if !ok {
+		return
+	}
+
+	if !h.uploading(c, taskImport) {
+		return
+	}
+
+	if _, ok := h.editableBoard(c, taskImport.BoardID, authenticatedUserID); !ok {
+		return
+	}
+
+	parts, err := h.importRepo.GetPartSummaries(c.Request.Context(), taskImport.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve import parts"})
+		return
+	}
+
+	if len(parts) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The import has no parts to import"})
+		return
+	}
+
+	// Пропуски допустимы лишь после неудачного запуска, когда часть частей уже импортирована
+	if taskImport.OperationID == nil {
+		if missing := missingImportPart(parts); missing > 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Part %d is missing", missing)})
+			return
+		}
+	}
+
+	op := &model.Operation{
+		UserID:    authenticatedUserID,
+		Kind:      model.OperationTaskImport,
+		Status:    model.OperationPending,
+		Errors:    json.RawMessage("[]"),
+		RequestID: requestid.FromContext(c.Request.Context()),
+	}
+	if err := h.operationRepo.Create(c.Request.Context(), op); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create operation"})
+		return
+	}
+
+	committed, err := h.importRepo.Commit(c.Request.Context(), taskImport.ID, op.ID, time.Now().Add(ImportLifetime))
+	if err != nil || !committed {
+		_ = h.operationRepo.Finish(c.Request.Context(), op.ID, model.OperationFailed, nil)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to commit import"})
+		} else {
+			c.JSON(http.StatusConflict, gin.H{"error": "Import is already committed"})
+		}
+		return
+	}
+
+	importID := taskImport.ID
+	err = h.queue.Enqueue(c.Request.Context(), func(ctx context.Context) {
+		h.importer.Import(ctx, op.ID, importID)
+	})
+	if err != nil {
+		_ = h.importRepo.Reopen(c.Request.Context(), importID)
+		_ = h.operationRepo.AppendError(c.Request.Context(), op.ID, "Too many operations in progress")
+		_ = h.operationRepo.Finish(c.Request.Context(), op.ID, model.OperationFailed, nil)
+		c.Header("Retry-After", "30")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Too many operations in progress, try again later"})
+		return
+	}
+
+	c.Header("Location", apiversion.Path(c, "/operations/"+op.ID.String()))
+	c.JSON(http.StatusAccepted, newOperationResponse(op))
+}
+
+// Delete godoc
+// @Summary Delete a task import
+// @Description Discards an import and its parts that were not imported. Tasks already imported are kept.
+// @Tags Imports
+// @Param id path string true "Import ID" format(uuid)
+// @Success 204 "Import deleted"
+// @Failure 400 {object} map[string]string "Invalid import ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 404 {object} map[string]string "Import not found"
+// @Failure 409 {object} map[string]string "Import is being imported"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /imports/{id} [delete]
+func (h *ImportHandler) Delete(c *gin.Context) {
+	_, taskImport, ok := h.ownImport(c)
+	if !ok {
+		return
+	}
+
+	if taskImport.Status == model.TaskImportCommitted {
+		c.JSON(http.StatusConflict, gin.H{"error": "Import is being imported, wait for its operation to finish"})
+		return
+	}
+
+	if err := h.importRepo.Delete(c.Request.Context(), taskImport.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete import"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ownImport loads the import in the id parameter, which only its creator can see.
+// On failure the response is already written.
+func (h *ImportHandler) ownImport(c *gin.Context) (uuid.UUID, *model.TaskImport, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	importID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid import ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	taskImport, err := h.importRepo.GetByID(c.Request.Context(), importID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTaskImportNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Import not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve import"})
+		}
+		return uuid.Nil, nil, false
+	}
+
+	if taskImport.UserID != authenticatedUserID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Import not found"})
+		return uuid.Nil, nil, false
+	}
+
+	return authenticatedUserID, taskImport, true
+}
+
+// editableBoard loads the board and checks that the user can create tasks on it and that it is
+// not frozen.
+// On failure the response is already written.
+func (h *ImportHandler) editableBoard(c *gin.Context, boardID, userID uuid.UUID) (*model.Board, bool) {
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return nil, false
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, userID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return nil, false
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to import tasks to this board"})
+		return nil, false
+	}
+
+	if !checkBoardWritable(c, board) {
+		return nil, false
+	}
+
+	return board, true
+}
+
+// uploading checks that parts can still be uploaded to and committed for the import.
+// On failure the response is already written.
+func (h *ImportHandler) uploading(c *gin.Context, taskImport *model.TaskImport) bool {
+	switch {
+	case taskImport.Status != model.TaskImportUploading:
+		c.JSON(http.StatusConflict, gin.H{"error": "Import is already committed"})
+		return false
+	case taskImport.Expired(time.Now()):
+		c.JSON(http.StatusConflict, gin.H{"error": "Import has expired, start a new one"})
+		return false
+	}
+	return true
+}
+
+func (h *ImportHandler) respondImport(c *gin.Context, status int, taskImport *model.TaskImport) {
+	parts, err := h.importRepo.GetPartSummaries(c.Request.Context(), taskImport.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve import parts"})
+		return
+	}
+
+	c.JSON(status, newImportResponse(taskImport, parts))
+}
+
+// validateImportRows resolves every row against the board and returns the errors of the
+// invalid ones, up to maxReportedRowErrors
+func validateImportRows(targets *jobs.ImportTargets, rows []jobs.ImportRow) []ImportRowError {
+	var rowErrors []ImportRowError
+	for i, row := range rows {
+		if _, _, err := targets.Resolve(row); err != nil {
+			rowErrors = append(rowErrors, ImportRowError{Row: i + 1, Error: err.Error()})
+			if len(rowErrors) == maxReportedRowErrors {
+				break
+			}
+		}
+	}
+	return rowErrors
+}
+
+// missingImportPart returns the first part number missing from parts sorted by number,
+// or 0 if they are numbered 1..N without gaps
+func missingImportPart(parts []repository.ImportPartSummary) int {
+	for i, part := range parts {
+		if part.PartNumber != i+1 {
+			return i + 1
+		}
+	}
+	return 0
+}
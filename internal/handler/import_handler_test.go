@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	"kanban/internal/jobs"
+	"kanban/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingImportPart(t *testing.T) {
+	parts := func(numbers ...int) []repository.ImportPartSummary {
+		summaries := make([]repository.ImportPartSummary, len(numbers))
+		for i, n := range numbers {
+			summaries[i] = repository.ImportPartSummary{PartNumber: n, TaskCount: 10}
+		}
+		return summaries
+	}
+
+	assert.Equal(t, 0, missingImportPart(parts(1, 2, 3)))
+	assert.Equal(t, 2, missingImportPart(parts(1, 3)))
+	assert.Equal(t, 1, missingImportPart(parts(2, 3)))
+}
+
+func TestParseCSVImport(t *testing.T) {
+	file := "\ufeffTitle,Labels,Assignee,Due Date\n" +
+		"Fix login,\"bug, backend\",anna@example.com,2024-06-03\n" +
+		"\n" +
+		"\"Write\ndocs\",,,\n"
+
+	rows, err := parseCSVImport(strings.NewReader(file), "To Do")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, 2, rows[0].line)
+	assert.Equal(t, jobs.ImportRow{Title: "Fix login", Column: "To Do", DueDate: "2024-06-03", Labels: []string{"bug", "backend"}}, rows[0].row)
+	assert.Equal(t, "anna@example.com", rows[0].assignee)
+	// Пустые строки пропускаются, а строка в кавычках может занимать несколько строк файла
+	assert.Equal(t, 4, rows[1].line)
+	assert.Equal(t, "Write\ndocs", rows[1].row.Title)
+}
+
+func TestParseCSVImport_InvalidFiles(t *testing.T) {
+	for name, file := range map[string]string{
+		"empty":         "",
+		"no tasks":      "title\n",
+		"no title":      "description\nSomething\n",
+		"unknown":       "title,estimate\nTask,3\n",
+		"broken quotes": "title\n\"Task\n",
+	} {
+		_, err := parseCSVImport(strings.NewReader(file), "To Do")
+		assert.Error(t, err, name)
+	}
+}
@@ -1,13 +1,17 @@
 package handler
 
 import (
+	"context"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
 	"kanban/internal/middleware"
 	"kanban/internal/model"
+	"kanban/internal/palette"
+	"kanban/internal/permission"
 	"kanban/internal/repository"
 )
 
@@ -26,34 +30,140 @@ type UpdateLabelRequest struct {
 	Color string `json:"color" binding:"required"`
 }
 
-// LabelResponse represents a label in response format
+// CreateWorkspaceLabelRequest defines the expected request body for creating a workspace label
+// @name CreateWorkspaceLabelRequest
+type CreateWorkspaceLabelRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Color string `json:"color" binding:"required"`
+}
+
+// MergeLabelRequest defines the expected request body for merging a label into another
+// @name MergeLabelRequest
+type MergeLabelRequest struct {
+	TargetID string `json:"target_id" binding:"required,uuid"`
+}
+
+// MergeLabelResponse represents the label a label was merged into
+// @name MergeLabelResponse
+type MergeLabelResponse struct {
+	Label LabelResponse `json:"label"`
+	// TasksUpdated counts the tasks that had the merged label
+	TasksUpdated int64 `json:"tasks_updated"`
+}
+
+// CopyLabelsRequest defines the expected request body for copying the labels of another board
+// @name CopyLabelsRequest
+type CopyLabelsRequest struct {
+	SourceBoardID string `json:"source_board_id" binding:"required,uuid"`
+}
+
+// CopyLabelsResponse represents the labels copied to a board
+// @name CopyLabelsResponse
+type CopyLabelsResponse struct {
+	Labels []LabelResponse `json:"labels"`
+	// Skipped counts the labels of the source board named like a label the board already has
+	Skipped int `json:"skipped"`
+}
+
+// LabelResponse represents a label in response format.
+// DarkColor is the variant of Color for dark themes, derived on the server.
 // @name LabelResponse
 type LabelResponse struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Color string `json:"color"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Color     string  `json:"color"`
+	DarkColor string  `json:"dark_color"`
+	Scope     string  `json:"scope"`
+	BoardID   *string `json:"board_id,omitempty"`
+}
+
+// newLabelResponse converts a label model into its response representation
+func newLabelResponse(label model.Label) LabelResponse {
+	response := LabelResponse{
+		ID:        label.ID.String(),
+		Name:      label.Name,
+		Color:     label.Color,
+		DarkColor: palette.DarkVariant(label.Color),
+		Scope:     label.Scope(),
+	}
+	if label.BoardID != nil {
+		boardID := label.BoardID.String()
+		response.BoardID = &boardID
+	}
+	return response
 }
 
 // LabelHandler handles label-related HTTP requests
 type LabelHandler struct {
-	labelRepo      *repository.LabelRepository
-	boardRepo      *repository.BoardRepository
-	boardShareRepo *repository.BoardShareRepository
+	labelRepo      repository.LabelRepositoryInterface
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	perms          *permission.Service
+	outboxRepo     *repository.OutboxRepository
+	txManager      *repository.TxManager
 }
 
 // NewLabelHandler creates a new LabelHandler instance
 func NewLabelHandler(
-	labelRepo *repository.LabelRepository,
-	boardRepo *repository.BoardRepository,
-	boardShareRepo *repository.BoardShareRepository,
+	labelRepo repository.LabelRepositoryInterface,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	perms *permission.Service,
+	outboxRepo *repository.OutboxRepository,
+	txManager *repository.TxManager,
 ) *LabelHandler {
 	return &LabelHandler{
 		labelRepo:      labelRepo,
 		boardRepo:      boardRepo,
 		boardShareRepo: boardShareRepo,
+		perms:          perms,
+		outboxRepo:     outboxRepo,
+		txManager:      txManager,
 	}
 }
 
+// create adds the label with its event in the outbox
+func (h *LabelHandler) create(ctx context.Context, label *model.Label, userID uuid.UUID) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := h.labelRepo.Create(ctx, label); err != nil {
+			return err
+		}
+		return h.outboxRepo.Add(ctx, model.EventLabelCreated, model.NewLabelPayload(label, userID))
+	})
+}
+
+// checkLabelAccess reports whether the user may access the label with the given role.
+// Board labels follow the board's sharing rules, workspace labels belong to their owner.
+func (h *LabelHandler) checkLabelAccess(c *gin.Context, label *model.Label, userID uuid.UUID, requiredRole string) (bool, error) {
+	if label.OwnerID != nil {
+		return *label.OwnerID == userID, nil
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), *label.BoardID)
+	if err != nil {
+		return false, err
+	}
+
+	if board.OwnerID == userID {
+		return true, nil
+	}
+
+	return h.boardShareRepo.CheckAccess(c.Request.Context(), board.ID, userID, requiredRole)
+}
+
+// workspaceOwnerOf returns the owner of the workspace the label lives in
+func (h *LabelHandler) workspaceOwnerOf(c *gin.Context, label *model.Label) (uuid.UUID, error) {
+	if label.OwnerID != nil {
+		return *label.OwnerID, nil
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), *label.BoardID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return board.OwnerID, nil
+}
+
 // Create creates a new label
 // @Summary Create label
 // @Description Create a new label for a board
@@ -66,6 +176,8 @@ func NewLabelHandler(
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 404 {object} object "Board not found"
+// @Failure 409 {object} object "Workspace label with the same name exists"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Internal server error"
 // @Security BearerAuth
 // @Router /labels [post]
@@ -115,22 +227,33 @@ func (h *LabelHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	existing, err := h.labelRepo.FindWorkspaceLabelByName(c.Request.Context(), board.OwnerID, req.Name)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check workspace labels"})
+		return
+	}
+
+	if existing != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "A workspace label with this name already exists"})
+		return
+	}
+
 	label := &model.Label{
-		BoardID: boardID,
+		BoardID: &boardID,
 		Name:    req.Name,
 		Color:   req.Color,
 	}
 
-	if err := h.labelRepo.Create(c.Request.Context(), label); err != nil {
+	if err := h.create(c.Request.Context(), label, authenticatedUserID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create label"})
 		return
 	}
 
-	c.JSON(http.StatusCreated, LabelResponse{
-		ID:    label.ID.String(),
-		Name:  label.Name,
-		Color: label.Color,
-	})
+	c.JSON(http.StatusCreated, newLabelResponse(*label))
 }
 
 // GetByID retrieves a label by its ID
@@ -177,33 +300,24 @@ func (h *LabelHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), label.BoardID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
-		return
-	}
-
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), label.BoardID, authenticatedUserID, model.RoleViewer)
+	hasAccess, err := h.checkLabelAccess(c, label, authenticatedUserID, model.RoleViewer)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
+	if !hasAccess {
 		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this label"})
 		return
 	}
 
-	c.JSON(http.StatusOK, LabelResponse{
-		ID:    label.ID.String(),
-		Name:  label.Name,
-		Color: label.Color,
-	})
+	c.JSON(http.StatusOK, newLabelResponse(*label))
 }
 
 // GetByBoardID retrieves all labels for a specific board
 // @Summary Get board labels
-// @Description Get all labels for a specific board
+// @Description Get all labels usable on a board, including workspace labels of the board owner.
+// @Description Board labels sharing a name with a workspace label are hidden.
 // @Tags Labels
 // @Produce json
 // @Param id path string true "Board ID"
@@ -256,7 +370,7 @@ func (h *LabelHandler) GetByBoardID(c *gin.Context) {
 		return
 	}
 
-	labels, err := h.labelRepo.GetByBoardID(c.Request.Context(), boardID)
+	labels, err := h.labelRepo.GetAvailableForBoard(c.Request.Context(), boardID, board.OwnerID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
 		return
@@ -264,16 +378,137 @@ func (h *LabelHandler) GetByBoardID(c *gin.Context) {
 
 	response := make([]LabelResponse, len(labels))
 	for i, label := range labels {
-		response[i] = LabelResponse{
-			ID:    label.ID.String(),
-			Name:  label.Name,
-			Color: label.Color,
-		}
+		response[i] = newLabelResponse(label)
 	}
 
 	c.JSON(http.StatusOK, response)
 }
 
+// CopyFromBoard copies the labels of another board
+// @Summary Copy labels from another board
+// @Description Copy the labels of a board the user owns to the board. Labels named like a label the board already
+// @Description has, ignoring case, are skipped; so are the source board's workspace labels, which both boards share
+// @Description when they have the same owner.
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID"
+// @Param input body CopyLabelsRequest true "Board to copy the labels of"
+// @Success 201 {object} CopyLabelsResponse
+// @Failure 400 {object} object "Invalid request"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 403 {object} object "Insufficient permissions"
+// @Failure 404 {object} object "Board not found"
+// @Failure 423 {object} object "Board is frozen"
+// @Failure 500 {object} object "Internal server error"
+// @Security BearerAuth
+// @Router /boards/{id}/labels/copy [post]
+func (h *LabelHandler) CopyFromBoard(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	var req CopyLabelsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	sourceID := uuid.MustParse(req.SourceBoardID)
+	if sourceID == boardID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot copy labels of a board to itself"})
+		return
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to create labels for this board"})
+		return
+	}
+
+	source, err := h.boardRepo.GetByID(c.Request.Context(), sourceID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Source board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	if source.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You can only copy labels from boards you own"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	labels, err := h.labelRepo.GetByBoardID(c.Request.Context(), sourceID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+		return
+	}
+
+	var created []model.Label
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		if created, err = h.labelRepo.AddMissingToBoard(ctx, boardID, board.OwnerID, labels); err != nil {
+			return err
+		}
+		for i := range created {
+			if err := h.outboxRepo.Add(ctx, model.EventLabelCreated, model.NewLabelPayload(&created[i], authenticatedUserID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to copy labels"})
+		return
+	}
+
+	response := CopyLabelsResponse{
+		Labels:  make([]LabelResponse, len(created)),
+		Skipped: len(labels) - len(created),
+	}
+	for i, label := range created {
+		response.Labels[i] = newLabelResponse(label)
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
 // Update updates an existing label
 // @Summary Update label
 // @Description Update an existing label
@@ -287,6 +522,8 @@ func (h *LabelHandler) GetByBoardID(c *gin.Context) {
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 404 {object} object "Label not found"
+// @Failure 409 {object} object "Workspace label with the same name exists"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Internal server error"
 // @Security BearerAuth
 // @Router /labels/{id} [put]
@@ -320,20 +557,18 @@ func (h *LabelHandler) Update(c *gin.Context) {
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), label.BoardID)
+	hasAccess, err := h.checkLabelAccess(c, label, authenticatedUserID, model.RoleEditor)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), label.BoardID, authenticatedUserID, model.RoleEditor)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to update this label"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to update this label"})
+	if label.BoardID != nil && !checkBoardIDWritable(c, h.perms, *label.BoardID) {
 		return
 	}
 
@@ -343,19 +578,45 @@ func (h *LabelHandler) Update(c *gin.Context) {
 		return
 	}
 
+	if !strings.EqualFold(label.Name, req.Name) {
+		ownerID, err := h.workspaceOwnerOf(c, label)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+			return
+		}
+
+		existing, err := h.labelRepo.FindWorkspaceLabelByName(c.Request.Context(), ownerID, req.Name)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check workspace labels"})
+			return
+		}
+
+		if existing != nil && existing.ID != label.ID {
+			c.JSON(http.StatusConflict, gin.H{"error": "A workspace label with this name already exists"})
+			return
+		}
+	}
+
+	previousName := label.Name
 	label.Name = req.Name
 	label.Color = req.Color
 
-	if err := h.labelRepo.Update(c.Request.Context(), label); err != nil {
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.labelRepo.Update(ctx, label); err != nil {
+			return err
+		}
+		payload := model.NewLabelPayload(label, authenticatedUserID)
+		if previousName != label.Name {
+			payload.PreviousName = previousName
+		}
+		return h.outboxRepo.Add(ctx, model.EventLabelUpdated, payload)
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update label"})
 		return
 	}
 
-	c.JSON(http.StatusOK, LabelResponse{
-		ID:    label.ID.String(),
-		Name:  label.Name,
-		Color: label.Color,
-	})
+	c.JSON(http.StatusOK, newLabelResponse(*label))
 }
 
 // Delete removes a label
@@ -369,6 +630,7 @@ func (h *LabelHandler) Update(c *gin.Context) {
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 404 {object} object "Label not found"
+// @Failure 423 {object} object "Board is frozen"
 // @Failure 500 {object} object "Internal server error"
 // @Security BearerAuth
 // @Router /labels/{id} [delete]
@@ -402,24 +664,28 @@ func (h *LabelHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), label.BoardID)
+	hasAccess, err := h.checkLabelAccess(c, label, authenticatedUserID, model.RoleEditor)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), label.BoardID, authenticatedUserID, model.RoleEditor)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to delete this label"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to delete this label"})
+	if label.BoardID != nil && !checkBoardIDWritable(c, h.perms, *label.BoardID) {
 		return
 	}
 
-	if err := h.labelRepo.Delete(c.Request.Context(), labelID); err != nil {
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.labelRepo.Delete(ctx, labelID); err != nil {
+			return err
+		}
+		return h.outboxRepo.Add(ctx, model.EventLabelDeleted, model.NewLabelPayload(label, authenticatedUserID))
+	})
+	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete label"})
 		return
 	}
@@ -427,14 +693,150 @@ func (h *LabelHandler) Delete(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Label deleted successfully"})
 }
 
+// Merge merges a label into another one
+// @Summary Merge labels
+// @Description Moves a label to the target label on every task and task template that has it, then deletes it, in one
+// @Description transaction. Use it to clean up near-duplicates such as "bug", "Bug" and "bugs". The target must be
+// @Description usable wherever the label is: a board label merges into a label of the same board or a workspace label
+// @Description of the board owner, a workspace label into another workspace label of the same owner.
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Param id path string true "ID of the label to merge and delete"
+// @Param input body MergeLabelRequest true "Label to merge into"
+// @Success 200 {object} MergeLabelResponse
+// @Failure 400 {object} object "Invalid label ID or target"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 403 {object} object "Insufficient permissions"
+// @Failure 404 {object} object "Label not found"
+// @Failure 423 {object} object "Board is frozen"
+// @Failure 500 {object} object "Internal server error"
+// @Security BearerAuth
+// @Router /labels/{id}/merge [post]
+func (h *LabelHandler) Merge(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	labelID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid label ID format"})
+		return
+	}
+
+	var req MergeLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	targetID := uuid.MustParse(req.TargetID)
+	if targetID == labelID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot merge a label into itself"})
+		return
+	}
+
+	var labels [2]*model.Label
+	for i, id := range []uuid.UUID{labelID, targetID} {
+		label, err := h.labelRepo.GetByID(c.Request.Context(), id)
+		if err != nil {
+			if err == repository.ErrLabelNotFound {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Label not found"})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve label"})
+			}
+			return
+		}
+
+		hasAccess, err := h.checkLabelAccess(c, label, authenticatedUserID, model.RoleEditor)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+			return
+		}
+
+		if !hasAccess {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to merge these labels"})
+			return
+		}
+		labels[i] = label
+	}
+	source, target := labels[0], labels[1]
+
+	compatible, err := h.mergeable(c, source, target)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !compatible {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Target label is not usable everywhere the label is"})
+		return
+	}
+
+	if source.BoardID != nil && !checkBoardIDWritable(c, h.perms, *source.BoardID) {
+		return
+	}
+
+	var tasks int64
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		if tasks, err = h.labelRepo.Merge(ctx, source.ID, target.ID); err != nil {
+			return err
+		}
+		payload := model.NewLabelPayload(source, authenticatedUserID)
+		payload.MergedInto = target.ID.String()
+		return h.outboxRepo.Add(ctx, model.EventLabelDeleted, payload)
+	})
+	if err != nil {
+		if err == repository.ErrLabelNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Label not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to merge labels"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, MergeLabelResponse{Label: newLabelResponse(*target), TasksUpdated: tasks})
+}
+
+// mergeable reports whether target is usable on every task source can be on: board labels of
+// the same board or workspace labels of the board owner for a board label, workspace labels of
+// the same owner for a workspace label
+func (h *LabelHandler) mergeable(c *gin.Context, source, target *model.Label) (bool, error) {
+	if source.OwnerID != nil {
+		return target.OwnerID != nil && *target.OwnerID == *source.OwnerID, nil
+	}
+
+	if target.BoardID != nil {
+		return *target.BoardID == *source.BoardID, nil
+	}
+
+	owner, err := h.workspaceOwnerOf(c, source)
+	if err != nil {
+		return false, err
+	}
+	return *target.OwnerID == owner, nil
+}
+
 // GetTasksWithLabel retrieves all tasks that have a specific label
 // @Summary Get tasks with label
 // @Description Get all tasks that have a specific label
 // @Tags Labels
 // @Produce json
 // @Param id path string true "Label ID"
-// @Success 200 {array} object{id=string,title=string,description=string,column_id=string}
-// @Failure 400 {object} object "Invalid label ID"
+// @Param sort query string false "Sort order, priority sorts most important first" Enums(position, priority)
+// @Param priority query string false "Comma-separated priorities to include, e.g. high,urgent"
+// @Param swimlane_id query string false "Only tasks of this swimlane, or none for tasks without a swimlane"
+// @Success 200 {array} object{id=string,title=string,description=string,column_id=string,priority=string}
+// @Failure 400 {object} object "Invalid label ID, sort, priority or swimlane"
 // @Failure 401 {object} object "Not authenticated"
 // @Failure 403 {object} object "Insufficient permissions"
 // @Failure 404 {object} object "Label not found"
@@ -471,24 +873,24 @@ func (h *LabelHandler) GetTasksWithLabel(c *gin.Context) {
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), label.BoardID)
+	hasAccess, err := h.checkLabelAccess(c, label, authenticatedUserID, model.RoleViewer)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), label.BoardID, authenticatedUserID, model.RoleViewer)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view tasks for this label"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view tasks for this label"})
+	opts, err := parseTaskListOptions(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	tasks, err := h.labelRepo.GetTasksWithLabel(c.Request.Context(), labelID)
+	tasks, err := h.labelRepo.GetTasksWithLabel(c.Request.Context(), labelID, opts)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
 		return
@@ -500,6 +902,7 @@ func (h *LabelHandler) GetTasksWithLabel(c *gin.Context) {
 		Title       string `json:"title"`
 		Description string `json:"description"`
 		ColumnID    string `json:"column_id"`
+		Priority    string `json:"priority"`
 	}
 
 	response := make([]TaskResponse, len(tasks))
@@ -509,8 +912,104 @@ func (h *LabelHandler) GetTasksWithLabel(c *gin.Context) {
 			Title:       task.Title,
 			Description: task.Description,
 			ColumnID:    task.ColumnID.String(),
+			Priority:    task.Priority,
 		}
 	}
 
 	c.JSON(http.StatusOK, response)
-}
\ No newline at end of file
+}
+
+// CreateWorkspaceLabel creates a label shared by all boards of the user
+// @Summary Create workspace label
+// @Description Create a label available on every board owned by the authenticated user
+// @Tags Labels
+// @Accept json
+// @Produce json
+// @Param input body CreateWorkspaceLabelRequest true "Label data"
+// @Success 201 {object} LabelResponse
+// @Failure 400 {object} object "Invalid request"
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 409 {object} object "Workspace label with the same name exists"
+// @Failure 500 {object} object "Internal server error"
+// @Security BearerAuth
+// @Router /workspace/labels [post]
+func (h *LabelHandler) CreateWorkspaceLabel(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req CreateWorkspaceLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request format"})
+		return
+	}
+
+	existing, err := h.labelRepo.FindWorkspaceLabelByName(c.Request.Context(), authenticatedUserID, req.Name)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check workspace labels"})
+		return
+	}
+
+	if existing != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "A workspace label with this name already exists"})
+		return
+	}
+
+	label := &model.Label{
+		OwnerID: &authenticatedUserID,
+		Name:    req.Name,
+		Color:   req.Color,
+	}
+
+	if err := h.create(c.Request.Context(), label, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create label"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newLabelResponse(*label))
+}
+
+// GetWorkspaceLabels retrieves the workspace labels of the user
+// @Summary Get workspace labels
+// @Description Get all workspace labels owned by the authenticated user
+// @Tags Labels
+// @Produce json
+// @Success 200 {array} LabelResponse
+// @Failure 401 {object} object "Not authenticated"
+// @Failure 500 {object} object "Internal server error"
+// @Security BearerAuth
+// @Router /workspace/labels [get]
+func (h *LabelHandler) GetWorkspaceLabels(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	labels, err := h.labelRepo.GetByOwnerID(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+		return
+	}
+
+	response := make([]LabelResponse, len(labels))
+	for i, label := range labels {
+		response[i] = newLabelResponse(label)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository/mocks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLabelHandler_GetByBoardID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	owner := uuid.New()
+	board := &model.Board{ID: uuid.New(), OwnerID: owner}
+
+	newHandler := func(t *testing.T) (*LabelHandler, *mocks.MockLabelRepositoryInterface, *mocks.MockBoardShareRepositoryInterface) {
+		ctrl := gomock.NewController(t)
+		labelRepo := mocks.NewMockLabelRepositoryInterface(ctrl)
+		boardRepo := mocks.NewMockBoardRepositoryInterface(ctrl)
+		boardShareRepo := mocks.NewMockBoardShareRepositoryInterface(ctrl)
+		boardRepo.EXPECT().GetByID(gomock.Any(), board.ID).Return(board, nil)
+		return NewLabelHandler(labelRepo, boardRepo, boardShareRepo, nil, nil, nil), labelRepo, boardShareRepo
+	}
+	get := func(h *LabelHandler, userID uuid.UUID) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/boards/"+board.ID.String()+"/labels", nil)
+		c.Params = gin.Params{{Key: "id", Value: board.ID.String()}}
+		c.Set(middleware.UserIDKey, userID)
+		h.GetByBoardID(c)
+		return w
+	}
+
+	t.Run("owner", func(t *testing.T) {
+		h, labelRepo, boardShareRepo := newHandler(t)
+		boardShareRepo.EXPECT().CheckAccess(gomock.Any(), board.ID, owner, model.RoleViewer).Return(false, nil)
+		labelRepo.EXPECT().GetAvailableForBoard(gomock.Any(), board.ID, owner).
+			Return([]model.Label{{ID: uuid.New(), Name: "bug", Color: "#ff0000", BoardID: &board.ID}}, nil)
+
+		w := get(h, owner)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var labels []LabelResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &labels))
+		require.Len(t, labels, 1)
+		assert.Equal(t, "bug", labels[0].Name)
+	})
+
+	t.Run("stranger", func(t *testing.T) {
+		// Без доступа к доске метки не запрашиваются вовсе
+		h, _, boardShareRepo := newHandler(t)
+		stranger := uuid.New()
+		boardShareRepo.EXPECT().CheckAccess(gomock.Any(), board.ID, stranger, model.RoleViewer).Return(false, nil)
+
+		w := get(h, stranger)
+		assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
+
+func TestLabelHandler_Merge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	owner := uuid.New()
+	board := &model.Board{ID: uuid.New(), OwnerID: owner}
+	other := &model.Board{ID: uuid.New(), OwnerID: owner}
+	bug := &model.Label{ID: uuid.New(), BoardID: &board.ID, Name: "bug"}
+	bugs := &model.Label{ID: uuid.New(), BoardID: &other.ID, Name: "bugs"}
+	workspace := &model.Label{ID: uuid.New(), OwnerID: &owner, Name: "Bug"}
+
+	newHandler := func(t *testing.T) (*LabelHandler, *mocks.MockLabelRepositoryInterface, *mocks.MockBoardRepositoryInterface) {
+		ctrl := gomock.NewController(t)
+		labelRepo := mocks.NewMockLabelRepositoryInterface(ctrl)
+		boardRepo := mocks.NewMockBoardRepositoryInterface(ctrl)
+		boardShareRepo := mocks.NewMockBoardShareRepositoryInterface(ctrl)
+		for _, label := range []*model.Label{bug, bugs, workspace} {
+			labelRepo.EXPECT().GetByID(gomock.Any(), label.ID).Return(label, nil).AnyTimes()
+		}
+		boardRepo.EXPECT().GetByID(gomock.Any(), board.ID).Return(board, nil).AnyTimes()
+		boardRepo.EXPECT().GetByID(gomock.Any(), other.ID).Return(other, nil).AnyTimes()
+		return NewLabelHandler(labelRepo, boardRepo, boardShareRepo, nil, nil, nil), labelRepo, boardRepo
+	}
+	merge := func(h *LabelHandler, userID uuid.UUID, source, target *model.Label) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body := `{"target_id":"` + target.ID.String() + `"}`
+		c.Request = httptest.NewRequest(http.MethodPost, "/labels/"+source.ID.String()+"/merge", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: source.ID.String()}}
+		c.Set(middleware.UserIDKey, userID)
+		h.Merge(c)
+		return w
+	}
+
+	t.Run("into itself", func(t *testing.T) {
+		h, _, _ := newHandler(t)
+		w := merge(h, owner, bug, bug)
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("label of another board", func(t *testing.T) {
+		// Метка другой доски недоступна задачам исходной доски
+		h, labelRepo, _ := newHandler(t)
+		labelRepo.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		w := merge(h, owner, bug, bugs)
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("workspace label into a board label", func(t *testing.T) {
+		h, _, _ := newHandler(t)
+		w := merge(h, owner, workspace, bug)
+		assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	})
+
+	t.Run("workspace labels of someone else", func(t *testing.T) {
+		h, labelRepo, _ := newHandler(t)
+		labelRepo.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		duplicate := &model.Label{ID: uuid.New(), OwnerID: &owner, Name: "bugs"}
+		labelRepo.EXPECT().GetByID(gomock.Any(), duplicate.ID).Return(duplicate, nil).AnyTimes()
+		w := merge(h, uuid.New(), duplicate, workspace)
+		assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+	})
+}
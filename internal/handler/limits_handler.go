@@ -0,0 +1,241 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LimitsHandler struct {
+	limits     *limits.Service
+	boardRepo  repository.BoardRepositoryInterface
+	columnRepo repository.ColumnRepositoryInterface
+	taskRepo   repository.TaskRepositoryInterface
+	perms      *permission.Service
+}
+
+func NewLimitsHandler(
+	limitService *limits.Service,
+	boardRepo repository.BoardRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	perms *permission.Service,
+) *LimitsHandler {
+	return &LimitsHandler{
+		limits:     limitService,
+		boardRepo:  boardRepo,
+		columnRepo: columnRepo,
+		taskRepo:   taskRepo,
+		perms:      perms,
+	}
+}
+
+// LimitsResponse represents the quotas of the authenticated user and how much of them is used
+// @name LimitsResponse
+type LimitsResponse struct {
+	// Limits of the user; 0 means unlimited
+	Limits      limits.Limits `json:"limits"`
+	OwnedBoards int64         `json:"owned_boards"`
+	// Board is the usage of the board requested with board_id
+	Board *BoardLimitsResponse `json:"board,omitempty"`
+}
+
+// BoardLimitsResponse represents the usage of a board. Columns and tasks count against the
+// limits of the board owner, which may differ from the caller's.
+// @name BoardLimitsResponse
+type BoardLimitsResponse struct {
+	BoardID string        `json:"board_id"`
+	Limits  limits.Limits `json:"limits"`
+	Columns int64         `json:"columns"`
+	// TasksPerColumn maps column IDs to the number of their tasks
+	TasksPerColumn map[string]int64 `json:"tasks_per_column"`
+}
+
+// Get godoc
+// @Summary Get limits
+// @Description Returns the limits on boards per user, columns per board and tasks per column that apply to the
+// @Description authenticated user, with the number of boards the user owns. With board_id, the column and task
+// @Description counts of that board are returned against its owner's limits.
+// @Tags Limits
+// @Produce json
+// @Param board_id query string false "Board ID" format(uuid)
+// @Success 200 {object} LimitsResponse "Limits and usage"
+// @Failure 400 {object} map[string]string "Invalid board ID"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "No access to the board"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /limits [get]
+func (h *LimitsHandler) Get(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	userLimits, err := h.limits.ForUser(ctx, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return
+	}
+
+	owned, err := h.boardRepo.CountOwned(ctx, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board count"})
+		return
+	}
+
+	response := LimitsResponse{Limits: userLimits, OwnedBoards: owned}
+
+	if param := c.Query("board_id"); param != "" {
+		boardID, err := uuid.Parse(param)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID"})
+			return
+		}
+
+		board, err := h.perms.GetBoard(ctx, boardID)
+		if err != nil {
+			if err == repository.ErrBoardNotFound {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+			}
+			return
+		}
+
+		hasAccess, err := h.perms.CheckAccess(ctx, boardID, authenticatedUserID, model.RoleViewer)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+			return
+		}
+		if !hasAccess {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+			return
+		}
+
+		ownerLimits := userLimits
+		if board.OwnerID != authenticatedUserID {
+			if ownerLimits, err = h.limits.ForUser(ctx, board.OwnerID); err != nil {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+				return
+			}
+		}
+
+		columns, err := h.columnRepo.GetByBoardID(ctx, boardID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+			return
+		}
+
+		counts, err := h.taskRepo.CountBySwimlane(ctx, boardID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count tasks"})
+			return
+		}
+
+		usage := &BoardLimitsResponse{
+			BoardID:        boardID.String(),
+			Limits:         ownerLimits,
+			Columns:        int64(len(columns)),
+			TasksPerColumn: make(map[string]int64, len(columns)),
+		}
+		for _, column := range columns {
+			usage.TasksPerColumn[column.ID.String()] = 0
+		}
+		for _, count := range counts {
+			usage.TasksPerColumn[count.ColumnID.String()] += int64(count.Count)
+		}
+		response.Board = usage
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// checkBoardLimit answers the request and returns false when the user already owns as many
+// boards as their limit allows
+func checkBoardLimit(c *gin.Context, limitService *limits.Service, boardRepo repository.BoardRepositoryInterface, userID uuid.UUID) bool {
+	userLimits, err := limitService.ForUser(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return false
+	}
+
+	count, err := boardRepo.CountOwned(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board count"})
+		return false
+	}
+
+	if limits.Reached(userLimits.Boards, count) {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("Maximum number of boards reached (%d)", userLimits.Boards)})
+		return false
+	}
+	return true
+}
+
+// checkColumnLimit answers the request and returns false when adding columns to the board would
+// exceed its owner's limit of columns per board
+func checkColumnLimit(c *gin.Context, limitService *limits.Service, columnRepo repository.ColumnRepositoryInterface, board *model.Board, adding int) bool {
+	ownerLimits, err := limitService.ForUser(c.Request.Context(), board.OwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return false
+	}
+	if ownerLimits.ColumnsPerBoard == 0 {
+		return true
+	}
+
+	count, err := columnRepo.CountByBoard(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check column count"})
+		return false
+	}
+
+	if limits.Exceeds(ownerLimits.ColumnsPerBoard, count+int64(adding)) {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("Maximum number of columns per board reached (%d)", ownerLimits.ColumnsPerBoard)})
+		return false
+	}
+	return true
+}
+
+// checkTaskLimit answers the request and returns false when the column already holds as many
+// tasks as the limit of its board's owner allows
+func checkTaskLimit(c *gin.Context, limitService *limits.Service, taskRepo repository.TaskRepositoryInterface, board *model.Board, columnID uuid.UUID) bool {
+	ownerLimits, err := limitService.ForUser(c.Request.Context(), board.OwnerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve limits"})
+		return false
+	}
+	if ownerLimits.TasksPerColumn == 0 {
+		return true
+	}
+
+	count, err := taskRepo.CountByColumn(c.Request.Context(), columnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check task count"})
+		return false
+	}
+
+	if limits.Reached(ownerLimits.TasksPerColumn, count) {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("Maximum number of tasks per column reached (%d)", ownerLimits.TasksPerColumn)})
+		return false
+	}
+	return true
+}
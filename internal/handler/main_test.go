@@ -0,0 +1,11 @@
+package handler_test
+
+import (
+	"testing"
+
+	"kanban/internal/testutil"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/notification"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxMentionsPerPage limits the number of mentions returned at once
+const MaxMentionsPerPage = 100
+
+type MentionHandler struct {
+	mentionRepo  *repository.TaskMentionRepository
+	settingsRepo *repository.NotificationSettingRepository
+}
+
+func NewMentionHandler(mentionRepo *repository.TaskMentionRepository, settingsRepo *repository.NotificationSettingRepository) *MentionHandler {
+	return &MentionHandler{mentionRepo: mentionRepo, settingsRepo: settingsRepo}
+}
+
+// MentionNotificationResponse represents a mention of the authenticated user by someone else
+// @name MentionNotificationResponse
+type MentionNotificationResponse struct {
+	ID          string  `json:"id"`
+	Token       string  `json:"token"`
+	Origin      string  `json:"origin"`
+	TaskID      string  `json:"task_id"`
+	TaskKey     string  `json:"task_key"`
+	TaskTitle   string  `json:"task_title"`
+	BoardID     string  `json:"board_id"`
+	BoardTitle  string  `json:"board_title"`
+	MentionedBy string  `json:"mentioned_by"`
+	AuthorName  string  `json:"author_name"`
+	CreatedAt   string  `json:"created_at"`
+	ReadAt      *string `json:"read_at,omitempty"`
+}
+
+// GetMine godoc
+// @Summary List my mentions
+// @Description Lists mentions of the authenticated user by other board members, newest first.
+// @Description Mentions on boards the user can no longer access, has muted or gets no in-app mention
+// @Description notifications from are left out.
+// @Tags Mentions
+// @Produce json
+// @Param unread query bool false "Only mentions that have not been read"
+// @Param limit query int false "Maximum number of mentions (default and max 100)"
+// @Success 200 {array} MentionNotificationResponse "Mentions"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/mentions [get]
+func (h *MentionHandler) GetMine(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(MaxMentionsPerPage)))
+	if err != nil || limit < 1 || limit > MaxMentionsPerPage {
+		limit = MaxMentionsPerPage
+	}
+
+	settings, mutes, err := h.settingsRepo.GetForUser(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notification settings"})
+		return
+	}
+
+	// Упоминания в приложении доставляются только с досок, где пользователь их не отключил
+	preferences := notification.NewPreferences(settings, mutes)
+	allBoards, exceptions := preferences.Exceptions(notification.EventMention, notification.ChannelInApp)
+	boards := repository.BoardFilter{AllBoards: allBoards, Boards: exceptions}
+
+	mentions, err := h.mentionRepo.GetForUser(c.Request.Context(), authenticatedUserID, boards, c.Query("unread") == "true", limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve mentions"})
+		return
+	}
+
+	response := make([]MentionNotificationResponse, len(mentions))
+	for i, m := range mentions {
+		board := m.Task.Column.Board
+		response[i] = MentionNotificationResponse{
+			ID:          m.ID.String(),
+			Token:       m.Token,
+			Origin:      m.Origin,
+			TaskID:      m.TaskID.String(),
+			TaskKey:     taskKey(&board, &m.Task),
+			TaskTitle:   m.Task.Title,
+			BoardID:     board.ID.String(),
+			BoardTitle:  board.Title,
+			MentionedBy: m.MentionedBy.String(),
+			AuthorName:  m.Author.Name,
+			CreatedAt:   m.CreatedAt.Format(time.RFC3339),
+		}
+
+		if m.ReadAt != nil {
+			readAt := m.ReadAt.Format(time.RFC3339)
+			response[i].ReadAt = &readAt
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkRead godoc
+// @Summary Mark a mention as read
+// @Description Marks a mention of the authenticated user as read
+// @Tags Mentions
+// @Produce json
+// @Param id path string true "Mention ID" format(uuid)
+// @Success 200 {object} map[string]string "Mention marked as read"
+// @Failure 400 {object} map[string]string "Invalid mention ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 404 {object} map[string]string "Mention not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/mentions/{id}/read [post]
+func (h *MentionHandler) MarkRead(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	mentionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid mention ID format"})
+		return
+	}
+
+	found, err := h.mentionRepo.MarkRead(c.Request.Context(), mentionID, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to mark mention as read"})
+		return
+	}
+
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Mention not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Mention marked as read"})
+}
@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/notification"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxNotificationBoards limits the number of boards with their own notification settings
+const MaxNotificationBoards = 200
+
+type NotificationSettingsHandler struct {
+	settingsRepo *repository.NotificationSettingRepository
+	boardRepo    repository.BoardRepositoryInterface
+}
+
+func NewNotificationSettingsHandler(
+	settingsRepo *repository.NotificationSettingRepository,
+	boardRepo repository.BoardRepositoryInterface,
+) *NotificationSettingsHandler {
+	return &NotificationSettingsHandler{
+		settingsRepo: settingsRepo,
+		boardRepo:    boardRepo,
+	}
+}
+
+// NotificationEventSettings chooses the channels that deliver the notifications of an event
+// @name NotificationEventSettings
+type NotificationEventSettings struct {
+	Event string `json:"event" binding:"required" example:"mention"`
+	InApp bool   `json:"in_app"`
+	Email bool   `json:"email"`
+}
+
+// BoardNotificationSettings overrides the notification settings on a board. A muted board
+// delivers no notifications; events without settings follow the defaults.
+// @name BoardNotificationSettings
+type BoardNotificationSettings struct {
+	BoardID string                      `json:"board_id" binding:"required" format:"uuid"`
+	Muted   bool                        `json:"muted"`
+	Events  []NotificationEventSettings `json:"events" binding:"dive"`
+}
+
+// NotificationSettingsRequest replaces all notification settings of the user
+// @name NotificationSettingsRequest
+type NotificationSettingsRequest struct {
+	// Events are the defaults for all boards; events left out are delivered in-app only
+	Events []NotificationEventSettings `json:"events" binding:"dive"`
+	Boards []BoardNotificationSettings `json:"boards" binding:"dive"`
+	// Digest is how often an email digest of overdue tasks, new assignments and mentions is sent,
+	// off when empty. Muted boards are left out of digests.
+	Digest string `json:"digest" enums:"off,daily,weekly" example:"daily"`
+}
+
+// NotificationSettingsResponse represents the notification settings of the user
+// @name NotificationSettingsResponse
+type NotificationSettingsResponse struct {
+	// Events are the defaults for all boards, one per event type
+	Events []NotificationEventSettings `json:"events"`
+	// Boards are the boards with settings of their own or muted
+	Boards []BoardNotificationSettings `json:"boards"`
+	Digest string                      `json:"digest" enums:"off,daily,weekly"`
+}
+
+// GetSettings godoc
+// @Summary Get my notification settings
+// @Description Returns the channels (in_app, email) that deliver each event type to the authenticated user by default and
+// @Description on the boards with settings of their own, the muted boards and the frequency of the email digest.
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} NotificationSettingsResponse "Notification settings"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/notification-settings [get]
+func (h *NotificationSettingsHandler) GetSettings(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	h.respondSettings(c, authenticatedUserID)
+}
+
+// UpdateSettings godoc
+// @Summary Update my notification settings
+// @Description Replaces the notification settings of the authenticated user: the channels of each event type by default,
+// @Description per board overrides and mutes, and the email digest. Daily digests are sent every day and weekly digests
+// @Description every Monday, at the hour configured on the server. Boards must be accessible to the user.
+// @Tags Notifications
+// @Accept json
+// @Produce json
+// @Param settings body NotificationSettingsRequest true "Notification settings"
+// @Success 200 {object} NotificationSettingsResponse "Updated notification settings"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Board not accessible"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/notification-settings [put]
+func (h *NotificationSettingsHandler) UpdateSettings(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req NotificationSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, mutes, boardIDs, err := notificationSettingsFromRequest(authenticatedUserID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	digest := req.Digest
+	if digest == "" {
+		digest = notification.DigestOff
+	}
+	if !slices.Contains(notification.DigestFrequencies, digest) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("unknown digest %q, expected one of %v", digest, notification.DigestFrequencies)})
+		return
+	}
+
+	accessible, err := h.boardRepo.GetAccessibleIDs(c.Request.Context(), authenticatedUserID, boardIDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check board access"})
+		return
+	}
+	if len(accessible) != len(boardIDs) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to one of the boards"})
+		return
+	}
+
+	if err := h.settingsRepo.Replace(c.Request.Context(), authenticatedUserID, settings, mutes, digest); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save notification settings"})
+		return
+	}
+
+	h.respondSettings(c, authenticatedUserID)
+}
+
+func (h *NotificationSettingsHandler) respondSettings(c *gin.Context, userID uuid.UUID) {
+	settings, mutes, err := h.settingsRepo.GetForUser(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notification settings"})
+		return
+	}
+
+	digest, err := h.settingsRepo.GetDigestFrequency(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve notification settings"})
+		return
+	}
+
+	response := buildNotificationSettings(settings, mutes)
+	response.Digest = digest
+	c.JSON(http.StatusOK, response)
+}
+
+// notificationSettingsFromRequest validates the settings of a request and returns them with the
+// IDs of their boards
+func notificationSettingsFromRequest(userID uuid.UUID, req NotificationSettingsRequest) ([]model.NotificationSetting, []model.NotificationMute, []uuid.UUID, error) {
+	if len(req.Boards) > MaxNotificationBoards {
+		return nil, nil, nil, fmt.Errorf("at most %d boards can have their own settings", MaxNotificationBoards)
+	}
+
+	var settings []model.NotificationSetting
+	addEvents := func(boardID *uuid.UUID, events []NotificationEventSettings) error {
+		var seen []string
+		for _, event := range events {
+			if !slices.Contains(notification.Events, event.Event) {
+				return fmt.Errorf("unknown event %q, expected one of %v", event.Event, notification.Events)
+			}
+			if slices.Contains(seen, event.Event) {
+				return fmt.Errorf("event %q is listed twice", event.Event)
+			}
+			seen = append(seen, event.Event)
+			settings = append(settings, model.NotificationSetting{
+				UserID:  userID,
+				BoardID: boardID,
+				Event:   event.Event,
+				InApp:   event.InApp,
+				Email:   event.Email,
+			})
+		}
+		return nil
+	}
+
+	if err := addEvents(nil, req.Events); err != nil {
+		return nil, nil, nil, err
+	}
+
+	var mutes []model.NotificationMute
+	boardIDs := []uuid.UUID{}
+	for _, board := range req.Boards {
+		boardID, err := uuid.Parse(board.BoardID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid board ID %q", board.BoardID)
+		}
+		if slices.Contains(boardIDs, boardID) {
+			return nil, nil, nil, fmt.Errorf("board %s is listed twice", boardID)
+		}
+		boardIDs = append(boardIDs, boardID)
+
+		if err := addEvents(&boardID, board.Events); err != nil {
+			return nil, nil, nil, err
+		}
+		if board.Muted {
+			mutes = append(mutes, model.NotificationMute{UserID: userID, BoardID: boardID})
+		}
+	}
+	return settings, mutes, boardIDs, nil
+}
+
+// buildNotificationSettings lists the default settings of every event type, saved or not, and
+// the boards with settings of their own or a mute
+func buildNotificationSettings(settings []model.NotificationSetting, mutes []model.NotificationMute) NotificationSettingsResponse {
+	defaults := make(map[string]model.NotificationSetting)
+	boards := make(map[uuid.UUID]*BoardNotificationSettings)
+	var order []uuid.UUID
+	board := func(boardID uuid.UUID) *BoardNotificationSettings {
+		if boards[boardID] == nil {
+			boards[boardID] = &BoardNotificationSettings{BoardID: boardID.String(), Events: []NotificationEventSettings{}}
+			order = append(order, boardID)
+		}
+		return boards[boardID]
+	}
+
+	for _, setting := range settings {
+		if setting.BoardID == nil {
+			defaults[setting.Event] = setting
+			continue
+		}
+		b := board(*setting.BoardID)
+		b.Events = append(b.Events, NotificationEventSettings{Event: setting.Event, InApp: setting.InApp, Email: setting.Email})
+	}
+	for _, mute := range mutes {
+		board(mute.BoardID).Muted = true
+	}
+
+	response := NotificationSettingsResponse{
+		Events: make([]NotificationEventSettings, len(notification.Events)),
+		Boards: make([]BoardNotificationSettings, len(order)),
+		Digest: notification.DigestOff,
+	}
+	for i, event := range notification.Events {
+		setting, ok := defaults[event]
+		if !ok {
+			setting = notification.Default(event)
+		}
+		response.Events[i] = NotificationEventSettings{Event: event, InApp: setting.InApp, Email: setting.Email}
+	}
+	for i, boardID := range order {
+		response.Boards[i] = *boards[boardID]
+	}
+	return response
+}
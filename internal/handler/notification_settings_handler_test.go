@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"testing"
+
+	"kanban/internal/model"
+	"kanban/internal/notification"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationSettingsFromRequest(t *testing.T) {
+	userID, boardID := uuid.New(), uuid.New()
+
+	settings, mutes, boardIDs, err := notificationSettingsFromRequest(userID, NotificationSettingsRequest{
+		Events: []NotificationEventSettings{{Event: notification.EventMention, InApp: true, Email: true}},
+		Boards: []BoardNotificationSettings{{
+			BoardID: boardID.String(),
+			Muted:   true,
+			Events:  []NotificationEventSettings{{Event: notification.EventMention}},
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, settings, 2)
+	assert.Nil(t, settings[0].BoardID)
+	assert.True(t, settings[0].Email)
+	assert.Equal(t, &boardID, settings[1].BoardID)
+	assert.Equal(t, []model.NotificationMute{{UserID: userID, BoardID: boardID}}, mutes)
+	assert.Equal(t, []uuid.UUID{boardID}, boardIDs)
+
+	// Неизвестные события, повторы и неверные ID отклоняются
+	_, _, _, err = notificationSettingsFromRequest(userID, NotificationSettingsRequest{
+		Events: []NotificationEventSettings{{Event: "digest"}},
+	})
+	assert.Error(t, err)
+	_, _, _, err = notificationSettingsFromRequest(userID, NotificationSettingsRequest{
+		Events: []NotificationEventSettings{{Event: notification.EventMention}, {Event: notification.EventMention}},
+	})
+	assert.Error(t, err)
+	_, _, _, err = notificationSettingsFromRequest(userID, NotificationSettingsRequest{
+		Boards: []BoardNotificationSettings{{BoardID: boardID.String()}, {BoardID: boardID.String()}},
+	})
+	assert.Error(t, err)
+	_, _, _, err = notificationSettingsFromRequest(userID, NotificationSettingsRequest{
+		Boards: []BoardNotificationSettings{{BoardID: "board"}},
+	})
+	assert.Error(t, err)
+}
+
+func TestBuildNotificationSettings(t *testing.T) {
+	muted := uuid.New()
+
+	// Без сохраненных настроек возвращаются значения по умолчанию для каждого события
+	response := buildNotificationSettings(nil, nil)
+	assert.Equal(t, []NotificationEventSettings{{Event: notification.EventMention, InApp: true}}, response.Events)
+	assert.Empty(t, response.Boards)
+	assert.Equal(t, notification.DigestOff, response.Digest)
+
+	response = buildNotificationSettings(nil, []model.NotificationMute{{BoardID: muted}})
+	assert.Equal(t, []BoardNotificationSettings{
+		{BoardID: muted.String(), Muted: true, Events: []NotificationEventSettings{}},
+	}, response.Boards)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"kanban/internal/apiversion"
+	"kanban/internal/model"
+	"kanban/internal/oauth"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+)
+
+const oauthStateCookie = "oauth_state"
+
+type OAuthHandler struct {
+	userRepo     *repository.UserRepository
+	identityRepo *repository.UserIdentityRepository
+	userHandler  *UserHandler
+	providers    map[string]oauth.Provider
+	// successRedirectURL, when set, receives the issued token in the URL fragment instead of a JSON response
+	successRedirectURL string
+}
+
+func NewOAuthHandler(
+	userRepo *repository.UserRepository,
+	identityRepo *repository.UserIdentityRepository,
+	userHandler *UserHandler,
+	providers []oauth.Provider,
+	successRedirectURL string,
+) *OAuthHandler {
+	byName := make(map[string]oauth.Provider, len(providers))
+	for _, p := range providers {
+		byName[p.Name()] = p
+	}
+
+	return &OAuthHandler{
+		userRepo:           userRepo,
+		identityRepo:       identityRepo,
+		userHandler:        userHandler,
+		providers:          byName,
+		successRedirectURL: successRedirectURL,
+	}
+}
+
+// Login godoc
+// @Summary Start OAuth login
+// @Description Redirects to the provider's consent page to start the OAuth2 authorization code flow
+// @Tags Users
+// @Param provider path string true "OAuth provider" Enums(google, github)
+// @Success 307 "Redirect to the provider"
+// @Failure 404 {object} map[string]string "Unknown or disabled provider"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /auth/oauth/{provider} [get]
+func (h *OAuthHandler) Login(c *gin.Context) {
+	provider, ok := h.providers[c.Param("provider")]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown OAuth provider"})
+		return
+	}
+
+	state, err := oauth.NewState()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start OAuth login"})
+		return
+	}
+
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, state, 600, apiversion.Path(c, "/auth/oauth"), "", c.Request.TLS != nil, true)
+	c.Redirect(http.StatusTemporaryRedirect, provider.AuthCodeURL(state))
+}
+
+// Callback godoc
+// @Summary Complete OAuth login
+// @Description Handles the provider callback, creates or links the user by verified email and issues a JWT
+// @Tags Users
+// @Produce json
+// @Param provider path string true "OAuth provider" Enums(google, github)
+// @Param code query string true "Authorization code"
+// @Param state query string true "OAuth state"
+// @Success 200 {object} AuthResponse "Login successful with auth token"
+// @Failure 400 {object} map[string]string "Invalid OAuth state or code"
+// @Failure 401 {object} map[string]string "Provider authentication failed"
+// @Failure 403 {object} map[string]string "Account is disabled"
+// @Failure 404 {object} map[string]string "Unknown or disabled provider"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /auth/oauth/{provider}/callback [get]
+func (h *OAuthHandler) Callback(c *gin.Context) {
+	provider, ok := h.providers[c.Param("provider")]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown OAuth provider"})
+		return
+	}
+
+	state, err := c.Cookie(oauthStateCookie)
+	if err != nil || state == "" || state != c.Query("state") {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid OAuth state"})
+		return
+	}
+	c.SetCookie(oauthStateCookie, "", -1, apiversion.Path(c, "/auth/oauth"), "", c.Request.TLS != nil, true)
+
+	code := c.Query("code")
+	if code == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing authorization code"})
+		return
+	}
+
+	info, err := provider.Authenticate(c.Request.Context(), code)
+	if err != nil {
+		if errors.Is(err, oauth.ErrUnverifiedEmail) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Your provider account has no verified email address"})
+		} else {
+			log.Printf("⚠️  OAuth login with %s failed: %v", provider.Name(), err)
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "OAuth authentication failed"})
+		}
+		return
+	}
+
+	user, err := h.findOrCreateUser(c, info)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to sign in user"})
+		return
+	}
+
+	if user.DisabledAt != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Account is disabled"})
+		return
+	}
+
+	token, err := h.userHandler.generateToken(user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
+		return
+	}
+
+	response := AuthResponse{
+		Token: token,
+		User: UserDetails{
+			ID:    user.ID.String(),
+			Email: user.Email,
+			Name:  user.Name,
+		},
+		SampleBoardID: h.userHandler.createSampleBoardOnFirstLogin(c, user),
+	}
+
+	if h.successRedirectURL != "" {
+		c.Redirect(http.StatusFound, h.successRedirectURL+"#token="+url.QueryEscape(token))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// findOrCreateUser resolves the user for a provider identity: an already linked account,
+// an existing account with the same verified email, or a new password-less account
+func (h *OAuthHandler) findOrCreateUser(c *gin.Context, info *oauth.UserInfo) (*model.User, error) {
+	ctx := c.Request.Context()
+
+	user, err := h.identityRepo.FindUser(ctx, info.Provider, info.ProviderID)
+	if err != nil || user != nil {
+		return user, err
+	}
+
+	user, err = h.userRepo.FindByEmail(ctx, info.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		name := info.Name
+		if name == "" {
+			name = strings.Split(info.Email, "@")[0]
+		}
+		// У OAuth-пользователей нет пароля, вход по паролю для них невозможен
+		user = &model.User{
+			Email: info.Email,
+			Name:  name,
+		}
+	}
+
+	if err := h.identityRepo.Link(ctx, user, info.Provider, info.ProviderID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
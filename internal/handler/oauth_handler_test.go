@@ -0,0 +1,179 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"kanban/internal/handler"
+	"kanban/internal/model"
+	"kanban/internal/oauth"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// stubOAuthProvider answers every authorization code with the same identity or error
+type stubOAuthProvider struct {
+	info  *oauth.UserInfo
+	err   error
+	calls int
+}
+
+func (p *stubOAuthProvider) Name() string { return "stub" }
+
+func (p *stubOAuthProvider) AuthCodeURL(state string) string {
+	return "https://provider.example.com/authorize?state=" + state
+}
+
+func (p *stubOAuthProvider) Authenticate(_ context.Context, code string) (*oauth.UserInfo, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	info := *p.info
+	return &info, nil
+}
+
+// newOAuthRouter serves the OAuth routes with the provider; db may be nil for requests that
+// fail before users are looked up
+func newOAuthRouter(t *testing.T, db *gorm.DB, provider oauth.Provider) *gin.Engine {
+	t.Helper()
+
+	userRepo := repository.NewUserRepository(db)
+	userHandler := handler.NewUserHandler(userRepo, nil, nil, nil, nil, false, "oauth-test-secret")
+	h := handler.NewOAuthHandler(userRepo, repository.NewUserIdentityRepository(db), userHandler, []oauth.Provider{provider}, "")
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/auth/oauth/:provider", h.Login)
+	r.GET("/auth/oauth/:provider/callback", h.Callback)
+	return r
+}
+
+// oauthCallback calls the callback with the state in the query and, unless empty, in the cookie
+func oauthCallback(r *gin.Engine, cookieState, queryState string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/auth/oauth/stub/callback?code=code&state="+queryState, nil)
+	if cookieState != "" {
+		req.AddCookie(&http.Cookie{Name: "oauth_state", Value: cookieState})
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestOAuthLogin_SetsState(t *testing.T) {
+	r := newOAuthRouter(t, nil, &stubOAuthProvider{})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auth/oauth/stub", nil))
+	require.Equal(t, http.StatusTemporaryRedirect, w.Code)
+
+	// Состояние в cookie совпадает с переданным провайдеру
+	cookies := w.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "oauth_state", cookies[0].Name)
+	assert.True(t, cookies[0].HttpOnly)
+	assert.Equal(t, "https://provider.example.com/authorize?state="+cookies[0].Value, w.Header().Get("Location"))
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/auth/oauth/unknown", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestOAuthCallback_RejectsState(t *testing.T) {
+	provider := &stubOAuthProvider{info: &oauth.UserInfo{Provider: "stub", ProviderID: "1", Email: "anna@example.com"}}
+	r := newOAuthRouter(t, nil, provider)
+
+	for name, states := range map[string][2]string{
+		"missing cookie":   {"", "state"},
+		"empty state":      {"state", ""},
+		"mismatched state": {"state", "other-state"},
+	} {
+		w := oauthCallback(r, states[0], states[1])
+		assert.Equal(t, http.StatusBadRequest, w.Code, name)
+		assert.JSONEq(t, `{"error": "Invalid OAuth state"}`, w.Body.String(), name)
+	}
+	// Код не обменивается без проверенного состояния
+	assert.Zero(t, provider.calls)
+}
+
+func TestOAuthCallback_ProviderErrors(t *testing.T) {
+	provider := &stubOAuthProvider{err: errors.New("failed to exchange authorization code: invalid_grant")}
+	r := newOAuthRouter(t, nil, provider)
+
+	w := oauthCallback(r, "state", "state")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.JSONEq(t, `{"error": "OAuth authentication failed"}`, w.Body.String())
+
+	// Аккаунт без подтверждённого email не входит и не связывается с пользователем
+	provider.err = oauth.ErrUnverifiedEmail
+	w = oauthCallback(r, "state", "state")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.JSONEq(t, `{"error": "Your provider account has no verified email address"}`, w.Body.String())
+}
+
+func TestOAuthCallback_Users(t *testing.T) {
+	db := testutil.OpenDB(t)
+	provider := &stubOAuthProvider{}
+	r := newOAuthRouter(t, db, provider)
+
+	login := func(info oauth.UserInfo) (int, handler.AuthResponse) {
+		t.Helper()
+		provider.info = &info
+		w := oauthCallback(r, "state", "state")
+		var response handler.AuthResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response
+	}
+
+	// Новый пользователь создаётся без пароля и связывается с аккаунтом провайдера
+	email := "oauth-" + uuid.NewString() + "@example.com"
+	t.Cleanup(func() { db.Where("email = ?", email).Delete(&model.User{}) })
+	providerID := uuid.NewString()
+	status, created := login(oauth.UserInfo{Provider: "stub", ProviderID: providerID, Email: email, Name: "Anna"})
+	require.Equal(t, http.StatusOK, status)
+	assert.NotEmpty(t, created.Token)
+	assert.Equal(t, email, created.User.Email)
+	assert.Equal(t, "Anna", created.User.Name)
+
+	var user model.User
+	require.NoError(t, db.Where("email = ?", email).First(&user).Error)
+	assert.Empty(t, user.HashedPassword)
+
+	// Повторный вход находит связанного пользователя, даже если email у провайдера сменился
+	status, again := login(oauth.UserInfo{Provider: "stub", ProviderID: providerID, Email: "changed-" + email})
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, created.User.ID, again.User.ID)
+	assert.Equal(t, email, again.User.Email)
+
+	// Существующий пользователь с тем же подтверждённым email связывается, а не дублируется
+	existing := testutil.CreateUser(t, db, "existing")
+	status, linked := login(oauth.UserInfo{Provider: "stub", ProviderID: uuid.NewString(), Email: existing.Email, Name: "Someone else"})
+	require.Equal(t, http.StatusOK, status)
+	assert.Equal(t, existing.ID.String(), linked.User.ID)
+	assert.Equal(t, existing.Name, linked.User.Name)
+	var count int64
+	require.NoError(t, db.Model(&model.User{}).Where("email = ?", existing.Email).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+
+	// Отключённый пользователь не получает токен, ни по email, ни по связанному аккаунту
+	disabled := testutil.CreateUser(t, db, "disabled")
+	require.NoError(t, db.Model(&model.User{}).Where("id = ?", disabled.ID).Update("disabled_at", time.Now()).Error)
+	status, _ = login(oauth.UserInfo{Provider: "stub", ProviderID: uuid.NewString(), Email: disabled.Email})
+	assert.Equal(t, http.StatusForbidden, status)
+	require.NoError(t, db.Model(&model.User{}).Where("id = ?", user.ID).Update("disabled_at", time.Now()).Error)
+	status, _ = login(oauth.UserInfo{Provider: "stub", ProviderID: providerID, Email: email})
+	assert.Equal(t, http.StatusForbidden, status)
+}
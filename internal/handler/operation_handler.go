@@ -0,0 +1,524 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"kanban/internal/apiversion"
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type OperationHandler struct {
+	operationRepo  *repository.OperationRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	duplicator     *jobs.BoardDuplicator
+	restructurer   *jobs.BoardRestructurer
+	queue          *jobs.Queue
+	limits         *limits.Service
+}
+
+func NewOperationHandler(
+	operationRepo *repository.OperationRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	duplicator *jobs.BoardDuplicator,
+	restructurer *jobs.BoardRestructurer,
+	queue *jobs.Queue,
+	limitService *limits.Service,
+) *OperationHandler {
+	return &OperationHandler{
+		operationRepo:  operationRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		duplicator:     duplicator,
+		restructurer:   restructurer,
+		queue:          queue,
+		limits:         limitService,
+	}
+}
+
+// DuplicateBoardRequest represents the request body for duplicating a board
+// @name DuplicateBoardRequest
+type DuplicateBoardRequest struct {
+	// Title of the copy, defaults to "Copy of <source title>"
+	Title string `json:"title"`
+	// IncludeTasks copies the tasks, defaults to true
+	IncludeTasks *bool `json:"include_tasks"`
+	// IncludeAssignees keeps tasks assigned to the caller assigned, defaults to true
+	IncludeAssignees *bool `json:"include_assignees"`
+	// IncludeDueDates keeps the due dates of tasks, defaults to true
+	IncludeDueDates *bool `json:"include_due_dates"`
+}
+
+// options converts the request into duplication options, applying the defaults
+func (r DuplicateBoardRequest) options(sourceTitle string) jobs.DuplicateOptions {
+	opts := jobs.DuplicateOptions{
+		Title:            r.Title,
+		IncludeTasks:     r.IncludeTasks == nil || *r.IncludeTasks,
+		IncludeAssignees: r.IncludeAssignees == nil || *r.IncludeAssignees,
+		IncludeDueDates:  r.IncludeDueDates == nil || *r.IncludeDueDates,
+	}
+	if opts.Title == "" {
+		opts.Title = "Copy of " + sourceTitle
+	}
+	return opts
+}
+
+// SplitBoardRequest represents the request body for splitting a board
+// @name SplitBoardRequest
+type SplitBoardRequest struct {
+	// Title of the new board
+	Title string `json:"title" binding:"required"`
+	// ColumnIDs are moved to the new board with all their tasks
+	ColumnIDs []string `json:"column_ids" binding:"dive,uuid"`
+	// TaskIDs of other columns are moved into a column of the same name on the new board
+	TaskIDs []string `json:"task_ids" binding:"dive,uuid"`
+	// DryRun returns a preview of the split without changing anything
+	DryRun bool `json:"dry_run"`
+}
+
+// MergeBoardRequest represents the request body for merging another board into a board
+// @name MergeBoardRequest
+type MergeBoardRequest struct {
+	// SourceBoardID is the board merged into this one and deleted afterwards
+	SourceBoardID string `json:"source_board_id" binding:"required,uuid"`
+	// DryRun returns a preview of the merge without changing anything
+	DryRun bool `json:"dry_run"`
+}
+
+// RestructurePreviewResponse describes what a split or merge would change
+// @name RestructurePreviewResponse
+type RestructurePreviewResponse struct {
+	Columns       int   `json:"columns"`
+	Tasks         int64 `json:"tasks"`
+	LabelsCreated int   `json:"labels_created"`
+	SharesChanged int   `json:"shares_changed"`
+}
+
+// OperationResponse represents the progress of a long-running operation
+// @name OperationResponse
+type OperationResponse struct {
+	ID            string   `json:"id"`
+	Kind          string   `json:"kind" enums:"board_duplicate,board_split,board_merge,task_import"`
+	Status        string   `json:"status" enums:"pending,running,succeeded,failed"`
+	Total         int      `json:"total"`
+	Completed     int      `json:"completed"`
+	Progress      int      `json:"progress"`
+	Errors        []string `json:"errors"`
+	ResultBoardID *string  `json:"result_board_id,omitempty"`
+	// RequestID identifies the request that started the operation in the server logs
+	RequestID string `json:"request_id,omitempty"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+func newOperationResponse(op *model.Operation) OperationResponse {
+	response := OperationResponse{
+		ID:        op.ID.String(),
+		Kind:      op.Kind,
+		Status:    op.Status,
+		Total:     op.Total,
+		Completed: op.Completed,
+		Errors:    []string{},
+		RequestID: op.RequestID,
+		CreatedAt: op.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: op.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if op.Total > 0 {
+		response.Progress = op.Completed * 100 / op.Total
+	}
+	if op.Status == model.OperationSucceeded {
+		response.Progress = 100
+	}
+
+	if len(op.Errors) > 0 {
+		_ = json.Unmarshal(op.Errors, &response.Errors)
+	}
+
+	if op.ResultBoardID != nil {
+		boardID := op.ResultBoardID.String()
+		response.ResultBoardID = &boardID
+	}
+	return response
+}
+
+// DuplicateBoard godoc
+// @Summary Duplicate a board
+// @Description Starts copying a board with its columns, labels and optionally its tasks into a new board owned by the
+// @Description authenticated user. Tasks can be copied without assignees or due dates. The copy is written in a single
+// @Description transaction in the background; poll the returned operation for progress.
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param board body DuplicateBoardRequest false "Copy settings"
+// @Success 202 {object} OperationResponse "Duplication started"
+// @Failure 400 {object} map[string]string "Invalid request or board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or board limit reached"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Failure 503 {object} map[string]string "Too many operations in progress"
+// @Security BearerAuth
+// @Router /boards/{id}/duplicate [post]
+func (h *OperationHandler) DuplicateBoard(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	var req DuplicateBoardRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+			return
+		}
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+		return
+	}
+
+	// Лимит проверяется ещё раз в задаче под блокировкой, здесь лишь ранний отказ
+	if !checkBoardLimit(c, h.limits, h.boardRepo, authenticatedUserID) {
+		return
+	}
+
+	opts := req.options(board.Title)
+
+	h.startOperation(c, authenticatedUserID, model.OperationBoardDuplicate, func(ctx context.Context, operationID uuid.UUID) {
+		h.duplicator.Duplicate(ctx, operationID, boardID, authenticatedUserID, opts)
+	})
+}
+
+// SplitBoard godoc
+// @Summary Split a board
+// @Description Moves the selected columns with their tasks, and selected tasks of other columns, into a new board
+// @Description owned by the authenticated user. Tasks of other columns land in a column of the same name. Members of
+// @Description the board get the same access to the new board. The split runs in the background as an operation;
+// @Description with dry_run it is previewed instead (owner only).
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param split body SplitBoardRequest true "Selection to move"
+// @Success 200 {object} RestructurePreviewResponse "Preview of the split (dry run)"
+// @Success 202 {object} OperationResponse "Split started"
+// @Failure 400 {object} map[string]string "Invalid request or selection"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or board limit reached"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Failure 503 {object} map[string]string "Too many operations in progress"
+// @Security BearerAuth
+// @Router /boards/{id}/split [post]
+func (h *OperationHandler) SplitBoard(c *gin.Context) {
+	var req SplitBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if len(req.ColumnIDs) == 0 && len(req.TaskIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Select at least one column or task to move"})
+		return
+	}
+
+	authenticatedUserID, board, ok := h.ownedBoard(c, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	params := jobs.SplitParams{
+		SourceID:  board.ID,
+		OwnerID:   authenticatedUserID,
+		Title:     req.Title,
+		ColumnIDs: parseUUIDs(req.ColumnIDs),
+		TaskIDs:   parseUUIDs(req.TaskIDs),
+	}
+
+	if req.DryRun {
+		result, err := h.restructurer.Split(c.Request.Context(), params, true)
+		h.respondPreview(c, result, err)
+		return
+	}
+
+	// Лимит проверяется ещё раз в задаче под блокировкой, здесь лишь ранний отказ
+	if !checkBoardLimit(c, h.limits, h.boardRepo, authenticatedUserID) {
+		return
+	}
+
+	h.startOperation(c, authenticatedUserID, model.OperationBoardSplit, func(ctx context.Context, operationID uuid.UUID) {
+		h.restructurer.RunSplit(ctx, operationID, params)
+	})
+}
+
+// MergeBoard godoc
+// @Summary Merge a board into another
+// @Description Appends the columns of the source board with their tasks to this board, maps labels by name
+// @Description (creating missing ones), gives members of the source board the same access here and deletes the
+// @Description source board. The merge runs in the background as an operation; with dry_run it is previewed
+// @Description instead. The caller must own both boards.
+// @Tags Boards
+// @Accept json
+// @Produce json
+// @Param id path string true "Target board ID" format(uuid)
+// @Param merge body MergeBoardRequest true "Board to merge"
+// @Success 200 {object} RestructurePreviewResponse "Preview of the merge (dry run)"
+// @Success 202 {object} OperationResponse "Merge started"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Failure 503 {object} map[string]string "Too many operations in progress"
+// @Security BearerAuth
+// @Router /boards/{id}/merge [post]
+func (h *OperationHandler) MergeBoard(c *gin.Context) {
+	var req MergeBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	authenticatedUserID, target, ok := h.ownedBoard(c, c.Param("id"))
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, target) {
+		return
+	}
+
+	if req.SourceBoardID == target.ID.String() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A board cannot be merged into itself"})
+		return
+	}
+
+	_, source, ok := h.ownedBoard(c, req.SourceBoardID)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, source) {
+		return
+	}
+
+	params := jobs.MergeParams{TargetID: target.ID, SourceID: source.ID}
+
+	if req.DryRun {
+		result, err := h.restructurer.Merge(c.Request.Context(), params, true)
+		h.respondPreview(c, result, err)
+		return
+	}
+
+	h.startOperation(c, authenticatedUserID, model.OperationBoardMerge, func(ctx context.Context, operationID uuid.UUID) {
+		h.restructurer.RunMerge(ctx, operationID, params)
+	})
+}
+
+// ownedBoard loads the board and checks that the authenticated user owns it.
+// On failure the response is already written.
+func (h *OperationHandler) ownedBoard(c *gin.Context, id string) (uuid.UUID, *model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	boardID, err := uuid.Parse(id)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, nil, false
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can split or merge boards"})
+		return uuid.Nil, nil, false
+	}
+
+	return authenticatedUserID, board, true
+}
+
+// startOperation creates an operation of the given kind, queues run for it and responds with
+// 202 and the operation, or 503 when the queue is full
+func (h *OperationHandler) startOperation(c *gin.Context, userID uuid.UUID, kind string, run func(ctx context.Context, operationID uuid.UUID)) {
+	op := &model.Operation{
+		UserID:    userID,
+		Kind:      kind,
+		Status:    model.OperationPending,
+		Errors:    json.RawMessage("[]"),
+		RequestID: requestid.FromContext(c.Request.Context()),
+	}
+	if err := h.operationRepo.Create(c.Request.Context(), op); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create operation"})
+		return
+	}
+
+	err := h.queue.Enqueue(c.Request.Context(), func(ctx context.Context) {
+		run(ctx, op.ID)
+	})
+	if err != nil {
+		_ = h.operationRepo.AppendError(c.Request.Context(), op.ID, "Too many operations in progress")
+		_ = h.operationRepo.Finish(c.Request.Context(), op.ID, model.OperationFailed, nil)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Too many operations in progress, try again later"})
+		return
+	}
+
+	c.Header("Location", apiversion.Path(c, "/operations/"+op.ID.String()))
+	c.JSON(http.StatusAccepted, newOperationResponse(op))
+}
+
+func (h *OperationHandler) respondPreview(c *gin.Context, result *jobs.RestructureResult, err error) {
+	if err != nil {
+		switch {
+		case errors.Is(err, jobs.ErrInvalidSelection):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Selected columns and tasks must belong to the board"})
+		case errors.Is(err, jobs.ErrBoardLimitReached):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Maximum number of boards reached"})
+		case errors.Is(err, jobs.ErrColumnLimitReached):
+			c.JSON(http.StatusForbidden, gin.H{"error": "Maximum number of columns per board reached"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to preview operation"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, RestructurePreviewResponse{
+		Columns:       result.Columns,
+		Tasks:         result.Tasks,
+		LabelsCreated: result.LabelsCreated,
+		SharesChanged: result.SharesChanged,
+	})
+}
+
+// parseUUIDs parses IDs already validated by request binding
+func parseUUIDs(ids []string) []uuid.UUID {
+	parsed := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if value, err := uuid.Parse(id); err == nil {
+			parsed = append(parsed, value)
+		}
+	}
+	return parsed
+}
+
+// GetByID godoc
+// @Summary Get operation progress
+// @Description Returns the status and progress of a long-running operation started by the authenticated user,
+// @Description the errors of items that could not be processed and the resulting board once it exists
+// @Tags Operations
+// @Produce json
+// @Param id path string true "Operation ID" format(uuid)
+// @Success 200 {object} OperationResponse "Operation progress"
+// @Failure 400 {object} map[string]string "Invalid operation ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 404 {object} map[string]string "Operation not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /operations/{id} [get]
+func (h *OperationHandler) GetByID(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	operationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid operation ID format"})
+		return
+	}
+
+	op, err := h.operationRepo.GetByID(c.Request.Context(), operationID)
+	if err != nil {
+		if err == repository.ErrOperationNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Operation not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve operation"})
+		}
+		return
+	}
+
+	// Чужие операции не раскрываются
+	if op.UserID != authenticatedUserID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Operation not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newOperationResponse(op))
+}
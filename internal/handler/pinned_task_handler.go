@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const MaxPinnedTasksPerUser = 50
+
+type PinnedTaskHandler struct {
+	pinRepo        *repository.PinnedTaskRepository
+	taskRepo       repository.TaskRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+}
+
+func NewPinnedTaskHandler(
+	pinRepo *repository.PinnedTaskRepository,
+	taskRepo repository.TaskRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+) *PinnedTaskHandler {
+	return &PinnedTaskHandler{
+		pinRepo:        pinRepo,
+		taskRepo:       taskRepo,
+		columnRepo:     columnRepo,
+		boardShareRepo: boardShareRepo,
+	}
+}
+
+// PinnedTaskResponse represents a task pinned by the current user
+// @name PinnedTaskResponse
+type PinnedTaskResponse struct {
+	TaskID      string  `json:"task_id"`
+	Key         string  `json:"key"`
+	Title       string  `json:"title"`
+	ColumnID    string  `json:"column_id"`
+	ColumnTitle string  `json:"column_title"`
+	BoardID     string  `json:"board_id"`
+	BoardTitle  string  `json:"board_title"`
+	DueDate     *string `json:"due_date,omitempty"`
+	PinnedAt    string  `json:"pinned_at"`
+}
+
+// Pin godoc
+// @Summary Pin a task
+// @Description Adds a task to the authenticated user's personal pinned tasks
+// @Tags Pinned tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} map[string]string "Task pinned successfully"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or pin limit reached"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/pin [post]
+func (h *PinnedTaskHandler) Pin(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task"})
+		return
+	}
+
+	count, err := h.pinRepo.CountByUserID(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check pinned task count"})
+		return
+	}
+
+	if count >= MaxPinnedTasksPerUser {
+		c.JSON(http.StatusForbidden, gin.H{"error": fmt.Sprintf("Maximum number of pinned tasks reached (%d)", MaxPinnedTasksPerUser)})
+		return
+	}
+
+	if err := h.pinRepo.Pin(c.Request.Context(), authenticatedUserID, taskID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to pin task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task pinned successfully"})
+}
+
+// Unpin godoc
+// @Summary Unpin a task
+// @Description Removes a task from the authenticated user's pinned tasks
+// @Tags Pinned tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} map[string]string "Task unpinned successfully"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/pin [delete]
+func (h *PinnedTaskHandler) Unpin(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	if err := h.pinRepo.Unpin(c.Request.Context(), authenticatedUserID, taskID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unpin task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task unpinned successfully"})
+}
+
+// GetPinned godoc
+// @Summary Get pinned tasks
+// @Description Returns the authenticated user's pinned tasks, newest pin first. Tasks on boards the user can no longer access are omitted.
+// @Tags Pinned tasks
+// @Produce json
+// @Success 200 {array} PinnedTaskResponse "Pinned tasks"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/pinned-tasks [get]
+func (h *PinnedTaskHandler) GetPinned(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	pins, err := h.pinRepo.GetByUserID(c.Request.Context(), authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve pinned tasks"})
+		return
+	}
+
+	response := make([]PinnedTaskResponse, len(pins))
+	for i, pin := range pins {
+		task := pin.Task
+		board := task.Column.Board
+
+		response[i] = PinnedTaskResponse{
+			TaskID:      task.ID.String(),
+			Key:         taskKey(&board, &task),
+			Title:       task.Title,
+			ColumnID:    task.ColumnID.String(),
+			ColumnTitle: task.Column.Title,
+			BoardID:     board.ID.String(),
+			BoardTitle:  board.Title,
+			PinnedAt:    pin.CreatedAt.Format(time.RFC3339),
+		}
+
+		if task.DueDate != nil {
+			dueDate := task.DueDate.Format(time.RFC3339)
+			response[i].DueDate = &dueDate
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
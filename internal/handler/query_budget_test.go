@@ -0,0 +1,262 @@
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"kanban/internal/handler"
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// Query budgets of hot endpoints. They must not grow with the number of tasks on a board.
+const (
+	// Доска, доступ и сохранённый вид; последняя открытая доска записывается в фоне
+	boardFetchBudget = 3
+	// Задачи с колонкой, доской и открытыми блокирующими задачами одним запросом, плюс метки
+	taskListBudget = 2
+	// Колонка с доской, доступ участника, версия доски, задачи с открытыми блокирующими задачами и метки
+	columnTasksBudget = 5
+	// Пользователь и доски со счётчиками
+	bootstrapBudget = 2
+	// Доска, доступ, версия доски, колонки, дорожки, задачи с метками, открытые блокирующие задачи
+	// и настройки доски пользователя
+	fullBoardBudget = 9
+	// Задача с доской, доступ и рейтинг участников одним запросом
+	assigneeSuggestionsBudget = 3
+	// Доска, доступ, колонки, время цикла, свернутые дни с их метриками и накопительной диаграммой,
+	// пропускная способность и накопительная диаграмма за несвернутые дни
+	analyticsBudget = 9
+	// Версия досок пользователя, задачи пользователя с доступом и фильтрами одним запросом, метки
+	// и открытые блокирующие задачи
+	myTasksBudget = 4
+)
+
+type budgetFixture struct {
+	counter *testutil.QueryCounter
+	router  *gin.Engine
+	user    uuid.UUID
+
+	owner  model.User
+	viewer model.User
+	board  model.Board
+	column model.Column
+	tasks  []string
+}
+
+// newBudgetFixture creates a board shared with a viewer that has a column full of labelled,
+// assigned tasks, and a router whose requests are authenticated as fixture.user
+func newBudgetFixture(t *testing.T) *budgetFixture {
+	db := testutil.OpenDB(t)
+	counter := testutil.NewQueryCounter()
+	counted := counter.Attach(db)
+	ctx := context.Background()
+
+	userRepo := repository.NewUserRepository(counted)
+	boardRepo := repository.NewBoardRepository(counted)
+	boardShareRepo := repository.NewBoardShareRepository(counted)
+	boardViewRepo := repository.NewBoardViewRepository(counted)
+	prefsRepo := repository.NewUserBoardPrefsRepository(counted)
+	columnRepo := repository.NewColumnRepository(counted)
+	taskRepo := repository.NewTaskRepository(counted)
+	labelRepo := repository.NewLabelRepository(counted)
+	taskRefRepo := repository.NewTaskReferenceRepository(counted)
+	mentionRepo := repository.NewTaskMentionRepository(counted)
+	relationRepo := repository.NewTaskRelationRepository(counted)
+	githubRepo := repository.NewGitHubRepository(counted)
+	changeRepo := repository.NewTaskChangeRepository(counted)
+	boardChangeRepo := repository.NewBoardChangeRepository(counted)
+	actionRepo := repository.NewActionRepository(counted)
+	outboxRepo := repository.NewOutboxRepository(counted)
+	txManager := repository.NewTxManager(counted)
+	taskTemplateRepo := repository.NewTaskTemplateRepository(counted)
+	taskLockRepo := repository.NewTaskLockRepository(counted)
+	descriptionDocRepo := repository.NewDescriptionDocRepository(counted)
+	archivedTaskRepo := repository.NewArchivedTaskRepository(counted)
+	swimlaneRepo := repository.NewSwimlaneRepository(counted)
+	analyticsRepo := repository.NewAnalyticsRepository(counted)
+
+	f := &budgetFixture{counter: counter}
+	suffix := uuid.NewString()
+	f.owner = model.User{Email: "owner-" + suffix + "@example.com", Name: "Owner", HashedPassword: "x"}
+	f.viewer = model.User{Email: "viewer-" + suffix + "@example.com", Name: "Viewer", HashedPassword: "x"}
+	require.NoError(t, userRepo.Create(ctx, &f.owner))
+	require.NoError(t, userRepo.Create(ctx, &f.viewer))
+	t.Cleanup(func() { cleanupUsers(db, f.owner.ID, f.viewer.ID) })
+
+	f.board = model.Board{Title: "Budget", OwnerID: f.owner.ID, Key: "BUD"}
+	require.NoError(t, boardRepo.Create(ctx, &f.board))
+	require.NoError(t, boardShareRepo.ShareBoard(ctx, f.board.ID, f.viewer.ID, model.RoleViewer))
+
+	f.column = model.Column{BoardID: f.board.ID, Title: "To Do", Position: 1}
+	require.NoError(t, columnRepo.Create(ctx, &f.column))
+
+	swimlane := model.Swimlane{BoardID: f.board.ID, Title: "Expedite"}
+	require.NoError(t, swimlaneRepo.Create(ctx, &swimlane))
+
+	var labels []model.Label
+	for i := 0; i < 3; i++ {
+		label := model.Label{BoardID: &f.board.ID, Name: fmt.Sprintf("label-%d", i), Color: "#000000"}
+		require.NoError(t, labelRepo.Create(ctx, &label))
+		labels = append(labels, label)
+	}
+
+	for i := 0; i < 10; i++ {
+		assignee := f.owner.ID
+		if i%2 == 0 {
+			assignee = f.viewer.ID
+		}
+		task := model.Task{
+			ColumnID:   f.column.ID,
+			Title:      fmt.Sprintf("Task %d", i),
+			CreatedBy:  f.owner.ID,
+			AssignedTo: &assignee,
+			Position:   i,
+		}
+		if i%3 == 0 {
+			task.SwimlaneID = &swimlane.ID
+		}
+		require.NoError(t, taskRepo.Create(ctx, &task))
+		f.tasks = append(f.tasks, task.ID.String())
+		for _, label := range labels {
+			require.NoError(t, taskRepo.AddLabel(ctx, task.ID, label.ID))
+		}
+	}
+
+	// Без кэша прав бюджет отражает запросы к базе
+	perms := permission.NewService(boardRepo, columnRepo, boardShareRepo, userRepo, nil, 0)
+	limitService := limits.NewService(limits.Limits{}, userRepo)
+	// Очередь без обработчиков: фоновые задания не попадают в бюджет
+	queue := jobs.NewQueue(1, 10)
+	boardHandler := handler.NewBoardHandler(boardRepo, boardShareRepo, columnRepo, labelRepo, boardViewRepo, prefsRepo, userRepo, txManager, outboxRepo, perms, limitService, queue, nil)
+	bootstrapHandler := handler.NewBootstrapHandler(userRepo, boardRepo, boardShareRepo, nil)
+	taskHandler := handler.NewTaskHandler(taskRepo, columnRepo, boardRepo, boardShareRepo, userRepo, labelRepo, taskRefRepo, mentionRepo, relationRepo, githubRepo, changeRepo, boardChangeRepo, actionRepo, outboxRepo, txManager, taskTemplateRepo, taskLockRepo, descriptionDocRepo, perms, limitService, archivedTaskRepo, nil)
+	swimlaneHandler := handler.NewSwimlaneHandler(swimlaneRepo, columnRepo, taskRepo, relationRepo, prefsRepo, boardChangeRepo, perms)
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsRepo, columnRepo, boardRepo, boardShareRepo)
+
+	gin.SetMode(gin.TestMode)
+	f.router = gin.New()
+	f.router.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, f.user)
+		c.Next()
+	})
+	f.router.GET("/boards/:id", boardHandler.GetByID)
+	f.router.GET("/columns/:id/tasks", taskHandler.GetByColumnID)
+	f.router.POST("/tasks/batch-get", taskHandler.BatchGet)
+	f.router.GET("/bootstrap", bootstrapHandler.Get)
+	f.router.GET("/boards/:id/full", swimlaneHandler.GetFullBoard)
+	f.router.GET("/tasks/:id/assignee-suggestions", taskHandler.GetAssigneeSuggestions)
+	f.router.GET("/boards/:id/analytics", analyticsHandler.GetAnalytics)
+	f.router.GET("/me/tasks", taskHandler.GetMine)
+
+	return f
+}
+
+// get performs the request as the given user; the counter then holds only its queries
+func (f *budgetFixture) get(t *testing.T, user uuid.UUID, path string) {
+	t.Helper()
+	f.do(t, user, httptest.NewRequest(http.MethodGet, path, nil))
+}
+
+// post performs the request with a JSON body as the given user
+func (f *budgetFixture) post(t *testing.T, user uuid.UUID, path, body string) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	f.do(t, user, req)
+}
+
+func (f *budgetFixture) do(t *testing.T, user uuid.UUID, req *http.Request) {
+	t.Helper()
+
+	f.user = user
+	f.counter.Reset()
+
+	w := httptest.NewRecorder()
+	f.router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+}
+
+func cleanupUsers(db *gorm.DB, ids ...uuid.UUID) {
+	db.Where("owner_id IN ?", ids).Delete(&model.Board{})
+	db.Where("id IN ?", ids).Delete(&model.User{})
+}
+
+func TestQueryBudget_BoardFetch(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.owner.ID, "/boards/"+f.board.ID.String())
+	f.counter.AssertBudget(t, "GET /boards/:id as owner", boardFetchBudget)
+
+	f.get(t, f.viewer.ID, "/boards/"+f.board.ID.String())
+	f.counter.AssertBudget(t, "GET /boards/:id as viewer", boardFetchBudget)
+}
+
+func TestQueryBudget_TaskList(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.owner.ID, "/columns/"+f.column.ID.String()+"/tasks")
+	f.counter.AssertBudget(t, "GET /columns/:id/tasks as owner", columnTasksBudget)
+
+	f.get(t, f.viewer.ID, "/columns/"+f.column.ID.String()+"/tasks")
+	f.counter.AssertBudget(t, "GET /columns/:id/tasks as viewer", columnTasksBudget)
+}
+
+func TestQueryBudget_TaskBatchGet(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	// Доступ проверяется в том же запросе, что и загрузка задач
+	body := `{"ids": ["` + strings.Join(f.tasks, `", "`) + `"]}`
+	f.post(t, f.viewer.ID, "/tasks/batch-get", body)
+	f.counter.AssertBudget(t, "POST /tasks/batch-get as viewer", taskListBudget)
+}
+
+func TestQueryBudget_Bootstrap(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.viewer.ID, "/bootstrap?board_id="+f.board.ID.String())
+	f.counter.AssertBudget(t, "GET /bootstrap as viewer", bootstrapBudget)
+}
+
+func TestQueryBudget_FullBoard(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.viewer.ID, "/boards/"+f.board.ID.String()+"/full")
+	f.counter.AssertBudget(t, "GET /boards/:id/full as viewer", fullBoardBudget)
+}
+
+func TestQueryBudget_AssigneeSuggestions(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.owner.ID, "/tasks/"+f.tasks[0]+"/assignee-suggestions")
+	f.counter.AssertBudget(t, "GET /tasks/:id/assignee-suggestions as owner", assigneeSuggestionsBudget)
+}
+
+func TestQueryBudget_Analytics(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.viewer.ID, "/boards/"+f.board.ID.String()+"/analytics?weeks=52")
+	f.counter.AssertBudget(t, "GET /boards/:id/analytics as viewer", analyticsBudget)
+}
+
+func TestQueryBudget_MyTasks(t *testing.T) {
+	f := newBudgetFixture(t)
+
+	f.get(t, f.viewer.ID, "/me/tasks?board_id="+f.board.ID.String()+"&due_to=2099-12-31&include_completed=true")
+	f.counter.AssertBudget(t, "GET /me/tasks as viewer", myTasksBudget)
+}
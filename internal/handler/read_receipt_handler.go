@@ -0,0 +1,466 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReadReceiptHandler struct {
+	receiptRepo    *repository.ReadReceiptRepository
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	userRepo       *repository.UserRepository
+	outboxRepo     *repository.OutboxRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+}
+
+func NewReadReceiptHandler(
+	receiptRepo *repository.ReadReceiptRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	userRepo *repository.UserRepository,
+	outboxRepo *repository.OutboxRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+) *ReadReceiptHandler {
+	return &ReadReceiptHandler{
+		receiptRepo:    receiptRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		columnRepo:     columnRepo,
+		userRepo:       userRepo,
+		outboxRepo:     outboxRepo,
+		txManager:      txManager,
+		perms:          perms,
+	}
+}
+
+// ViewEventRequest represents the request body of a board view event
+// @name ViewEventRequest
+type ViewEventRequest struct {
+	// ColumnIDs are the columns the user saw; omitted means all columns of the board
+	ColumnIDs []string `json:"column_ids" binding:"omitempty,dive,uuid"`
+}
+
+// ReadReceiptSettingsRequest represents the request body for turning read receipts of a board on or off
+// @name ReadReceiptSettingsRequest
+type ReadReceiptSettingsRequest struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+// ReadReceiptPreferenceRequest represents the request body for opting out of read receipts
+// @name ReadReceiptPreferenceRequest
+type ReadReceiptPreferenceRequest struct {
+	// Hide leaves the user's board views out of read receipts on every board
+	Hide *bool `json:"hide" binding:"required"`
+}
+
+// ReadReceiptPreferenceResponse represents whether the user is left out of read receipts
+// @name ReadReceiptPreferenceResponse
+type ReadReceiptPreferenceResponse struct {
+	Hide bool `json:"hide"`
+}
+
+// ReadReceiptCount counts the readers who opened a board or column
+// @name ReadReceiptCount
+type ReadReceiptCount struct {
+	// UpdatedAt is when the board or column, or a task in it, last changed
+	UpdatedAt string `json:"updated_at"`
+	// Seen counts the readers who opened it since it last changed
+	Seen int `json:"seen"`
+	// Opened counts the readers who ever opened it
+	Opened int `json:"opened"`
+}
+
+// ColumnReadReceipts counts the readers who opened a column
+// @name ColumnReadReceipts
+type ColumnReadReceipts struct {
+	ColumnID string `json:"column_id"`
+	Title    string `json:"title"`
+	ReadReceiptCount
+}
+
+// ReadReceiptsResponse represents the read receipts of a board. Only counts are reported,
+// never which readers opened the board.
+// @name ReadReceiptsResponse
+type ReadReceiptsResponse struct {
+	Enabled bool `json:"enabled"`
+	// Readers counts the viewers and commenters of the board who did not opt out
+	Readers int64                `json:"readers"`
+	Board   *ReadReceiptCount    `json:"board,omitempty"`
+	Columns []ColumnReadReceipts `json:"columns"`
+}
+
+// RecordView godoc
+// @Summary Record a board view
+// @Description Records that the authenticated user opened the board and saw the given columns, or all of them. Views
+// @Description only count for viewers and commenters on boards with read receipts turned on, and never for users who
+// @Description opted out; other calls succeed without recording anything.
+// @Tags Read receipts
+// @Accept json
+// @Param id path string true "Board ID" format(uuid)
+// @Param view body ViewEventRequest false "Columns seen"
+// @Success 204 "View recorded"
+// @Failure 400 {object} map[string]string "Invalid request or board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/views [post]
+func (h *ReadReceiptHandler) RecordView(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	var req ViewEventRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+			return
+		}
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	role := "owner"
+	if board.OwnerID != authenticatedUserID {
+		role, err = h.boardShareRepo.GetUserRole(c.Request.Context(), boardID, authenticatedUserID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+			return
+		}
+
+		if role == "" {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+			return
+		}
+	}
+
+	if !board.ReadReceipts || !slices.Contains(model.ReadReceiptRoles, role) {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	user, err := h.userRepo.GetByID(c.Request.Context(), authenticatedUserID)
+	if err != nil || user == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
+	}
+
+	if user.HideReadReceipts {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	boardColumnIDs := make([]uuid.UUID, len(columns))
+	for i, column := range columns {
+		boardColumnIDs[i] = column.ID
+	}
+
+	columnIDs := boardColumnIDs
+	if req.ColumnIDs != nil {
+		columnIDs = nil
+		for _, id := range parseUUIDs(req.ColumnIDs) {
+			if !slices.Contains(boardColumnIDs, id) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Columns must belong to the board"})
+				return
+			}
+			if !slices.Contains(columnIDs, id) {
+				columnIDs = append(columnIDs, id)
+			}
+		}
+	}
+
+	if err := h.receiptRepo.Record(c.Request.Context(), boardID, authenticatedUserID, columnIDs, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record view"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetReadReceipts godoc
+// @Summary Get board read receipts
+// @Description Returns how many of the board's viewers and commenters have opened the board and each column since it
+// @Description last changed. Only counts are reported, never who opened the board. Requires editor access.
+// @Tags Read receipts
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {object} ReadReceiptsResponse "Read receipts"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/read-receipts [get]
+func (h *ReadReceiptHandler) GetReadReceipts(c *gin.Context) {
+	_, board, ok := h.boardWithRole(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	h.respondReadReceipts(c, board)
+}
+
+// UpdateSettings godoc
+// @Summary Turn board read receipts on or off
+// @Description Turns read receipts of the board on or off. Turning them off forgets all recorded views of the board.
+// @Description Only the board owner can change this setting.
+// @Tags Read receipts
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param settings body ReadReceiptSettingsRequest true "Read receipt settings"
+// @Success 200 {object} ReadReceiptsResponse "Read receipts"
+// @Failure 400 {object} map[string]string "Invalid request or board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/read-receipts [put]
+func (h *ReadReceiptHandler) UpdateSettings(c *gin.Context) {
+	var req ReadReceiptSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	authenticatedUserID, board, ok := h.boardWithRole(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can change read receipts"})
+		return
+	}
+
+	changed := board.ReadReceipts != *req.Enabled
+	board.ReadReceipts = *req.Enabled
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.boardRepo.SetReadReceipts(ctx, board.ID, board.ReadReceipts); err != nil {
+			return err
+		}
+		if !board.ReadReceipts {
+			if err := h.receiptRepo.DeleteByBoardID(ctx, board.ID); err != nil {
+				return err
+			}
+		}
+		if !changed {
+			return nil
+		}
+		return h.outboxRepo.Add(ctx, model.EventBoardUpdated, model.NewBoardUpdatedPayload(board, authenticatedUserID))
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update read receipts"})
+		return
+	}
+	h.perms.InvalidateBoard(c.Request.Context(), board.ID)
+	h.respondReadReceipts(c, board)
+}
+
+// UpdatePreference godoc
+// @Summary Opt out of read receipts
+// @Description Sets whether the authenticated user's board views are left out of read receipts on every board. Opting
+// @Description out forgets the user's recorded views.
+// @Tags Read receipts
+// @Accept json
+// @Produce json
+// @Param preference body ReadReceiptPreferenceRequest true "Read receipt preference"
+// @Success 200 {object} ReadReceiptPreferenceResponse "Updated preference"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/read-receipts [put]
+func (h *ReadReceiptHandler) UpdatePreference(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req ReadReceiptPreferenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.userRepo.SetHideReadReceipts(c.Request.Context(), authenticatedUserID, *req.Hide); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update preference"})
+		return
+	}
+
+	if *req.Hide {
+		if err := h.receiptRepo.DeleteByUserID(c.Request.Context(), authenticatedUserID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete read receipts"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, ReadReceiptPreferenceResponse{Hide: *req.Hide})
+}
+
+// boardWithRole loads the board in the id parameter and checks that the user has at least
+// the given role on it. On failure the response is already written.
+func (h *ReadReceiptHandler) boardWithRole(c *gin.Context, role string) (uuid.UUID, *model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, nil, false
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return uuid.Nil, nil, false
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view read receipts of this board"})
+		return uuid.Nil, nil, false
+	}
+
+	return authenticatedUserID, board, true
+}
+
+func (h *ReadReceiptHandler) respondReadReceipts(c *gin.Context, board *model.Board) {
+	if !board.ReadReceipts {
+		c.JSON(http.StatusOK, ReadReceiptsResponse{Columns: []ColumnReadReceipts{}})
+		return
+	}
+
+	readers, err := h.receiptRepo.CountReaders(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count readers"})
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	receipts, err := h.receiptRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve read receipts"})
+		return
+	}
+
+	response := summarizeReadReceipts(board, columns, receipts)
+	response.Readers = readers
+	c.JSON(http.StatusOK, response)
+}
+
+// summarizeReadReceipts counts the receipts of the board and each column against when they
+// last changed. A board changes whenever one of its columns does.
+func summarizeReadReceipts(board *model.Board, columns []model.Column, receipts []model.ViewReceipt) ReadReceiptsResponse {
+	boardUpdatedAt := board.UpdatedAt
+	for _, column := range columns {
+		if column.UpdatedAt.After(boardUpdatedAt) {
+			boardUpdatedAt = column.UpdatedAt
+		}
+	}
+
+	count := func(columnID *uuid.UUID, updatedAt time.Time) ReadReceiptCount {
+		result := ReadReceiptCount{UpdatedAt: updatedAt.Format(time.RFC3339)}
+		for _, receipt := range receipts {
+			if (columnID == nil) != (receipt.ColumnID == nil) || columnID != nil && *receipt.ColumnID != *columnID {
+				continue
+			}
+			result.Opened++
+			if !receipt.ViewedAt.Before(updatedAt) {
+				result.Seen++
+			}
+		}
+		return result
+	}
+
+	boardCount := count(nil, boardUpdatedAt)
+	response := ReadReceiptsResponse{
+		Enabled: true,
+		Board:   &boardCount,
+		Columns: make([]ColumnReadReceipts, 0, len(columns)),
+	}
+	for _, column := range columns {
+		response.Columns = append(response.Columns, ColumnReadReceipts{
+			ColumnID:         column.ID.String(),
+			Title:            column.Title,
+			ReadReceiptCount: count(&column.ID, column.UpdatedAt),
+		})
+	}
+	return response
+}
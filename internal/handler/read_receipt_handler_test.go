@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeReadReceipts(t *testing.T) {
+	base := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	board := &model.Board{ID: uuid.New(), UpdatedAt: base}
+	todo := model.Column{ID: uuid.New(), Title: "To Do", UpdatedAt: base.Add(-time.Hour)}
+	done := model.Column{ID: uuid.New(), Title: "Done", UpdatedAt: base.Add(time.Hour)}
+
+	receipts := []model.ViewReceipt{
+		// Первый читатель открыл доску до последнего изменения колонки Done
+		{BoardID: board.ID, ViewedAt: base.Add(30 * time.Minute)},
+		{BoardID: board.ID, ColumnID: &todo.ID, ViewedAt: base.Add(30 * time.Minute)},
+		{BoardID: board.ID, ColumnID: &done.ID, ViewedAt: base.Add(30 * time.Minute)},
+		// Второй видел только To Do, но уже после всех изменений
+		{BoardID: board.ID, ViewedAt: base.Add(2 * time.Hour)},
+		{BoardID: board.ID, ColumnID: &todo.ID, ViewedAt: base.Add(2 * time.Hour)},
+	}
+
+	response := summarizeReadReceipts(board, []model.Column{todo, done}, receipts)
+	require.True(t, response.Enabled)
+	require.NotNil(t, response.Board)
+	// Доска считается изменённой вместе с самой свежей колонкой
+	assert.Equal(t, done.UpdatedAt.Format(time.RFC3339), response.Board.UpdatedAt)
+	assert.Equal(t, 2, response.Board.Opened)
+	assert.Equal(t, 1, response.Board.Seen)
+
+	require.Len(t, response.Columns, 2)
+	assert.Equal(t, ReadReceiptCount{UpdatedAt: todo.UpdatedAt.Format(time.RFC3339), Seen: 2, Opened: 2}, response.Columns[0].ReadReceiptCount)
+	assert.Equal(t, ReadReceiptCount{UpdatedAt: done.UpdatedAt.Format(time.RFC3339), Seen: 0, Opened: 1}, response.Columns[1].ReadReceiptCount)
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/report"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	taskRepo       repository.TaskRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	// renderer turns reports into PDF; nil when no rendering service is configured
+	renderer report.PDFRenderer
+}
+
+func NewReportHandler(
+	taskRepo repository.TaskRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	renderer report.PDFRenderer,
+) *ReportHandler {
+	return &ReportHandler{
+		taskRepo:       taskRepo,
+		columnRepo:     columnRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		renderer:       renderer,
+	}
+}
+
+// GetReport godoc
+// @Summary Get board report
+// @Description Renders a printable report of a board for stakeholders: task counts, the overdue tasks and the
+// @Description tasks of each column. The report is an HTML page, or with format=pdf a PDF attachment rendered by
+// @Description the configured rendering service.
+// @Tags Boards
+// @Produce html
+// @Produce application/pdf
+// @Param id path string true "Board ID" format(uuid)
+// @Param format query string false "Report format" Enums(html, pdf)
+// @Success 200 {string} string "Board report"
+// @Failure 400 {object} map[string]string "Invalid board ID or format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Failure 501 {object} map[string]string "PDF reports are not configured"
+// @Failure 502 {object} map[string]string "Rendering service failed"
+// @Security BearerAuth
+// @Router /boards/{id}/report [get]
+func (h *ReportHandler) GetReport(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	format := c.DefaultQuery("format", "html")
+	if format != "html" && format != "pdf" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format, expected html or pdf"})
+		return
+	}
+
+	if format == "pdf" && h.renderer == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "PDF reports are not configured on this server"})
+		return
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	tasks, err := h.taskRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return
+	}
+
+	now := time.Now().UTC()
+	var page bytes.Buffer
+	if err := report.WriteHTML(&page, report.Build(board, columns, tasks, now)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render report"})
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	if format == "html" {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page.Bytes())
+		return
+	}
+
+	pdf, err := h.renderer.RenderPDF(c.Request.Context(), page.Bytes())
+	if err != nil {
+		log.Printf("⚠️  Failed to render PDF report of board %s: %v", boardID, err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": "Failed to render PDF report"})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-report-%s.pdf"`, board.Key, now.Format("2006-01-02")))
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
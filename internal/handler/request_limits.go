@@ -0,0 +1,20 @@
+package handler
+
+import "time"
+
+// RequestTimeouts override the request timeout for routes that legitimately run longer
+var RequestTimeouts = map[string]time.Duration{
+	"POST /me/export":                5 * time.Minute,
+	"PUT /imports/:id/parts/:number": 2 * time.Minute,
+	// Exports of the activity log stream for as long as the range takes and end with the client
+	"GET /admin/audit-log": 0,
+	// Event streams end by themselves after BoardEventStreamDuration
+	"GET /boards/:id/events": 0,
+}
+
+// BodyLimits override the request body limit for routes that accept larger bodies. Their
+// handlers check the same limits themselves.
+var BodyLimits = map[string]int64{
+	"PUT /imports/:id/parts/:number":  MaxImportPartBytes,
+	"POST /webhooks/github/:board_id": MaxGitHubPayloadBytes,
+}
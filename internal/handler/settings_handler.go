@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/settings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SettingsHandler serves the runtime settings of the instance. Its routes must be restricted to
+// administrators with middleware.RequireAdmin.
+type SettingsHandler struct {
+	store *settings.Store
+}
+
+func NewSettingsHandler(store *settings.Store) *SettingsHandler {
+	return &SettingsHandler{store: store}
+}
+
+// SettingOverrideResponse represents a setting an administrator overrode
+// @name SettingOverrideResponse
+type SettingOverrideResponse struct {
+	Key string `json:"key" enums:"rate_limits,limits,feature_flags,default_labels"`
+	// Value holds the fields that replace the defaults
+	Value     json.RawMessage `json:"value" swaggertype:"object"`
+	UpdatedAt string          `json:"updated_at"`
+	// UpdatedBy is the administrator who changed the setting last, unless their account was deleted
+	UpdatedBy *string `json:"updated_by"`
+}
+
+// SettingsResponse represents the runtime settings
+// @name SettingsResponse
+type SettingsResponse struct {
+	// Current are the settings in effect: the defaults with the overrides applied
+	Current settings.Settings `json:"current"`
+	// Defaults are the settings from the server configuration
+	Defaults  settings.Settings         `json:"defaults"`
+	Overrides []SettingOverrideResponse `json:"overrides"`
+}
+
+// GetSettings godoc
+// @Summary Get runtime settings
+// @Description Returns the runtime settings in effect, the configured defaults and the overrides set by administrators.
+// @Description Administrators only.
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} SettingsResponse "Settings"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/settings [get]
+func (h *SettingsHandler) GetSettings(c *gin.Context) {
+	h.respond(c)
+}
+
+// SetSetting godoc
+// @Summary Override a runtime setting
+// @Description Overrides a runtime setting without a restart. The body holds the fields that differ from the
+// @Description configured defaults, like {"export": {"per_hour": 10, "burst": 3}} for rate_limits or
+// @Description {"boards": 20} for limits; feature_flags maps flag names to whether they are enabled, and
+// @Description default_labels is the whole list of labels new boards start with, like [{"name": "bug", "color":
+// @Description "#d73a4a"}]. It replaces an earlier override of the setting. Other server instances apply it within their refresh interval.
+// @Description Administrators only.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param key path string true "Setting" Enums(rate_limits, limits, feature_flags, default_labels)
+// @Param value body object true "Overridden fields of the setting"
+// @Success 200 {object} SettingsResponse "Settings"
+// @Failure 400 {object} map[string]string "Invalid value"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "Unknown setting"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/settings/{key} [put]
+func (h *SettingsHandler) SetSetting(c *gin.Context) {
+	userIDValue, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	adminID, ok := userIDValue.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	value, err := io.ReadAll(c.Request.Body)
+	if err != nil || !json.Valid(value) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Request body must be JSON"})
+		return
+	}
+
+	key := c.Param("key")
+	if err := h.store.Set(c.Request.Context(), key, value, adminID); err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	log.Printf("🛡️  Admin %s changed setting %s", adminID, key)
+	h.respond(c)
+}
+
+// ResetSetting godoc
+// @Summary Reset a runtime setting
+// @Description Removes the override of a runtime setting, returning it to the configured default. Administrators
+// @Description only.
+// @Tags Admin
+// @Produce json
+// @Param key path string true "Setting" Enums(rate_limits, limits, feature_flags, default_labels)
+// @Success 200 {object} SettingsResponse "Settings"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Administrator access required"
+// @Failure 404 {object} map[string]string "Unknown setting"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /admin/settings/{key} [delete]
+func (h *SettingsHandler) ResetSetting(c *gin.Context) {
+	key := c.Param("key")
+	if err := h.store.Reset(c.Request.Context(), key); err != nil {
+		h.fail(c, err)
+		return
+	}
+
+	log.Printf("🛡️  Admin %v reset setting %s", c.MustGet(middleware.UserIDKey), key)
+	h.respond(c)
+}
+
+func (h *SettingsHandler) fail(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, settings.ErrUnknownKey):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Unknown setting"})
+	case errors.Is(err, settings.ErrInvalidValue):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update setting"})
+	}
+}
+
+func (h *SettingsHandler) respond(c *gin.Context) {
+	overrides, err := h.store.Overrides(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve settings"})
+		return
+	}
+
+	response := SettingsResponse{
+		Current:   h.store.Get(),
+		Defaults:  h.store.Defaults(),
+		Overrides: make([]SettingOverrideResponse, len(overrides)),
+	}
+	for i, override := range overrides {
+		response.Overrides[i] = SettingOverrideResponse{
+			Key:       override.Key,
+			Value:     override.Value,
+			UpdatedAt: override.UpdatedAt.Format(time.RFC3339),
+			UpdatedBy: uuidString(override.UpdatedBy),
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DefaultShareLinkLifetime is used when a share link is created without an expiry
+const DefaultShareLinkLifetime = 7 * 24 * time.Hour
+
+type ShareLinkHandler struct {
+	boardRepo     repository.BoardRepositoryInterface
+	shareLinkRepo *repository.ShareLinkRepository
+	outboxRepo    *repository.OutboxRepository
+	txManager     *repository.TxManager
+	perms         *permission.Service
+}
+
+func NewShareLinkHandler(
+	boardRepo repository.BoardRepositoryInterface,
+	shareLinkRepo *repository.ShareLinkRepository,
+	outboxRepo *repository.OutboxRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+) *ShareLinkHandler {
+	return &ShareLinkHandler{
+		boardRepo:     boardRepo,
+		shareLinkRepo: shareLinkRepo,
+		outboxRepo:    outboxRepo,
+		txManager:     txManager,
+		perms:         perms,
+	}
+}
+
+// CreateShareLinkRequest represents request for creating an invitation link
+// @name CreateShareLinkRequest
+type CreateShareLinkRequest struct {
+	// Role granted by the link, commenter by default
+	Role           string `json:"role" binding:"omitempty,oneof=viewer commenter editor" enums:"viewer,commenter,editor"`
+	MaxUses        int    `json:"max_uses" binding:"required,min=1,max=1000"`
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=1,max=720"`
+}
+
+// ShareLinkResponse represents an invitation link of a board
+// @name ShareLinkResponse
+type ShareLinkResponse struct {
+	ID        string  `json:"id"`
+	BoardID   string  `json:"board_id"`
+	Token     string  `json:"token"`
+	Role      string  `json:"role"`
+	MaxUses   int     `json:"max_uses"`
+	Uses      int     `json:"uses"`
+	ExpiresAt string  `json:"expires_at"`
+	RevokedAt *string `json:"revoked_at,omitempty"`
+	Active    bool    `json:"active"`
+	CreatedAt string  `json:"created_at"`
+}
+
+// AcceptShareLinkResponse represents the board access gained through a link
+// @name AcceptShareLinkResponse
+type AcceptShareLinkResponse struct {
+	BoardID string `json:"board_id"`
+	Role    string `json:"role"`
+}
+
+func newShareLinkResponse(link *model.ShareLink) ShareLinkResponse {
+	response := ShareLinkResponse{
+		ID:        link.ID.String(),
+		BoardID:   link.BoardID.String(),
+		Token:     link.Token,
+		Role:      link.Role,
+		MaxUses:   link.MaxUses,
+		Uses:      link.Uses,
+		ExpiresAt: link.ExpiresAt.Format(time.RFC3339),
+		Active:    link.Active(time.Now()),
+		CreatedAt: link.CreatedAt.Format(time.RFC3339),
+	}
+
+	if link.RevokedAt != nil {
+		revokedAt := link.RevokedAt.Format(time.RFC3339)
+		response.RevokedAt = &revokedAt
+	}
+	return response
+}
+
+// ownedBoard parses the board ID and checks that the authenticated user owns the board.
+// It writes the error response and returns false when the request cannot go on.
+func (h *ShareLinkHandler) ownedBoard(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can manage share links"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return authenticatedUserID, boardID, true
+}
+
+// Create godoc
+// @Summary Create share link
+// @Description Creates an invitation link granting the chosen role, comment-only access by default, to any
+// @Description authenticated user who opens it, until it has been used max_uses times or expires (owner only).
+// @Description Links granting edit access should be given few uses and a short expiry.
+// @Tags board-sharing
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param input body CreateShareLinkRequest true "Link settings"
+// @Success 201 {object} ShareLinkResponse "Share link created"
+// @Failure 400 {object} map[string]string "Invalid request"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/share-links [post]
+func (h *ShareLinkHandler) Create(c *gin.Context) {
+	authenticatedUserID, boardID, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	var req CreateShareLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	role := req.Role
+	if role == "" {
+		role = model.RoleCommenter
+	}
+
+	lifetime := DefaultShareLinkLifetime
+	if req.ExpiresInHours > 0 {
+		lifetime = time.Duration(req.ExpiresInHours) * time.Hour
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate link token"})
+		return
+	}
+
+	link := &model.ShareLink{
+		BoardID:   boardID,
+		Token:     base64.RawURLEncoding.EncodeToString(token),
+		Role:      role,
+		MaxUses:   req.MaxUses,
+		ExpiresAt: time.Now().Add(lifetime),
+		CreatedBy: authenticatedUserID,
+	}
+
+	if err := h.shareLinkRepo.Create(c.Request.Context(), link); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create share link"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newShareLinkResponse(link))
+}
+
+// GetByBoardID godoc
+// @Summary List share links
+// @Description Lists the invitation links of a board with their usage (owner only)
+// @Tags board-sharing
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {array} ShareLinkResponse "Share links"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/share-links [get]
+func (h *ShareLinkHandler) GetByBoardID(c *gin.Context) {
+	_, boardID, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	links, err := h.shareLinkRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve share links"})
+		return
+	}
+
+	response := make([]ShareLinkResponse, len(links))
+	for i := range links {
+		response[i] = newShareLinkResponse(&links[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Revoke godoc
+// @Summary Revoke share link
+// @Description Disables an invitation link. Access already granted through it is kept (owner only)
+// @Tags board-sharing
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param link_id path string true "Share link ID" format(uuid)
+// @Success 200 {object} map[string]string "Share link revoked"
+// @Failure 400 {object} map[string]string "Invalid ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board or share link not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/share-links/{link_id} [delete]
+func (h *ShareLinkHandler) Revoke(c *gin.Context) {
+	_, boardID, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	linkID, err := uuid.Parse(c.Param("link_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid share link ID format"})
+		return
+	}
+
+	if err := h.shareLinkRepo.Revoke(c.Request.Context(), boardID, linkID); err != nil {
+		if err == repository.ErrShareLinkNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Share link not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke share link"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Share link revoked"})
+}
+
+// Accept godoc
+// @Summary Accept share link
+// @Description Joins the board of an invitation link with the link's role. Users who already have that role
+// @Description or a higher one keep their access and do not use up the link.
+// @Tags board-sharing
+// @Produce json
+// @Param token path string true "Share link token"
+// @Success 200 {object} AcceptShareLinkResponse "Board access granted"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 404 {object} map[string]string "Share link not found"
+// @Failure 410 {object} map[string]string "Share link revoked, expired or used up"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /share-links/{token}/accept [post]
+func (h *ShareLinkHandler) Accept(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var link *model.ShareLink
+	var role string
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var previous string
+		var err error
+		if link, role, previous, err = h.shareLinkRepo.Redeem(ctx, c.Param("token"), authenticatedUserID); err != nil {
+			return err
+		}
+		// Пользователь входит по ссылке сам, изменение записывается от его имени
+		return addShareEvent(ctx, h.outboxRepo, link.BoardID, authenticatedUserID, previous, role, authenticatedUserID)
+	})
+	if err != nil {
+		switch err {
+		case repository.ErrShareLinkNotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "Share link not found"})
+		case repository.ErrShareLinkInactive:
+			c.JSON(http.StatusGone, gin.H{"error": "Share link is revoked, expired or used up"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to accept share link"})
+		}
+		return
+	}
+	// Иначе закэшированный прежний доступ пользователя действовал бы до истечения кэша
+	h.perms.InvalidateBoard(c.Request.Context(), link.BoardID)
+
+	c.JSON(http.StatusOK, AcceptShareLinkResponse{
+		BoardID: link.BoardID.String(),
+		Role:    role,
+	})
+}
@@ -0,0 +1,494 @@
+package handler
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxSprintDays limits the length of a sprint, which bounds its burndown chart
+const MaxSprintDays = 90
+
+type SprintHandler struct {
+	sprintRepo *repository.SprintRepository
+	taskRepo   repository.TaskRepositoryInterface
+	perms      *permission.Service
+}
+
+func NewSprintHandler(
+	sprintRepo *repository.SprintRepository,
+	taskRepo repository.TaskRepositoryInterface,
+	perms *permission.Service,
+) *SprintHandler {
+	return &SprintHandler{
+		sprintRepo: sprintRepo,
+		taskRepo:   taskRepo,
+		perms:      perms,
+	}
+}
+
+// CreateSprintRequest represents the request body for adding a sprint to a board.
+// Dates are days in UTC; the sprint includes both.
+// @name CreateSprintRequest
+type CreateSprintRequest struct {
+	Name      string `json:"name" binding:"required,max=100"`
+	Goal      string `json:"goal" binding:"max=1000"`
+	StartDate string `json:"start_date" binding:"required" example:"2024-06-03"`
+	EndDate   string `json:"end_date" binding:"required" example:"2024-06-14"`
+}
+
+// SprintTasksRequest represents the request body for planning tasks into a sprint
+// @name SprintTasksRequest
+type SprintTasksRequest struct {
+	TaskIDs []string `json:"task_ids" binding:"required,min=1,max=100,dive,uuid"`
+}
+
+// SprintResponse represents a sprint with the totals of its tasks
+// @name SprintResponse
+type SprintResponse struct {
+	ID        string  `json:"id"`
+	BoardID   string  `json:"board_id"`
+	Name      string  `json:"name"`
+	Goal      string  `json:"goal"`
+	StartDate string  `json:"start_date"`
+	EndDate   string  `json:"end_date"`
+	ClosedAt  *string `json:"closed_at,omitempty"`
+	CreatedAt string  `json:"created_at"`
+
+	Tasks           int `json:"tasks"`
+	CompletedTasks  int `json:"completed_tasks"`
+	Points          int `json:"points"`
+	CompletedPoints int `json:"completed_points"`
+}
+
+// SprintBurndownDay represents the story points left at the end of a sprint day
+// @name SprintBurndownDay
+type SprintBurndownDay struct {
+	Date string `json:"date"`
+	// Remaining is null for days that have not ended yet and days after the sprint was closed
+	Remaining *int    `json:"remaining"`
+	Ideal     float64 `json:"ideal"`
+}
+
+// SprintBurndownResponse represents the burndown chart of a sprint. Points count the tasks
+// currently in the sprint; tasks without an estimate are counted separately.
+// @name SprintBurndownResponse
+type SprintBurndownResponse struct {
+	SprintID    string              `json:"sprint_id"`
+	TotalPoints int                 `json:"total_points"`
+	Unestimated int                 `json:"unestimated"`
+	Days        []SprintBurndownDay `json:"days"`
+}
+
+// GetByBoardID godoc
+// @Summary List board sprints
+// @Description Lists the sprints of a board, latest first, with the totals of their tasks
+// @Tags Sprints
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {array} SprintResponse "Board sprints"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/sprints [get]
+func (h *SprintHandler) GetByBoardID(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	sprints, err := h.sprintRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve sprints"})
+		return
+	}
+
+	ids := make([]uuid.UUID, len(sprints))
+	for i := range sprints {
+		ids[i] = sprints[i].ID
+	}
+	stats, err := h.sprintRepo.Stats(c.Request.Context(), ids)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve sprint totals"})
+		return
+	}
+
+	response := make([]SprintResponse, len(sprints))
+	for i := range sprints {
+		response[i] = newSprintResponse(&sprints[i], stats[sprints[i].ID])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Add a sprint
+// @Description Adds a sprint to a board. Sprints may last up to 90 days.
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param sprint body CreateSprintRequest true "Sprint information"
+// @Success 201 {object} SprintResponse "Sprint created successfully"
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/sprints [post]
+func (h *SprintHandler) Create(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req CreateSprintRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid start_date, expected YYYY-MM-DD"})
+		return
+	}
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid end_date, expected YYYY-MM-DD"})
+		return
+	}
+	if endDate.Before(startDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_date must not be before start_date"})
+		return
+	}
+	if endDate.After(startDate.AddDate(0, 0, MaxSprintDays-1)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Sprints may last up to 90 days"})
+		return
+	}
+
+	sprint := &model.Sprint{
+		BoardID:   board.ID,
+		Name:      req.Name,
+		Goal:      req.Goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	if err := h.sprintRepo.Create(c.Request.Context(), sprint); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create sprint"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newSprintResponse(sprint, repository.SprintStats{}))
+}
+
+// GetByID godoc
+// @Summary Get a sprint
+// @Description Returns a sprint with the totals of its tasks
+// @Tags Sprints
+// @Produce json
+// @Param id path string true "Sprint ID" format(uuid)
+// @Success 200 {object} SprintResponse "Sprint"
+// @Failure 400 {object} map[string]string "Invalid sprint ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Sprint not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /sprints/{id} [get]
+func (h *SprintHandler) GetByID(c *gin.Context) {
+	sprint, ok := h.accessibleSprint(c, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	h.respondWithSprint(c, sprint)
+}
+
+// Close godoc
+// @Summary Close a sprint
+// @Description Closes a sprint. Open tasks stay in it until they are planned into another sprint.
+// @Tags Sprints
+// @Produce json
+// @Param id path string true "Sprint ID" format(uuid)
+// @Success 200 {object} SprintResponse "Sprint closed successfully"
+// @Failure 400 {object} map[string]string "Invalid sprint ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Sprint not found"
+// @Failure 409 {object} map[string]string "Sprint is already closed"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/close [post]
+func (h *SprintHandler) Close(c *gin.Context) {
+	sprint, ok := h.accessibleSprint(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, sprint.BoardID) {
+		return
+	}
+
+	if err := h.sprintRepo.Close(c.Request.Context(), sprint); err != nil {
+		if err == repository.ErrSprintClosed {
+			c.JSON(http.StatusConflict, gin.H{"error": "Sprint is already closed"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to close sprint"})
+		}
+		return
+	}
+
+	h.respondWithSprint(c, sprint)
+}
+
+// AddTasks godoc
+// @Summary Plan tasks into a sprint
+// @Description Plans tasks of the sprint's board into the sprint, taking them out of any other sprint.
+// @Description Tasks of other boards are skipped.
+// @Tags Sprints
+// @Accept json
+// @Produce json
+// @Param id path string true "Sprint ID" format(uuid)
+// @Param tasks body SprintTasksRequest true "Tasks to plan"
+// @Success 200 {object} SprintResponse "Tasks planned successfully"
+// @Failure 400 {object} map[string]string "Invalid input or no tasks of the sprint's board"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Sprint not found"
+// @Failure 409 {object} map[string]string "Sprint is closed"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/tasks [post]
+func (h *SprintHandler) AddTasks(c *gin.Context) {
+	sprint, ok := h.accessibleSprint(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, sprint.BoardID) {
+		return
+	}
+
+	var req SprintTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if sprint.Closed() {
+		c.JSON(http.StatusConflict, gin.H{"error": "Sprint is closed"})
+		return
+	}
+
+	taskIDs := make([]uuid.UUID, len(req.TaskIDs))
+	for i, id := range req.TaskIDs {
+		taskIDs[i], _ = uuid.Parse(id)
+	}
+
+	planned, err := h.taskRepo.SetSprint(c.Request.Context(), taskIDs, sprint)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to plan tasks"})
+		return
+	}
+
+	if planned == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "None of the tasks belong to the sprint's board"})
+		return
+	}
+
+	h.respondWithSprint(c, sprint)
+}
+
+// RemoveTask godoc
+// @Summary Take a task out of a sprint
+// @Description Takes a task out of a sprint back to the board's backlog
+// @Tags Sprints
+// @Produce json
+// @Param id path string true "Sprint ID" format(uuid)
+// @Param task_id path string true "Task ID" format(uuid)
+// @Success 200 {object} SprintResponse "Task removed successfully"
+// @Failure 400 {object} map[string]string "Invalid sprint or task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Sprint not found or task not in it"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/tasks/{task_id} [delete]
+func (h *SprintHandler) RemoveTask(c *gin.Context) {
+	sprint, ok := h.accessibleSprint(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, sprint.BoardID) {
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("task_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	if err := h.taskRepo.RemoveFromSprint(c.Request.Context(), taskID, sprint.ID); err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task is not in the sprint"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove task from sprint"})
+		}
+		return
+	}
+
+	h.respondWithSprint(c, sprint)
+}
+
+// GetBurndown godoc
+// @Summary Get sprint burndown
+// @Description Returns the story points left at the end of every sprint day next to an ideal straight line.
+// @Description Days are in UTC and remaining points are only given for days that have ended or are under way.
+// @Tags Sprints
+// @Produce json
+// @Param id path string true "Sprint ID" format(uuid)
+// @Success 200 {object} SprintBurndownResponse "Sprint burndown"
+// @Failure 400 {object} map[string]string "Invalid sprint ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Sprint not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /sprints/{id}/burndown [get]
+func (h *SprintHandler) GetBurndown(c *gin.Context) {
+	sprint, ok := h.accessibleSprint(c, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.taskRepo.GetBySprintID(c.Request.Context(), sprint.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve sprint tasks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, buildBurndown(sprint, tasks, time.Now().UTC().Truncate(24*time.Hour)))
+}
+
+// buildBurndown lists the story points of the tasks still open at the end of every sprint day
+// up to today or the day the sprint was closed, and an ideal line from all points to zero
+func buildBurndown(sprint *model.Sprint, tasks []model.Task, today time.Time) SprintBurndownResponse {
+	response := SprintBurndownResponse{SprintID: sprint.ID.String(), Days: []SprintBurndownDay{}}
+	for _, task := range tasks {
+		if task.Estimate == nil {
+			response.Unestimated++
+			continue
+		}
+		response.TotalPoints += *task.Estimate
+	}
+
+	last := today
+	if sprint.ClosedAt != nil {
+		if closed := sprint.ClosedAt.UTC().Truncate(24 * time.Hour); closed.Before(last) {
+			last = closed
+		}
+	}
+
+	days := int(sprint.EndDate.Sub(sprint.StartDate).Hours()/24) + 1
+	for i := 0; i < days; i++ {
+		day := sprint.StartDate.AddDate(0, 0, i)
+		point := SprintBurndownDay{Date: day.Format("2006-01-02")}
+		if days > 1 {
+			ideal := float64(response.TotalPoints) * float64(days-1-i) / float64(days-1)
+			point.Ideal = math.Round(ideal*100) / 100
+		}
+
+		if !day.After(last) {
+			end := day.AddDate(0, 0, 1)
+			remaining := response.TotalPoints
+			for _, task := range tasks {
+				if task.Estimate != nil && task.CompletedAt != nil && task.CompletedAt.Before(end) {
+					remaining -= *task.Estimate
+				}
+			}
+			point.Remaining = &remaining
+		}
+		response.Days = append(response.Days, point)
+	}
+	return response
+}
+
+// respondWithSprint writes the sprint with fresh totals of its tasks
+func (h *SprintHandler) respondWithSprint(c *gin.Context, sprint *model.Sprint) {
+	stats, err := h.sprintRepo.Stats(c.Request.Context(), []uuid.UUID{sprint.ID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve sprint totals"})
+		return
+	}
+
+	c.JSON(http.StatusOK, newSprintResponse(sprint, stats[sprint.ID]))
+}
+
+// accessibleSprint loads the sprint from the path and checks that the user has the role
+// on its board. On failure the response is already written.
+func (h *SprintHandler) accessibleSprint(c *gin.Context, role string) (*model.Sprint, bool) {
+	sprintID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid sprint ID format"})
+		return nil, false
+	}
+
+	sprint, err := h.sprintRepo.GetByID(c.Request.Context(), sprintID)
+	if err != nil {
+		if err == repository.ErrSprintNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Sprint not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve sprint"})
+		}
+		return nil, false
+	}
+
+	if _, ok := accessibleBoardByID(c, h.perms, sprint.BoardID, role); !ok {
+		return nil, false
+	}
+	return sprint, true
+}
+
+func newSprintResponse(sprint *model.Sprint, stats repository.SprintStats) SprintResponse {
+	response := SprintResponse{
+		ID:        sprint.ID.String(),
+		BoardID:   sprint.BoardID.String(),
+		Name:      sprint.Name,
+		Goal:      sprint.Goal,
+		StartDate: sprint.StartDate.Format("2006-01-02"),
+		EndDate:   sprint.EndDate.Format("2006-01-02"),
+		CreatedAt: sprint.CreatedAt.Format(time.RFC3339),
+
+		Tasks:           stats.Tasks,
+		CompletedTasks:  stats.CompletedTasks,
+		Points:          stats.Points,
+		CompletedPoints: stats.CompletedPoints,
+	}
+	if sprint.ClosedAt != nil {
+		closedAt := sprint.ClosedAt.Format(time.RFC3339)
+		response.ClosedAt = &closedAt
+	}
+	return response
+}
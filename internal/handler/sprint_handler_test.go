@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBurndown(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 6, d, 0, 0, 0, 0, time.UTC) }
+	points := func(n int) *int { return &n }
+	at := func(t time.Time) *time.Time { return &t }
+
+	sprint := &model.Sprint{ID: uuid.New(), StartDate: day(3), EndDate: day(7)}
+	tasks := []model.Task{
+		{Estimate: points(5), CompletedAt: at(day(4).Add(15 * time.Hour))},
+		{Estimate: points(3), CompletedAt: at(day(5).Add(time.Hour))},
+		{Estimate: points(4)},
+		{},
+	}
+
+	response := buildBurndown(sprint, tasks, day(5))
+	assert.Equal(t, 12, response.TotalPoints)
+	assert.Equal(t, 1, response.Unestimated)
+	require.Len(t, response.Days, 5)
+
+	assert.Equal(t, "2024-06-03", response.Days[0].Date)
+	assert.Equal(t, 12.0, response.Days[0].Ideal)
+	assert.Equal(t, 3.0, response.Days[3].Ideal)
+	assert.Equal(t, 0.0, response.Days[4].Ideal)
+
+	// Оставшиеся очки считаются на конец дня и только до сегодняшнего дня
+	require.NotNil(t, response.Days[0].Remaining)
+	assert.Equal(t, 12, *response.Days[0].Remaining)
+	assert.Equal(t, 7, *response.Days[1].Remaining)
+	assert.Equal(t, 4, *response.Days[2].Remaining)
+	assert.Nil(t, response.Days[3].Remaining)
+
+	// После закрытия спринта оставшиеся очки больше не считаются
+	sprint.ClosedAt = at(day(4).Add(18 * time.Hour))
+	response = buildBurndown(sprint, tasks, day(10))
+	assert.NotNil(t, response.Days[1].Remaining)
+	assert.Nil(t, response.Days[2].Remaining)
+}
@@ -0,0 +1,293 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type StandupHandler struct {
+	taskRepo       repository.TaskRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+}
+
+func NewStandupHandler(
+	taskRepo repository.TaskRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+) *StandupHandler {
+	return &StandupHandler{
+		taskRepo:       taskRepo,
+		columnRepo:     columnRepo,
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+	}
+}
+
+// StandupTask represents a task listed in a standup summary
+// @name StandupTask
+type StandupTask struct {
+	ID            string  `json:"id"`
+	Key           string  `json:"key"`
+	Title         string  `json:"title"`
+	Column        string  `json:"column"`
+	AssignedTo    *string `json:"assigned_to,omitempty"`
+	AssigneeName  *string `json:"assignee_name,omitempty"`
+	CompletedAt   *string `json:"completed_at,omitempty"`
+	BlockedReason string  `json:"blocked_reason,omitempty"`
+}
+
+// StandupAssignee groups in-progress tasks of one assignee; AssignedTo is empty for unassigned tasks
+// @name StandupAssignee
+type StandupAssignee struct {
+	AssignedTo   *string       `json:"assigned_to,omitempty"`
+	AssigneeName string        `json:"assignee_name"`
+	Tasks        []StandupTask `json:"tasks"`
+}
+
+// StandupResponse represents the daily standup summary of a board
+// @name StandupResponse
+type StandupResponse struct {
+	BoardID            string            `json:"board_id"`
+	BoardTitle         string            `json:"board_title"`
+	Date               string            `json:"date"`
+	CompletedYesterday []StandupTask     `json:"completed_yesterday"`
+	InProgress         []StandupAssignee `json:"in_progress"`
+	Blockers           []StandupTask     `json:"blockers"`
+}
+
+// GetStandup godoc
+// @Summary Get daily standup summary
+// @Description Summarizes a board for a standup: tasks completed the day before, in-progress tasks per assignee
+// @Description (open tasks outside the first and the done columns) and blocked tasks.
+// @Description With format=text the summary is returned as plain text for chat bots.
+// @Tags Boards
+// @Produce json
+// @Produce plain
+// @Param id path string true "Board ID" format(uuid)
+// @Param date query string false "Standup day in YYYY-MM-DD (UTC), defaults to today"
+// @Param format query string false "Response format" Enums(json, text)
+// @Success 200 {object} StandupResponse "Standup summary"
+// @Failure 400 {object} map[string]string "Invalid board ID or date"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 429 {object} map[string]interface{} "Operation rate limit exceeded"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/standup [get]
+func (h *StandupHandler) GetStandup(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return
+	}
+
+	day := time.Now().UTC().Truncate(24 * time.Hour)
+	if date := c.Query("date"); date != "" {
+		day, err = time.Parse("2006-01-02", date)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid date, expected YYYY-MM-DD"})
+			return
+		}
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), boardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have access to this board"})
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	tasks, err := h.taskRepo.GetByBoardID(c.Request.Context(), boardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return
+	}
+
+	response := buildStandup(board, columns, tasks, day)
+
+	if c.Query("format") == "text" {
+		c.String(http.StatusOK, response.Text())
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// buildStandup sorts the board's tasks into the standup sections for the given day.
+// The first column is treated as the backlog, so its open tasks are not in progress.
+func buildStandup(board *model.Board, columns []model.Column, tasks []model.Task, day time.Time) StandupResponse {
+	response := StandupResponse{
+		BoardID:            board.ID.String(),
+		BoardTitle:         board.Title,
+		Date:               day.Format("2006-01-02"),
+		CompletedYesterday: []StandupTask{},
+		InProgress:         []StandupAssignee{},
+		Blockers:           []StandupTask{},
+	}
+
+	var backlogID uuid.UUID
+	if len(columns) > 0 {
+		backlogID = columns[0].ID
+	}
+
+	yesterday := day.AddDate(0, 0, -1)
+	groups := make(map[uuid.UUID]int)
+	for i := range tasks {
+		task := &tasks[i]
+		item := newStandupTask(board, task)
+
+		if task.Column.IsDone || task.CompletedAt != nil {
+			if task.CompletedAt != nil && !task.CompletedAt.Before(yesterday) && task.CompletedAt.Before(day) {
+				response.CompletedYesterday = append(response.CompletedYesterday, item)
+			}
+			continue
+		}
+
+		if task.Blocked {
+			response.Blockers = append(response.Blockers, item)
+		}
+
+		if task.ColumnID == backlogID {
+			continue
+		}
+
+		var assigneeID uuid.UUID
+		if task.AssignedTo != nil {
+			assigneeID = *task.AssignedTo
+		}
+		idx, ok := groups[assigneeID]
+		if !ok {
+			group := StandupAssignee{AssignedTo: item.AssignedTo, AssigneeName: "Unassigned"}
+			if item.AssigneeName != nil {
+				group.AssigneeName = *item.AssigneeName
+			}
+			response.InProgress = append(response.InProgress, group)
+			idx = len(response.InProgress) - 1
+			groups[assigneeID] = idx
+		}
+		response.InProgress[idx].Tasks = append(response.InProgress[idx].Tasks, item)
+	}
+
+	// Assignees alphabetically, unassigned tasks last
+	sort.SliceStable(response.InProgress, func(i, j int) bool {
+		a, b := response.InProgress[i], response.InProgress[j]
+		if (a.AssignedTo == nil) != (b.AssignedTo == nil) {
+			return b.AssignedTo == nil
+		}
+		return a.AssigneeName < b.AssigneeName
+	})
+
+	return response
+}
+
+func newStandupTask(board *model.Board, task *model.Task) StandupTask {
+	item := StandupTask{
+		ID:            task.ID.String(),
+		Key:           taskKey(board, task),
+		Title:         task.Title,
+		Column:        task.Column.Title,
+		BlockedReason: task.BlockedReason,
+	}
+
+	if task.AssignedTo != nil {
+		assignedTo := task.AssignedTo.String()
+		item.AssignedTo = &assignedTo
+		item.AssigneeName = &task.Assignee.Name
+	}
+
+	if task.CompletedAt != nil {
+		completedAt := task.CompletedAt.Format(time.RFC3339)
+		item.CompletedAt = &completedAt
+	}
+
+	return item
+}
+
+// Text renders the summary as plain text suitable for posting to a chat
+func (s StandupResponse) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Standup for %s, %s\n", s.BoardTitle, s.Date)
+
+	b.WriteString("\nDone yesterday:\n")
+	if len(s.CompletedYesterday) == 0 {
+		b.WriteString("  nothing\n")
+	}
+	for _, t := range s.CompletedYesterday {
+		fmt.Fprintf(&b, "  - %s %s", t.Key, t.Title)
+		if t.AssigneeName != nil {
+			fmt.Fprintf(&b, " (%s)", *t.AssigneeName)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\nIn progress:\n")
+	if len(s.InProgress) == 0 {
+		b.WriteString("  nothing\n")
+	}
+	for _, g := range s.InProgress {
+		fmt.Fprintf(&b, "  %s:\n", g.AssigneeName)
+		for _, t := range g.Tasks {
+			fmt.Fprintf(&b, "    - %s %s [%s]\n", t.Key, t.Title, t.Column)
+		}
+	}
+
+	b.WriteString("\nBlockers:\n")
+	if len(s.Blockers) == 0 {
+		b.WriteString("  none\n")
+	}
+	for _, t := range s.Blockers {
+		fmt.Fprintf(&b, "  - %s %s", t.Key, t.Title)
+		if t.BlockedReason != "" {
+			fmt.Fprintf(&b, ": %s", t.BlockedReason)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
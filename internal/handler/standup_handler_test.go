@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildStandup(t *testing.T) {
+	board := &model.Board{ID: uuid.New(), Title: "Platform", Key: "PLAT"}
+	todo := model.Column{ID: uuid.New(), Title: "To Do", Position: 1}
+	doing := model.Column{ID: uuid.New(), Title: "Doing", Position: 2}
+	done := model.Column{ID: uuid.New(), Title: "Done", Position: 3, IsDone: true}
+
+	alice := model.User{ID: uuid.New(), Name: "Alice"}
+	bob := model.User{ID: uuid.New(), Name: "Bob"}
+
+	day := time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC)
+	yesterday := day.Add(-3 * time.Hour)
+	lastWeek := day.AddDate(0, 0, -7)
+
+	task := func(number int, column model.Column, assignee *model.User) model.Task {
+		t := model.Task{ID: uuid.New(), Number: number, Title: "task", ColumnID: column.ID, Column: column}
+		if assignee != nil {
+			t.AssignedTo = &assignee.ID
+			t.Assignee = *assignee
+		}
+		return t
+	}
+
+	backlog := task(1, todo, &alice)
+	blockedBacklog := task(2, todo, nil)
+	blockedBacklog.Blocked = true
+	blockedBacklog.BlockedReason = "waiting for design"
+	bobWork := task(3, doing, &bob)
+	aliceWork := task(4, doing, &alice)
+	unassignedWork := task(5, doing, nil)
+	doneYesterday := task(6, done, &bob)
+	doneYesterday.CompletedAt = &yesterday
+	doneLastWeek := task(7, done, &alice)
+	doneLastWeek.CompletedAt = &lastWeek
+
+	columns := []model.Column{todo, doing, done}
+	tasks := []model.Task{backlog, blockedBacklog, bobWork, aliceWork, unassignedWork, doneYesterday, doneLastWeek}
+
+	standup := buildStandup(board, columns, tasks, day)
+
+	assert.Equal(t, "2024-05-14", standup.Date)
+
+	require.Len(t, standup.CompletedYesterday, 1)
+	assert.Equal(t, "PLAT-6", standup.CompletedYesterday[0].Key)
+
+	require.Len(t, standup.Blockers, 1)
+	assert.Equal(t, "PLAT-2", standup.Blockers[0].Key)
+	assert.Equal(t, "waiting for design", standup.Blockers[0].BlockedReason)
+
+	// Задачи из первой колонки не считаются начатыми, неназначенные идут последними
+	require.Len(t, standup.InProgress, 3)
+	assert.Equal(t, "Alice", standup.InProgress[0].AssigneeName)
+	assert.Equal(t, "PLAT-4", standup.InProgress[0].Tasks[0].Key)
+	assert.Equal(t, "Bob", standup.InProgress[1].AssigneeName)
+	assert.Nil(t, standup.InProgress[2].AssignedTo)
+	assert.Equal(t, "PLAT-5", standup.InProgress[2].Tasks[0].Key)
+
+	assert.Contains(t, standup.Text(), "PLAT-2 task: waiting for design")
+}
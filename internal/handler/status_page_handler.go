@@ -0,0 +1,490 @@
+package handler
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"slices"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StatusPageMaxAge is how long clients and proxies may cache a public status page
+const StatusPageMaxAge = time.Minute
+
+type StatusPageHandler struct {
+	statusPageRepo  *repository.StatusPageRepository
+	boardRepo       repository.BoardRepositoryInterface
+	columnRepo      repository.ColumnRepositoryInterface
+	taskRepo        repository.TaskRepositoryInterface
+	boardChangeRepo *repository.BoardChangeRepository
+}
+
+func NewStatusPageHandler(
+	statusPageRepo *repository.StatusPageRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	boardChangeRepo *repository.BoardChangeRepository,
+) *StatusPageHandler {
+	return &StatusPageHandler{
+		statusPageRepo:  statusPageRepo,
+		boardRepo:       boardRepo,
+		columnRepo:      columnRepo,
+		taskRepo:        taskRepo,
+		boardChangeRepo: boardChangeRepo,
+	}
+}
+
+// StatusPageRequest represents the settings of a board's public status page
+// @name StatusPageRequest
+type StatusPageRequest struct {
+	// Title shown on the page, the board title by default
+	Title string `json:"title" binding:"max=200"`
+	// ColumnIDs are the published columns; they are shown in board order
+	ColumnIDs []string `json:"column_ids" binding:"required,min=1,dive,uuid"`
+}
+
+// StatusPageResponse represents the settings of a board's public status page
+// @name StatusPageResponse
+type StatusPageResponse struct {
+	ID        string   `json:"id"`
+	BoardID   string   `json:"board_id"`
+	Slug      string   `json:"slug"`
+	Title     string   `json:"title"`
+	ColumnIDs []string `json:"column_ids"`
+	// Path of the public JSON page; the HTML page is served under Path + "/html"
+	Path      string `json:"path"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// PublicStatusLabel represents a label of a task on a public status page
+// @name PublicStatusLabel
+type PublicStatusLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// PublicStatusTask represents a task on a public status page. Descriptions and people are not published.
+// @name PublicStatusTask
+type PublicStatusTask struct {
+	Key         string              `json:"key"`
+	Title       string              `json:"title"`
+	Labels      []PublicStatusLabel `json:"labels"`
+	DueDate     *string             `json:"due_date,omitempty"`
+	CompletedAt *string             `json:"completed_at,omitempty"`
+}
+
+// PublicStatusColumn represents a published column with its tasks in board order
+// @name PublicStatusColumn
+type PublicStatusColumn struct {
+	Title string             `json:"title"`
+	Tasks []PublicStatusTask `json:"tasks"`
+}
+
+// PublicStatusPageResponse represents a public status page
+// @name PublicStatusPageResponse
+type PublicStatusPageResponse struct {
+	Title   string               `json:"title"`
+	Columns []PublicStatusColumn `json:"columns"`
+}
+
+// Get godoc
+// @Summary Get board status page settings
+// @Description Returns the settings of the board's public status page (owner only)
+// @Tags Status pages
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {object} StatusPageResponse "Status page settings"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found or status page not published"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/status-page [get]
+func (h *StatusPageHandler) Get(c *gin.Context) {
+	_, board, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.statusPageRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		if err == repository.ErrStatusPageNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Status page not published"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve status page"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, newStatusPageResponse(page))
+}
+
+// Publish godoc
+// @Summary Publish board status page
+// @Description Publishes the tasks of the selected columns as a read-only status page that anyone with its link
+// @Description can open without signing in, or updates the title and columns of the published page. The page
+// @Description shows task keys, titles, labels, due and completion dates only (owner only).
+// @Tags Status pages
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param input body StatusPageRequest true "Status page settings"
+// @Success 200 {object} StatusPageResponse "Status page updated"
+// @Success 201 {object} StatusPageResponse "Status page published"
+// @Failure 400 {object} map[string]string "Invalid request or column of another board"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/status-page [put]
+func (h *StatusPageHandler) Publish(c *gin.Context) {
+	authenticatedUserID, board, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	var req StatusPageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	requested := make(map[uuid.UUID]bool, len(req.ColumnIDs))
+	for _, id := range req.ColumnIDs {
+		requested[uuid.MustParse(id)] = true
+	}
+	var columnIDs []uuid.UUID
+	for _, column := range columns {
+		if requested[column.ID] {
+			columnIDs = append(columnIDs, column.ID)
+		}
+	}
+	if len(columnIDs) != len(requested) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "All columns must belong to the board"})
+		return
+	}
+
+	title := req.Title
+	if title == "" {
+		title = board.Title
+	}
+
+	page, err := h.statusPageRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil && err != repository.ErrStatusPageNotFound {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve status page"})
+		return
+	}
+
+	if page != nil {
+		page.Title = title
+		page.ColumnIDs = columnIDs
+		if err := h.statusPageRepo.Update(c.Request.Context(), page); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update status page"})
+			return
+		}
+		c.JSON(http.StatusOK, newStatusPageResponse(page))
+		return
+	}
+
+	slug := make([]byte, 16)
+	if _, err := rand.Read(slug); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate status page slug"})
+		return
+	}
+
+	page = &model.StatusPage{
+		BoardID:   board.ID,
+		Slug:      base64.RawURLEncoding.EncodeToString(slug),
+		Title:     title,
+		ColumnIDs: columnIDs,
+		CreatedBy: authenticatedUserID,
+	}
+	if err := h.statusPageRepo.Create(c.Request.Context(), page); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to publish status page"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newStatusPageResponse(page))
+}
+
+// Unpublish godoc
+// @Summary Unpublish board status page
+// @Description Removes the board's public status page; its link stops working (owner only)
+// @Tags Status pages
+// @Param id path string true "Board ID" format(uuid)
+// @Success 204 "Status page removed"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Not board owner"
+// @Failure 404 {object} map[string]string "Board not found or status page not published"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/status-page [delete]
+func (h *StatusPageHandler) Unpublish(c *gin.Context) {
+	_, board, ok := h.ownedBoard(c)
+	if !ok {
+		return
+	}
+
+	if err := h.statusPageRepo.DeleteByBoardID(c.Request.Context(), board.ID); err != nil {
+		if err == repository.ErrStatusPageNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Status page not published"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove status page"})
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetPublic godoc
+// @Summary Get public status page
+// @Description Returns the published columns of a board with their tasks. No authentication is needed; responses
+// @Description carry an ETag and may be cached for a minute.
+// @Tags Status pages
+// @Produce json
+// @Param slug path string true "Status page slug"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} PublicStatusPageResponse "Status page"
+// @Success 304 "Cached copy is current"
+// @Failure 404 {object} map[string]string "Status page not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /status/{slug} [get]
+func (h *StatusPageHandler) GetPublic(c *gin.Context) {
+	page, ok := h.publicPage(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// GetPublicHTML godoc
+// @Summary Get public status page as HTML
+// @Description Renders the public status page as a simple HTML page. No authentication is needed.
+// @Tags Status pages
+// @Produce html
+// @Param slug path string true "Status page slug"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {string} string "Status page"
+// @Success 304 "Cached copy is current"
+// @Failure 404 {object} map[string]string "Status page not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Router /status/{slug}/html [get]
+func (h *StatusPageHandler) GetPublicHTML(c *gin.Context) {
+	page, ok := h.publicPage(c)
+	if !ok {
+		return
+	}
+
+	var body bytes.Buffer
+	if err := statusPageTemplate.Execute(&body, page); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to render status page"})
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", body.Bytes())
+}
+
+// publicPage loads the status page of the slug in the path with the tasks of its columns.
+// Columns deleted or moved to another board since publishing are left out. Proxies may cache
+// the page for StatusPageMaxAge; a request for an unchanged page is answered with 304 Not
+// Modified before the tasks are loaded, and false is returned.
+func (h *StatusPageHandler) publicPage(c *gin.Context) (*PublicStatusPageResponse, bool) {
+	page, err := h.statusPageRepo.GetBySlug(c.Request.Context(), c.Param("slug"))
+	if err != nil {
+		if err == repository.ErrStatusPageNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Status page not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve status page"})
+		}
+		return nil, false
+	}
+
+	version, err := h.boardChangeRepo.Version(c.Request.Context(), []uuid.UUID{page.BoardID}, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve status page version"})
+		return nil, false
+	}
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(StatusPageMaxAge.Seconds()))
+	if notModified(c, cacheControl, page.ID, page.UpdatedAt, version) {
+		return nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), page.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return nil, false
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), page.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return nil, false
+	}
+	columns = slices.DeleteFunc(columns, func(column model.Column) bool {
+		return !slices.Contains(page.ColumnIDs, column.ID)
+	})
+
+	columnIDs := make([]uuid.UUID, len(columns))
+	for i := range columns {
+		columnIDs[i] = columns[i].ID
+	}
+
+	tasks, err := h.taskRepo.GetByColumnIDs(c.Request.Context(), columnIDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return nil, false
+	}
+
+	return buildPublicStatusPage(page.Title, board, columns, tasks), true
+}
+
+// buildPublicStatusPage lays out the tasks by column; tasks and columns keep their order
+func buildPublicStatusPage(title string, board *model.Board, columns []model.Column, tasks []model.Task) *PublicStatusPageResponse {
+	response := &PublicStatusPageResponse{Title: title, Columns: make([]PublicStatusColumn, len(columns))}
+	index := make(map[uuid.UUID]int, len(columns))
+	for i, column := range columns {
+		response.Columns[i] = PublicStatusColumn{Title: column.Title, Tasks: []PublicStatusTask{}}
+		index[column.ID] = i
+	}
+
+	for i := range tasks {
+		task := &tasks[i]
+		column, ok := index[task.ColumnID]
+		if !ok {
+			continue
+		}
+
+		item := PublicStatusTask{
+			Key:    taskKey(board, task),
+			Title:  task.Title,
+			Labels: make([]PublicStatusLabel, len(task.Labels)),
+		}
+		for j, label := range task.Labels {
+			item.Labels[j] = PublicStatusLabel{Name: label.Name, Color: label.Color}
+		}
+		if task.DueDate != nil {
+			dueDate := task.DueDate.Format("2006-01-02")
+			item.DueDate = &dueDate
+		}
+		if task.CompletedAt != nil {
+			completedAt := task.CompletedAt.Format(time.RFC3339)
+			item.CompletedAt = &completedAt
+		}
+		response.Columns[column].Tasks = append(response.Columns[column].Tasks, item)
+	}
+	return response
+}
+
+// ownedBoard resolves the authenticated user and the board from the request and checks that
+// the user owns the board. It writes the error response and returns false otherwise.
+func (h *StatusPageHandler) ownedBoard(c *gin.Context) (uuid.UUID, *model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	boardID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
+		return uuid.Nil, nil, false
+	}
+
+	board, err := h.boardRepo.GetByID(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return uuid.Nil, nil, false
+	}
+
+	if board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the board owner can manage its status page"})
+		return uuid.Nil, nil, false
+	}
+
+	return authenticatedUserID, board, true
+}
+
+func newStatusPageResponse(page *model.StatusPage) StatusPageResponse {
+	response := StatusPageResponse{
+		ID:        page.ID.String(),
+		BoardID:   page.BoardID.String(),
+		Slug:      page.Slug,
+		Title:     page.Title,
+		ColumnIDs: make([]string, len(page.ColumnIDs)),
+		Path:      "/status/" + page.Slug,
+		CreatedAt: page.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: page.UpdatedAt.Format(time.RFC3339),
+	}
+	for i, id := range page.ColumnIDs {
+		response.ColumnIDs[i] = id.String()
+	}
+	return response
+}
+
+var statusPageTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2rem; color: #1f2328; }
+main { display: flex; gap: 1.5rem; align-items: flex-start; flex-wrap: wrap; }
+section { flex: 1 1 16rem; background: #f6f8fa; border-radius: 8px; padding: 1rem; }
+ul { list-style: none; padding: 0; margin: 0; }
+li { background: #fff; border-radius: 6px; padding: .6rem .8rem; margin-top: .5rem; box-shadow: 0 1px 2px rgba(0,0,0,.08); }
+.key { color: #656d76; font-size: .85em; margin-right: .4rem; }
+.label { display: inline-block; font-size: .75em; color: #fff; border-radius: 1em; padding: 0 .5em; margin: .3rem .3rem 0 0; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<main>
+{{- range .Columns}}
+<section>
+<h2>{{.Title}} ({{len .Tasks}})</h2>
+<ul>
+{{- range .Tasks}}
+<li><span class="key">{{.Key}}</span>{{.Title}}
+{{- if .Labels}}<div>{{range .Labels}}<span class="label" style="background: {{.Color}}">{{.Name}}</span>{{end}}</div>{{end}}</li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+</main>
+</body>
+</html>
+`))
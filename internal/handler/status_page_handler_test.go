@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPublicStatusPage(t *testing.T) {
+	board := &model.Board{ID: uuid.New(), Key: "ROAD"}
+	planned := model.Column{ID: uuid.New(), Title: "Planned", Position: 1}
+	shipped := model.Column{ID: uuid.New(), Title: "Shipped", Position: 3}
+	completedAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tasks := []model.Task{
+		{ID: uuid.New(), ColumnID: planned.ID, Number: 4, Title: "Dark mode", Labels: []model.Label{{Name: "UI", Color: "#0969da"}}},
+		// Задача неопубликованной колонки не попадает на страницу
+		{ID: uuid.New(), ColumnID: uuid.New(), Number: 5, Title: "Secret"},
+		{ID: uuid.New(), ColumnID: shipped.ID, Number: 1, Title: "Export", CompletedAt: &completedAt},
+	}
+
+	page := buildPublicStatusPage("Roadmap", board, []model.Column{planned, shipped}, tasks)
+
+	assert.Equal(t, "Roadmap", page.Title)
+	require.Len(t, page.Columns, 2)
+	assert.Equal(t, "Planned", page.Columns[0].Title)
+	require.Len(t, page.Columns[0].Tasks, 1)
+	assert.Equal(t, "ROAD-4", page.Columns[0].Tasks[0].Key)
+	assert.Equal(t, []PublicStatusLabel{{Name: "UI", Color: "#0969da"}}, page.Columns[0].Tasks[0].Labels)
+	require.Len(t, page.Columns[1].Tasks, 1)
+	assert.Equal(t, "2026-03-02T10:00:00Z", *page.Columns[1].Tasks[0].CompletedAt)
+
+	// Названия задач экранируются в HTML-версии
+	page.Columns[0].Tasks[0].Title = "<script>alert(1)</script>"
+	var html bytes.Buffer
+	require.NoError(t, statusPageTemplate.Execute(&html, page))
+	assert.NotContains(t, html.String(), "<script>")
+	assert.Contains(t, html.String(), "ROAD-4")
+	assert.Contains(t, html.String(), "background: #0969da")
+}
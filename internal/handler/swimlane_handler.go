@@ -0,0 +1,597 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxSwimlanesPerBoard limits the number of swimlanes on a board
+const MaxSwimlanesPerBoard = 20
+
+type SwimlaneHandler struct {
+	swimlaneRepo    *repository.SwimlaneRepository
+	columnRepo      repository.ColumnRepositoryInterface
+	taskRepo        repository.TaskRepositoryInterface
+	relationRepo    *repository.TaskRelationRepository
+	prefsRepo       *repository.UserBoardPrefsRepository
+	boardChangeRepo *repository.BoardChangeRepository
+	perms           *permission.Service
+}
+
+func NewSwimlaneHandler(
+	swimlaneRepo *repository.SwimlaneRepository,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	relationRepo *repository.TaskRelationRepository,
+	prefsRepo *repository.UserBoardPrefsRepository,
+	boardChangeRepo *repository.BoardChangeRepository,
+	perms *permission.Service,
+) *SwimlaneHandler {
+	return &SwimlaneHandler{
+		swimlaneRepo:    swimlaneRepo,
+		columnRepo:      columnRepo,
+		taskRepo:        taskRepo,
+		relationRepo:    relationRepo,
+		prefsRepo:       prefsRepo,
+		boardChangeRepo: boardChangeRepo,
+		perms:           perms,
+	}
+}
+
+// CreateSwimlaneRequest represents the request body for adding a swimlane to a board
+// @name CreateSwimlaneRequest
+type CreateSwimlaneRequest struct {
+	Title string `json:"title" binding:"required,max=255"`
+}
+
+// UpdateSwimlaneRequest represents the request body for renaming or moving a swimlane
+// @name UpdateSwimlaneRequest
+type UpdateSwimlaneRequest struct {
+	Title    string `json:"title" binding:"omitempty,max=255"`
+	Position int    `json:"position"`
+}
+
+// SetTaskSwimlaneRequest represents the request body for moving a task to a swimlane;
+// a null swimlane_id moves it to the default lane
+// @name SetTaskSwimlaneRequest
+type SetTaskSwimlaneRequest struct {
+	SwimlaneID *string `json:"swimlane_id" binding:"omitempty,uuid"`
+}
+
+// SwimlaneResponse represents a swimlane of a board
+// @name SwimlaneResponse
+type SwimlaneResponse struct {
+	ID        string `json:"id"`
+	BoardID   string `json:"board_id"`
+	Title     string `json:"title"`
+	Position  int    `json:"position"`
+	CreatedAt string `json:"created_at"`
+}
+
+// FullBoardCell holds the tasks of one column within a swimlane
+// @name FullBoardCell
+type FullBoardCell struct {
+	ColumnID string         `json:"column_id"`
+	Tasks    []TaskResponse `json:"tasks"`
+}
+
+// FullBoardLane represents a swimlane with its tasks by column. The default lane holding
+// tasks without a swimlane has no ID and comes last.
+// @name FullBoardLane
+type FullBoardLane struct {
+	ID       *string         `json:"id"`
+	Title    string          `json:"title"`
+	Position int             `json:"position"`
+	Default  bool            `json:"default"`
+	Columns  []FullBoardCell `json:"columns"`
+}
+
+// FullBoardResponse represents a board with its columns and its tasks grouped by swimlane
+// @name FullBoardResponse
+type FullBoardResponse struct {
+	Board BoardResponse `json:"board"`
+	// CardLayout is the board's choice of fields to show on task cards
+	CardLayout CardLayoutResponse `json:"card_layout"`
+	Columns    []ColumnResponse   `json:"columns"`
+	Swimlanes  []FullBoardLane    `json:"swimlanes"`
+}
+
+// GetByBoardID godoc
+// @Summary List board swimlanes
+// @Description Lists the swimlanes of a board ordered by position
+// @Tags Swimlanes
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {array} SwimlaneResponse "Board swimlanes"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/swimlanes [get]
+func (h *SwimlaneHandler) GetByBoardID(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	swimlanes, err := h.swimlaneRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve swimlanes"})
+		return
+	}
+
+	response := make([]SwimlaneResponse, len(swimlanes))
+	for i := range swimlanes {
+		response[i] = newSwimlaneResponse(&swimlanes[i])
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Add a swimlane
+// @Description Adds a swimlane after the last swimlane of the board
+// @Tags Swimlanes
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param swimlane body CreateSwimlaneRequest true "Swimlane information"
+// @Success 201 {object} SwimlaneResponse "Swimlane created successfully"
+// @Failure 400 {object} map[string]string "Invalid input or too many swimlanes"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/swimlanes [post]
+func (h *SwimlaneHandler) Create(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req CreateSwimlaneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	existing, err := h.swimlaneRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve swimlanes"})
+		return
+	}
+
+	if len(existing) >= MaxSwimlanesPerBoard {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Maximum number of swimlanes reached (20)"})
+		return
+	}
+
+	swimlane := &model.Swimlane{BoardID: board.ID, Title: req.Title}
+	if err := h.swimlaneRepo.Create(c.Request.Context(), swimlane); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create swimlane"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newSwimlaneResponse(swimlane))
+}
+
+// Update godoc
+// @Summary Update a swimlane
+// @Description Renames a swimlane and/or moves it to a 1-based position; other swimlanes shift to make room
+// @Tags Swimlanes
+// @Accept json
+// @Produce json
+// @Param id path string true "Swimlane ID" format(uuid)
+// @Param swimlane body UpdateSwimlaneRequest true "Swimlane changes"
+// @Success 200 {object} SwimlaneResponse "Swimlane updated successfully"
+// @Failure 400 {object} map[string]string "Invalid input"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Swimlane not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /swimlanes/{id} [put]
+func (h *SwimlaneHandler) Update(c *gin.Context) {
+	swimlane, ok := h.accessibleSwimlane(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, swimlane.BoardID) {
+		return
+	}
+
+	var req UpdateSwimlaneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.Title != "" {
+		swimlane.Title = req.Title
+	}
+	position := swimlane.Position
+	if req.Position != 0 {
+		position = req.Position
+	}
+
+	if err := h.swimlaneRepo.Update(c.Request.Context(), swimlane, position); err != nil {
+		if err == repository.ErrSwimlaneNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Swimlane not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update swimlane"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, newSwimlaneResponse(swimlane))
+}
+
+// Delete godoc
+// @Summary Delete a swimlane
+// @Description Deletes a swimlane; its tasks move to the default lane
+// @Tags Swimlanes
+// @Produce json
+// @Param id path string true "Swimlane ID" format(uuid)
+// @Success 200 {object} map[string]string "Swimlane deleted successfully"
+// @Failure 400 {object} map[string]string "Invalid swimlane ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Swimlane not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /swimlanes/{id} [delete]
+func (h *SwimlaneHandler) Delete(c *gin.Context) {
+	swimlane, ok := h.accessibleSwimlane(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, swimlane.BoardID) {
+		return
+	}
+
+	if err := h.swimlaneRepo.Delete(c.Request.Context(), swimlane); err != nil {
+		if err == repository.ErrSwimlaneNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Swimlane not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete swimlane"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Swimlane deleted successfully"})
+}
+
+// SetTaskSwimlane godoc
+// @Summary Move a task to a swimlane
+// @Description Moves a task to a swimlane of its board, or to the default lane when swimlane_id is null.
+// @Description The task keeps its column and position.
+// @Tags Swimlanes
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param swimlane body SetTaskSwimlaneRequest true "Target swimlane"
+// @Success 200 {object} map[string]string "Task moved successfully"
+// @Failure 400 {object} map[string]string "Invalid input or swimlane of another board"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task or swimlane not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/swimlane [put]
+func (h *SwimlaneHandler) SetTaskSwimlane(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	var req SetTaskSwimlaneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	task, err := h.taskRepo.GetWithBoard(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), task.Column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to update this task"})
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, task.Column.BoardID) {
+		return
+	}
+
+	var swimlaneID *uuid.UUID
+	if req.SwimlaneID != nil {
+		id, _ := uuid.Parse(*req.SwimlaneID)
+		swimlane, err := h.swimlaneRepo.GetByID(c.Request.Context(), id)
+		if err != nil {
+			if err == repository.ErrSwimlaneNotFound {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Swimlane not found"})
+			} else {
+				c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve swimlane"})
+			}
+			return
+		}
+
+		if swimlane.BoardID != task.Column.BoardID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Swimlane belongs to another board"})
+			return
+		}
+		swimlaneID = &swimlane.ID
+	}
+
+	if err := h.taskRepo.SetSwimlane(c.Request.Context(), task.ID, swimlaneID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task moved successfully"})
+}
+
+// GetFullBoard godoc
+// @Summary Get a board with all its tasks
+// @Description Returns the board, its columns and its tasks grouped by swimlane and column, in board order.
+// @Description Tasks without a swimlane are returned in the default lane, which comes last. The board's card
+// @Description layout tells clients which task fields to show on cards. Columns carry the requesting user's
+// @Description collapsed flags and a summary of their tasks.
+// @Tags Swimlanes
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param render query string false "Also return task descriptions as sanitized HTML" Enums(html)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} FullBoardResponse "Board with tasks"
+// @Success 304 "Cached copy is current"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/full [get]
+func (h *SwimlaneHandler) GetFullBoard(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	render, ok := wantsHTML(c)
+	if !ok {
+		return
+	}
+
+	// Версия читается до данных: изменение между ними лишь даст клиенту лишний ответ 200
+	now := time.Now()
+	version, err := h.boardChangeRepo.Version(c.Request.Context(), []uuid.UUID{board.ID}, now)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board version"})
+		return
+	}
+
+	swimlanes, err := h.swimlaneRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve swimlanes"})
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	prefs, err := h.prefsRepo.Get(c.Request.Context(), userID, board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board preferences"})
+		return
+	}
+
+	// Дорожек и свернутых колонок нет в журнале изменений, они входят в версию сами
+	lanes := make([][]any, len(swimlanes))
+	for i, swimlane := range swimlanes {
+		lanes[i] = []any{swimlane.ID, swimlane.Title, swimlane.Position}
+	}
+	var collapsed []uuid.UUID
+	if prefs != nil {
+		collapsed = prefs.CollapsedColumns
+	}
+	if notModified(c, privateCacheControl, userID, version, board.UpdatedAt, board.FrozenAt, lanes, collapsed) {
+		return
+	}
+
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
+		return
+	}
+
+	tasks, err := h.taskRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		return
+	}
+
+	taskIDs := make([]uuid.UUID, len(tasks))
+	for i := range tasks {
+		taskIDs[i] = tasks[i].ID
+	}
+
+	blockers, err := h.relationRepo.OpenBlockerCounts(c.Request.Context(), taskIDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task dependencies"})
+		return
+	}
+
+	response := FullBoardResponse{
+		Board: BoardResponse{
+			ID:          board.ID.String(),
+			Title:       board.Title,
+			Description: board.Description,
+			OwnerID:     board.OwnerID.String(),
+			Key:         board.Key,
+			FrozenAt:    frozenAt(board),
+			CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
+		},
+		CardLayout: newCardLayoutResponse(board),
+		Columns:    make([]ColumnResponse, len(columns)),
+		Swimlanes:  groupBySwimlane(board, columns, swimlanes, tasks, blockers),
+	}
+	for i := range columns {
+		response.Columns[i] = newColumnResponse(&columns[i], prefs)
+	}
+	setColumnSummaries(response.Columns, summarizeColumnTasks(tasks, now))
+	if render {
+		for _, lane := range response.Swimlanes {
+			for _, cell := range lane.Columns {
+				for i := range cell.Tasks {
+					renderDescription(&cell.Tasks[i])
+				}
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// groupBySwimlane lays out the board's tasks as one cell per column in every swimlane and in
+// the default lane, which takes tasks without a swimlane and comes last. Tasks keep their order.
+func groupBySwimlane(board *model.Board, columns []model.Column, swimlanes []model.Swimlane, tasks []model.Task, blockers map[uuid.UUID]int) []FullBoardLane {
+	lanes := make([]FullBoardLane, len(swimlanes)+1)
+	laneIndex := make(map[uuid.UUID]int, len(swimlanes))
+	columnIndex := make(map[uuid.UUID]int, len(columns))
+	for i := range lanes {
+		if i < len(swimlanes) {
+			lanes[i] = FullBoardLane{
+				ID:       uuidString(&swimlanes[i].ID),
+				Title:    swimlanes[i].Title,
+				Position: swimlanes[i].Position,
+			}
+			laneIndex[swimlanes[i].ID] = i
+		} else {
+			lanes[i] = FullBoardLane{Position: len(swimlanes) + 1, Default: true}
+		}
+
+		lanes[i].Columns = make([]FullBoardCell, len(columns))
+		for j := range columns {
+			lanes[i].Columns[j] = FullBoardCell{ColumnID: columns[j].ID.String(), Tasks: []TaskResponse{}}
+			columnIndex[columns[j].ID] = j
+		}
+	}
+
+	for i := range tasks {
+		task := &tasks[i]
+		column, ok := columnIndex[task.ColumnID]
+		if !ok {
+			continue
+		}
+
+		lane := len(swimlanes)
+		if task.SwimlaneID != nil {
+			if idx, ok := laneIndex[*task.SwimlaneID]; ok {
+				lane = idx
+			}
+		}
+
+		cell := &lanes[lane].Columns[column]
+		cell.Tasks = append(cell.Tasks, newTaskListResponse(task, board, blockers[task.ID] > 0))
+	}
+	return lanes
+}
+
+// accessibleSwimlane loads the swimlane from the path and checks that the user has the role
+// on its board. On failure the response is already written.
+func (h *SwimlaneHandler) accessibleSwimlane(c *gin.Context, role string) (*model.Swimlane, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return nil, false
+	}
+
+	swimlaneID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid swimlane ID format"})
+		return nil, false
+	}
+
+	swimlane, err := h.swimlaneRepo.GetByID(c.Request.Context(), swimlaneID)
+	if err != nil {
+		if err == repository.ErrSwimlaneNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Swimlane not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve swimlane"})
+		}
+		return nil, false
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), swimlane.BoardID, authenticatedUserID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return nil, false
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this swimlane"})
+		return nil, false
+	}
+
+	return swimlane, true
+}
+
+func newSwimlaneResponse(swimlane *model.Swimlane) SwimlaneResponse {
+	return SwimlaneResponse{
+		ID:        swimlane.ID.String(),
+		BoardID:   swimlane.BoardID.String(),
+		Title:     swimlane.Title,
+		Position:  swimlane.Position,
+		CreatedAt: swimlane.CreatedAt.Format(time.RFC3339),
+	}
+}
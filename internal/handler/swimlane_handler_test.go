@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"testing"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupBySwimlane(t *testing.T) {
+	board := &model.Board{ID: uuid.New(), Key: "OPS"}
+	todo := model.Column{ID: uuid.New(), Title: "To Do", Position: 1}
+	done := model.Column{ID: uuid.New(), Title: "Done", Position: 2, IsDone: true}
+	backend := model.Swimlane{ID: uuid.New(), Title: "Backend", Position: 1}
+	expedite := model.Swimlane{ID: uuid.New(), Title: "Expedite", Position: 2}
+
+	task := func(number int, column model.Column, swimlane *model.Swimlane) model.Task {
+		t := model.Task{ID: uuid.New(), Number: number, ColumnID: column.ID}
+		if swimlane != nil {
+			t.SwimlaneID = &swimlane.ID
+		}
+		return t
+	}
+
+	first := task(1, todo, &backend)
+	second := task(2, todo, &backend)
+	unassigned := task(3, done, nil)
+	// Дорожка другой доски считается отсутствующей
+	stale := task(4, todo, &model.Swimlane{ID: uuid.New()})
+	blocked := task(5, done, &expedite)
+
+	tasks := []model.Task{first, second, stale, unassigned, blocked}
+	lanes := groupBySwimlane(board, []model.Column{todo, done}, []model.Swimlane{backend, expedite}, tasks, map[uuid.UUID]int{blocked.ID: 1})
+
+	require.Len(t, lanes, 3)
+	assert.Equal(t, backend.ID.String(), *lanes[0].ID)
+	assert.Equal(t, expedite.ID.String(), *lanes[1].ID)
+	assert.Nil(t, lanes[2].ID)
+	assert.True(t, lanes[2].Default)
+	assert.Equal(t, 3, lanes[2].Position)
+
+	// Каждая дорожка содержит ячейку на каждую колонку, даже пустую
+	for _, lane := range lanes {
+		require.Len(t, lane.Columns, 2)
+		assert.Equal(t, todo.ID.String(), lane.Columns[0].ColumnID)
+		assert.Equal(t, done.ID.String(), lane.Columns[1].ColumnID)
+	}
+
+	keys := func(cell FullBoardCell) []string {
+		var result []string
+		for _, task := range cell.Tasks {
+			result = append(result, task.Key)
+		}
+		return result
+	}
+	assert.Equal(t, []string{"OPS-1", "OPS-2"}, keys(lanes[0].Columns[0]))
+	assert.Empty(t, lanes[0].Columns[1].Tasks)
+	assert.Equal(t, []string{"OPS-5"}, keys(lanes[1].Columns[1]))
+	assert.True(t, lanes[1].Columns[1].Tasks[0].DependencyBlocked)
+	assert.Equal(t, []string{"OPS-4"}, keys(lanes[2].Columns[0]))
+	assert.Equal(t, []string{"OPS-3"}, keys(lanes[2].Columns[1]))
+}
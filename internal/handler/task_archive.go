@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ArchivedTaskResponse represents a task moved to the task archive
+// @name ArchivedTaskResponse
+type ArchivedTaskResponse struct {
+	ID          string `json:"id"`
+	BoardID     string `json:"board_id"`
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	CompletedAt string `json:"completed_at"`
+	ArchivedAt  string `json:"archived_at"`
+}
+
+// GetArchivedTasks godoc
+// @Summary List archived tasks
+// @Description Returns the tasks of the board moved to the task archive, by number. Tasks completed more than
+// @Description TASK_ARCHIVE_AFTER_DAYS days ago are archived with their labels, relations and history, and can be
+// @Description restored from the archive.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {array} ArchivedTaskResponse "Archived tasks"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/archived-tasks [get]
+func (h *TaskHandler) GetArchivedTasks(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	archived, err := h.archiveRepo.GetByBoard(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve archived tasks"})
+		return
+	}
+
+	response := make([]ArchivedTaskResponse, len(archived))
+	for i, task := range archived {
+		response[i] = ArchivedTaskResponse{
+			ID:          task.ID.String(),
+			BoardID:     task.BoardID.String(),
+			Number:      task.Number,
+			Title:       task.Title,
+			CompletedAt: task.CompletedAt.Format(time.RFC3339),
+			ArchivedAt:  task.ArchivedAt.Format(time.RFC3339),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RestoreArchivedTask godoc
+// @Summary Restore an archived task
+// @Description Puts an archived task back into its column with its ID, number, labels, relations and history.
+// @Description Labels, swimlanes, sprints, assignees and related tasks deleted in the meantime are left out. A restored
+// @Description task stays on the board, it is not archived again.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} map[string]string "Task restored"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Archived task not found"
+// @Failure 409 {object} map[string]string "Task already restored or its column no longer exists"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Failure 503 {object} map[string]string "Task archive is not configured"
+// @Security BearerAuth
+// @Router /archived-tasks/{id}/restore [post]
+func (h *TaskHandler) RestoreArchivedTask(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	archived, err := h.archiveRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrArchivedTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Archived task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve archived task"})
+		}
+		return
+	}
+
+	board, ok := accessibleBoardByID(c, h.perms, archived.BoardID, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	// Без каталога архива файлы задач недоступны
+	if h.archiver == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Task archive is not configured"})
+		return
+	}
+
+	record, err := h.archiver.Read(archived)
+	if err != nil {
+		requestid.Logf(c.Request.Context(), "⚠️  Failed to read archived task %s from %s: %v", archived.ID, archived.Archive, err)
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read archived task"})
+		return
+	}
+	task := &record.Task
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil || column.BoardID != board.ID {
+		c.JSON(http.StatusConflict, gin.H{"error": "The column of the task no longer exists on the board"})
+		return
+	}
+
+	if !checkTaskLimit(c, h.limits, h.taskRepo, board, column.ID) {
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.archiveRepo.MarkRestored(ctx, archived.ID); err != nil {
+			return err
+		}
+		if err := h.taskRepo.Restore(ctx, task, record.LabelIDs, record.Relations); err != nil {
+			return err
+		}
+		if err := h.archiveRepo.RestoreHistory(ctx, record.Changes); err != nil {
+			return err
+		}
+		if err := h.syncReferences(ctx, task, board, userID); err != nil {
+			return err
+		}
+		return h.syncMentions(ctx, task, board, userID)
+	})
+	if err != nil {
+		if err == repository.ErrArchivedTaskNotFound {
+			c.JSON(http.StatusConflict, gin.H{"error": "Task is already restored"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore task"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task restored successfully", "task_id": task.ID.String()})
+}
@@ -0,0 +1,354 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/ot"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// DescriptionSaveInterval is how often the text of a description being edited
+	// collaboratively is saved to its task
+	DescriptionSaveInterval = 10 * time.Second
+	// DescriptionMaxOps limits the operations returned by one poll of a description
+	DescriptionMaxOps = 500
+)
+
+var (
+	errDescriptionVersion = errors.New("description version is not available")
+	errDescriptionOp      = errors.New("operation does not match the description")
+)
+
+// DescriptionOpRequest represents an edit of a task description
+// @name DescriptionOpRequest
+type DescriptionOpRequest struct {
+	// Version is the version of the description the edit was made on
+	Version int64 `json:"version" binding:"min=0"`
+	// Ops are the retain, insert and delete steps of the edit, covering the whole description;
+	// lengths count Unicode code points
+	Ops ot.Op `json:"ops" binding:"required"`
+}
+
+// DescriptionOpResponse represents an edit in the log of a task description
+// @name DescriptionOpResponse
+type DescriptionOpResponse struct {
+	// Version is the version of the description after the edit
+	Version   int64  `json:"version"`
+	UserID    string `json:"user_id,omitempty"`
+	Ops       ot.Op  `json:"ops"`
+	CreatedAt string `json:"created_at"`
+}
+
+// DescriptionDocResponse represents the live text of a task description and its recent edits
+// @name DescriptionDocResponse
+type DescriptionDocResponse struct {
+	Version int64 `json:"version"`
+	// Content is the text at Version, returned when no since is given
+	Content *string                 `json:"content,omitempty"`
+	Ops     []DescriptionOpResponse `json:"ops"`
+}
+
+// GetDescription godoc
+// @Summary Get the live description of a task
+// @Description Returns the text of the task description with its version, to start editing it collaboratively.
+// @Description With since, returns the edits made after that version instead, up to 500 at a time, so editors can
+// @Description poll for each other's edits and apply them to their copy. A since that is no longer available,
+// @Description for instance after a long time offline, is rejected with 409; the client then reloads the text.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param since query int false "Return the edits after this version"
+// @Success 200 {object} DescriptionDocResponse "Text or edits of the description"
+// @Failure 400 {object} map[string]string "Invalid task ID or version"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 409 {object} map[string]interface{} "Version is not available; the current version is returned"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/description [get]
+func (h *TaskHandler) GetDescription(c *gin.Context) {
+	_, task, _, ok := h.accessibleTask(c, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	var since *int64
+	if value := c.Query("since"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid version"})
+			return
+		}
+		since = &parsed
+	}
+
+	doc, err := h.docRepo.Open(c.Request.Context(), task.ID, task.Description)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve description"})
+		return
+	}
+
+	response := DescriptionDocResponse{Version: doc.Version, Ops: []DescriptionOpResponse{}}
+	if since == nil {
+		response.Content = &doc.Content
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	if *since > doc.Version {
+		c.JSON(http.StatusConflict, gin.H{"error": "Description version is not available", "version": doc.Version})
+		return
+	}
+
+	ops, err := h.docRepo.GetOps(c.Request.Context(), task.ID, *since, DescriptionMaxOps)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve description edits"})
+		return
+	}
+
+	// Старые правки удаляются; без непрерывной цепочки клиент должен перечитать текст
+	if *since < doc.Version && (len(ops) == 0 || ops[0].Version != *since+1) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Description version is not available", "version": doc.Version})
+		return
+	}
+
+	for _, op := range ops {
+		item, err := newDescriptionOpResponse(&op)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read description edits"})
+			return
+		}
+		response.Ops = append(response.Ops, item)
+	}
+	if len(ops) > 0 {
+		response.Version = ops[len(ops)-1].Version
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// EditDescription godoc
+// @Summary Edit a task description collaboratively
+// @Description Applies an edit made on a version of the description. Edits others made since that version are
+// @Description transformed against, so concurrent edits are merged instead of overwriting each other; when two
+// @Description edits insert at the same place, the earlier one comes first. The edit is returned as it was
+// @Description applied, with the new version. The text is saved to the task every 10 seconds while it is being
+// @Description edited and shortly after the last edit.
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param request body DescriptionOpRequest true "Edit"
+// @Success 200 {object} DescriptionOpResponse "Edit applied"
+// @Failure 400 {object} map[string]string "Invalid request or the edit does not match its version"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 409 {object} map[string]interface{} "Version is not available; the current version is returned"
+// @Failure 423 {object} map[string]string "Board is frozen or the description is locked by another user"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/description/ops [post]
+func (h *TaskHandler) EditDescription(c *gin.Context) {
+	authenticatedUserID, task, board, ok := h.accessibleTask(c, model.RoleEditor)
+	if !ok || !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req DescriptionOpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	if err := req.Ops.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid operation: " + err.Error()})
+		return
+	}
+
+	if !h.checkDescriptionLock(c, task.ID, authenticatedUserID) {
+		return
+	}
+
+	if _, err := h.docRepo.Open(c.Request.Context(), task.ID, task.Description); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve description"})
+		return
+	}
+
+	now := time.Now()
+	var applied model.DescriptionOp
+	var doc *model.DescriptionDoc
+	saved := false
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		doc, err = h.docRepo.GetForUpdate(ctx, task.ID)
+		if err != nil {
+			return err
+		}
+		if doc == nil {
+			return repository.ErrTaskNotFound
+		}
+
+		op, err := h.rebaseDescriptionOp(ctx, doc, req.Version, req.Ops)
+		if err != nil {
+			return err
+		}
+
+		content, err := ot.Apply(doc.Content, op)
+		if err != nil {
+			return errDescriptionOp
+		}
+
+		// Правка, которая ничего не меняет, не попадает в журнал
+		if op.Noop() {
+			applied = model.DescriptionOp{TaskID: task.ID, Version: doc.Version, CreatedAt: now}
+			applied.Ops, err = json.Marshal(op)
+			return err
+		}
+
+		doc.Version++
+		doc.Content = content
+		doc.UpdatedBy = &authenticatedUserID
+		doc.UpdatedAt = now
+		applied = model.DescriptionOp{TaskID: task.ID, Version: doc.Version, UserID: &authenticatedUserID, CreatedAt: now}
+		if applied.Ops, err = json.Marshal(op); err != nil {
+			return err
+		}
+		if err := h.docRepo.Append(ctx, doc, &applied); err != nil {
+			return err
+		}
+
+		if now.Sub(doc.SavedAt) < DescriptionSaveInterval {
+			return nil
+		}
+		saved = true
+		return h.withHistory(ctx, task.ID, authenticatedUserID, func(ctx context.Context) error {
+			if err := h.taskRepo.SetDescription(ctx, task.ID, doc.Content); err != nil {
+				return err
+			}
+			return h.docRepo.MarkSaved(ctx, task.ID, doc.Version, now)
+		})
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, errDescriptionVersion):
+			c.JSON(http.StatusConflict, gin.H{"error": "Description version is not available", "version": doc.Version})
+		case errors.Is(err, errDescriptionOp):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Operation does not match version " + strconv.FormatInt(req.Version, 10) + " of the description"})
+		case errors.Is(err, repository.ErrTaskNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to edit description"})
+		}
+		return
+	}
+
+	if saved {
+		task.Description = doc.Content
+		if err := h.syncReferences(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task references"})
+			return
+		}
+
+		if err := h.syncMentions(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task mentions"})
+			return
+		}
+	}
+
+	response, err := newDescriptionOpResponse(&applied)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read description edit"})
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// rebaseDescriptionOp transforms an operation made on version of the document against the
+// operations applied since, so it applies to the document's current version
+func (h *TaskHandler) rebaseDescriptionOp(ctx context.Context, doc *model.DescriptionDoc, version int64, op ot.Op) (ot.Op, error) {
+	if version > doc.Version {
+		return nil, errDescriptionVersion
+	}
+	if version == doc.Version {
+		return op, nil
+	}
+
+	concurrent, err := h.docRepo.GetOps(ctx, doc.TaskID, version, int(doc.Version-version))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(concurrent)) != doc.Version-version || concurrent[0].Version != version+1 {
+		return nil, errDescriptionVersion
+	}
+
+	for _, stored := range concurrent {
+		var other ot.Op
+		if err := json.Unmarshal(stored.Ops, &other); err != nil {
+			return nil, err
+		}
+		// Правки, принятые раньше, при вставке в то же место идут первыми
+		_, op, err = ot.Transform(other, op)
+		if err != nil {
+			return nil, errDescriptionOp
+		}
+	}
+	return op, nil
+}
+
+// syncDescriptionDoc keeps the description document of a task in step with a whole-description
+// update: a changed description replaces the text being edited, as an edit of its own, while an
+// unchanged one takes up the edits not saved yet. It must run in the transaction that saves the task.
+func (h *TaskHandler) syncDescriptionDoc(ctx context.Context, task *model.Task, userID uuid.UUID, changed bool, now time.Time) error {
+	doc, err := h.docRepo.GetForUpdate(ctx, task.ID)
+	if err != nil || doc == nil {
+		return err
+	}
+
+	if !changed || task.Description == doc.Content {
+		task.Description = doc.Content
+		return h.docRepo.MarkSaved(ctx, task.ID, doc.Version, now)
+	}
+
+	op := ot.Replace(len([]rune(doc.Content)), task.Description)
+	payload, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	doc.Version++
+	doc.Content = task.Description
+	doc.SavedVersion = doc.Version
+	doc.SavedAt = now
+	doc.UpdatedBy = &userID
+	doc.UpdatedAt = now
+	return h.docRepo.Append(ctx, doc, &model.DescriptionOp{TaskID: task.ID, Version: doc.Version, UserID: &userID, Ops: payload, CreatedAt: now})
+}
+
+func newDescriptionOpResponse(op *model.DescriptionOp) (DescriptionOpResponse, error) {
+	response := DescriptionOpResponse{
+		Version:   op.Version,
+		CreatedAt: op.CreatedAt.Format(time.RFC3339),
+	}
+	if op.UserID != nil {
+		response.UserID = op.UserID.String()
+	}
+	if err := json.Unmarshal(op.Ops, &response.Ops); err != nil {
+		return DescriptionOpResponse{}, err
+	}
+	if response.Ops == nil {
+		response.Ops = ot.Op{}
+	}
+	return response, nil
+}
@@ -1,11 +1,26 @@
 package handler
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
+	"kanban/internal/markdown"
+	"kanban/internal/mention"
 	"kanban/internal/middleware"
 	"kanban/internal/model"
+	"kanban/internal/palette"
+	"kanban/internal/permission"
+	"kanban/internal/quickadd"
+	"kanban/internal/reference"
 	"kanban/internal/repository"
 
 	"github.com/gin-gonic/gin"
@@ -13,26 +28,78 @@ import (
 )
 
 type TaskHandler struct {
-	taskRepo       *repository.TaskRepository
-	columnRepo     *repository.ColumnRepository
-	boardRepo      *repository.BoardRepository
-	boardShareRepo *repository.BoardShareRepository
-	userRepo       *repository.UserRepository
+	taskRepo        repository.TaskRepositoryInterface
+	columnRepo      repository.ColumnRepositoryInterface
+	boardRepo       repository.BoardRepositoryInterface
+	boardShareRepo  repository.BoardShareRepositoryInterface
+	userRepo        *repository.UserRepository
+	labelRepo       repository.LabelRepositoryInterface
+	taskRefRepo     *repository.TaskReferenceRepository
+	mentionRepo     *repository.TaskMentionRepository
+	relationRepo    *repository.TaskRelationRepository
+	githubRepo      *repository.GitHubRepository
+	changeRepo      *repository.TaskChangeRepository
+	boardChangeRepo *repository.BoardChangeRepository
+	actionRepo      *repository.ActionRepository
+	outboxRepo      *repository.OutboxRepository
+	txManager       *repository.TxManager
+	templateRepo    *repository.TaskTemplateRepository
+	lockRepo        *repository.TaskLockRepository
+	docRepo         *repository.DescriptionDocRepository
+	perms           *permission.Service
+	limits          *limits.Service
+	archiveRepo     *repository.ArchivedTaskRepository
+	// archiver is nil when tasks are not archived
+	archiver *jobs.TaskArchiver
 }
 
 func NewTaskHandler(
-	taskRepo *repository.TaskRepository,
-	columnRepo *repository.ColumnRepository,
-	boardRepo *repository.BoardRepository,
-	boardShareRepo *repository.BoardShareRepository,
+	taskRepo repository.TaskRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
 	userRepo *repository.UserRepository,
+	labelRepo repository.LabelRepositoryInterface,
+	taskRefRepo *repository.TaskReferenceRepository,
+	mentionRepo *repository.TaskMentionRepository,
+	relationRepo *repository.TaskRelationRepository,
+	githubRepo *repository.GitHubRepository,
+	changeRepo *repository.TaskChangeRepository,
+	boardChangeRepo *repository.BoardChangeRepository,
+	actionRepo *repository.ActionRepository,
+	outboxRepo *repository.OutboxRepository,
+	txManager *repository.TxManager,
+	templateRepo *repository.TaskTemplateRepository,
+	lockRepo *repository.TaskLockRepository,
+	docRepo *repository.DescriptionDocRepository,
+	perms *permission.Service,
+	limitService *limits.Service,
+	archiveRepo *repository.ArchivedTaskRepository,
+	archiver *jobs.TaskArchiver,
 ) *TaskHandler {
 	return &TaskHandler{
-		taskRepo:       taskRepo,
-		columnRepo:     columnRepo,
-		boardRepo:      boardRepo,
-		boardShareRepo: boardShareRepo,
-		userRepo:       userRepo,
+		taskRepo:        taskRepo,
+		columnRepo:      columnRepo,
+		boardRepo:       boardRepo,
+		boardShareRepo:  boardShareRepo,
+		userRepo:        userRepo,
+		labelRepo:       labelRepo,
+		taskRefRepo:     taskRefRepo,
+		mentionRepo:     mentionRepo,
+		relationRepo:    relationRepo,
+		githubRepo:      githubRepo,
+		changeRepo:      changeRepo,
+		boardChangeRepo: boardChangeRepo,
+		actionRepo:      actionRepo,
+		outboxRepo:      outboxRepo,
+		txManager:       txManager,
+		templateRepo:    templateRepo,
+		lockRepo:        lockRepo,
+		docRepo:         docRepo,
+		perms:           perms,
+		limits:          limitService,
+		archiveRepo:     archiveRepo,
+		archiver:        archiver,
 	}
 }
 
@@ -49,11 +116,29 @@ type TaskRequest struct {
 	Title       string     `json:"title" binding:"required"`
 	Description string     `json:"description"`
 	ColumnID    string     `json:"column_id" binding:"required,uuid"`
+	StartDate   *time.Time `json:"start_date"`
 	DueDate     *time.Time `json:"due_date"`
 	Position    *int       `json:"position"`
+	Priority    string     `json:"priority" binding:"omitempty,oneof=low medium high urgent" enums:"low,medium,high,urgent"`
+	// Estimate in story points; null leaves the task unestimated
+	Estimate *int `json:"estimate" binding:"omitempty,min=0,max=1000"`
 }
 
 
+// SetBlockedRequest represents the request body for flagging a task as blocked
+// @name SetBlockedRequest
+type SetBlockedRequest struct {
+	Blocked bool   `json:"blocked"`
+	Reason  string `json:"reason"`
+}
+
+// SetCoverRequest represents the request body for setting a task cover
+// @name SetCoverRequest
+type SetCoverRequest struct {
+	// Color is a hex color such as #4caf50; an empty string removes the cover
+	Color string `json:"color" example:"#4caf50"`
+}
+
 // TaskMoveRequest represents the request body for moving a task
 // @name TaskMoveRequest
 type TaskMoveRequest struct {
@@ -61,26 +146,254 @@ type TaskMoveRequest struct {
 	Position int    `json:"position" binding:"required,min=0"`
 }
 
+// TaskPositionResponse represents the position of a task after a move
+// @name TaskPositionResponse
+type TaskPositionResponse struct {
+	ID       string `json:"id"`
+	ColumnID string `json:"column_id"`
+	Position int    `json:"position"`
+}
+
+// TaskMoveResponse represents the result of a move: the moved task and the normalized
+// positions of all tasks in the source and target columns
+// @name TaskMoveResponse
+type TaskMoveResponse struct {
+	Message string `json:"message"`
+	// ActionID undoes the move with POST /actions/{id}/undo
+	ActionID string                 `json:"action_id"`
+	Task     TaskPositionResponse   `json:"task"`
+	Affected []TaskPositionResponse `json:"affected"`
+}
+
+// TaskMoveToBoardRequest represents the request body for moving a task to a column of another board
+// @name TaskMoveToBoardRequest
+type TaskMoveToBoardRequest struct {
+	ColumnID string `json:"column_id" binding:"required,uuid"`
+	Position int    `json:"position" binding:"min=0"`
+}
+
+// TaskMoveToBoardResponse represents a task moved to another board and what changed with its labels
+// @name TaskMoveToBoardResponse
+type TaskMoveToBoardResponse struct {
+	Task TaskPositionResponse `json:"task"`
+	// Key is the new key of the task on the target board
+	Key string `json:"key"`
+	// LabelsRemapped counts the labels replaced by the target board's labels of the same name,
+	// LabelsDropped the labels removed because the target board has none
+	LabelsRemapped int `json:"labels_remapped"`
+	LabelsDropped  int `json:"labels_dropped"`
+}
+
 // TaskAssignRequest represents the request body for assigning a user to a task
 // @name TaskAssignRequest
 type TaskAssignRequest struct {
 	UserID string `json:"user_id" binding:"required,uuid"`
 }
 
+// MaxBatchGetTasks limits the number of tasks requested at once from POST /tasks/batch-get
+const MaxBatchGetTasks = 100
+
+// BatchGetTasksRequest represents the request body for fetching several tasks at once
+// @name BatchGetTasksRequest
+type BatchGetTasksRequest struct {
+	IDs []string `json:"ids" binding:"required,min=1,dive,uuid"`
+}
+
+// QuickAddRequest represents a line of quick task entry
+// @name QuickAddRequest
+type QuickAddRequest struct {
+	Text string `json:"text" binding:"required,max=1000" example:"Fix login bug #backend @anna !high due:fri"`
+	// ColumnID is the column to add the task to, the first column of the board by default
+	ColumnID string `json:"column_id" binding:"omitempty,uuid"`
+}
+
+// CreateFromTemplateRequest represents the request body for creating a task from a template
+// @name CreateFromTemplateRequest
+type CreateFromTemplateRequest struct {
+	// ColumnID is the column to add the task to, the first column of the board by default
+	ColumnID string `json:"column_id" binding:"omitempty,uuid"`
+	// Title replaces the title made from the template's pattern
+	Title string `json:"title" binding:"max=255"`
+}
+
+// QuickAddParsed represents what was read from a quick-add line
+// @name QuickAddParsed
+type QuickAddParsed struct {
+	Title    string   `json:"title"`
+	Labels   []string `json:"labels"`
+	Assignee string   `json:"assignee,omitempty"`
+	Priority string   `json:"priority,omitempty"`
+	DueDate  *string  `json:"due_date,omitempty"`
+}
+
+// QuickAddResponse represents a task created from a quick-add line
+// @name QuickAddResponse
+type QuickAddResponse struct {
+	Task   TaskResponse   `json:"task"`
+	Parsed QuickAddParsed `json:"parsed"`
+	// Unmatched lists the #label and @assignee tokens that name no label or member of the board;
+	// the task was created without them
+	Unmatched []string `json:"unmatched"`
+}
+
+// MaxAssigneeSuggestions limits the number of members returned by GET /tasks/{id}/assignee-suggestions
+const MaxAssigneeSuggestions = 20
+
+// AssigneeSuggestionWindow is how far back completed tasks count towards assignee suggestions
+const AssigneeSuggestionWindow = 90 * 24 * time.Hour
+
+// AssigneeSuggestionResponse represents a board member suggested as assignee of a task
+// @name AssigneeSuggestionResponse
+type AssigneeSuggestionResponse struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+	Score  int    `json:"score"`
+	// SharedLabels counts the labels of the task on the member's recent tasks
+	SharedLabels int `json:"shared_labels"`
+	// SameColumn counts the member's recent tasks in the task's column
+	SameColumn int `json:"same_column"`
+	OpenTasks  int `json:"open_tasks"`
+}
+
+// BatchGetTasksResponse lists the requested tasks; missing holds the IDs that do not exist
+// or are on boards the user cannot view
+// @name BatchGetTasksResponse
+type BatchGetTasksResponse struct {
+	Tasks   []TaskResponse `json:"tasks"`
+	Missing []string       `json:"missing"`
+}
+
 // LabelResponse represents the response for a label
 // @name LabelResponse
 type TaskResponse struct {
-	ID           string          `json:"id"`
-	Title        string          `json:"title"`
-	Description  string          `json:"description"`
-	ColumnID     string          `json:"column_id"`
-	AssignedTo   *string         `json:"assigned_to,omitempty"`
-	AssigneeName *string         `json:"assignee_name,omitempty"`
-	CreatedBy    string          `json:"created_by"`
-	CreatorName  string          `json:"creator_name"`
-	DueDate      *string         `json:"due_date,omitempty"`
-	Position     int             `json:"position"`
-	Labels       []LabelResponse `json:"labels,omitempty"`
+	ID            string          `json:"id"`
+	Title         string          `json:"title"`
+	Description   string          `json:"description"`
+	// DescriptionHTML is the description rendered as sanitized HTML, returned with render=html
+	DescriptionHTML *string `json:"description_html,omitempty"`
+	ColumnID      string          `json:"column_id"`
+	AssignedTo    *string         `json:"assigned_to,omitempty"`
+	AssigneeName  *string         `json:"assignee_name,omitempty"`
+	CreatedBy     string          `json:"created_by"`
+	CreatorName   string          `json:"creator_name"`
+	StartDate     *string         `json:"start_date,omitempty"`
+	DueDate       *string         `json:"due_date,omitempty"`
+	Position      int             `json:"position"`
+	Number        int             `json:"number"`
+	Key           string          `json:"key"`
+	CompletedAt   *string         `json:"completed_at,omitempty"`
+	// Overdue is set for open tasks whose due date has passed
+	Overdue       bool            `json:"overdue"`
+	Blocked       bool            `json:"blocked"`
+	BlockedReason string          `json:"blocked_reason,omitempty"`
+	Priority      string          `json:"priority"`
+	SwimlaneID    *string         `json:"swimlane_id,omitempty"`
+	Estimate      *int            `json:"estimate,omitempty"`
+	SprintID      *string         `json:"sprint_id,omitempty"`
+	Labels        []LabelResponse `json:"labels,omitempty"`
+	// Cover is omitted for tasks without a cover
+	Cover *TaskCoverResponse `json:"cover,omitempty"`
+
+	// DependencyBlocked is set while a task blocking this one is not completed
+	DependencyBlocked bool `json:"dependency_blocked"`
+
+	References  []TaskReferenceResponse  `json:"references,omitempty"`
+	Mentions    []TaskMentionResponse    `json:"mentions,omitempty"`
+	GitHubLinks []TaskGitHubLinkResponse `json:"github_links,omitempty"`
+}
+
+// setTaskDates fills in the dates of the task and whether it is overdue
+func setTaskDates(response *TaskResponse, task *model.Task) {
+	if task.StartDate != nil {
+		startDate := task.StartDate.Format(time.RFC3339)
+		response.StartDate = &startDate
+	}
+
+	if task.DueDate != nil {
+		dueDate := task.DueDate.Format(time.RFC3339)
+		response.DueDate = &dueDate
+	}
+
+	if task.CompletedAt != nil {
+		completedAt := task.CompletedAt.Format(time.RFC3339)
+		response.CompletedAt = &completedAt
+	}
+
+	response.Overdue = task.Overdue(time.Now())
+}
+
+// wantsHTML reads the render query parameter; with render=html task responses also carry their
+// description as sanitized HTML. On failure the response is already written.
+func wantsHTML(c *gin.Context) (bool, bool) {
+	switch c.Query("render") {
+	case "":
+		return false, true
+	case "html":
+		return true, true
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": "Unsupported render, expected html"})
+	return false, false
+}
+
+// renderDescription adds the description rendered from markdown to the response
+func renderDescription(response *TaskResponse) {
+	rendered := markdown.Render(response.Description)
+	response.DescriptionHTML = &rendered
+}
+
+// validTaskDates reports whether a task may have the start and due dates; either may be unset
+func validTaskDates(startDate, dueDate *time.Time) bool {
+	return startDate == nil || dueDate == nil || !startDate.After(*dueDate)
+}
+
+// TaskCoverResponse represents the cover shown on a task card.
+// DarkColor is the variant of Color for dark themes, derived on the server.
+// @name TaskCoverResponse
+type TaskCoverResponse struct {
+	Color     string `json:"color"`
+	DarkColor string `json:"dark_color"`
+}
+
+func newTaskCoverResponse(task *model.Task) *TaskCoverResponse {
+	if task.CoverColor == "" {
+		return nil
+	}
+	return &TaskCoverResponse{Color: task.CoverColor, DarkColor: palette.DarkVariant(task.CoverColor)}
+}
+
+// TaskReferenceResponse represents a task referenced from another task's text
+// @name TaskReferenceResponse
+type TaskReferenceResponse struct {
+	Token   string `json:"token"`
+	Origin  string `json:"origin"`
+	TaskID  string `json:"task_id"`
+	BoardID string `json:"board_id"`
+	Key     string `json:"key"`
+	Title   string `json:"title"`
+}
+
+// TaskGitHubLinkResponse represents a commit or pull request that mentions a task
+// @name TaskGitHubLinkResponse
+type TaskGitHubLinkResponse struct {
+	Kind       string `json:"kind" enums:"commit,pull_request"`
+	Repository string `json:"repository"`
+	// Ref is the commit SHA or the pull request number
+	Ref       string `json:"ref"`
+	URL       string `json:"url"`
+	Title     string `json:"title"`
+	State     string `json:"state,omitempty" enums:"open,closed,merged"`
+	Author    string `json:"author,omitempty"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// TaskMentionResponse represents a board member mentioned in a task's text
+// @name TaskMentionResponse
+type TaskMentionResponse struct {
+	Token  string `json:"token"`
+	Origin string `json:"origin"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
 }
 
 // Create godoc
@@ -93,8 +406,9 @@ type TaskResponse struct {
 // @Success 201 {object} TaskResponse "Task created successfully"
 // @Failure 400 {object} map[string]string "Invalid request"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
 // @Failure 404 {object} map[string]string "Column not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
 // @Router /tasks [post]
@@ -117,13 +431,18 @@ func (h *TaskHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if !validTaskDates(req.StartDate, req.DueDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Start date must not be after the due date"})
+		return
+	}
+
 	columnID, err := uuid.Parse(req.ColumnID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
+	column, err := h.perms.GetColumn(c.Request.Context(), columnID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
 		return
@@ -134,13 +453,13 @@ func (h *TaskHandler) Create(c *gin.Context) {
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
@@ -151,6 +470,14 @@ func (h *TaskHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	if !checkTaskLimit(c, h.limits, h.taskRepo, board, columnID) {
+		return
+	}
+
 	position := 0
 	if req.Position != nil {
 		position = *req.Position
@@ -163,13 +490,21 @@ func (h *TaskHandler) Create(c *gin.Context) {
 		position = len(tasks)
 	}
 
+	priority := req.Priority
+	if priority == "" {
+		priority = model.PriorityMedium
+	}
+
 	task := &model.Task{
 		ColumnID:    columnID,
 		Title:       req.Title,
 		Description: req.Description,
 		CreatedBy:   authenticatedUserID,
+		StartDate:   req.StartDate,
 		DueDate:     req.DueDate,
 		Position:    position,
+		Priority:    priority,
+		Estimate:    req.Estimate,
 	}
 
 	if err := h.taskRepo.Create(c.Request.Context(), task); err != nil {
@@ -177,6 +512,16 @@ func (h *TaskHandler) Create(c *gin.Context) {
 		return
 	}
 
+	if err := h.syncReferences(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task references"})
+		return
+	}
+
+	if err := h.syncMentions(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task mentions"})
+		return
+	}
+
 	creator, err := h.userRepo.GetByID(c.Request.Context(), authenticatedUserID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user information"})
@@ -184,39 +529,63 @@ func (h *TaskHandler) Create(c *gin.Context) {
 	}
 
 	response := TaskResponse{
-		ID:          task.ID.String(),
-		Title:       task.Title,
-		Description: task.Description,
-		ColumnID:    task.ColumnID.String(),
-		CreatedBy:   task.CreatedBy.String(),
-		CreatorName: creator.Name,
-		Position:    task.Position,
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      task.ColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		CreatorName:   creator.Name,
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
 	}
 
-	if task.DueDate != nil {
-		dueDate := task.DueDate.Format(time.RFC3339)
-		response.DueDate = &dueDate
+	setTaskDates(&response, task)
+
+	response.References, err = h.referenceResponses(c.Request.Context(), task.ID, board.ID, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task references"})
+		return
+	}
+
+	response.Mentions, err = h.mentionResponses(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task mentions"})
+		return
 	}
 
 	c.JSON(http.StatusCreated, response)
 }
 
-// GetByID godoc
-// @Summary Get task by ID
-// @Description Retrieves a task by its ID
+// QuickAdd godoc
+// @Summary Quick-add a task
+// @Description Creates a task from a single line of text such as "Fix login bug #backend @anna !high due:fri".
+// @Description #name adds the board label of that name, @handle assigns the board member with that username or
+// @Description email, !low, !medium, !high and !urgent set the priority and due: takes today, tomorrow, a weekday,
+// @Description +Nd or a YYYY-MM-DD date (UTC). The rest of the line is the title. Labels and members that are not
+// @Description found are reported as unmatched instead of failing the request.
 // @Tags Tasks
 // @Accept json
 // @Produce json
-// @Param id path string true "Task ID" format(uuid)
-// @Success 200 {object} TaskResponse "Task details"
-// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Param id path string true "Board ID" format(uuid)
+// @Param task body QuickAddRequest true "Quick-add line"
+// @Success 201 {object} QuickAddResponse "Task created successfully"
+// @Failure 400 {object} map[string]string "Invalid request, due date or empty title"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Board or column not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id} [get]
-func (h *TaskHandler) GetByID(c *gin.Context) {
+// @Router /boards/{id}/quick-add [post]
+func (h *TaskHandler) QuickAdd(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -229,95 +598,174 @@ func (h *TaskHandler) GetByID(c *gin.Context) {
 		return
 	}
 
-	taskIDStr := c.Param("id")
-	taskID, err := uuid.Parse(taskIDStr)
+	boardID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
 		return
 	}
 
-	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
-	if err != nil {
-		if err == repository.ErrTaskNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
-		}
+	var req QuickAddRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	entry, err := quickadd.Parse(req.Text, time.Now())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if entry.Title == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The task needs a title besides labels, assignee, priority and due date"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	board, err := h.perms.GetBoard(c.Request.Context(), boardID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), board.ID, authenticatedUserID, model.RoleEditor)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
 	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task"})
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to create tasks on this board"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
 		return
 	}
 
-	creator, err := h.userRepo.GetByID(c.Request.Context(), task.CreatedBy)
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve creator information"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
 		return
 	}
 
-	response := TaskResponse{
-		ID:          task.ID.String(),
-		Title:       task.Title,
-		Description: task.Description,
-		ColumnID:    task.ColumnID.String(),
-		CreatedBy:   task.CreatedBy.String(),
-		CreatorName: creator.Name,
-		Position:    task.Position,
+	column := pickColumn(columns, req.ColumnID)
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found on this board"})
+		return
 	}
 
-	if task.DueDate != nil {
-		dueDate := task.DueDate.Format(time.RFC3339)
-		response.DueDate = &dueDate
+	if !checkTaskLimit(c, h.limits, h.taskRepo, board, column.ID) {
+		return
 	}
 
-	if task.AssignedTo != nil {
-		assignee, err := h.userRepo.GetByID(c.Request.Context(), *task.AssignedTo)
-		if err == nil {
-			assignedToStr := task.AssignedTo.String()
-			response.AssignedTo = &assignedToStr
-			response.AssigneeName = &assignee.Name
+	unmatched := []string{}
+
+	var labelIDs []uuid.UUID
+	if len(entry.Labels) > 0 {
+		labels, err := h.labelRepo.GetAvailableForBoard(c.Request.Context(), board.ID, board.OwnerID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+			return
+		}
+
+		for _, name := range entry.Labels {
+			index := slices.IndexFunc(labels, func(label model.Label) bool {
+				return strings.EqualFold(label.Name, name)
+			})
+			if index < 0 {
+				unmatched = append(unmatched, "#"+name)
+				continue
+			}
+			labelIDs = append(labelIDs, labels[index].ID)
 		}
 	}
 
-	c.JSON(http.StatusOK, response)
+	var assigneeID *uuid.UUID
+	if entry.Assignee != "" {
+		members, err := h.boardMembers(c.Request.Context(), board)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board members"})
+			return
+		}
+
+		token := mention.Mention{Handle: entry.Assignee, Token: "@" + entry.Assignee}
+		if matches := matchMentions([]mention.Mention{token}, members); len(matches) == 1 {
+			assigneeID = &matches[0].user.ID
+		} else {
+			unmatched = append(unmatched, token.Token)
+		}
+	}
+
+	priority := entry.Priority
+	if priority == "" {
+		priority = model.PriorityMedium
+	}
+
+	task := &model.Task{
+		ColumnID:   column.ID,
+		Title:      entry.Title,
+		CreatedBy:  authenticatedUserID,
+		AssignedTo: assigneeID,
+		DueDate:    entry.DueDate,
+		Priority:   priority,
+	}
+
+	if err := h.appendTask(c.Request.Context(), task, labelIDs); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task"})
+		return
+	}
+
+	created, err := h.taskRepo.GetWithDetails(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		return
+	}
+
+	response := QuickAddResponse{
+		Task: newTaskListResponse(created, board, false),
+		Parsed: QuickAddParsed{
+			Title:    entry.Title,
+			Labels:   entry.Labels,
+			Assignee: entry.Assignee,
+			Priority: entry.Priority,
+		},
+		Unmatched: unmatched,
+	}
+	if response.Parsed.Labels == nil {
+		response.Parsed.Labels = []string{}
+	}
+	if entry.DueDate != nil {
+		dueDate := entry.DueDate.Format("2006-01-02")
+		response.Parsed.DueDate = &dueDate
+	}
+
+	c.JSON(http.StatusCreated, response)
 }
 
-// GetByColumnID godoc
-// @Summary Get tasks by column ID
-// @Description Retrieves all tasks for a specific column
+// CreateFromTemplate godoc
+// @Summary Create a task from a template
+// @Description Creates a task pre-filled from a task template of the board: the title pattern with its placeholders
+// @Description filled in, the description followed by the checklist as a Markdown task list, the priority and the
+// @Description labels that still exist. The task goes to the end of the given column, the first column by default.
 // @Tags Tasks
 // @Accept json
 // @Produce json
-// @Param id path string true "Column ID" format(uuid)
-// @Success 200 {array} TaskResponse "List of tasks in the column"
-// @Failure 400 {object} map[string]string "Invalid column ID format"
+// @Param template_id path string true "Task template ID" format(uuid)
+// @Param task body CreateFromTemplateRequest false "Target column and title"
+// @Success 201 {object} TaskResponse "Task created successfully"
+// @Failure 400 {object} map[string]string "Invalid request"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Column not found"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Task template or column not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /columns/{id}/tasks [get]
-func (h *TaskHandler) GetByColumnID(c *gin.Context) {
+// @Router /tasks/from-template/{template_id} [post]
+func (h *TaskHandler) CreateFromTemplate(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -330,124 +778,190 @@ func (h *TaskHandler) GetByColumnID(c *gin.Context) {
 		return
 	}
 
-	columnIDStr := c.Param("id")
-	columnID, err := uuid.Parse(columnIDStr)
+	templateID, err := uuid.Parse(c.Param("template_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID format"})
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), columnID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+	// Тело необязательно: без него задача попадает в первую колонку
+	var req CreateFromTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
 		return
 	}
 
-	if column == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
+	template, err := h.templateRepo.GetByID(c.Request.Context(), templateID)
+	if err != nil {
+		if err == repository.ErrTaskTemplateNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task template not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task template"})
+		}
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	board, err := h.perms.GetBoard(c.Request.Context(), template.BoardID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), board.ID, authenticatedUserID, model.RoleEditor)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
 	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view tasks on this board"})
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to create tasks on this board"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
 		return
 	}
 
-	tasks, err := h.taskRepo.GetTasksWithLabels(c.Request.Context(), columnID)
+	columns, err := h.columnRepo.GetByBoardID(c.Request.Context(), board.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve columns"})
 		return
 	}
 
-	userCache := make(map[uuid.UUID]*model.User)
+	column := pickColumn(columns, req.ColumnID)
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found on this board"})
+		return
+	}
 
-	response := make([]TaskResponse, len(tasks))
-	for i, task := range tasks {
-		var creator *model.User
-		var ok bool
-		if creator, ok = userCache[task.CreatedBy]; !ok {
-			creator, err = h.userRepo.GetByID(c.Request.Context(), task.CreatedBy)
-			if err == nil {
-				userCache[task.CreatedBy] = creator
-			}
+	if !checkTaskLimit(c, h.limits, h.taskRepo, board, column.ID) {
+		return
+	}
+
+	creator, err := h.userRepo.GetByID(c.Request.Context(), authenticatedUserID)
+	if err != nil || creator == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user information"})
+		return
+	}
+
+	var labelIDs []uuid.UUID
+	if len(template.LabelIDs) > 0 {
+		labels, err := h.labelRepo.GetAvailableForBoard(c.Request.Context(), board.ID, board.OwnerID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+			return
 		}
 
-		response[i] = TaskResponse{
-			ID:          task.ID.String(),
-			Title:       task.Title,
-			Description: task.Description,
-			ColumnID:    task.ColumnID.String(),
-			CreatedBy:   task.CreatedBy.String(),
-			CreatorName: creator.Name,
-			Position:    task.Position,
+		for _, id := range template.LabelIDs {
+			if slices.ContainsFunc(labels, func(label model.Label) bool { return label.ID == id }) {
+				labelIDs = append(labelIDs, id)
+			}
 		}
+	}
+
+	title := req.Title
+	if title == "" {
+		title = template.Title(time.Now(), creator.Name)
+	}
+
+	task := &model.Task{
+		ColumnID:    column.ID,
+		Title:       title,
+		Description: template.TaskDescription(),
+		CreatedBy:   authenticatedUserID,
+		Priority:    template.Priority,
+	}
+
+	if err := h.appendTask(c.Request.Context(), task, labelIDs); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task"})
+		return
+	}
+
+	if err := h.syncReferences(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task references"})
+		return
+	}
+
+	if err := h.syncMentions(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task mentions"})
+		return
+	}
+
+	created, err := h.taskRepo.GetWithDetails(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		return
+	}
+
+	response := newTaskListResponse(created, board, false)
+
+	response.References, err = h.referenceResponses(c.Request.Context(), task.ID, board.ID, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task references"})
+		return
+	}
+
+	response.Mentions, err = h.mentionResponses(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task mentions"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
 
-		if task.DueDate != nil {
-			dueDate := task.DueDate.Format(time.RFC3339)
-			response[i].DueDate = &dueDate
+// appendTask creates the task at the end of its column with the given labels
+func (h *TaskHandler) appendTask(ctx context.Context, task *model.Task, labelIDs []uuid.UUID) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		tasks, err := h.taskRepo.GetByColumnID(ctx, task.ColumnID)
+		if err != nil {
+			return err
 		}
+		task.Position = len(tasks)
 
-		if task.AssignedTo != nil {
-			var assignee *model.User
-			if assignee, ok = userCache[*task.AssignedTo]; !ok {
-				assignee, err = h.userRepo.GetByID(c.Request.Context(), *task.AssignedTo)
-				if err == nil {
-					userCache[*task.AssignedTo] = assignee
-				}
-			}
+		if err := h.taskRepo.Create(ctx, task); err != nil {
+			return err
+		}
 
-			if assignee != nil {
-				assignedToStr := task.AssignedTo.String()
-				response[i].AssignedTo = &assignedToStr
-				response[i].AssigneeName = &assignee.Name
+		for _, labelID := range labelIDs {
+			if err := h.taskRepo.AddLabel(ctx, task.ID, labelID); err != nil {
+				return err
 			}
 		}
+		return nil
+	})
+}
 
-		if len(task.Labels) > 0 {
-			labels := make([]LabelResponse, len(task.Labels))
-			for j, label := range task.Labels {
-				labels[j] = LabelResponse{
-					ID:    label.ID.String(),
-					Name:  label.Name,
-					Color: label.Color,
-				}
-			}
-			response[i].Labels = labels
+// pickColumn returns the requested column, which must be one of the board's columns, or the
+// first column of the board when none is requested. It returns nil if there is no such column.
+func pickColumn(columns []model.Column, requested string) *model.Column {
+	for i := range columns {
+		if requested == "" || strings.EqualFold(columns[i].ID.String(), requested) {
+			return &columns[i]
 		}
 	}
-
-	c.JSON(http.StatusOK, response)
+	return nil
 }
 
-// Update godoc
-// @Summary Update a task
-// @Description Updates an existing task with new details
+// GetByID godoc
+// @Summary Get task by ID
+// @Description Retrieves a task by its ID with its creator, assignee, labels, references, mentions and GitHub links
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID" format(uuid)
-// @Param task body TaskRequest true "Updated task information"
-// @Success 200 {object} TaskResponse "Task updated successfully"
-// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Param render query string false "Also return task descriptions as sanitized HTML" Enums(html)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} TaskResponse "Task details"
+// @Success 304 "Cached copy is current"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
 // @Failure 401 {object} map[string]string "Not authenticated"
 // @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task or column not found"
+// @Failure 404 {object} map[string]string "Task not found"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id} [put]
-func (h *TaskHandler) Update(c *gin.Context) {
+// @Router /tasks/{id} [get]
+func (h *TaskHandler) GetByID(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -467,6 +981,11 @@ func (h *TaskHandler) Update(c *gin.Context) {
 		return
 	}
 
+	render, ok := wantsHTML(c)
+	if !ok {
+		return
+	}
+
 	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
@@ -477,118 +996,52 @@ func (h *TaskHandler) Update(c *gin.Context) {
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
 	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to update this task"})
-		return
-	}
-
-	var req TaskRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task"})
 		return
 	}
 
-	var newColumnID uuid.UUID
-	var columnChanged bool
-	if req.ColumnID != task.ColumnID.String() {
-		columnChanged = true
-		newColumnID, err = uuid.Parse(req.ColumnID)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
-			return
-		}
-
-		newColumn, err := h.columnRepo.GetByID(c.Request.Context(), newColumnID)
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
-			return
-		}
-
-		if newColumn == nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
-			return
-		}
-
-		if newColumn.BoardID != column.BoardID {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot move task to a column from another board"})
-			return
-		}
-	} else {
-		newColumnID = task.ColumnID
-	}
-
-	task.Title = req.Title
-	task.Description = req.Description
-	task.DueDate = req.DueDate
-
-	if columnChanged || (req.Position != nil && *req.Position != task.Position) {
-		position := task.Position
-		if req.Position != nil {
-			position = *req.Position
-		}
-
-		if err := h.taskRepo.MoveTask(c.Request.Context(), taskID, newColumnID, position); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
-			return
-		}
-	} else {
-		if err := h.taskRepo.Update(c.Request.Context(), task); err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task"})
-			return
-		}
-	}
-
-	response := TaskResponse{
-		ID:          task.ID.String(),
-		Title:       task.Title,
-		Description: task.Description,
-		ColumnID:    newColumnID.String(),
-		CreatedBy:   task.CreatedBy.String(),
-		Position:    task.Position,
-	}
-
-	if task.DueDate != nil {
-		dueDate := task.DueDate.Format(time.RFC3339)
-		response.DueDate = &dueDate
-	}
-
-	c.JSON(http.StatusOK, response)
+	h.respondWithTaskDetails(c, task.ID, board, authenticatedUserID, render)
 }
 
-// Delete godoc
-// @Summary Delete a task
-// @Description Deletes a task by its ID
+// GetByNumber godoc
+// @Summary Get task by number
+// @Description Retrieves a task of a board by its per-board number, given alone (123) or as the task key (PROJ-123),
+// @Description with the same details as getting it by ID
 // @Tags Tasks
-// @Accept json
 // @Produce json
-// @Param id path string true "Task ID" format(uuid)
-// @Success 200 {object} map[string]string "Task deleted successfully"
-// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Param id path string true "Board ID" format(uuid)
+// @Param num path string true "Task number or key"
+// @Param render query string false "Also return task descriptions as sanitized HTML" Enums(html)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {object} TaskResponse "Task details"
+// @Success 304 "Cached copy is current"
+// @Failure 400 {object} map[string]string "Invalid board ID or task number"
 // @Failure 401 {object} map[string]string "Not authenticated"
 // @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 404 {object} map[string]string "Board or task not found"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id} [delete]
-func (h *TaskHandler) Delete(c *gin.Context) {
+// @Router /boards/{id}/tasks/by-number/{num} [get]
+func (h *TaskHandler) GetByNumber(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -601,14 +1054,45 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	taskIDStr := c.Param("id")
-	taskID, err := uuid.Parse(taskIDStr)
+	boardID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid board ID format"})
 		return
 	}
 
-	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	render, ok := wantsHTML(c)
+	if !ok {
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), boardID)
+	if err != nil {
+		if err == repository.ErrBoardNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Board not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		}
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), board.ID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this board"})
+		return
+	}
+
+	number, ok := parseTaskNumber(c.Param("num"), board.Key)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task number, expected a number or a key like " + board.Key + "-1"})
+		return
+	}
+
+	found, err := h.taskRepo.GetByNumber(c.Request.Context(), board.ID, number)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
@@ -618,54 +1102,108 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	h.respondWithTaskDetails(c, found.ID, board, authenticatedUserID, render)
+}
+
+// parseTaskNumber reads a task number given alone or as a task key of the board, ignoring the
+// case of the key
+func parseTaskNumber(value, boardKey string) (int, bool) {
+	if prefix := boardKey + "-"; len(value) > len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+		value = value[len(prefix):]
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return 0, false
+	}
+	return number, true
+}
+
+// respondWithTaskDetails writes the task with its dependencies, references, mentions and GitHub
+// links, as returned when getting a single task. A request for an unchanged task is answered
+// with 304 Not Modified before the task is loaded.
+func (h *TaskHandler) respondWithTaskDetails(c *gin.Context, taskID uuid.UUID, board *model.Board, authenticatedUserID uuid.UUID, render bool) {
+	// Версия читается до данных: изменение между ними лишь даст клиенту лишний ответ 200
+	version, err := h.boardChangeRepo.TaskVersion(c.Request.Context(), taskID, time.Now())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task version"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	// Ссылок GitHub нет в журнале изменений, в версию входят загруженные ссылки
+	links, err := h.githubRepo.GetLinksByTaskID(c.Request.Context(), taskID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve GitHub links"})
+		return
+	}
+	linkVersions := make([][]any, len(links))
+	for i, link := range links {
+		linkVersions[i] = []any{link.ID, link.UpdatedAt}
+	}
+	if notModified(c, privateCacheControl, authenticatedUserID, version, board.UpdatedAt, linkVersions) {
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	task, err := h.taskRepo.GetWithDetails(c.Request.Context(), taskID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID && task.CreatedBy != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to delete this task"})
+	dependencyBlocked, err := h.dependencyBlocked(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task dependencies"})
 		return
 	}
 
-	if err := h.taskRepo.Delete(c.Request.Context(), taskID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task"})
+	response := newTaskListResponse(task, board, dependencyBlocked)
+	if render {
+		renderDescription(&response)
+	}
+
+	response.References, err = h.referenceResponses(c.Request.Context(), task.ID, board.ID, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task references"})
+		return
+	}
+
+	response.Mentions, err = h.mentionResponses(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task mentions"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Task deleted successfully"})
+	response.GitHubLinks = newTaskGitHubLinkResponses(links)
+
+	c.JSON(http.StatusOK, response)
 }
 
-// MoveTask godoc
-// @Summary Move a task
-// @Description Moves a task to a different column and/or position
+// GetByColumnID godoc
+// @Summary Get tasks by column ID
+// @Description Retrieves all tasks for a specific column
 // @Tags Tasks
 // @Accept json
 // @Produce json
-// @Param id path string true "Task ID" format(uuid)
-// @Param move body TaskMoveRequest true "Task move information"
-// @Success 200 {object} map[string]string "Task moved successfully"
-// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Param id path string true "Column ID" format(uuid)
+// @Param sort query string false "Sort order, priority sorts most important first" Enums(position, priority)
+// @Param priority query string false "Comma-separated priorities to include, e.g. high,urgent"
+// @Param swimlane_id query string false "Only tasks of this swimlane, or none for tasks without a swimlane"
+// @Param render query string false "Also return task descriptions as sanitized HTML" Enums(html)
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {array} TaskResponse "List of tasks in the column"
+// @Success 304 "Cached copy is current"
+// @Failure 400 {object} map[string]string "Invalid column ID format, sort, priority or swimlane"
 // @Failure 401 {object} map[string]string "Not authenticated"
 // @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task or column not found"
+// @Failure 404 {object} map[string]string "Column not found"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id}/move [post]
-func (h *TaskHandler) MoveTask(c *gin.Context) {
+// @Router /columns/{id}/tasks [get]
+func (h *TaskHandler) GetByColumnID(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -678,101 +1216,93 @@ func (h *TaskHandler) MoveTask(c *gin.Context) {
 		return
 	}
 
-	taskIDStr := c.Param("id")
-	taskID, err := uuid.Parse(taskIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
-		return
-	}
-
-	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	columnIDStr := c.Param("id")
+	columnID, err := uuid.Parse(columnIDStr)
 	if err != nil {
-		if err == repository.ErrTaskNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
-		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	opts, err := parseTaskListOptions(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	opts.ViewerID = &authenticatedUserID
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+	render, ok := wantsHTML(c)
+	if !ok {
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	column, err := h.columnRepo.GetWithBoard(c.Request.Context(), columnID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
-		return
-	}
-
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to move this task"})
-		return
-	}
-
-	var req TaskMoveRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
 		return
 	}
 
-	targetColumnID, err := uuid.Parse(req.ColumnID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+	if column == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
 		return
 	}
 
-	if targetColumnID != task.ColumnID {
-		targetColumn, err := h.columnRepo.GetByID(c.Request.Context(), targetColumnID)
+	if column.Board.OwnerID != authenticatedUserID {
+		hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve target column"})
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 			return
 		}
 
-		if targetColumn == nil {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Target column not found"})
+		if !hasAccess {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view tasks on this board"})
 			return
 		}
+	}
+	board := &column.Board
 
-		if targetColumn.BoardID != column.BoardID {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot move task to a column from another board"})
-			return
-		}
+	// Версия читается до задач: изменение между ними лишь даст клиенту лишний ответ 200
+	version, err := h.boardChangeRepo.Version(c.Request.Context(), []uuid.UUID{board.ID}, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board version"})
+		return
+	}
+	if notModified(c, privateCacheControl, authenticatedUserID, version, board.UpdatedAt) {
+		return
 	}
 
-	if err := h.taskRepo.MoveTask(c.Request.Context(), taskID, targetColumnID, req.Position); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
+	tasks, err := h.taskRepo.GetTasksWithLabels(c.Request.Context(), columnID, opts)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Task moved successfully"})
+	response := make([]TaskResponse, len(tasks))
+	for i := range tasks {
+		response[i] = newTaskListResponse(&tasks[i].Task, board, tasks[i].DependencyBlocked)
+		if render {
+			renderDescription(&response[i])
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
-// AssignUser godoc
-// @Summary Assign user to task
-// @Description Assigns a user to a specific task
+// BatchGet godoc
+// @Summary Get tasks by IDs
+// @Description Retrieves up to 100 tasks in one request, in the requested order.
+// @Description Tasks that do not exist or are on boards the user cannot view are listed in missing.
 // @Tags Tasks
 // @Accept json
 // @Produce json
-// @Param id path string true "Task ID" format(uuid)
-// @Param assignment body TaskAssignRequest true "User assignment information"
-// @Success 200 {object} map[string]string "User assigned to task successfully"
-// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Param request body BatchGetTasksRequest true "Task IDs"
+// @Param render query string false "Also return task descriptions as sanitized HTML" Enums(html)
+// @Success 200 {object} BatchGetTasksResponse "Requested tasks"
+// @Failure 400 {object} map[string]string "Invalid input"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task or user not found"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id}/assign [post]
-func (h *TaskHandler) AssignUser(c *gin.Context) {
+// @Router /tasks/batch-get [post]
+func (h *TaskHandler) BatchGet(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -785,93 +1315,91 @@ func (h *TaskHandler) AssignUser(c *gin.Context) {
 		return
 	}
 
-	taskIDStr := c.Param("id")
-	taskID, err := uuid.Parse(taskIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+	var req BatchGetTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
-	if err != nil {
-		if err == repository.ErrTaskNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
-		}
+	render, ok := wantsHTML(c)
+	if !ok {
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+	if len(req.IDs) > MaxBatchGetTasks {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("At most %d tasks can be requested at once", MaxBatchGetTasks)})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
-		return
+	ids := make([]uuid.UUID, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		taskID, err := uuid.Parse(id)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+			return
+		}
+		if !slices.Contains(ids, taskID) {
+			ids = append(ids, taskID)
+		}
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	tasks, err := h.taskRepo.GetAccessibleByIDs(c.Request.Context(), ids, authenticatedUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to assign users to this task"})
-		return
+	byID := make(map[uuid.UUID]*repository.TaskListItem, len(tasks))
+	for i := range tasks {
+		byID[tasks[i].ID] = &tasks[i]
 	}
 
-	var req TaskAssignRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
-		return
+	response := BatchGetTasksResponse{Tasks: []TaskResponse{}, Missing: []string{}}
+	for _, id := range ids {
+		task, ok := byID[id]
+		if !ok {
+			response.Missing = append(response.Missing, id.String())
+			continue
+		}
+		response.Tasks = append(response.Tasks, newTaskListResponse(&task.Task, &task.Column.Board, task.DependencyBlocked))
+		if render {
+			renderDescription(&response.Tasks[len(response.Tasks)-1])
+		}
 	}
 
-	assigneeID, err := uuid.Parse(req.UserID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
-		return
-	}
-
-	assignee, err := h.userRepo.GetByID(c.Request.Context(), assigneeID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
-		return
-	}
-
-	if assignee == nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
-		return
-	}
-
-	if err := h.taskRepo.AssignUser(c.Request.Context(), taskID, assigneeID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to assign user to task"})
-		return
-	}
+	c.JSON(http.StatusOK, response)
+}
 
-	c.JSON(http.StatusOK, gin.H{"message": "User assigned to task successfully"})
+// MyTaskResponse represents a task assigned to the authenticated user together with its board
+// @name MyTaskResponse
+type MyTaskResponse struct {
+	TaskResponse
+	BoardID     string `json:"board_id"`
+	BoardTitle  string `json:"board_title"`
+	ColumnTitle string `json:"column_title"`
 }
 
-// UnassignUser godoc
-// @Summary Unassign user from task
-// @Description Removes the assigned user from a task
+// GetMine godoc
+// @Summary Get my tasks
+// @Description Retrieves the open tasks assigned to the authenticated user on every board they can access, soonest
+// @Description due first and tasks without a due date last. Due dates are compared in UTC.
 // @Tags Tasks
-// @Accept json
 // @Produce json
-// @Param id path string true "Task ID" format(uuid)
-// @Success 200 {object} map[string]string "User unassigned from task successfully"
-// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Param board_id query string false "Only tasks of this board" format(uuid)
+// @Param label_id query string false "Only tasks with this label" format(uuid)
+// @Param due_from query string false "Only tasks due on or after this date" format(date)
+// @Param due_to query string false "Only tasks due on or before this date" format(date)
+// @Param overdue query bool false "Only tasks whose due date has passed"
+// @Param include_completed query bool false "Include completed tasks"
+// @Param If-None-Match header string false "ETag of a cached copy"
+// @Success 200 {array} MyTaskResponse "Tasks assigned to the user"
+// @Success 304 "Cached copy is current"
+// @Failure 400 {object} map[string]string "Invalid filter"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task not found"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id}/assign [delete]
-func (h *TaskHandler) UnassignUser(c *gin.Context) {
+// @Router /me/tasks [get]
+func (h *TaskHandler) GetMine(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -884,71 +1412,166 @@ func (h *TaskHandler) UnassignUser(c *gin.Context) {
 		return
 	}
 
-	taskIDStr := c.Param("id")
-	taskID, err := uuid.Parse(taskIDStr)
+	now := time.Now()
+	filter, err := parseAssignedTaskFilter(c, now)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	// Версия всех досок пользователя, в том числе тех, доступ к которым он потерял или получил
+	version, err := h.boardChangeRepo.UserVersion(c.Request.Context(), authenticatedUserID, now)
 	if err != nil {
-		if err == repository.ErrTaskNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
-		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board version"})
 		return
 	}
-
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+	if notModified(c, privateCacheControl, authenticatedUserID, version) {
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	tasks, err := h.taskRepo.GetAssigned(c.Request.Context(), authenticatedUserID, filter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve tasks"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	ids := make([]uuid.UUID, len(tasks))
+	for i := range tasks {
+		ids[i] = tasks[i].ID
+	}
+
+	blockers, err := h.relationRepo.OpenBlockerCounts(c.Request.Context(), ids)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task dependencies"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
-		return
+	response := make([]MyTaskResponse, len(tasks))
+	for i := range tasks {
+		task := &tasks[i]
+		response[i] = MyTaskResponse{
+			TaskResponse: newTaskListResponse(task, &task.Column.Board, blockers[task.ID] > 0),
+			BoardID:      task.Column.BoardID.String(),
+			BoardTitle:   task.Column.Board.Title,
+			ColumnTitle:  task.Column.Title,
+		}
 	}
 
-	if err := h.taskRepo.UnassignUser(c.Request.Context(), taskID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unassign user from task"})
-		return
+	c.JSON(http.StatusOK, response)
+}
+
+// parseAssignedTaskFilter reads the filter query parameters of GET /me/tasks. Dates are whole
+// UTC days, so due_to includes tasks due at any time of that day.
+func parseAssignedTaskFilter(c *gin.Context, now time.Time) (repository.AssignedTaskFilter, error) {
+	var filter repository.AssignedTaskFilter
+
+	for name, target := range map[string]**uuid.UUID{"board_id": &filter.BoardID, "label_id": &filter.LabelID} {
+		if value := c.Query(name); value != "" {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return filter, fmt.Errorf("Invalid %s %q", name, value)
+			}
+			*target = &id
+		}
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "User unassigned from task successfully"})
+	if value := c.Query("due_from"); value != "" {
+		day, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid due_from %q, expected YYYY-MM-DD", value)
+		}
+		filter.DueFrom = &day
+	}
+	if value := c.Query("due_to"); value != "" {
+		day, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid due_to %q, expected YYYY-MM-DD", value)
+		}
+		dueBefore := day.AddDate(0, 0, 1)
+		filter.DueBefore = &dueBefore
+	}
+
+	if value := c.Query("overdue"); value != "" {
+		overdue, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid overdue %q, expected true or false", value)
+		}
+		if overdue {
+			filter.Overdue = &now
+		}
+	}
+	if value := c.Query("include_completed"); value != "" {
+		includeCompleted, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid include_completed %q, expected true or false", value)
+		}
+		filter.IncludeCompleted = includeCompleted
+	}
+	return filter, nil
 }
 
-// AddLabel godoc
-// @Summary Add label to task
-// @Description Adds a label to a specific task
+// newTaskListResponse builds the response of a task loaded with its creator, assignee and labels.
+// References and mentions are only returned for a single task.
+func newTaskListResponse(task *model.Task, board *model.Board, dependencyBlocked bool) TaskResponse {
+	response := TaskResponse{
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      task.ColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		CreatorName:   task.Creator.Name,
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
+
+		DependencyBlocked: dependencyBlocked,
+	}
+
+	setTaskDates(&response, task)
+
+	if task.AssignedTo != nil {
+		assignedToStr := task.AssignedTo.String()
+		response.AssignedTo = &assignedToStr
+		response.AssigneeName = &task.Assignee.Name
+	}
+
+	if len(task.Labels) > 0 {
+		labels := make([]LabelResponse, len(task.Labels))
+		for j, label := range task.Labels {
+			labels[j] = newLabelResponse(label)
+		}
+		response.Labels = labels
+	}
+
+	return response
+}
+
+// Update godoc
+// @Summary Update a task
+// @Description Updates an existing task with new details. The description can't be changed while another user holds
+// @Description a lock on the task.
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID" format(uuid)
-// @Param label_id path string true "Label ID" format(uuid)
-// @Success 200 {object} map[string]string "Label added to task successfully"
-// @Failure 400 {object} map[string]string "Invalid task or label ID format"
+// @Param task body TaskRequest true "Updated task information"
+// @Success 200 {object} TaskResponse "Task updated successfully"
+// @Failure 400 {object} map[string]string "Invalid request or task ID format"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Task or column not found"
+// @Failure 423 {object} map[string]interface{} "Board is frozen or the description is locked by another user"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id}/labels/{label_id} [post]
-func (h *TaskHandler) AddLabel(c *gin.Context) {
+// @Router /tasks/{id} [put]
+func (h *TaskHandler) Update(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -968,13 +1591,6 @@ func (h *TaskHandler) AddLabel(c *gin.Context) {
 		return
 	}
 
-	labelIDStr := c.Param("label_id")
-	labelID, err := uuid.Parse(labelIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid label ID format"})
-		return
-	}
-
 	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
 	if err != nil {
 		if err == repository.ErrTaskNotFound {
@@ -985,137 +1601,191 @@ func (h *TaskHandler) AddLabel(c *gin.Context) {
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
 	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to add labels to this task"})
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to update this task"})
 		return
 	}
 
-	if err := h.taskRepo.AddLabel(c.Request.Context(), taskID, labelID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add label to task"})
+	if !checkBoardWritable(c, board) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Label added to task successfully"})
-}
-
-// RemoveLabel godoc
-// @Summary Remove label from task
-// @Description Removes a label from a specific task
-// @Tags Tasks
-// @Accept json
-// @Produce json
-// @Param id path string true "Task ID" format(uuid)
-// @Param label_id path string true "Label ID" format(uuid)
-// @Success 200 {object} map[string]string "Label removed from task successfully"
-// @Failure 400 {object} map[string]string "Invalid task or label ID format"
-// @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task not found"
-// @Failure 500 {object} map[string]string "Server error"
-// @Security BearerAuth
-// @Router /tasks/{id}/labels/{label_id} [delete]
-func (h *TaskHandler) RemoveLabel(c *gin.Context) {
-	userID, exists := c.Get(middleware.UserIDKey)
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+	var req TaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
 		return
 	}
 
-	authenticatedUserID, ok := userID.(uuid.UUID)
-	if !ok {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+	if !validTaskDates(req.StartDate, req.DueDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Start date must not be after the due date"})
 		return
 	}
 
-	taskIDStr := c.Param("id")
-	taskID, err := uuid.Parse(taskIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+	descriptionChanged := req.Description != task.Description
+	if descriptionChanged && !h.checkDescriptionLock(c, task.ID, authenticatedUserID) {
 		return
 	}
 
-	labelIDStr := c.Param("label_id")
-	labelID, err := uuid.Parse(labelIDStr)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid label ID format"})
-		return
+	var newColumnID uuid.UUID
+	var columnChanged bool
+	if req.ColumnID != task.ColumnID.String() {
+		columnChanged = true
+		newColumnID, err = uuid.Parse(req.ColumnID)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+			return
+		}
+
+		newColumn, err := h.perms.GetColumn(c.Request.Context(), newColumnID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+			return
+		}
+
+		if newColumn == nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Column not found"})
+			return
+		}
+
+		if newColumn.BoardID != column.BoardID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot move task to a column from another board"})
+			return
+		}
+
+		if !checkTaskLimit(c, h.limits, h.taskRepo, board, newColumnID) {
+			return
+		}
+	} else {
+		newColumnID = task.ColumnID
 	}
 
-	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
-	if err != nil {
-		if err == repository.ErrTaskNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
-		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+	task.Title = req.Title
+	task.Description = req.Description
+	task.StartDate = req.StartDate
+	task.DueDate = req.DueDate
+	task.Estimate = req.Estimate
+	if req.Priority != "" {
+		task.Priority = req.Priority
+	}
+
+	if columnChanged || (req.Position != nil && *req.Position != task.Position) {
+		position := task.Position
+		if req.Position != nil {
+			position = *req.Position
+		}
+
+		err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+			return h.taskRepo.MoveTask(ctx, taskID, newColumnID, position)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
+			return
 		}
+	} else {
+		err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+			if err := h.syncDescriptionDoc(ctx, task, authenticatedUserID, descriptionChanged, time.Now()); err != nil {
+				return err
+			}
+			return h.taskRepo.Update(ctx, task)
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task"})
+			return
+		}
+	}
+
+	if err := h.syncReferences(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task references"})
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+	if err := h.syncMentions(c.Request.Context(), task, board, authenticatedUserID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task mentions"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	response := TaskResponse{
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      newColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
+	}
+
+	setTaskDates(&response, task)
+
+	response.References, err = h.referenceResponses(c.Request.Context(), task.ID, board.ID, authenticatedUserID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task references"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	response.Mentions, err = h.mentionResponses(c.Request.Context(), task.ID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task mentions"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to remove labels from this task"})
+	response.GitHubLinks, err = h.gitHubLinkResponses(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve GitHub links"})
 		return
 	}
 
-	if err := h.taskRepo.RemoveLabel(c.Request.Context(), taskID, labelID); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove label from task"})
+	response.DependencyBlocked, err = h.dependencyBlocked(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task dependencies"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Label removed from task successfully"})
+	c.JSON(http.StatusOK, response)
 }
 
-// GetTaskLabels godoc
-// @Summary Get task labels
-// @Description Retrieves all labels associated with a specific task
+// Delete godoc
+// @Summary Delete a task
+// @Description Deletes a task by its ID
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID" format(uuid)
-// @Success 200 {array} LabelResponse "List of labels associated with the task"
+// @Success 200 {object} map[string]string "Task deleted successfully"
 // @Failure 400 {object} map[string]string "Invalid task ID format"
 // @Failure 401 {object} map[string]string "Not authenticated"
 // @Failure 403 {object} map[string]string "Permission denied"
 // @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id}/labels [get]
-func (h *TaskHandler) GetTaskLabels(c *gin.Context) {
+// @Router /tasks/{id} [delete]
+func (h *TaskHandler) Delete(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -1145,69 +1815,85 @@ func (h *TaskHandler) GetTaskLabels(c *gin.Context) {
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
-	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task's labels"})
+	if !hasAccess && board.OwnerID != authenticatedUserID && task.CreatedBy != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to delete this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
 		return
 	}
 
-	taskWithLabels, err := h.taskRepo.GetTasksWithLabels(c.Request.Context(), column.ID)
+	labels, err := h.labelRepo.GetByTaskID(c.Request.Context(), taskID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task labels"})
 		return
 	}
 
-	var labels []LabelResponse
-	for _, t := range taskWithLabels {
-		if t.ID == taskID {
-			for _, label := range t.Labels {
-				labels = append(labels, LabelResponse{
-					ID:    label.ID.String(),
-					Name:  label.Name,
-					Color: label.Color,
-				})
-			}
-			break
+	relations, err := h.relationRepo.GetByTaskID(c.Request.Context(), taskID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task relations"})
+		return
+	}
+
+	payload := model.ActionPayload{TaskID: taskID, Task: task, Relations: relations}
+	for _, label := range labels {
+		payload.LabelIDs = append(payload.LabelIDs, label.ID)
+	}
+
+	var action *model.Action
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.taskRepo.Delete(ctx, taskID); err != nil {
+			return err
 		}
+		action, err = h.recordAction(ctx, authenticatedUserID, board.ID, model.ActionTaskDelete, payload)
+		return err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task"})
+		return
 	}
 
-	c.JSON(http.StatusOK, labels)
+	c.JSON(http.StatusOK, gin.H{"message": "Task deleted successfully", "action_id": action.ID.String()})
 }
 
-// SetDueDate godoc
-// @Summary Set task due date
-// @Description Sets or updates the due date for a task
+// MoveTask godoc
+// @Summary Move a task
+// @Description Moves a task to a different column and/or position. Positions in the source and target columns are
+// @Description renumbered without gaps and returned, so clients can reconcile their state without refetching.
 // @Tags Tasks
 // @Accept json
 // @Produce json
 // @Param id path string true "Task ID" format(uuid)
-// @Param due_date body SetDueDateRequest true "Due date information"
-// @Success 200 {object} TaskResponse "Due date updated successfully"
+// @Param move body TaskMoveRequest true "Task move information"
+// @Success 200 {object} TaskMoveResponse "Task moved, with the new positions of all tasks in the affected columns"
 // @Failure 400 {object} map[string]string "Invalid request or task ID format"
 // @Failure 401 {object} map[string]string "Not authenticated"
-// @Failure 403 {object} map[string]string "Permission denied"
-// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Task or column not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BearerAuth
-// @Router /tasks/{id}/due-date [post]
-func (h *TaskHandler) SetDueDate(c *gin.Context) {
+// @Router /tasks/{id}/move [post]
+func (h *TaskHandler) MoveTask(c *gin.Context) {
 	userID, exists := c.Get(middleware.UserIDKey)
 	if !exists {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
@@ -1237,56 +1923,2061 @@ func (h *TaskHandler) SetDueDate(c *gin.Context) {
 		return
 	}
 
-	column, err := h.columnRepo.GetByID(c.Request.Context(), task.ColumnID)
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
 		return
 	}
 
-	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to move this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req TaskMoveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	targetColumnID, err := uuid.Parse(req.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid column ID format"})
+		return
+	}
+
+	if targetColumnID != task.ColumnID {
+		targetColumn, err := h.perms.GetColumn(c.Request.Context(), targetColumnID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve target column"})
+			return
+		}
+
+		if targetColumn == nil {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Target column not found"})
+			return
+		}
+
+		if targetColumn.BoardID != column.BoardID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Cannot move task to a column from another board"})
+			return
+		}
+
+		if !checkTaskLimit(c, h.limits, h.taskRepo, board, targetColumnID) {
+			return
+		}
+	}
+
+	var action *model.Action
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		if err := h.taskRepo.MoveTask(ctx, taskID, targetColumnID, req.Position); err != nil {
+			return err
+		}
+		action, err = h.recordAction(ctx, authenticatedUserID, board.ID, model.ActionTaskMove, model.ActionPayload{
+			TaskID:       taskID,
+			FromColumnID: &task.ColumnID,
+			FromPosition: task.Position,
+			ToColumnID:   &targetColumnID,
+		})
+		return err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
+		return
+	}
+
+	positions, err := h.taskRepo.GetPositions(c.Request.Context(), []uuid.UUID{task.ColumnID, targetColumnID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task positions"})
+		return
+	}
+
+	response := TaskMoveResponse{
+		Message:  "Task moved successfully",
+		ActionID: action.ID.String(),
+		Affected: make([]TaskPositionResponse, len(positions)),
+	}
+	for i, t := range positions {
+		response.Affected[i] = TaskPositionResponse{
+			ID:       t.ID.String(),
+			ColumnID: t.ColumnID.String(),
+			Position: t.Position,
+		}
+		if t.ID == taskID {
+			response.Task = response.Affected[i]
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MoveToBoard godoc
+// @Summary Move a task to another board
+// @Description Moves a task to a column of another board; the user needs edit access to both boards. The task gets a new
+// @Description number on the target board. Its labels are replaced by the target board's labels of the same name and
+// @Description removed when the board has none. The task keeps its swimlane when the target board has one with the same
+// @Description title and goes to the default lane otherwise. It leaves its sprint, its relations are removed, and its
+// @Description assignee is unassigned without access to the target board. The move is recorded in the task history.
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param move body TaskMoveToBoardRequest true "Target column and position"
+// @Success 200 {object} TaskMoveToBoardResponse "Task moved"
+// @Failure 400 {object} map[string]string "Invalid request, or the column is on the task's board"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Task or column not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/move-to-board [post]
+func (h *TaskHandler) MoveToBoard(c *gin.Context) {
+	authenticatedUserID, task, board, ok := h.accessibleTask(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	var req TaskMoveToBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+	targetColumnID, _ := uuid.Parse(req.ColumnID)
+
+	targetColumn, err := h.perms.GetColumn(c.Request.Context(), targetColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve target column"})
+		return
+	}
+
+	if targetColumn == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Target column not found"})
+		return
+	}
+
+	if targetColumn.BoardID == board.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "The column is on the task's board, move the task within the board instead"})
+		return
+	}
+
+	target, err := h.perms.GetBoard(c.Request.Context(), targetColumn.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), target.ID, authenticatedUserID, model.RoleEditor)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
 		return
 	}
 
-	board, err := h.boardRepo.GetByID(c.Request.Context(), column.BoardID)
+	if !hasAccess && target.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You need edit access to both boards to move this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) || !checkBoardWritable(c, target) {
+		return
+	}
+
+	if !checkTaskLimit(c, h.limits, h.taskRepo, target, targetColumnID) {
+		return
+	}
+
+	response := TaskMoveToBoardResponse{}
+	err = h.withHistory(c.Request.Context(), task.ID, authenticatedUserID, func(ctx context.Context) error {
+		// Связи возможны только внутри доски, поэтому удаляются до переноса
+		if err := h.relationRepo.DeleteByTaskID(ctx, task.ID); err != nil {
+			return err
+		}
+
+		if err := h.taskRepo.MoveTask(ctx, task.ID, targetColumnID, req.Position); err != nil {
+			return err
+		}
+
+		if err := h.taskRepo.SettleOnBoard(ctx, task.ID, target.ID); err != nil {
+			return err
+		}
+
+		var err error
+		response.LabelsRemapped, response.LabelsDropped, err = h.labelRepo.RemapTaskLabels(ctx, task.ID, target.ID, target.OwnerID)
+		if err != nil {
+			return err
+		}
+
+		return h.changeRepo.Record(ctx, []model.TaskChange{{
+			TaskID:   task.ID,
+			UserID:   &authenticatedUserID,
+			Field:    model.TaskFieldBoard,
+			OldValue: historyText(board.ID.String()),
+			NewValue: historyText(target.ID.String()),
+		}})
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
+		return
+	}
+
+	moved, err := h.taskRepo.GetByID(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		return
+	}
+
+	response.Task = TaskPositionResponse{
+		ID:       moved.ID.String(),
+		ColumnID: moved.ColumnID.String(),
+		Position: moved.Position,
+	}
+	response.Key = taskKey(target, moved)
+	c.JSON(http.StatusOK, response)
+}
+
+// AssignUser godoc
+// @Summary Assign user to task
+// @Description Assigns a user to a specific task
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param assignment body TaskAssignRequest true "User assignment information"
+// @Success 200 {object} map[string]string "User assigned to task successfully"
+// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task or user not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/assign [post]
+func (h *TaskHandler) AssignUser(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskIDStr := c.Param("id")
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
 		return
 	}
 
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
 	if !hasAccess && board.OwnerID != authenticatedUserID {
-		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to assign users to this task"})
 		return
 	}
 
-	var req struct {
-		DueDate *time.Time `json:"due_date"`
+	if !checkBoardWritable(c, board) {
+		return
 	}
+
+	var req TaskAssignRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
 		return
 	}
 
-	task.DueDate = req.DueDate
-	if err := h.taskRepo.Update(c.Request.Context(), task); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task due date"})
+	assigneeID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
 		return
 	}
 
-	response := TaskResponse{
-		ID:          task.ID.String(),
-		Title:       task.Title,
-		Description: task.Description,
-		ColumnID:    task.ColumnID.String(),
-		CreatedBy:   task.CreatedBy.String(),
-		Position:    task.Position,
+	assignee, err := h.userRepo.GetByID(c.Request.Context(), assigneeID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve user"})
+		return
 	}
 
-	if task.DueDate != nil {
-		dueDate := task.DueDate.Format(time.RFC3339)
-		response.DueDate = &dueDate
+	if assignee == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		return h.taskRepo.AssignUser(ctx, taskID, assigneeID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to assign user to task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User assigned to task successfully"})
+}
+
+// UnassignUser godoc
+// @Summary Unassign user from task
+// @Description Removes the assigned user from a task
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} map[string]string "User unassigned from task successfully"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/assign [delete]
+func (h *TaskHandler) UnassignUser(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskIDStr := c.Param("id")
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		return h.taskRepo.UnassignUser(ctx, taskID)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unassign user from task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User unassigned from task successfully"})
+}
+
+// AddLabel godoc
+// @Summary Add label to task
+// @Description Adds a label to a specific task
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param label_id path string true "Label ID" format(uuid)
+// @Success 200 {object} map[string]string "Label added to task successfully"
+// @Failure 400 {object} map[string]string "Invalid task or label ID format, or label not available on the board"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task or label not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/labels/{label_id} [post]
+func (h *TaskHandler) AddLabel(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskIDStr := c.Param("id")
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	labelIDStr := c.Param("label_id")
+	labelID, err := uuid.Parse(labelIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid label ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to add labels to this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	label, err := h.labelRepo.GetByID(c.Request.Context(), labelID)
+	if err != nil {
+		if err == repository.ErrLabelNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Label not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve label"})
+		}
+		return
+	}
+
+	onBoard := label.BoardID != nil && *label.BoardID == board.ID
+	inWorkspace := label.OwnerID != nil && *label.OwnerID == board.OwnerID
+	if !onBoard && !inWorkspace {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Label is not available on this board"})
+		return
+	}
+
+	if err := h.taskRepo.AddLabel(c.Request.Context(), taskID, labelID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add label to task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Label added to task successfully"})
+}
+
+// RemoveLabel godoc
+// @Summary Remove label from task
+// @Description Removes a label from a specific task
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param label_id path string true "Label ID" format(uuid)
+// @Success 200 {object} map[string]string "Label removed from task successfully"
+// @Failure 400 {object} map[string]string "Invalid task or label ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/labels/{label_id} [delete]
+func (h *TaskHandler) RemoveLabel(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskIDStr := c.Param("id")
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	labelIDStr := c.Param("label_id")
+	labelID, err := uuid.Parse(labelIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid label ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to remove labels from this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	labels, err := h.labelRepo.GetByTaskID(c.Request.Context(), taskID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task labels"})
+		return
+	}
+
+	// Снятие метки, которой у задачи нет, нечего отменять
+	if !slices.ContainsFunc(labels, func(label model.Label) bool { return label.ID == labelID }) {
+		c.JSON(http.StatusOK, gin.H{"message": "Label removed from task successfully"})
+		return
+	}
+
+	var action *model.Action
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.taskRepo.RemoveLabel(ctx, taskID, labelID); err != nil {
+			return err
+		}
+		action, err = h.recordAction(ctx, authenticatedUserID, board.ID, model.ActionTaskLabelRemove, model.ActionPayload{
+			TaskID:  taskID,
+			LabelID: &labelID,
+		})
+		return err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove label from task"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Label removed from task successfully", "action_id": action.ID.String()})
+}
+
+// GetTaskLabels godoc
+// @Summary Get task labels
+// @Description Retrieves all labels associated with a specific task
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {array} LabelResponse "List of labels associated with the task"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/labels [get]
+func (h *TaskHandler) GetTaskLabels(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskIDStr := c.Param("id")
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task's labels"})
+		return
+	}
+
+	taskWithLabels, err := h.taskRepo.GetTasksWithLabels(c.Request.Context(), column.ID, repository.TaskListOptions{})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task labels"})
+		return
+	}
+
+	var labels []LabelResponse
+	for _, t := range taskWithLabels {
+		if t.ID == taskID {
+			for _, label := range t.Labels {
+				labels = append(labels, newLabelResponse(label))
+			}
+			break
+		}
+	}
+
+	c.JSON(http.StatusOK, labels)
+}
+
+// SetDueDate godoc
+// @Summary Set task due date
+// @Description Sets or updates the due date for a task
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param due_date body SetDueDateRequest true "Due date information"
+// @Success 200 {object} TaskResponse "Due date updated successfully"
+// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/due-date [post]
+func (h *TaskHandler) SetDueDate(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskIDStr := c.Param("id")
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req struct {
+		DueDate *time.Time `json:"due_date"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	if !validTaskDates(task.StartDate, req.DueDate) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Due date must not be before the start date"})
+		return
+	}
+
+	task.DueDate = req.DueDate
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		return h.taskRepo.Update(ctx, task)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task due date"})
+		return
+	}
+
+	response := TaskResponse{
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      task.ColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
+	}
+
+	setTaskDates(&response, task)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetBlocked godoc
+// @Summary Set task blocked flag
+// @Description Marks a task as blocked with an optional reason, or clears the flag
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param blocked body SetBlockedRequest true "Blocked flag information"
+// @Success 200 {object} TaskResponse "Blocked flag updated successfully"
+// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/blocked [post]
+func (h *TaskHandler) SetBlocked(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req SetBlockedRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	task.Blocked = req.Blocked
+	task.BlockedReason = ""
+	if req.Blocked {
+		task.BlockedReason = req.Reason
+	}
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		return h.taskRepo.Update(ctx, task)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task"})
+		return
+	}
+
+	response := TaskResponse{
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      task.ColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
+	}
+
+	setTaskDates(&response, task)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetCover godoc
+// @Summary Set task cover
+// @Description Sets the cover color shown on the task card, or removes the cover when the color is empty
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param cover body SetCoverRequest true "Cover information"
+// @Success 200 {object} TaskResponse "Cover updated successfully"
+// @Failure 400 {object} map[string]string "Invalid request or task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/cover [put]
+func (h *TaskHandler) SetCover(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req SetCoverRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+		return
+	}
+
+	if req.Color != "" && !palette.IsHex(req.Color) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Color must be a hex code such as #4caf50"})
+		return
+	}
+
+	task.CoverColor = req.Color
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		return h.taskRepo.Update(ctx, task)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task"})
+		return
+	}
+
+	response := TaskResponse{
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      task.ColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
+	}
+
+	setTaskDates(&response, task)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Complete godoc
+// @Summary Complete a task
+// @Description Marks a task as completed without moving it. Tasks moved into a done column are completed automatically.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} TaskResponse "Task completed successfully"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/complete [post]
+func (h *TaskHandler) Complete(c *gin.Context) {
+	h.setCompleted(c, true)
+}
+
+// Reopen godoc
+// @Summary Reopen a task
+// @Description Clears the completion of a task. Tasks in a done column stay completed until they are moved out of it.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} TaskResponse "Task reopened successfully"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 409 {object} map[string]string "Task is in a done column"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/complete [delete]
+func (h *TaskHandler) Reopen(c *gin.Context) {
+	h.setCompleted(c, false)
+}
+
+func (h *TaskHandler) setCompleted(c *gin.Context, completed bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to modify this task"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	if completed {
+		if task.CompletedAt == nil {
+			now := time.Now()
+			task.CompletedAt = &now
+		}
+	} else {
+		// В колонке «готово» задача всегда завершена, переоткрыть её можно только перенеся в другую колонку
+		if column.IsDone {
+			c.JSON(http.StatusConflict, gin.H{"error": "Task is in a done column, move it to another column to reopen it"})
+			return
+		}
+		task.CompletedAt = nil
+	}
+	err = h.withHistory(c.Request.Context(), taskID, authenticatedUserID, func(ctx context.Context) error {
+		return h.taskRepo.Update(ctx, task)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task"})
+		return
+	}
+
+	response := TaskResponse{
+		ID:            task.ID.String(),
+		Title:         task.Title,
+		Description:   task.Description,
+		ColumnID:      task.ColumnID.String(),
+		CreatedBy:     task.CreatedBy.String(),
+		Position:      task.Position,
+		Number:        task.Number,
+		Key:           taskKey(board, task),
+		Blocked:       task.Blocked,
+		BlockedReason: task.BlockedReason,
+		Priority:      task.Priority,
+		SwimlaneID:    uuidString(task.SwimlaneID),
+		Estimate:      task.Estimate,
+		SprintID:      uuidString(task.SprintID),
+		Cover:         newTaskCoverResponse(task),
+	}
+
+	setTaskDates(&response, task)
+
+	c.JSON(http.StatusOK, response)
+}
+
+// TaskChangeResponse represents a change in the history of a task. Values are strings:
+// IDs for column, board and assignee, RFC 3339 times for dates and completion; null means unset.
+// @name TaskChangeResponse
+type TaskChangeResponse struct {
+	Field    string  `json:"field" enums:"title,description,column,board,assignee,start_date,due_date,priority,blocked,blocked_reason,estimate,cover,completed"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+	// UserID and UserName are empty once the user who made the change is deleted
+	UserID    string `json:"user_id,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+	ChangedAt string `json:"changed_at"`
+}
+
+// GetHistory godoc
+// @Summary Get task history
+// @Description Lists the changes made to the fields of a task and who made them, latest first
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {array} TaskChangeResponse "Changes of the task"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/history [get]
+func (h *TaskHandler) GetHistory(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, model.RoleViewer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task"})
+		return
+	}
+
+	changes, err := h.changeRepo.GetByTaskID(c.Request.Context(), taskID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task history"})
+		return
+	}
+
+	response := make([]TaskChangeResponse, len(changes))
+	for i, change := range changes {
+		response[i] = TaskChangeResponse{
+			Field:     change.Field,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			ChangedAt: change.CreatedAt.Format(time.RFC3339),
+		}
+		if change.UserID != nil {
+			response[i].UserID = change.UserID.String()
+			response[i].UserName = change.User.Name
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UndoWindow is how long after an action it can be undone
+const UndoWindow = 10 * time.Minute
+
+// ActionResponse represents an action of the current user that can still be undone
+// @name ActionResponse
+type ActionResponse struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind" enums:"task_delete,task_move,task_label_remove"`
+	BoardID   string `json:"board_id"`
+	TaskID    string `json:"task_id"`
+	CreatedAt string `json:"created_at"`
+	// ExpiresAt is when the action can no longer be undone
+	ExpiresAt string `json:"expires_at"`
+}
+
+// GetActions godoc
+// @Summary List undoable actions
+// @Description Lists the current user's task deletions, moves and label removals that can still be undone, latest first
+// @Tags Actions
+// @Produce json
+// @Success 200 {array} ActionResponse "Undoable actions"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /actions [get]
+func (h *TaskHandler) GetActions(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	actions, err := h.actionRepo.GetUndoable(c.Request.Context(), authenticatedUserID, time.Now().Add(-UndoWindow))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve actions"})
+		return
+	}
+
+	response := make([]ActionResponse, len(actions))
+	for i, action := range actions {
+		response[i] = ActionResponse{
+			ID:        action.ID.String(),
+			Kind:      action.Kind,
+			BoardID:   action.BoardID.String(),
+			TaskID:    action.Payload.TaskID.String(),
+			CreatedAt: action.CreatedAt.Format(time.RFC3339),
+			ExpiresAt: action.CreatedAt.Add(UndoWindow).Format(time.RFC3339),
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Undo godoc
+// @Summary Undo an action
+// @Description Reverts a task deletion, move or label removal of the current user made in the last 10 minutes.
+// @Description A deleted task comes back at its position with its labels and relations; a moved task goes back
+// @Description to where it was unless it was moved again since. Each action can be undone once.
+// @Tags Actions
+// @Produce json
+// @Param id path string true "Action ID" format(uuid)
+// @Success 200 {object} map[string]string "Action undone"
+// @Failure 400 {object} map[string]string "Invalid action ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied or task limit of the column reached"
+// @Failure 404 {object} map[string]string "Action not found"
+// @Failure 409 {object} map[string]string "Action already undone, expired or overtaken by later changes"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /actions/{id}/undo [post]
+func (h *TaskHandler) Undo(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	actionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid action ID format"})
+		return
+	}
+
+	action, err := h.actionRepo.GetByID(c.Request.Context(), actionID)
+	if err != nil {
+		if err == repository.ErrActionNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Action not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve action"})
+		}
+		return
+	}
+
+	// Чужие действия не раскрываем
+	if action.UserID != authenticatedUserID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Action not found"})
+		return
+	}
+
+	if action.UndoneAt != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Action is already undone"})
+		return
+	}
+
+	if time.Since(action.CreatedAt) > UndoWindow {
+		c.JSON(http.StatusConflict, gin.H{"error": "Action is too old to be undone"})
+		return
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), action.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), board.ID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	// Удалить задачу может и её автор, значит и вернуть её тоже
+	isCreator := action.Payload.Task != nil && action.Payload.Task.CreatedBy == authenticatedUserID
+	if !hasAccess && board.OwnerID != authenticatedUserID && !isCreator {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to undo this action"})
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	switch action.Kind {
+	case model.ActionTaskDelete:
+		h.undoDelete(c, action, board, authenticatedUserID)
+	case model.ActionTaskMove:
+		h.undoMove(c, action, board, authenticatedUserID)
+	case model.ActionTaskLabelRemove:
+		h.undoLabelRemove(c, action)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Unsupported action"})
+	}
+}
+
+// undoDelete restores a deleted task into its column
+func (h *TaskHandler) undoDelete(c *gin.Context, action *model.Action, board *model.Board, userID uuid.UUID) {
+	task := action.Payload.Task
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil || column.BoardID != board.ID {
+		c.JSON(http.StatusConflict, gin.H{"error": "The column of the task no longer exists on the board"})
+		return
+	}
+
+	if !checkTaskLimit(c, h.limits, h.taskRepo, board, column.ID) {
+		return
+	}
+
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.actionRepo.MarkUndone(ctx, action.ID); err != nil {
+			return err
+		}
+		if err := h.taskRepo.Restore(ctx, task, action.Payload.LabelIDs, action.Payload.Relations); err != nil {
+			return err
+		}
+		if err := h.syncReferences(ctx, task, board, userID); err != nil {
+			return err
+		}
+		return h.syncMentions(ctx, task, board, userID)
+	})
+	if err != nil {
+		if err == repository.ErrActionUndone {
+			c.JSON(http.StatusConflict, gin.H{"error": "Action is already undone"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore task"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task restored successfully", "task_id": task.ID.String()})
+}
+
+// undoMove moves a task back to the column and position it was moved from
+func (h *TaskHandler) undoMove(c *gin.Context, action *model.Action, board *model.Board, userID uuid.UUID) {
+	payload := action.Payload
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), payload.TaskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusConflict, gin.H{"error": "The task no longer exists"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	if task.ColumnID != *payload.ToColumnID {
+		c.JSON(http.StatusConflict, gin.H{"error": "The task was moved again since"})
+		return
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), *payload.FromColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return
+	}
+
+	if column == nil || column.BoardID != board.ID {
+		c.JSON(http.StatusConflict, gin.H{"error": "The column the task was moved from no longer exists on the board"})
+		return
+	}
+
+	if column.ID != task.ColumnID && !checkTaskLimit(c, h.limits, h.taskRepo, board, column.ID) {
+		return
+	}
+
+	err = h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.actionRepo.MarkUndone(ctx, action.ID); err != nil {
+			return err
+		}
+		return h.withHistory(ctx, task.ID, userID, func(ctx context.Context) error {
+			return h.taskRepo.MoveTask(ctx, task.ID, column.ID, payload.FromPosition)
+		})
+	})
+	if err != nil {
+		if err == repository.ErrActionUndone {
+			c.JSON(http.StatusConflict, gin.H{"error": "Action is already undone"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to move task"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task moved back successfully"})
+}
+
+// undoLabelRemove attaches the removed label to the task again
+func (h *TaskHandler) undoLabelRemove(c *gin.Context, action *model.Action) {
+	payload := action.Payload
+
+	if _, err := h.taskRepo.GetByID(c.Request.Context(), payload.TaskID); err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusConflict, gin.H{"error": "The task no longer exists"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	if _, err := h.labelRepo.GetByID(c.Request.Context(), *payload.LabelID); err != nil {
+		if err == repository.ErrLabelNotFound {
+			c.JSON(http.StatusConflict, gin.H{"error": "The label no longer exists"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve label"})
+		}
+		return
+	}
+
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		if err := h.actionRepo.MarkUndone(ctx, action.ID); err != nil {
+			return err
+		}
+		return h.taskRepo.AddLabel(ctx, payload.TaskID, *payload.LabelID)
+	})
+	if err != nil {
+		if err == repository.ErrActionUndone {
+			c.JSON(http.StatusConflict, gin.H{"error": "Action is already undone"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add label to task"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Label added back to task successfully"})
+}
+
+// recordAction records an action of the user so it can be undone for UndoWindow
+func (h *TaskHandler) recordAction(ctx context.Context, userID, boardID uuid.UUID, kind string, payload model.ActionPayload) (*model.Action, error) {
+	action := &model.Action{UserID: userID, BoardID: boardID, Kind: kind, Payload: payload}
+	if err := h.actionRepo.Create(ctx, action, time.Now().Add(-UndoWindow)); err != nil {
+		return nil, err
+	}
+	return action, nil
+}
+
+// withHistory runs write and records the task fields it changed on behalf of the user,
+// both in one transaction. The task is read back after the write, so side effects such as
+// completing a task moved into a done column end up in the history too.
+func (h *TaskHandler) withHistory(ctx context.Context, taskID, userID uuid.UUID, write func(ctx context.Context) error) error {
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := h.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if err := write(ctx); err != nil {
+			return err
+		}
+		after, err := h.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		return h.changeRepo.Record(ctx, diffTask(before, after, userID))
+	})
+}
+
+// diffTask lists the changes of the tracked fields between two versions of a task
+func diffTask(before, after *model.Task, userID uuid.UUID) []model.TaskChange {
+	var changes []model.TaskChange
+	add := func(field string, oldValue, newValue *string) {
+		if oldValue == nil && newValue == nil || oldValue != nil && newValue != nil && *oldValue == *newValue {
+			return
+		}
+		changes = append(changes, model.TaskChange{
+			TaskID:   after.ID,
+			UserID:   &userID,
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	add(model.TaskFieldTitle, historyText(before.Title), historyText(after.Title))
+	add(model.TaskFieldDescription, historyText(before.Description), historyText(after.Description))
+	add(model.TaskFieldColumn, historyText(before.ColumnID.String()), historyText(after.ColumnID.String()))
+	add(model.TaskFieldAssignee, uuidString(before.AssignedTo), uuidString(after.AssignedTo))
+	add(model.TaskFieldStartDate, historyTime(before.StartDate), historyTime(after.StartDate))
+	add(model.TaskFieldDueDate, historyTime(before.DueDate), historyTime(after.DueDate))
+	add(model.TaskFieldPriority, historyText(before.Priority), historyText(after.Priority))
+	add(model.TaskFieldBlocked, historyText(strconv.FormatBool(before.Blocked)), historyText(strconv.FormatBool(after.Blocked)))
+	add(model.TaskFieldBlockedReason, historyText(before.BlockedReason), historyText(after.BlockedReason))
+	add(model.TaskFieldEstimate, historyInt(before.Estimate), historyInt(after.Estimate))
+	add(model.TaskFieldCover, historyText(before.CoverColor), historyText(after.CoverColor))
+	add(model.TaskFieldCompleted, historyTime(before.CompletedAt), historyTime(after.CompletedAt))
+	return changes
+}
+
+func historyText(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func historyTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	return historyText(value.UTC().Format(time.RFC3339))
+}
+
+func historyInt(value *int) *string {
+	if value == nil {
+		return nil
+	}
+	return historyText(strconv.Itoa(*value))
+}
+
+// GetAssigneeSuggestions godoc
+// @Summary Suggest assignees for a task
+// @Description Ranks the board members by their recent work on the board: tasks with the same labels count three times,
+// @Description tasks in the same column once. Open tasks and tasks completed in the last 90 days are considered.
+// @Description Ties go to the member with fewer open tasks; the current assignee is left out.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param limit query int false "Maximum number of suggestions (default 5, max 20)"
+// @Success 200 {array} AssigneeSuggestionResponse "Suggested assignees, best first"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/assignee-suggestions [get]
+func (h *TaskHandler) GetAssigneeSuggestions(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "5"))
+	if err != nil || limit < 1 {
+		limit = 5
+	}
+	limit = min(limit, MaxAssigneeSuggestions)
+
+	task, err := h.taskRepo.GetWithBoard(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), task.Column.BoardID, authenticatedUserID, model.RoleEditor)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to assign users to this task"})
+		return
+	}
+
+	since := time.Now().Add(-AssigneeSuggestionWindow)
+	suggestions, err := h.taskRepo.SuggestAssignees(c.Request.Context(), task, task.Column.BoardID, since, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to suggest assignees"})
+		return
+	}
+
+	response := make([]AssigneeSuggestionResponse, len(suggestions))
+	for i, s := range suggestions {
+		response[i] = AssigneeSuggestionResponse{
+			UserID:       s.UserID.String(),
+			Name:         s.Name,
+			Email:        s.Email,
+			Score:        s.Score,
+			SharedLabels: s.SharedLabels,
+			SameColumn:   s.SameColumn,
+			OpenTasks:    s.OpenTasks,
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// parseTaskListOptions reads the sort, priority and swimlane query parameters of task listings
+func parseTaskListOptions(c *gin.Context) (repository.TaskListOptions, error) {
+	opts := repository.TaskListOptions{SortBy: c.DefaultQuery("sort", "position")}
+	if opts.SortBy != "position" && opts.SortBy != "priority" {
+		return opts, fmt.Errorf("Invalid sort %q, expected position or priority", opts.SortBy)
+	}
+
+	if value := c.Query("priority"); value != "" {
+		for _, priority := range strings.Split(value, ",") {
+			priority = strings.TrimSpace(priority)
+			if !slices.Contains(model.TaskPriorities, priority) {
+				return opts, fmt.Errorf("Invalid priority %q", priority)
+			}
+			opts.Priorities = append(opts.Priorities, priority)
+		}
+	}
+
+	// none выбирает задачи без дорожки
+	if value := c.Query("swimlane_id"); value != "" {
+		swimlaneID := uuid.Nil
+		if value != "none" {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return opts, fmt.Errorf("Invalid swimlane_id %q", value)
+			}
+			swimlaneID = id
+		}
+		opts.SwimlaneID = &swimlaneID
+	}
+	return opts, nil
+}
+
+// taskKey formats the human-readable key of a task, e.g. PROJ-12
+func taskKey(board *model.Board, task *model.Task) string {
+	return fmt.Sprintf("%s-%d", board.Key, task.Number)
+}
+
+// syncReferences resolves the task references in the task description and stores them.
+// Board-local references (#12) point into the task's board, qualified ones (PROJ-12)
+// into any board with that key the user can access.
+func (h *TaskHandler) syncReferences(ctx context.Context, task *model.Task, board *model.Board, userID uuid.UUID) error {
+	var refs []model.TaskReference
+	for _, ref := range reference.Parse(task.Description) {
+		target, err := h.resolveReference(ctx, ref, board, userID)
+		if err != nil {
+			return err
+		}
+
+		if target == nil || target.ID == task.ID {
+			continue
+		}
+
+		refs = append(refs, model.TaskReference{
+			TaskID:       task.ID,
+			TargetTaskID: target.ID,
+			Origin:       model.ReferenceOriginDescription,
+			Token:        ref.Token,
+		})
+	}
+
+	return h.taskRefRepo.ReplaceForTask(ctx, task.ID, model.ReferenceOriginDescription, refs)
+}
+
+// resolveReference finds the task a reference points to, or nil if there is none
+func (h *TaskHandler) resolveReference(ctx context.Context, ref reference.Ref, board *model.Board, userID uuid.UUID) (*model.Task, error) {
+	boardIDs := []uuid.UUID{board.ID}
+	if ref.BoardKey != "" && ref.BoardKey != board.Key {
+		boards, err := h.boardRepo.GetAccessibleByKey(ctx, userID, ref.BoardKey)
+		if err != nil {
+			return nil, err
+		}
+
+		boardIDs = boardIDs[:0]
+		for _, b := range boards {
+			boardIDs = append(boardIDs, b.ID)
+		}
+	}
+
+	for _, boardID := range boardIDs {
+		task, err := h.taskRepo.GetByNumber(ctx, boardID, ref.Number)
+		if errors.Is(err, repository.ErrTaskNotFound) {
+			continue
+		}
+		return task, err
+	}
+	return nil, nil
+}
+
+// gitHubLinkResponses builds the commits and pull requests linked to a task
+func (h *TaskHandler) gitHubLinkResponses(ctx context.Context, taskID uuid.UUID) ([]TaskGitHubLinkResponse, error) {
+	links, err := h.githubRepo.GetLinksByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return newTaskGitHubLinkResponses(links), nil
+}
+
+// newTaskGitHubLinkResponses builds the responses of loaded GitHub links
+func newTaskGitHubLinkResponses(links []model.TaskGitHubLink) []TaskGitHubLinkResponse {
+	var response []TaskGitHubLinkResponse
+	for _, link := range links {
+		response = append(response, TaskGitHubLinkResponse{
+			Kind:       link.Kind,
+			Repository: link.Repository,
+			Ref:        link.Ref,
+			URL:        link.URL,
+			Title:      link.Title,
+			State:      link.State,
+			Author:     link.Author,
+			UpdatedAt:  link.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return response
+}
+
+// referenceResponses builds the resolved references of a task, hiding tasks on
+// boards the viewing user cannot access
+func (h *TaskHandler) referenceResponses(ctx context.Context, taskID, boardID, userID uuid.UUID) ([]TaskReferenceResponse, error) {
+	refs, err := h.taskRefRepo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []TaskReferenceResponse
+	for _, ref := range refs {
+		target := ref.TargetTask
+		targetBoard := target.Column.Board
+
+		if targetBoard.ID != boardID && targetBoard.OwnerID != userID {
+			hasAccess, err := h.perms.CheckAccess(ctx, targetBoard.ID, userID, model.RoleViewer)
+			if err != nil {
+				return nil, err
+			}
+			if !hasAccess {
+				continue
+			}
+		}
+
+		response = append(response, TaskReferenceResponse{
+			Token:   ref.Token,
+			Origin:  ref.Origin,
+			TaskID:  target.ID.String(),
+			BoardID: targetBoard.ID.String(),
+			Key:     taskKey(&targetBoard, &target),
+			Title:   target.Title,
+		})
+	}
+	return response, nil
+}
+
+// syncMentions resolves the @mentions in the task description to board members and stores them.
+// Mentions of users without access to the board are dropped. Users newly mentioned by someone
+// else get a mention event in the outbox, written in the same transaction.
+func (h *TaskHandler) syncMentions(ctx context.Context, task *model.Task, board *model.Board, authorID uuid.UUID) error {
+	parsed := mention.Parse(task.Description)
+
+	var mentions []model.TaskMention
+	if len(parsed) > 0 {
+		members, err := h.boardMembers(ctx, board)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range matchMentions(parsed, members) {
+			mentions = append(mentions, model.TaskMention{
+				TaskID:      task.ID,
+				UserID:      m.user.ID,
+				MentionedBy: authorID,
+				Origin:      model.ReferenceOriginDescription,
+				Token:       m.mention.Token,
+			})
+		}
+	}
+
+	return h.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := h.mentionRepo.ReplaceForTask(ctx, task.ID, model.ReferenceOriginDescription, mentions)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range created {
+			if m.UserID == authorID {
+				continue
+			}
+			err := h.outboxRepo.Add(ctx, model.EventMentionCreated, model.MentionCreatedPayload{
+				MentionID:   m.ID.String(),
+				TaskID:      task.ID.String(),
+				TaskTitle:   task.Title,
+				BoardID:     board.ID.String(),
+				UserID:      m.UserID.String(),
+				MentionedBy: authorID.String(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// boardMembers returns the owner and every user the board is shared with
+func (h *TaskHandler) boardMembers(ctx context.Context, board *model.Board) ([]model.User, error) {
+	owner, err := h.userRepo.GetByID(ctx, board.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := h.boardShareRepo.GetBoardShares(ctx, board.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]model.User, 0, len(shares)+1)
+	if owner != nil {
+		members = append(members, *owner)
+	}
+	for _, share := range shares {
+		members = append(members, share.User)
+	}
+	return members, nil
+}
+
+type mentionMatch struct {
+	mention mention.Mention
+	user    model.User
+}
+
+// matchMentions pairs mentions with the members they name. An email mention matches the member's
+// email; a username matches the member's name without spaces or the local part of their email.
+// Usernames that fit several members are ambiguous and skipped, as is a second mention of the same member.
+func matchMentions(mentions []mention.Mention, members []model.User) []mentionMatch {
+	var matches []mentionMatch
+	seen := make(map[uuid.UUID]bool)
+
+	for _, m := range mentions {
+		var found []model.User
+		for _, user := range members {
+			localPart, _, _ := strings.Cut(user.Email, "@")
+			if m.IsEmail() && strings.EqualFold(user.Email, m.Handle) ||
+				!m.IsEmail() && (strings.EqualFold(strings.ReplaceAll(user.Name, " ", ""), m.Handle) ||
+					strings.EqualFold(localPart, m.Handle)) {
+				found = append(found, user)
+			}
+		}
+
+		if len(found) != 1 || seen[found[0].ID] {
+			continue
+		}
+		seen[found[0].ID] = true
+		matches = append(matches, mentionMatch{mention: m, user: found[0]})
+	}
+	return matches
+}
+
+// mentionResponses builds the resolved mentions of a task
+func (h *TaskHandler) mentionResponses(ctx context.Context, taskID uuid.UUID) ([]TaskMentionResponse, error) {
+	mentions, err := h.mentionRepo.GetByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []TaskMentionResponse
+	for _, m := range mentions {
+		response = append(response, TaskMentionResponse{
+			Token:  m.Token,
+			Origin: m.Origin,
+			UserID: m.UserID.String(),
+			Name:   m.User.Name,
+		})
+	}
+	return response, nil
+}
+
+// dependencyBlocked reports whether a task still waits for a task blocking it
+func (h *TaskHandler) dependencyBlocked(ctx context.Context, taskID uuid.UUID) (bool, error) {
+	blockers, err := h.relationRepo.OpenBlockerCounts(ctx, []uuid.UUID{taskID})
+	if err != nil {
+		return false, err
+	}
+	return blockers[taskID] > 0, nil
 }
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"kanban/internal/limits"
+	"kanban/internal/mention"
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaskListOptions(t *testing.T) {
+	parse := func(query string) (string, []string, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/columns/x/tasks"+query, nil)
+		opts, err := parseTaskListOptions(c)
+		return opts.SortBy, opts.Priorities, err
+	}
+
+	// По умолчанию сортировка по позиции без фильтра
+	sortBy, priorities, err := parse("")
+	require.NoError(t, err)
+	assert.Equal(t, "position", sortBy)
+	assert.Empty(t, priorities)
+
+	sortBy, priorities, err = parse("?sort=priority&priority=high,%20urgent")
+	require.NoError(t, err)
+	assert.Equal(t, "priority", sortBy)
+	assert.Equal(t, []string{"high", "urgent"}, priorities)
+
+	_, _, err = parse("?sort=title")
+	assert.Error(t, err)
+
+	_, _, err = parse("?priority=critical")
+	assert.Error(t, err)
+}
+
+func TestParseTaskListOptions_Swimlane(t *testing.T) {
+	parse := func(query string) (*uuid.UUID, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/columns/x/tasks"+query, nil)
+		opts, err := parseTaskListOptions(c)
+		return opts.SwimlaneID, err
+	}
+
+	// Без параметра фильтра по дорожке нет
+	swimlaneID, err := parse("")
+	require.NoError(t, err)
+	assert.Nil(t, swimlaneID)
+
+	// none выбирает задачи без дорожки
+	swimlaneID, err = parse("?swimlane_id=none")
+	require.NoError(t, err)
+	require.NotNil(t, swimlaneID)
+	assert.Equal(t, uuid.Nil, *swimlaneID)
+
+	id := uuid.New()
+	swimlaneID, err = parse("?swimlane_id=" + id.String())
+	require.NoError(t, err)
+	require.NotNil(t, swimlaneID)
+	assert.Equal(t, id, *swimlaneID)
+
+	_, err = parse("?swimlane_id=backend")
+	assert.Error(t, err)
+}
+
+func TestParseAssignedTaskFilter(t *testing.T) {
+	now := time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
+	parse := func(query string) (repository.AssignedTaskFilter, error) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest("GET", "/me/tasks"+query, nil)
+		return parseAssignedTaskFilter(c, now)
+	}
+
+	// Без параметров только открытые задачи без других фильтров
+	filter, err := parse("")
+	require.NoError(t, err)
+	assert.Equal(t, repository.AssignedTaskFilter{}, filter)
+
+	boardID := uuid.New()
+	filter, err = parse("?board_id=" + boardID.String() + "&due_from=2026-06-01&due_to=2026-06-30&overdue=true&include_completed=1")
+	require.NoError(t, err)
+	require.NotNil(t, filter.BoardID)
+	assert.Equal(t, boardID, *filter.BoardID)
+	assert.Nil(t, filter.LabelID)
+	assert.Equal(t, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), *filter.DueFrom)
+	// due_to включает весь день
+	assert.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), *filter.DueBefore)
+	assert.Equal(t, now, *filter.Overdue)
+	assert.True(t, filter.IncludeCompleted)
+
+	filter, err = parse("?overdue=false")
+	require.NoError(t, err)
+	assert.Nil(t, filter.Overdue)
+
+	for _, query := range []string{"?label_id=red", "?due_from=06/01/2026", "?overdue=yes"} {
+		_, err = parse(query)
+		assert.Error(t, err, query)
+	}
+}
+
+func TestMatchMentions(t *testing.T) {
+	alice := model.User{ID: uuid.New(), Name: "Alice Smith", Email: "alice@example.com"}
+	bob := model.User{ID: uuid.New(), Name: "Bob", Email: "bob@example.com"}
+	otherBob := model.User{ID: uuid.New(), Name: "Bob", Email: "robert@example.com"}
+	members := []model.User{alice, bob, otherBob}
+
+	matches := matchMentions(mention.Parse("@AliceSmith @alice @bob@example.com @bob @mallory"), members)
+
+	// @alice указывает на ту же участницу и пропускается, @bob неоднозначен, @mallory не участник доски
+	require.Len(t, matches, 2)
+	assert.Equal(t, alice.ID, matches[0].user.ID)
+	assert.Equal(t, "@AliceSmith", matches[0].mention.Token)
+	assert.Equal(t, bob.ID, matches[1].user.ID)
+}
+
+func TestSetTaskDates(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	start := past.Add(-24 * time.Hour)
+
+	var response TaskResponse
+	setTaskDates(&response, &model.Task{StartDate: &start, DueDate: &past})
+	require.NotNil(t, response.StartDate)
+	assert.Equal(t, start.Format(time.RFC3339), *response.StartDate)
+	assert.True(t, response.Overdue)
+
+	// Завершённая задача не просрочена
+	response = TaskResponse{}
+	setTaskDates(&response, &model.Task{DueDate: &past, CompletedAt: &past})
+	assert.False(t, response.Overdue)
+	assert.NotNil(t, response.CompletedAt)
+
+	assert.True(t, validTaskDates(&start, &past))
+	assert.True(t, validTaskDates(nil, &past))
+	assert.False(t, validTaskDates(&past, &start))
+}
+
+func TestDiffTask(t *testing.T) {
+	userID := uuid.New()
+	due := time.Date(2026, 6, 10, 12, 0, 0, 0, time.UTC)
+	before := &model.Task{ID: uuid.New(), ColumnID: uuid.New(), Title: "Old", Priority: model.PriorityMedium, DueDate: &due}
+
+	// Без изменений история не пополняется
+	same := *before
+	assert.Empty(t, diffTask(before, &same, userID))
+
+	assignee := uuid.New()
+	estimate := 3
+	after := *before
+	after.Title = "New"
+	after.DueDate = nil
+	after.AssignedTo = &assignee
+	after.Estimate = &estimate
+
+	changes := diffTask(before, &after, userID)
+	require.Len(t, changes, 4)
+	for _, change := range changes {
+		assert.Equal(t, before.ID, change.TaskID)
+		assert.Equal(t, userID, *change.UserID)
+	}
+
+	assert.Equal(t, model.TaskFieldTitle, changes[0].Field)
+	assert.Equal(t, "Old", *changes[0].OldValue)
+	assert.Equal(t, "New", *changes[0].NewValue)
+
+	assert.Equal(t, model.TaskFieldAssignee, changes[1].Field)
+	assert.Nil(t, changes[1].OldValue)
+	assert.Equal(t, assignee.String(), *changes[1].NewValue)
+
+	// Снятый срок записывается как null
+	assert.Equal(t, model.TaskFieldDueDate, changes[2].Field)
+	assert.Equal(t, "2026-06-10T12:00:00Z", *changes[2].OldValue)
+	assert.Nil(t, changes[2].NewValue)
+
+	assert.Equal(t, model.TaskFieldEstimate, changes[3].Field)
+	assert.Equal(t, "3", *changes[3].NewValue)
+}
+
+func TestTaskHandler_MoveTaskAffected(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	db := testutil.OpenDB(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	userRepo := repository.NewUserRepository(db)
+	boardRepo := repository.NewBoardRepository(db)
+	boardShareRepo := repository.NewBoardShareRepository(db)
+	columnRepo := repository.NewColumnRepository(db)
+	taskRepo := repository.NewTaskRepository(db)
+	perms := permission.NewService(boardRepo, columnRepo, boardShareRepo, userRepo, nil, 0)
+	h := NewTaskHandler(
+		taskRepo, columnRepo, boardRepo, boardShareRepo, userRepo, repository.NewLabelRepository(db),
+		repository.NewTaskReferenceRepository(db), repository.NewTaskMentionRepository(db), repository.NewTaskRelationRepository(db),
+		repository.NewGitHubRepository(db), repository.NewTaskChangeRepository(db), repository.NewBoardChangeRepository(db), repository.NewActionRepository(db),
+		repository.NewOutboxRepository(db), repository.NewTxManager(db), repository.NewTaskTemplateRepository(db),
+		repository.NewTaskLockRepository(db), repository.NewDescriptionDocRepository(db), perms,
+		limits.NewService(limits.Limits{}, userRepo), repository.NewArchivedTaskRepository(db), nil,
+	)
+
+	board := &model.Board{Title: "Board", OwnerID: owner.ID}
+	require.NoError(t, db.Create(board).Error)
+	todo := &model.Column{BoardID: board.ID, Title: "To Do", Position: 1}
+	done := &model.Column{BoardID: board.ID, Title: "Done", Position: 2}
+	require.NoError(t, db.Create(todo).Error)
+	require.NoError(t, db.Create(done).Error)
+	newTask := func(column *model.Column, title string, position int) string {
+		task := &model.Task{ColumnID: column.ID, Title: title, CreatedBy: owner.ID, Position: position}
+		require.NoError(t, taskRepo.Create(context.Background(), task))
+		return task.ID.String()
+	}
+	first := newTask(todo, "First", 0)
+	second := newTask(todo, "Second", 1)
+	third := newTask(todo, "Third", 2)
+	shipped := newTask(done, "Shipped", 0)
+
+	move := func(taskID string, column *model.Column, position int) TaskMoveResponse {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body := fmt.Sprintf(`{"column_id":"%s","position":%d}`, column.ID, position)
+		c.Request = httptest.NewRequest(http.MethodPost, "/tasks/"+taskID+"/move", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = gin.Params{{Key: "id", Value: taskID}}
+		c.Set(middleware.UserIDKey, owner.ID)
+		h.MoveTask(c)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		var response TaskMoveResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+	position := func(id string, column *model.Column, position int) TaskPositionResponse {
+		return TaskPositionResponse{ID: id, ColumnID: column.ID.String(), Position: position}
+	}
+
+	// Внутри колонки: возвращаются новые позиции всех задач колонки, другие колонки не затронуты
+	response := move(first, todo, 2)
+	assert.Equal(t, position(first, todo, 2), response.Task)
+	assert.ElementsMatch(t, []TaskPositionResponse{
+		position(second, todo, 0), position(third, todo, 1), position(first, todo, 2),
+	}, response.Affected)
+
+	// В другую колонку: возвращаются позиции обеих колонок, пересчитанные без пропусков
+	response = move(third, done, 1)
+	assert.Equal(t, position(third, done, 1), response.Task)
+	assert.ElementsMatch(t, []TaskPositionResponse{
+		position(second, todo, 0), position(first, todo, 1),
+		position(shipped, done, 0), position(third, done, 1),
+	}, response.Affected)
+	assert.NotEmpty(t, response.ActionID)
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TaskLockTTL is how long a task lock lasts unless its holder renews it
+const TaskLockTTL = 2 * time.Minute
+
+// TaskLockResponse represents the soft lock of a task's description
+// @name TaskLockResponse
+type TaskLockResponse struct {
+	TaskID   string `json:"task_id"`
+	UserID   string `json:"user_id"`
+	UserName string `json:"user_name"`
+	LockedAt string `json:"locked_at"`
+	// ExpiresAt is when the lock ends unless it is renewed
+	ExpiresAt string `json:"expires_at"`
+}
+
+// Lock godoc
+// @Summary Lock a task for editing
+// @Description Takes a soft lock on the task's description for TaskLockTTL (2 minutes), or renews the lock the user
+// @Description already holds. While the lock is active only its holder can change the description, so editors
+// @Description should renew it while they type and release it when done. Lock changes are published in the board's
+// @Description change feed and event stream, which also reports locks that expire.
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {object} TaskLockResponse "Lock taken or renewed"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 409 {object} map[string]interface{} "Task is locked by another user; the lock is returned"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/lock [post]
+func (h *TaskHandler) Lock(c *gin.Context) {
+	authenticatedUserID, task, ok := h.lockableTask(c)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	lock := &model.TaskLock{TaskID: task.ID, UserID: authenticatedUserID, ExpiresAt: now.Add(TaskLockTTL), CreatedAt: now}
+	acquired, err := h.lockRepo.Acquire(c.Request.Context(), lock, now)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to lock task"})
+		return
+	}
+
+	current, err := h.lockRepo.GetActive(c.Request.Context(), task.ID, now)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task lock"})
+		return
+	}
+
+	// Блокировка могла истечь между двумя запросами; тогда задачу можно попробовать заблокировать снова
+	if current == nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Task lock changed, try again"})
+		return
+	}
+
+	if !acquired || current.UserID != authenticatedUserID {
+		c.JSON(http.StatusConflict, gin.H{"error": "Task is being edited by " + current.User.Name, "lock": newTaskLockResponse(current)})
+		return
+	}
+
+	c.JSON(http.StatusOK, newTaskLockResponse(current))
+}
+
+// Unlock godoc
+// @Summary Release a task lock
+// @Description Releases the user's lock of the task, so others can edit its description
+// @Tags Tasks
+// @Param id path string true "Task ID" format(uuid)
+// @Success 204 "Lock released"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found or not locked by the user"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/lock [delete]
+func (h *TaskHandler) Unlock(c *gin.Context) {
+	authenticatedUserID, task, ok := h.lockableTask(c)
+	if !ok {
+		return
+	}
+
+	released, err := h.lockRepo.Release(c.Request.Context(), task.ID, authenticatedUserID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to release task lock"})
+		return
+	}
+
+	if !released {
+		c.JSON(http.StatusNotFound, gin.H{"error": "You don't hold a lock on this task"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// lockableTask resolves the authenticated user and the task from the request and checks that
+// the user can edit the task. It writes the error response and returns false otherwise.
+func (h *TaskHandler) lockableTask(c *gin.Context) (uuid.UUID, *model.Task, bool) {
+	authenticatedUserID, task, board, ok := h.accessibleTask(c, model.RoleEditor)
+	if !ok || !checkBoardWritable(c, board) {
+		return uuid.Nil, nil, false
+	}
+	return authenticatedUserID, task, true
+}
+
+// accessibleTask resolves the authenticated user, the task from the request and its board, and
+// checks that the user has at least the role on the board. It writes the error response and
+// returns false otherwise.
+func (h *TaskHandler) accessibleTask(c *gin.Context, role string) (uuid.UUID, *model.Task, *model.Board, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return uuid.Nil, nil, nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return uuid.Nil, nil, nil, false
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return uuid.Nil, nil, nil, false
+	}
+
+	task, err := h.taskRepo.GetByID(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return uuid.Nil, nil, nil, false
+	}
+
+	column, err := h.perms.GetColumn(c.Request.Context(), task.ColumnID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve column"})
+		return uuid.Nil, nil, nil, false
+	}
+
+	board, err := h.perms.GetBoard(c.Request.Context(), column.BoardID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve board"})
+		return uuid.Nil, nil, nil, false
+	}
+
+	hasAccess, err := h.perms.CheckAccess(c.Request.Context(), column.BoardID, authenticatedUserID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return uuid.Nil, nil, nil, false
+	}
+
+	if !hasAccess && board.OwnerID != authenticatedUserID {
+		if role == model.RoleEditor {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to edit this task"})
+		} else {
+			c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to view this task"})
+		}
+		return uuid.Nil, nil, nil, false
+	}
+
+	return authenticatedUserID, task, board, true
+}
+
+// checkDescriptionLock writes 423 Locked and returns false while another user holds an active
+// lock on the task's description
+func (h *TaskHandler) checkDescriptionLock(c *gin.Context, taskID, userID uuid.UUID) bool {
+	lock, err := h.lockRepo.GetActive(c.Request.Context(), taskID, time.Now())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task lock"})
+		return false
+	}
+
+	if lock != nil && lock.UserID != userID {
+		c.JSON(http.StatusLocked, gin.H{"error": "Task description is being edited by " + lock.User.Name, "lock": newTaskLockResponse(lock)})
+		return false
+	}
+	return true
+}
+
+func newTaskLockResponse(lock *model.TaskLock) TaskLockResponse {
+	return TaskLockResponse{
+		TaskID:    lock.TaskID.String(),
+		UserID:    lock.UserID.String(),
+		UserName:  lock.User.Name,
+		LockedAt:  lock.CreatedAt.Format(time.RFC3339),
+		ExpiresAt: lock.ExpiresAt.Format(time.RFC3339),
+	}
+}
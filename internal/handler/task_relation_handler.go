@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type TaskRelationHandler struct {
+	relationRepo   *repository.TaskRelationRepository
+	taskRepo       repository.TaskRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	perms          *permission.Service
+}
+
+func NewTaskRelationHandler(
+	relationRepo *repository.TaskRelationRepository,
+	taskRepo repository.TaskRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	perms *permission.Service,
+) *TaskRelationHandler {
+	return &TaskRelationHandler{
+		relationRepo:   relationRepo,
+		taskRepo:       taskRepo,
+		boardShareRepo: boardShareRepo,
+		perms:          perms,
+	}
+}
+
+// CreateTaskRelationRequest represents the request body for linking two tasks
+// @name CreateTaskRelationRequest
+type CreateTaskRelationRequest struct {
+	Type   string `json:"type" binding:"required,oneof=blocks blocked_by relates_to"`
+	TaskID string `json:"task_id" binding:"required,uuid"`
+}
+
+// TaskRelationResponse represents a relation as seen from the task it was requested for:
+// the other task and how this task relates to it
+// @name TaskRelationResponse
+type TaskRelationResponse struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	TaskID    string `json:"task_id"`
+	Key       string `json:"key"`
+	Title     string `json:"title"`
+	ColumnID  string `json:"column_id"`
+	Completed bool   `json:"completed"`
+	CreatedAt string `json:"created_at"`
+}
+
+// GetByTaskID godoc
+// @Summary List task relations
+// @Description Lists the tasks this task blocks, is blocked by or relates to
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Success 200 {array} TaskRelationResponse "Task relations"
+// @Failure 400 {object} map[string]string "Invalid task ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/relations [get]
+func (h *TaskRelationHandler) GetByTaskID(c *gin.Context) {
+	task, ok := h.accessibleTask(c, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	relations, err := h.relationRepo.GetByTaskID(c.Request.Context(), task.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task relations"})
+		return
+	}
+
+	response := make([]TaskRelationResponse, len(relations))
+	for i := range relations {
+		response[i] = newTaskRelationResponse(&relations[i], task)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Link two tasks
+// @Description Links the task to another task of the same board. blocked_by is stored as the inverse blocks relation.
+// @Description A blocking relation that would make a task (transitively) block itself is rejected.
+// @Tags Tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param relation body CreateTaskRelationRequest true "Relation information"
+// @Success 201 {object} TaskRelationResponse "Relation created successfully"
+// @Failure 400 {object} map[string]string "Invalid input or tasks on different boards"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task not found"
+// @Failure 409 {object} map[string]string "Relation already exists or would create a cycle"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/relations [post]
+func (h *TaskRelationHandler) Create(c *gin.Context) {
+	task, ok := h.accessibleTask(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, task.Column.BoardID) {
+		return
+	}
+
+	var req CreateTaskRelationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	otherID, _ := uuid.Parse(req.TaskID)
+	if otherID == task.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "A task cannot be related to itself"})
+		return
+	}
+
+	other, err := h.taskRepo.GetWithBoard(c.Request.Context(), otherID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Related task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve related task"})
+		}
+		return
+	}
+
+	if other.Column.BoardID != task.Column.BoardID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only tasks of the same board can be related"})
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	relation := &model.TaskRelation{
+		TaskID:        task.ID,
+		RelatedTaskID: other.ID,
+		Type:          req.Type,
+		CreatedBy:     &userID,
+	}
+	if req.Type == model.RelationBlockedBy {
+		relation.TaskID, relation.RelatedTaskID = other.ID, task.ID
+		relation.Type = model.RelationBlocks
+	}
+
+	if err := h.relationRepo.Create(c.Request.Context(), relation, task.Column.BoardID); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRelationExists):
+			c.JSON(http.StatusConflict, gin.H{"error": "Tasks are already related this way"})
+		case errors.Is(err, repository.ErrRelationCycle):
+			c.JSON(http.StatusConflict, gin.H{"error": "Relation would create a dependency cycle"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task relation"})
+		}
+		return
+	}
+
+	if relation.TaskID == task.ID {
+		relation.Task, relation.RelatedTask = *task, *other
+	} else {
+		relation.Task, relation.RelatedTask = *other, *task
+	}
+
+	c.JSON(http.StatusCreated, newTaskRelationResponse(relation, task))
+}
+
+// Delete godoc
+// @Summary Remove a task relation
+// @Description Removes a relation the task takes part in, from either side
+// @Tags Tasks
+// @Produce json
+// @Param id path string true "Task ID" format(uuid)
+// @Param relation_id path string true "Relation ID" format(uuid)
+// @Success 200 {object} map[string]string "Relation removed successfully"
+// @Failure 400 {object} map[string]string "Invalid ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task or relation not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /tasks/{id}/relations/{relation_id} [delete]
+func (h *TaskRelationHandler) Delete(c *gin.Context) {
+	relationID, err := uuid.Parse(c.Param("relation_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid relation ID format"})
+		return
+	}
+
+	task, ok := h.accessibleTask(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardIDWritable(c, h.perms, task.Column.BoardID) {
+		return
+	}
+
+	if err := h.relationRepo.Delete(c.Request.Context(), relationID, task.ID); err != nil {
+		if err == repository.ErrRelationNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Relation not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove task relation"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Relation removed successfully"})
+}
+
+// accessibleTask loads the task from the path with its column and board and checks that
+// the user has the role on the board. On failure the response is already written.
+func (h *TaskRelationHandler) accessibleTask(c *gin.Context, role string) (*model.Task, bool) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return nil, false
+	}
+
+	authenticatedUserID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return nil, false
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return nil, false
+	}
+
+	task, err := h.taskRepo.GetWithBoard(c.Request.Context(), taskID)
+	if err != nil {
+		if err == repository.ErrTaskNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task"})
+		}
+		return nil, false
+	}
+
+	hasAccess, err := h.boardShareRepo.CheckAccess(c.Request.Context(), task.Column.BoardID, authenticatedUserID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check access"})
+		return nil, false
+	}
+
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You don't have permission to access this task"})
+		return nil, false
+	}
+
+	return task, true
+}
+
+// newTaskRelationResponse describes the relation from the side of task, which carries the board
+func newTaskRelationResponse(relation *model.TaskRelation, task *model.Task) TaskRelationResponse {
+	other := &relation.RelatedTask
+	relationType := relation.Type
+	if relation.RelatedTaskID == task.ID {
+		other = &relation.Task
+		if relationType == model.RelationBlocks {
+			relationType = model.RelationBlockedBy
+		}
+	}
+
+	return TaskRelationResponse{
+		ID:        relation.ID.String(),
+		Type:      relationType,
+		TaskID:    other.ID.String(),
+		Key:       taskKey(&task.Column.Board, other),
+		Title:     other.Title,
+		ColumnID:  other.ColumnID.String(),
+		Completed: other.CompletedAt != nil,
+		CreatedAt: relation.CreatedAt.Format(time.RFC3339),
+	}
+}
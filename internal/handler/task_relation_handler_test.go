@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"testing"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTaskRelationResponse(t *testing.T) {
+	board := model.Board{ID: uuid.New(), Key: "PLAT"}
+	blocker := model.Task{ID: uuid.New(), Number: 1, Title: "API", Column: model.Column{BoardID: board.ID, Board: board}}
+	blocked := model.Task{ID: uuid.New(), Number: 2, Title: "UI", Column: model.Column{BoardID: board.ID, Board: board}}
+
+	relation := &model.TaskRelation{
+		ID:            uuid.New(),
+		TaskID:        blocker.ID,
+		RelatedTaskID: blocked.ID,
+		Type:          model.RelationBlocks,
+		Task:          blocker,
+		RelatedTask:   blocked,
+	}
+
+	fromBlocker := newTaskRelationResponse(relation, &blocker)
+	assert.Equal(t, model.RelationBlocks, fromBlocker.Type)
+	assert.Equal(t, "PLAT-2", fromBlocker.Key)
+
+	// Со стороны блокируемой задачи связь выглядит как blocked_by
+	fromBlocked := newTaskRelationResponse(relation, &blocked)
+	assert.Equal(t, model.RelationBlockedBy, fromBlocked.Type)
+	assert.Equal(t, blocker.ID.String(), fromBlocked.TaskID)
+	assert.Equal(t, "PLAT-1", fromBlocked.Key)
+}
@@ -0,0 +1,351 @@
+package handler
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"kanban/internal/middleware"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// MaxTaskTemplatesPerBoard limits the number of task templates of a board
+const MaxTaskTemplatesPerBoard = 50
+
+type TaskTemplateHandler struct {
+	templateRepo *repository.TaskTemplateRepository
+	labelRepo    repository.LabelRepositoryInterface
+	perms        *permission.Service
+}
+
+func NewTaskTemplateHandler(
+	templateRepo *repository.TaskTemplateRepository,
+	labelRepo repository.LabelRepositoryInterface,
+	perms *permission.Service,
+) *TaskTemplateHandler {
+	return &TaskTemplateHandler{
+		templateRepo: templateRepo,
+		labelRepo:    labelRepo,
+		perms:        perms,
+	}
+}
+
+// TaskTemplateRequest represents the request body for creating or replacing a task template.
+// The title pattern may contain {date} (UTC day as YYYY-MM-DD), {week} (ISO week such as
+// 2024-W07) and {user} (name of the user creating the task).
+// @name TaskTemplateRequest
+type TaskTemplateRequest struct {
+	Name         string   `json:"name" binding:"required,max=100" example:"Bug report"`
+	TitlePattern string   `json:"title_pattern" binding:"required,max=255" example:"Bug: "`
+	Description  string   `json:"description" binding:"max=10000"`
+	Priority     string   `json:"priority" binding:"omitempty,oneof=low medium high urgent" enums:"low,medium,high,urgent"`
+	LabelIDs     []string `json:"label_ids" binding:"max=20,dive,uuid"`
+	// Checklist items are added to the task description as a Markdown task list
+	Checklist []string `json:"checklist" binding:"max=50,dive,min=1,max=200"`
+}
+
+// TaskTemplateResponse represents a task template
+// @name TaskTemplateResponse
+type TaskTemplateResponse struct {
+	ID           string   `json:"id"`
+	BoardID      string   `json:"board_id"`
+	Name         string   `json:"name"`
+	TitlePattern string   `json:"title_pattern"`
+	Description  string   `json:"description"`
+	Priority     string   `json:"priority"`
+	LabelIDs     []string `json:"label_ids"`
+	Checklist    []string `json:"checklist"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+func newTaskTemplateResponse(template *model.TaskTemplate) TaskTemplateResponse {
+	response := TaskTemplateResponse{
+		ID:           template.ID.String(),
+		BoardID:      template.BoardID.String(),
+		Name:         template.Name,
+		TitlePattern: template.TitlePattern,
+		Description:  template.Description,
+		Priority:     template.Priority,
+		LabelIDs:     make([]string, len(template.LabelIDs)),
+		Checklist:    template.Checklist,
+		CreatedAt:    template.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    template.UpdatedAt.Format(time.RFC3339),
+	}
+	for i, id := range template.LabelIDs {
+		response.LabelIDs[i] = id.String()
+	}
+	if response.Checklist == nil {
+		response.Checklist = []string{}
+	}
+	return response
+}
+
+// GetByBoardID godoc
+// @Summary List board task templates
+// @Description Lists the task templates of a board ordered by name
+// @Tags Task templates
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Success 200 {array} TaskTemplateResponse "Board task templates"
+// @Failure 400 {object} map[string]string "Invalid board ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/task-templates [get]
+func (h *TaskTemplateHandler) GetByBoardID(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	templates, err := h.templateRepo.GetByBoardID(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task templates"})
+		return
+	}
+
+	response := make([]TaskTemplateResponse, len(templates))
+	for i := range templates {
+		response[i] = newTaskTemplateResponse(&templates[i])
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Create a task template
+// @Description Adds a task template to a board. Labels must be available on the board. A board can have up to 50 templates.
+// @Tags Task templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Board ID" format(uuid)
+// @Param template body TaskTemplateRequest true "Task template"
+// @Success 201 {object} TaskTemplateResponse "Task template created successfully"
+// @Failure 400 {object} map[string]string "Invalid input, unknown label or too many templates"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Board not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /boards/{id}/task-templates [post]
+func (h *TaskTemplateHandler) Create(c *gin.Context) {
+	board, ok := accessibleBoard(c, h.perms, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req TaskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.templateRepo.CountByBoard(c.Request.Context(), board.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task templates"})
+		return
+	}
+
+	if count >= MaxTaskTemplatesPerBoard {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Maximum number of task templates reached (50)"})
+		return
+	}
+
+	userID := c.MustGet(middleware.UserIDKey).(uuid.UUID)
+	template := &model.TaskTemplate{BoardID: board.ID, CreatedBy: &userID}
+	if !h.applyRequest(c, board, template, &req) {
+		return
+	}
+
+	if err := h.templateRepo.Create(c.Request.Context(), template); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task template"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, newTaskTemplateResponse(template))
+}
+
+// GetByID godoc
+// @Summary Get a task template
+// @Description Returns a task template
+// @Tags Task templates
+// @Produce json
+// @Param id path string true "Task template ID" format(uuid)
+// @Success 200 {object} TaskTemplateResponse "Task template"
+// @Failure 400 {object} map[string]string "Invalid task template ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task template not found"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /task-templates/{id} [get]
+func (h *TaskTemplateHandler) GetByID(c *gin.Context) {
+	template, _, ok := h.accessibleTemplate(c, model.RoleViewer)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, newTaskTemplateResponse(template))
+}
+
+// Update godoc
+// @Summary Replace a task template
+// @Description Replaces all fields of a task template; tasks created from it are not changed
+// @Tags Task templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Task template ID" format(uuid)
+// @Param template body TaskTemplateRequest true "Task template"
+// @Success 200 {object} TaskTemplateResponse "Task template updated successfully"
+// @Failure 400 {object} map[string]string "Invalid input or unknown label"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task template not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /task-templates/{id} [put]
+func (h *TaskTemplateHandler) Update(c *gin.Context) {
+	template, board, ok := h.accessibleTemplate(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	var req TaskTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !h.applyRequest(c, board, template, &req) {
+		return
+	}
+
+	if err := h.templateRepo.Update(c.Request.Context(), template); err != nil {
+		if err == repository.ErrTaskTemplateNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task template not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task template"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, newTaskTemplateResponse(template))
+}
+
+// Delete godoc
+// @Summary Delete a task template
+// @Description Deletes a task template; tasks created from it are kept
+// @Tags Task templates
+// @Produce json
+// @Param id path string true "Task template ID" format(uuid)
+// @Success 200 {object} map[string]string "Task template deleted successfully"
+// @Failure 400 {object} map[string]string "Invalid task template ID format"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} map[string]string "Task template not found"
+// @Failure 423 {object} map[string]string "Board is frozen"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /task-templates/{id} [delete]
+func (h *TaskTemplateHandler) Delete(c *gin.Context) {
+	template, board, ok := h.accessibleTemplate(c, model.RoleEditor)
+	if !ok {
+		return
+	}
+
+	if !checkBoardWritable(c, board) {
+		return
+	}
+
+	if err := h.templateRepo.Delete(c.Request.Context(), template.ID); err != nil {
+		if err == repository.ErrTaskTemplateNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task template not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task template"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task template deleted successfully"})
+}
+
+// applyRequest copies the request into the template after checking that its labels are
+// available on the board. On failure the response is already written.
+func (h *TaskTemplateHandler) applyRequest(c *gin.Context, board *model.Board, template *model.TaskTemplate, req *TaskTemplateRequest) bool {
+	labelIDs := make([]uuid.UUID, 0, len(req.LabelIDs))
+	if len(req.LabelIDs) > 0 {
+		labels, err := h.labelRepo.GetAvailableForBoard(c.Request.Context(), board.ID, board.OwnerID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve labels"})
+			return false
+		}
+
+		for _, value := range req.LabelIDs {
+			id, _ := uuid.Parse(value)
+			if !slices.ContainsFunc(labels, func(label model.Label) bool { return label.ID == id }) {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Label " + value + " is not available on this board"})
+				return false
+			}
+			if !slices.Contains(labelIDs, id) {
+				labelIDs = append(labelIDs, id)
+			}
+		}
+	}
+
+	template.Name = req.Name
+	template.TitlePattern = req.TitlePattern
+	template.Description = req.Description
+	template.Priority = req.Priority
+	if template.Priority == "" {
+		template.Priority = model.PriorityMedium
+	}
+	template.LabelIDs = labelIDs
+	template.Checklist = req.Checklist
+	if template.Checklist == nil {
+		template.Checklist = []string{}
+	}
+	return true
+}
+
+// accessibleTemplate loads the task template from the path and checks that the user has the
+// role on its board. On failure the response is already written.
+func (h *TaskTemplateHandler) accessibleTemplate(c *gin.Context, role string) (*model.TaskTemplate, *model.Board, bool) {
+	templateID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task template ID format"})
+		return nil, nil, false
+	}
+
+	template, err := h.templateRepo.GetByID(c.Request.Context(), templateID)
+	if err != nil {
+		if err == repository.ErrTaskTemplateNotFound {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task template not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to retrieve task template"})
+		}
+		return nil, nil, false
+	}
+
+	board, ok := accessibleBoardByID(c, h.perms, template.BoardID, role)
+	if !ok {
+		return nil, nil, false
+	}
+	return template, board, true
+}
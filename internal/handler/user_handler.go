@@ -1,11 +1,15 @@
 package handler
 
 import (
+	"context"
 	"errors"
+	"log"
 	"net/http"
-	"os"
 	"time"
 
+	"kanban/internal/boardtemplate"
+	"kanban/internal/limits"
+	"kanban/internal/middleware"
 	"kanban/internal/model"
 	"kanban/internal/repository"
 
@@ -16,12 +20,34 @@ import (
 )
 
 type UserHandler struct {
-    userRepo *repository.UserRepository
+    userRepo          *repository.UserRepository
+    boardRepo         repository.BoardRepositoryInterface
+    boardTemplateRepo *repository.BoardTemplateRepository
+    txManager         *repository.TxManager
+    limits            *limits.Service
+    // sampleBoardOnFirstLogin generates the onboarding board on a user's first login
+    sampleBoardOnFirstLogin bool
+    // jwtSecret signs the tokens issued at login
+    jwtSecret string
 }
 
-func NewUserHandler(userRepo *repository.UserRepository) *UserHandler {
+func NewUserHandler(
+    userRepo *repository.UserRepository,
+    boardRepo repository.BoardRepositoryInterface,
+    boardTemplateRepo *repository.BoardTemplateRepository,
+    txManager *repository.TxManager,
+    limitService *limits.Service,
+    sampleBoardOnFirstLogin bool,
+    jwtSecret string,
+) *UserHandler {
     return &UserHandler{
-        userRepo: userRepo,
+        userRepo:                userRepo,
+        boardRepo:               boardRepo,
+        boardTemplateRepo:       boardTemplateRepo,
+        txManager:               txManager,
+        limits:                  limitService,
+        sampleBoardOnFirstLogin: sampleBoardOnFirstLogin,
+        jwtSecret:               jwtSecret,
     }
 }
 
@@ -39,6 +65,8 @@ type LoginRequest struct {
 type AuthResponse struct {
 	Token string      `json:"token"`
 	User  UserDetails `json:"user"`
+	// SampleBoardID is set when the onboarding sample board was generated by this request
+	SampleBoardID *string `json:"sample_board_id,omitempty"`
 }
 
 type UserDetails struct {
@@ -94,7 +122,7 @@ func (h *UserHandler) Register(c *gin.Context) {
 		return
 	}
 
-	token, err := generateToken(user.ID)
+	token, err := h.generateToken(user.ID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
 		return
@@ -107,6 +135,7 @@ func (h *UserHandler) Register(c *gin.Context) {
 			Email: user.Email,
 			Name:  user.Name,
 		},
+		SampleBoardID: h.createSampleBoardOnFirstLogin(c, user),
 	})
 }
 
@@ -120,6 +149,7 @@ func (h *UserHandler) Register(c *gin.Context) {
 // @Success 200 {object} AuthResponse "Login successful with auth token"
 // @Failure 400 {object} map[string]string "Invalid request"
 // @Failure 401 {object} map[string]string "Invalid credentials"
+// @Failure 403 {object} map[string]string "Account is disabled"
 // @Failure 500 {object} map[string]string "Server error"
 // @Router /login [post]
 func (h *UserHandler) Login(c *gin.Context) {
@@ -145,7 +175,12 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
-	token, err := generateToken(user.ID)
+	if user.DisabledAt != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Account is disabled"})
+		return
+	}
+
+	token, err := h.generateToken(user.ID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate token"})
 		return
@@ -158,12 +193,101 @@ func (h *UserHandler) Login(c *gin.Context) {
 			Email: user.Email,
 			Name:  user.Name,
 		},
+		SampleBoardID: h.createSampleBoardOnFirstLogin(c, user),
 	})
 }
 
-func generateToken(userID uuid.UUID) (string, error) {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
+// CreateSampleBoard godoc
+// @Summary Create the onboarding sample board
+// @Description Generate a guided sample board with explanatory cards demonstrating labels, due dates and sharing
+// @Tags Users
+// @Produce json
+// @Success 201 {object} BoardResponse "Sample board created successfully"
+// @Failure 401 {object} map[string]string "Not authenticated"
+// @Failure 403 {object} map[string]string "Maximum number of boards reached"
+// @Failure 500 {object} map[string]string "Server error"
+// @Security BearerAuth
+// @Router /me/sample-board [post]
+func (h *UserHandler) CreateSampleBoard(c *gin.Context) {
+	userID, exists := c.Get(middleware.UserIDKey)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return
+	}
+
+	ownerID, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	if !checkBoardLimit(c, h.limits, h.boardRepo, ownerID) {
+		return
+	}
+
+	var board *model.Board
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		var err error
+		board, err = h.boardTemplateRepo.Instantiate(ctx, boardtemplate.Onboarding, ownerID)
+		if err != nil {
+			return err
+		}
+
+		_, err = h.userRepo.MarkSampleBoardCreated(ctx, ownerID)
+		return err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create sample board"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, BoardResponse{
+		ID:          board.ID.String(),
+		Title:       board.Title,
+		Description: board.Description,
+		OwnerID:     board.OwnerID.String(),
+		Key:         board.Key,
+		CreatedAt:   board.CreatedAt.Format(http.TimeFormat),
+	})
+}
+
+// createSampleBoardOnFirstLogin generates the onboarding board the first time a user
+// signs in and returns its ID. Failures are logged and never block authentication.
+func (h *UserHandler) createSampleBoardOnFirstLogin(c *gin.Context, user *model.User) *string {
+	if !h.sampleBoardOnFirstLogin || user.SampleBoardCreatedAt != nil {
+		return nil
+	}
+
+	// The board and the onboarding mark are stored together, so a failed attempt is retried on the next login
+	var board *model.Board
+	err := h.txManager.WithinTransaction(c.Request.Context(), func(ctx context.Context) error {
+		first, err := h.userRepo.MarkSampleBoardCreated(ctx, user.ID)
+		if err != nil || !first {
+			return err
+		}
+
+		count, err := h.boardRepo.CountOwned(ctx, user.ID)
+		if err != nil || limits.Reached(h.limits.Defaults().Override(user).Boards, count) {
+			return err
+		}
+
+		board, err = h.boardTemplateRepo.Instantiate(ctx, boardtemplate.Onboarding, user.ID)
+		return err
+	})
+	if err != nil {
+		log.Printf("⚠️  Failed to create sample board for user %s: %v", user.ID, err)
+		return nil
+	}
+	if board == nil {
+		return nil
+	}
+
+	boardID := board.ID.String()
+	return &boardID
+}
+
+func (h *UserHandler) generateToken(userID uuid.UUID) (string, error) {
+	if h.jwtSecret == "" {
 		return "", errors.New("JWT secret not configured")
 	}
 
@@ -174,5 +298,5 @@ func generateToken(userID uuid.UUID) (string, error) {
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	return token.SignedString([]byte(jwtSecret))
+	return token.SignedString([]byte(h.jwtSecret))
 }
\ No newline at end of file
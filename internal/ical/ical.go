@@ -0,0 +1,88 @@
+// Package ical writes iCalendar (RFC 5545) feeds that calendar apps can subscribe to.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Event is an all-day event
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Date        time.Time // only the date in UTC is used
+}
+
+// maxLineLength is the line length in octets after which content lines are folded
+const maxLineLength = 75
+
+// Write writes a calendar named name with the given events. Stamp is reported as the time
+// the events were generated.
+func Write(w io.Writer, name string, events []Event, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(property, value string) {
+		writeFolded(bw, property+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//kanban//calendar feed//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", escapeText(name))
+
+	dtstamp := stamp.UTC().Format("20060102T150405Z")
+	for _, event := range events {
+		date := event.Date.UTC()
+		line("BEGIN", "VEVENT")
+		line("UID", event.UID)
+		line("DTSTAMP", dtstamp)
+		line("DTSTART;VALUE=DATE", date.Format("20060102"))
+		line("DTEND;VALUE=DATE", date.AddDate(0, 0, 1).Format("20060102"))
+		line("SUMMARY", escapeText(event.Summary))
+		if event.Description != "" {
+			line("DESCRIPTION", escapeText(event.Description))
+		}
+		line("TRANSP", "TRANSPARENT")
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeText escapes a TEXT property value
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\n", "\\n",
+		"\r", "\\n",
+	).Replace(s)
+}
+
+// writeFolded writes a content line terminated by CRLF, folding it into continuation lines
+// starting with a space so that no line exceeds maxLineLength octets. Multi-byte characters
+// are never split.
+func writeFolded(w *bufio.Writer, content string) {
+	limit := maxLineLength
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		w.WriteString(content[:cut])
+		w.WriteString("\r\n ")
+		content = content[cut:]
+		// Пробел в начале строки продолжения занимает один октет
+		limit = maxLineLength - 1
+	}
+	w.WriteString(content)
+	w.WriteString("\r\n")
+}
@@ -0,0 +1,51 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	events := []Event{{
+		UID:         "task-1@kanban",
+		Summary:     "OPS-1 Deploy, then verify; rollback\\plan",
+		Description: "Line one\nLine two",
+		Date:        time.Date(2026, 5, 31, 22, 0, 0, 0, time.UTC),
+	}}
+	stamp := time.Date(2026, 5, 1, 12, 30, 0, 0, time.UTC)
+	require.NoError(t, Write(&buf, "Ops board", events, stamp))
+
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "X-WR-CALNAME:Ops board\r\n")
+	assert.Contains(t, out, "DTSTAMP:20260501T123000Z\r\n")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20260531\r\n")
+	assert.Contains(t, out, "DTEND;VALUE=DATE:20260601\r\n")
+	assert.Contains(t, out, `SUMMARY:OPS-1 Deploy\, then verify\; rollback\\plan`+"\r\n")
+	assert.Contains(t, out, `DESCRIPTION:Line one\nLine two`+"\r\n")
+}
+
+func TestWrite_FoldsLongLines(t *testing.T) {
+	var buf bytes.Buffer
+	summary := strings.Repeat("ж", 100)
+	require.NoError(t, Write(&buf, "Board", []Event{{UID: "1", Summary: summary, Date: time.Now()}}, time.Now()))
+
+	var unfolded strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineLength)
+		// Строки продолжения начинаются с пробела
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(line[1:])
+		} else {
+			unfolded.WriteString("\n" + line)
+		}
+	}
+	assert.Contains(t, unfolded.String(), "\nSUMMARY:"+summary+"\n")
+}
@@ -0,0 +1,92 @@
+// Package idgen assigns the primary keys of new entities in the application instead of the
+// database, so that they can be time-sortable UUIDv7s. Time-ordered keys are inserted at the end
+// of primary key indexes instead of at random pages, which keeps inserts into large tables such
+// as tasks cheap. IDs keep the UUID format either way, so existing UUIDv4 IDs stay valid.
+package idgen
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// ID generation strategies
+const (
+	// StrategyUUIDv4 leaves key generation to the uuid_generate_v4() column defaults
+	StrategyUUIDv4 = "uuidv4"
+	// StrategyUUIDv7 generates UUIDv7 keys, ordered by their creation time in milliseconds
+	StrategyUUIDv7 = "uuidv7"
+)
+
+// Strategies lists the supported ID generation strategies
+var Strategies = []string{StrategyUUIDv4, StrategyUUIDv7}
+
+// Generator returns a new ID
+type Generator func() (uuid.UUID, error)
+
+// New returns the generator of a strategy, or nil for StrategyUUIDv4 whose keys are generated
+// by the database
+func New(strategy string) (Generator, error) {
+	switch strategy {
+	case StrategyUUIDv4, "":
+		return nil, nil
+	case StrategyUUIDv7:
+		return uuid.NewV7, nil
+	default:
+		return nil, fmt.Errorf("unknown ID strategy %q, expected one of %v", strategy, Strategies)
+	}
+}
+
+// Register makes db assign generated keys to new records whose single UUID primary key is not
+// set. Records inserted by raw SQL keep getting keys from the column defaults.
+func Register(db *gorm.DB, strategy string) error {
+	generate, err := New(strategy)
+	if err != nil || generate == nil {
+		return err
+	}
+	return db.Callback().Create().Before("gorm:create").Register("idgen:assign", assign(generate))
+}
+
+func assign(generate Generator) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Error != nil || db.Statement.Schema == nil {
+			return
+		}
+		field := db.Statement.Schema.PrioritizedPrimaryField
+		if field == nil || field.FieldType != reflect.TypeOf(uuid.UUID{}) {
+			return
+		}
+
+		value := db.Statement.ReflectValue
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				setID(db, field, reflect.Indirect(value.Index(i)), generate)
+			}
+		case reflect.Struct:
+			setID(db, field, value, generate)
+		}
+	}
+}
+
+// setID assigns a generated key to a record without one
+func setID(db *gorm.DB, field *schema.Field, record reflect.Value, generate Generator) {
+	if record.Kind() != reflect.Struct {
+		return
+	}
+	ctx := db.Statement.Context
+	if _, zero := field.ValueOf(ctx, record); !zero {
+		return
+	}
+	id, err := generate()
+	if err != nil {
+		db.AddError(fmt.Errorf("failed to generate ID: %w", err))
+		return
+	}
+	if err := field.Set(ctx, record, id); err != nil {
+		db.AddError(err)
+	}
+}
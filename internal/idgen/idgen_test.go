@@ -0,0 +1,71 @@
+package idgen
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type record struct {
+	ID    uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Title string
+}
+
+func dryRunDB(t *testing.T, strategy string) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, Register(db, strategy))
+	return db
+}
+
+func TestNew(t *testing.T) {
+	generate, err := New(StrategyUUIDv4)
+	require.NoError(t, err)
+	assert.Nil(t, generate)
+
+	generate, err = New(StrategyUUIDv7)
+	require.NoError(t, err)
+	id, err := generate()
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(7), id.Version())
+
+	_, err = New("ulid")
+	assert.Error(t, err)
+}
+
+func TestRegister_UUIDv7(t *testing.T) {
+	db := dryRunDB(t, StrategyUUIDv7)
+
+	one := record{Title: "one"}
+	require.NoError(t, db.Create(&one).Error)
+	assert.Equal(t, uuid.Version(7), one.ID.Version())
+
+	// Заданный ключ не перезаписывается
+	existing := uuid.New()
+	kept := record{ID: existing}
+	require.NoError(t, db.Create(&kept).Error)
+	assert.Equal(t, existing, kept.ID)
+
+	batch := []record{{Title: "a"}, {Title: "b"}}
+	require.NoError(t, db.Create(&batch).Error)
+	assert.Equal(t, uuid.Version(7), batch[0].ID.Version())
+	// Ключи упорядочены по времени создания
+	assert.Less(t, batch[0].ID.String(), batch[1].ID.String())
+}
+
+func TestRegister_UUIDv4(t *testing.T) {
+	db := dryRunDB(t, StrategyUUIDv4)
+
+	// Ключ генерирует база данных
+	one := record{Title: "one"}
+	require.NoError(t, db.Create(&one).Error)
+	assert.Equal(t, uuid.Nil, one.ID)
+}
@@ -0,0 +1,166 @@
+package jobs
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/audit"
+	"kanban/internal/repository"
+)
+
+// AuditRetentionInterval is how often the activity log is checked for entries past the retention
+const AuditRetentionInterval = time.Hour
+
+// MaxAuditRetentionBatchSize bounds the batches, whose IDs are removed in a single statement
+const MaxAuditRetentionBatchSize = 10000
+
+// AuditRetentionConfig controls how long the activity log is kept. Entries older than Retention
+// are removed in batches of BatchSize; when ArchiveDir is set they are first written to a
+// gzipped NDJSON file in it, a directory meant to be synced to cold storage.
+type AuditRetentionConfig struct {
+	Retention  time.Duration
+	ArchiveDir string
+	BatchSize  int
+}
+
+// AuditRetention removes the activity log entries past the retention, archiving them if configured
+type AuditRetention struct {
+	changeRepo *repository.TaskChangeRepository
+	cfg        AuditRetentionConfig
+}
+
+// NewAuditRetention returns an error for a retention or batch size that would remove nothing,
+// or everything at once
+func NewAuditRetention(changeRepo *repository.TaskChangeRepository, cfg AuditRetentionConfig) (*AuditRetention, error) {
+	if cfg.Retention <= 0 {
+		return nil, fmt.Errorf("activity log retention must be positive, got %s", cfg.Retention)
+	}
+	if cfg.BatchSize <= 0 || cfg.BatchSize > MaxAuditRetentionBatchSize {
+		return nil, fmt.Errorf("activity log retention batch size must be between 1 and %d, got %d", MaxAuditRetentionBatchSize, cfg.BatchSize)
+	}
+	return &AuditRetention{changeRepo: changeRepo, cfg: cfg}, nil
+}
+
+// Run applies the retention every AuditRetentionInterval until ctx is cancelled
+func (a *AuditRetention) Run(ctx context.Context) {
+	ticker := time.NewTicker(AuditRetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := a.RunOnce(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Activity log retention failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce removes the entries older than the retention at now. Each batch is archived before it
+// is removed, so a failed run archives some entries again on the next run rather than losing them.
+func (a *AuditRetention) RunOnce(ctx context.Context, now time.Time) error {
+	before := now.Add(-a.cfg.Retention)
+
+	var archive *auditArchive
+	defer func() {
+		if archive != nil {
+			archive.close()
+		}
+	}()
+
+	var removed int64
+	for {
+		entries, err := a.changeRepo.ListLog(ctx, time.Time{}, before, nil, a.cfg.BatchSize)
+		if err != nil || len(entries) == 0 {
+			a.logRemoved(removed, archive)
+			return err
+		}
+
+		if a.cfg.ArchiveDir != "" {
+			if archive == nil {
+				if archive, err = openAuditArchive(a.cfg.ArchiveDir, now); err != nil {
+					return err
+				}
+			}
+			if err := archive.write(entries); err != nil {
+				return err
+			}
+		}
+
+		ids := make([]uuid.UUID, len(entries))
+		for i, entry := range entries {
+			ids[i] = entry.ID
+		}
+		n, err := a.changeRepo.DeleteByIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		removed += n
+
+		if len(entries) < a.cfg.BatchSize {
+			a.logRemoved(removed, archive)
+			return nil
+		}
+	}
+}
+
+func (a *AuditRetention) logRemoved(removed int64, archive *auditArchive) {
+	if removed == 0 {
+		return
+	}
+	if archive != nil {
+		log.Printf("✅ Archived %d activity log entries to %s", removed, archive.path)
+	} else {
+		log.Printf("✅ Pruned %d activity log entries", removed)
+	}
+}
+
+// auditArchive is a gzipped NDJSON file of the entries removed by one run
+type auditArchive struct {
+	path string
+	file *os.File
+	gz   *gzip.Writer
+	w    audit.Writer
+}
+
+func openAuditArchive(dir string, now time.Time) (*auditArchive, error) {
+	path := filepath.Join(dir, fmt.Sprintf("activity-log-%s.ndjson.gz", now.UTC().Format("20060102T150405Z")))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(file)
+	w, _ := audit.NewWriter(gz, audit.FormatNDJSON)
+	return &auditArchive{path: path, file: file, gz: gz, w: w}, nil
+}
+
+// write appends the entries and flushes them to disk before they are removed from the database
+func (a *auditArchive) write(entries []audit.Entry) error {
+	for _, entry := range entries {
+		if err := a.w.Write(entry); err != nil {
+			return err
+		}
+	}
+	if err := a.gz.Flush(); err != nil {
+		return err
+	}
+	return a.file.Sync()
+}
+
+func (a *auditArchive) close() {
+	if err := a.gz.Close(); err != nil {
+		log.Printf("⚠️  Failed to finish activity log archive %s: %v", a.path, err)
+	}
+	if err := a.file.Close(); err != nil {
+		log.Printf("⚠️  Failed to close activity log archive %s: %v", a.path, err)
+	}
+}
@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditRetention_RejectsBatchSize(t *testing.T) {
+	for _, size := range []int{0, -1, MaxAuditRetentionBatchSize + 1} {
+		_, err := NewAuditRetention(nil, AuditRetentionConfig{Retention: 24 * time.Hour, BatchSize: size})
+		assert.Error(t, err, size)
+	}
+
+	_, err := NewAuditRetention(nil, AuditRetentionConfig{Retention: 0, BatchSize: 1000})
+	assert.Error(t, err)
+
+	_, err = NewAuditRetention(nil, AuditRetentionConfig{Retention: 24 * time.Hour, BatchSize: MaxAuditRetentionBatchSize})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"kanban/internal/repository"
+)
+
+// ChangePruneInterval is how often the board change feed is pruned
+const ChangePruneInterval = time.Hour
+
+// ChangePruner deletes board changes older than the retention, which clients can no longer ask for
+type ChangePruner struct {
+	changeRepo *repository.BoardChangeRepository
+	retention  time.Duration
+}
+
+func NewChangePruner(changeRepo *repository.BoardChangeRepository, retention time.Duration) *ChangePruner {
+	return &ChangePruner{changeRepo: changeRepo, retention: retention}
+}
+
+// Run prunes the change feed every ChangePruneInterval until ctx is cancelled
+func (p *ChangePruner) Run(ctx context.Context) {
+	ticker := time.NewTicker(ChangePruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := p.RunOnce(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Board change pruning failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce deletes the changes older than the retention
+func (p *ChangePruner) RunOnce(ctx context.Context) error {
+	pruned, err := p.changeRepo.Prune(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		return err
+	}
+	if pruned > 0 {
+		log.Printf("✅ Pruned %d board changes", pruned)
+	}
+	return nil
+}
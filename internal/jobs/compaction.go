@@ -0,0 +1,91 @@
+// Package jobs contains background maintenance jobs run alongside the API server.
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"kanban/internal/repository"
+)
+
+// CompactionConfig controls when and how much the position compactor works.
+// The job only runs while the UTC hour is in [WindowStartHour, WindowEndHour);
+// a window that wraps midnight (e.g. 22 to 5) is supported, and equal hours mean all day.
+type CompactionConfig struct {
+	Interval        time.Duration
+	WindowStartHour int
+	WindowEndHour   int
+	BatchSize       int
+}
+
+// PositionCompactor periodically renumbers fragmented task and column positions
+// so ordering keys stay short and contiguous
+type PositionCompactor struct {
+	taskRepo   repository.TaskRepositoryInterface
+	columnRepo repository.ColumnRepositoryInterface
+	cfg        CompactionConfig
+}
+
+func NewPositionCompactor(
+	taskRepo repository.TaskRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	cfg CompactionConfig,
+) *PositionCompactor {
+	return &PositionCompactor{
+		taskRepo:   taskRepo,
+		columnRepo: columnRepo,
+		cfg:        cfg,
+	}
+}
+
+// Run compacts positions on every tick inside the configured window until ctx is cancelled
+func (p *PositionCompactor) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if !inWindow(now.UTC().Hour(), p.cfg.WindowStartHour, p.cfg.WindowEndHour) {
+				continue
+			}
+			if err := p.RunOnce(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Position compaction failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce compacts one batch of fragmented columns and boards
+func (p *PositionCompactor) RunOnce(ctx context.Context) error {
+	columns, tasks, err := p.taskRepo.CompactFragmentedColumns(ctx, p.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	boards, boardColumns, err := p.columnRepo.CompactFragmentedBoards(ctx, p.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	if columns > 0 || boards > 0 {
+		log.Printf("✅ Compacted positions: %d tasks in %d columns, %d columns in %d boards",
+			tasks, columns, boardColumns, boards)
+	}
+	return nil
+}
+
+// inWindow reports whether hour falls in [start, end), wrapping around midnight when start > end
+func inWindow(hour, start, end int) bool {
+	switch {
+	case start == end:
+		return true
+	case start < end:
+		return hour >= start && hour < end
+	default:
+		return hour >= start || hour < end
+	}
+}
@@ -0,0 +1,22 @@
+package jobs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInWindow(t *testing.T) {
+	// Обычное окно внутри суток
+	assert.True(t, inWindow(2, 1, 5))
+	assert.False(t, inWindow(5, 1, 5))
+	assert.False(t, inWindow(0, 1, 5))
+
+	// Окно через полночь
+	assert.True(t, inWindow(23, 22, 5))
+	assert.True(t, inWindow(3, 22, 5))
+	assert.False(t, inWindow(12, 22, 5))
+
+	// Одинаковые границы означают круглые сутки
+	assert.True(t, inWindow(12, 3, 3))
+}
@@ -0,0 +1,123 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+)
+
+// DescriptionOpHistory is how many versions of edits each description document keeps, so
+// editors that fell behind can catch up
+const DescriptionOpHistory = 1000
+
+// DescriptionSaverConfig controls saving of collaboratively edited descriptions. Documents
+// whose last edit is at least IdleAfter old are saved every Interval.
+type DescriptionSaverConfig struct {
+	Interval  time.Duration
+	IdleAfter time.Duration
+	BatchSize int
+}
+
+// DescriptionSaver saves the last edits of description documents nobody edits anymore to their
+// tasks; documents being edited are saved by the edits themselves. References and mentions of
+// the text are updated the next time the description is saved through the API.
+type DescriptionSaver struct {
+	docRepo    *repository.DescriptionDocRepository
+	taskRepo   *repository.TaskRepository
+	changeRepo *repository.TaskChangeRepository
+	txManager  *repository.TxManager
+	cfg        DescriptionSaverConfig
+}
+
+func NewDescriptionSaver(
+	docRepo *repository.DescriptionDocRepository,
+	taskRepo *repository.TaskRepository,
+	changeRepo *repository.TaskChangeRepository,
+	txManager *repository.TxManager,
+	cfg DescriptionSaverConfig,
+) *DescriptionSaver {
+	return &DescriptionSaver{
+		docRepo:    docRepo,
+		taskRepo:   taskRepo,
+		changeRepo: changeRepo,
+		txManager:  txManager,
+		cfg:        cfg,
+	}
+}
+
+// Run saves idle documents every Interval until ctx is cancelled
+func (s *DescriptionSaver) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.RunOnce(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Saving descriptions failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce saves up to BatchSize documents idle at now and prunes the edits older than
+// DescriptionOpHistory versions
+func (s *DescriptionSaver) RunOnce(ctx context.Context, now time.Time) error {
+	taskIDs, err := s.docRepo.GetUnsaved(ctx, now.Add(-s.cfg.IdleAfter), s.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, taskID := range taskIDs {
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			doc, err := s.docRepo.GetForUpdate(ctx, taskID)
+			if err != nil || doc == nil || !doc.Unsaved() {
+				return err
+			}
+			return s.save(ctx, doc, now)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	pruned, err := s.docRepo.PruneOps(ctx, DescriptionOpHistory)
+	if err != nil {
+		return err
+	}
+	if len(taskIDs) > 0 || pruned > 0 {
+		log.Printf("✅ Saved %d descriptions, pruned %d edits", len(taskIDs), pruned)
+	}
+	return nil
+}
+
+// save writes the document to its task, recording the change in the task history as made by
+// the last editor
+func (s *DescriptionSaver) save(ctx context.Context, doc *model.DescriptionDoc, now time.Time) error {
+	task, err := s.taskRepo.GetByID(ctx, doc.TaskID)
+	if err != nil {
+		return err
+	}
+
+	if task.Description != doc.Content {
+		if err := s.taskRepo.SetDescription(ctx, task.ID, doc.Content); err != nil {
+			return err
+		}
+		change := model.TaskChange{TaskID: task.ID, UserID: doc.UpdatedBy, Field: model.TaskFieldDescription}
+		if task.Description != "" {
+			change.OldValue = &task.Description
+		}
+		if doc.Content != "" {
+			change.NewValue = &doc.Content
+		}
+		if err := s.changeRepo.Record(ctx, []model.TaskChange{change}); err != nil {
+			return err
+		}
+	}
+	return s.docRepo.MarkSaved(ctx, doc.TaskID, doc.Version, now)
+}
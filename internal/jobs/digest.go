@@ -0,0 +1,276 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"kanban/internal/mail"
+	"kanban/internal/model"
+	"kanban/internal/notification"
+	"kanban/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// DigestMaxMentions limits the mentions listed in a digest
+const DigestMaxMentions = 50
+
+// DigestConfig controls the email digests. Daily digests are due every day at Hour (UTC) and
+// weekly ones on Mondays at Hour; digests missed while the server was down are sent late.
+type DigestConfig struct {
+	Interval  time.Duration
+	Hour      int
+	BatchSize int
+}
+
+// DigestSender emails users a summary of their overdue tasks, the tasks assigned to them and
+// their mentions since the last digest, across all their boards except the muted ones
+type DigestSender struct {
+	settingsRepo *repository.NotificationSettingRepository
+	taskRepo     *repository.TaskRepository
+	changeRepo   *repository.TaskChangeRepository
+	mentionRepo  *repository.TaskMentionRepository
+	mailer       mail.Mailer
+	cfg          DigestConfig
+}
+
+func NewDigestSender(
+	settingsRepo *repository.NotificationSettingRepository,
+	taskRepo *repository.TaskRepository,
+	changeRepo *repository.TaskChangeRepository,
+	mentionRepo *repository.TaskMentionRepository,
+	mailer mail.Mailer,
+	cfg DigestConfig,
+) *DigestSender {
+	return &DigestSender{
+		settingsRepo: settingsRepo,
+		taskRepo:     taskRepo,
+		changeRepo:   changeRepo,
+		mentionRepo:  mentionRepo,
+		mailer:       mailer,
+		cfg:          cfg,
+	}
+}
+
+// Run sends the due digests on every tick until ctx is cancelled
+func (d *DigestSender) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := d.RunOnce(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Sending digests failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce sends up to BatchSize digests of each frequency that are due at now. A digest that
+// fails is logged and retried on the next run.
+func (d *DigestSender) RunOnce(ctx context.Context, now time.Time) error {
+	for _, frequency := range []string{notification.DigestDaily, notification.DigestWeekly} {
+		scheduledAt := DigestScheduledAt(frequency, now, d.cfg.Hour)
+		digests, err := d.settingsRepo.GetDueDigests(ctx, frequency, scheduledAt, d.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		sent := 0
+		for i := range digests {
+			digest := &digests[i]
+			ok, err := d.send(ctx, digest, scheduledAt, now)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				log.Printf("⚠️  Failed to send %s digest to user %s: %v", frequency, digest.UserID, err)
+				continue
+			}
+			if err := d.settingsRepo.MarkDigestSent(ctx, digest.UserID, now); err != nil {
+				return err
+			}
+			if ok {
+				sent++
+			}
+		}
+
+		if sent > 0 {
+			log.Printf("✉️  Sent %d %s digests", sent, frequency)
+		}
+	}
+	return nil
+}
+
+// send emails the user's digest and reports whether there was anything to send
+func (d *DigestSender) send(ctx context.Context, digest *model.NotificationDigest, scheduledAt, now time.Time) (bool, error) {
+	since := scheduledAt.AddDate(0, 0, -digestDays(digest.Frequency))
+	if digest.LastSentAt != nil {
+		since = *digest.LastSentAt
+	}
+
+	_, mutes, err := d.settingsRepo.GetForUser(ctx, digest.UserID)
+	if err != nil {
+		return false, err
+	}
+	muted := make(map[uuid.UUID]bool, len(mutes))
+	boards := repository.BoardFilter{AllBoards: true}
+	for _, mute := range mutes {
+		muted[mute.BoardID] = true
+		boards.Boards = append(boards.Boards, mute.BoardID)
+	}
+
+	overdue, err := d.taskRepo.GetAssigned(ctx, digest.UserID, repository.AssignedTaskFilter{Overdue: &now})
+	if err != nil {
+		return false, err
+	}
+	assigned, err := d.changeRepo.GetAssignedSince(ctx, digest.UserID, since)
+	if err != nil {
+		return false, err
+	}
+	mentions, err := d.mentionRepo.GetForUser(ctx, digest.UserID, boards, false, DigestMaxMentions)
+	if err != nil {
+		return false, err
+	}
+
+	content := Digest{Frequency: digest.Frequency, Name: digest.User.Name}
+	for _, task := range overdue {
+		if !muted[task.Column.BoardID] {
+			content.Overdue = append(content.Overdue, newDigestTask(&task))
+		}
+	}
+	for _, task := range assigned {
+		if !muted[task.Column.BoardID] {
+			content.Assigned = append(content.Assigned, newDigestTask(&task))
+		}
+	}
+	// Упоминания идут от новых к старым
+	for _, mention := range mentions {
+		if !mention.CreatedAt.After(since) {
+			break
+		}
+		item := newDigestTask(&mention.Task)
+		item.By = mention.Author.Name
+		content.Mentions = append(content.Mentions, item)
+	}
+
+	if content.Empty() {
+		return false, nil
+	}
+	return true, d.mailer.Send(ctx, mail.Message{To: digest.User.Email, Subject: content.Subject(), Body: content.Text()})
+}
+
+// DigestScheduledAt returns the last time at or before now that digests of the frequency were
+// due: today's hour for daily digests and Monday's for weekly ones, in UTC
+func DigestScheduledAt(frequency string, now time.Time, hour int) time.Time {
+	now = now.UTC()
+	scheduled := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if frequency == notification.DigestWeekly {
+		scheduled = scheduled.AddDate(0, 0, -((int(scheduled.Weekday()) + 6) % 7))
+	}
+	if scheduled.After(now) {
+		scheduled = scheduled.AddDate(0, 0, -digestDays(frequency))
+	}
+	return scheduled
+}
+
+// digestDays is the number of days a digest of the frequency covers
+func digestDays(frequency string) int {
+	if frequency == notification.DigestWeekly {
+		return 7
+	}
+	return 1
+}
+
+// Digest is the content of a user's digest email
+type Digest struct {
+	Frequency string
+	Name      string
+	Overdue   []DigestTask
+	Assigned  []DigestTask
+	Mentions  []DigestTask
+}
+
+// DigestTask is a task listed in a digest
+type DigestTask struct {
+	Key     string
+	Title   string
+	Board   string
+	DueDate *time.Time
+	// By is the author of a mention
+	By string
+}
+
+func newDigestTask(task *model.Task) DigestTask {
+	board := &task.Column.Board
+	return DigestTask{
+		Key:     fmt.Sprintf("%s-%d", board.Key, task.Number),
+		Title:   task.Title,
+		Board:   board.Title,
+		DueDate: task.DueDate,
+	}
+}
+
+// Empty reports whether the digest has nothing to tell; empty digests are not sent
+func (d Digest) Empty() bool {
+	return len(d.Overdue) == 0 && len(d.Assigned) == 0 && len(d.Mentions) == 0
+}
+
+// Subject is the subject line of the digest email
+func (d Digest) Subject() string {
+	var parts []string
+	if n := len(d.Overdue); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d overdue", n))
+	}
+	if n := len(d.Assigned); n > 0 {
+		parts = append(parts, fmt.Sprintf("%d assigned", n))
+	}
+	if n := len(d.Mentions); n > 0 {
+		parts = append(parts, plural(n, "mention"))
+	}
+	return fmt.Sprintf("Your %s digest: %s", d.Frequency, strings.Join(parts, ", "))
+}
+
+// Text renders the digest as the plain text body of the email
+func (d Digest) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Hi %s,\n\nhere is your %s digest.\n", d.Name, d.Frequency)
+
+	section := func(title string, tasks []DigestTask, line func(t DigestTask) string) {
+		if len(tasks) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n%s:\n", title)
+		for _, t := range tasks {
+			fmt.Fprintf(&b, "  - %s %s (%s)%s\n", t.Key, t.Title, t.Board, line(t))
+		}
+	}
+	section("Overdue", d.Overdue, func(t DigestTask) string {
+		return ", due " + t.DueDate.Format("Jan 2")
+	})
+	section("Assigned to you", d.Assigned, func(t DigestTask) string {
+		if t.DueDate == nil {
+			return ""
+		}
+		return ", due " + t.DueDate.Format("Jan 2")
+	})
+	section("Mentions", d.Mentions, func(t DigestTask) string {
+		return ", by " + t.By
+	})
+
+	b.WriteString("\nYou can change how often you get this email in your notification settings.\n")
+	return b.String()
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
@@ -0,0 +1,47 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/notification"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestScheduledAt(t *testing.T) {
+	// Среда, 10 апреля 2024
+	at := func(day, hour int) time.Time { return time.Date(2024, 4, day, hour, 0, 0, 0, time.UTC) }
+
+	assert.Equal(t, at(10, 7), DigestScheduledAt(notification.DigestDaily, at(10, 9), 7))
+	// До часа рассылки последней была вчерашняя
+	assert.Equal(t, at(9, 7), DigestScheduledAt(notification.DigestDaily, at(10, 6), 7))
+
+	// Еженедельная рассылка идет по понедельникам
+	assert.Equal(t, at(8, 7), DigestScheduledAt(notification.DigestWeekly, at(10, 9), 7))
+	assert.Equal(t, at(8, 7), DigestScheduledAt(notification.DigestWeekly, at(8, 7), 7))
+	assert.Equal(t, at(1, 7), DigestScheduledAt(notification.DigestWeekly, at(8, 6), 7))
+}
+
+func TestDigest_Text(t *testing.T) {
+	due := time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC)
+	digest := Digest{
+		Frequency: notification.DigestDaily,
+		Name:      "Anna",
+		Overdue:   []DigestTask{{Key: "OPS-1", Title: "Renew certificate", Board: "Ops", DueDate: &due}},
+		Mentions: []DigestTask{
+			{Key: "WEB-4", Title: "Fix login", Board: "Web", By: "Boris"},
+			{Key: "WEB-5", Title: "Fix logout", Board: "Web", By: "Vera"},
+		},
+	}
+
+	assert.False(t, digest.Empty())
+	assert.True(t, Digest{Frequency: notification.DigestDaily}.Empty())
+	assert.Equal(t, "Your daily digest: 1 overdue, 2 mentions", digest.Subject())
+
+	text := digest.Text()
+	assert.Contains(t, text, "Hi Anna,")
+	assert.Contains(t, text, "Overdue:\n  - OPS-1 Renew certificate (Ops), due Apr 8\n")
+	assert.Contains(t, text, "Mentions:\n  - WEB-4 Fix login (Web), by Boris\n")
+	assert.NotContains(t, text, "Assigned to you")
+}
@@ -0,0 +1,257 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/limits"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/requestid"
+)
+
+// BoardDuplicator copies a board with its columns, labels and tasks as a tracked operation
+type BoardDuplicator struct {
+	boardRepo     repository.BoardRepositoryInterface
+	columnRepo    repository.ColumnRepositoryInterface
+	taskRepo      repository.TaskRepositoryInterface
+	labelRepo     repository.LabelRepositoryInterface
+	userRepo      *repository.UserRepository
+	operationRepo *repository.OperationRepository
+	txManager     *repository.TxManager
+	limits        *limits.Service
+}
+
+func NewBoardDuplicator(
+	boardRepo repository.BoardRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	userRepo *repository.UserRepository,
+	operationRepo *repository.OperationRepository,
+	txManager *repository.TxManager,
+	limitService *limits.Service,
+) *BoardDuplicator {
+	return &BoardDuplicator{
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		taskRepo:      taskRepo,
+		labelRepo:     labelRepo,
+		userRepo:      userRepo,
+		operationRepo: operationRepo,
+		txManager:     txManager,
+		limits:        limitService,
+	}
+}
+
+// DuplicateOptions selects what a board copy includes besides columns and labels
+type DuplicateOptions struct {
+	Title            string
+	IncludeTasks     bool
+	IncludeAssignees bool
+	IncludeDueDates  bool
+}
+
+// boardSnapshot is the content of the source board read in one consistent snapshot
+type boardSnapshot struct {
+	board   *model.Board
+	columns []model.Column
+	labels  []model.Label
+	tasks   []model.Task
+}
+
+// Duplicate copies the source board into a new board owned by ownerID.
+// The source is read from a single snapshot, so edits made meanwhile never produce a torn copy.
+// The copy is written in one transaction; a task that cannot be copied is rolled back to its
+// savepoint and recorded as a partial error on the operation, and the copy continues with the next one.
+func (d *BoardDuplicator) Duplicate(ctx context.Context, operationID, sourceID, ownerID uuid.UUID, opts DuplicateOptions) {
+	var src boardSnapshot
+	err := d.txManager.WithinSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		if src.board, err = d.boardRepo.GetByID(ctx, sourceID); err != nil {
+			return err
+		}
+		if src.columns, err = d.columnRepo.GetByBoardID(ctx, sourceID); err != nil {
+			return err
+		}
+		if src.labels, err = d.labelRepo.GetByBoardID(ctx, sourceID); err != nil {
+			return err
+		}
+		if opts.IncludeTasks {
+			src.tasks, err = d.taskRepo.GetByBoardID(ctx, sourceID)
+		}
+		return err
+	})
+	if err != nil {
+		d.fail(ctx, operationID, fmt.Sprintf("Failed to read source board: %v", err))
+		return
+	}
+
+	// Структура доски считается одним шагом, каждая задача отдельным
+	if err := d.operationRepo.Start(ctx, operationID, len(src.tasks)+1); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to start operation %s: %v", operationID, err)
+	}
+
+	board := &model.Board{
+		Title:       opts.Title,
+		Description: src.board.Description,
+		OwnerID:     ownerID,
+		Key:         src.board.Key,
+		CardFields:  src.board.CardFields,
+	}
+	if opts.IncludeTasks {
+		board.TaskCounter = src.board.TaskCounter
+	}
+
+	// Прогресс пишется вне транзакции копирования, чтобы его было видно до её завершения
+	opCtx := ctx
+	err = d.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Блокировка пользователя не даёт параллельным копиям превысить лимит досок
+		if err := d.userRepo.Lock(ctx, ownerID); err != nil {
+			return err
+		}
+		ownerLimits, err := d.limits.ForUser(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		count, err := d.boardRepo.CountOwned(ctx, ownerID)
+		if err != nil {
+			return err
+		}
+		if limits.Reached(ownerLimits.Boards, count) {
+			return fmt.Errorf("Maximum number of boards reached (%d)", ownerLimits.Boards)
+		}
+		if err := checkCopyLimits(ownerLimits, src, opts); err != nil {
+			return err
+		}
+
+		if err := d.boardRepo.Create(ctx, board); err != nil {
+			return err
+		}
+
+		labelIDs := make(map[uuid.UUID]uuid.UUID, len(src.labels))
+		for _, l := range src.labels {
+			label := model.Label{BoardID: &board.ID, Name: l.Name, Color: l.Color}
+			if err := d.labelRepo.Create(ctx, &label); err != nil {
+				return err
+			}
+			labelIDs[l.ID] = label.ID
+		}
+
+		columnIDs := make(map[uuid.UUID]uuid.UUID, len(src.columns))
+		for _, c := range src.columns {
+			column := model.Column{BoardID: board.ID, Title: c.Title, Position: c.Position, IsDone: c.IsDone, Color: c.Color, Description: c.Description}
+			if err := d.columnRepo.Create(ctx, &column); err != nil {
+				return err
+			}
+			columnIDs[c.ID] = column.ID
+		}
+		d.advance(opCtx, operationID)
+
+		for _, t := range src.tasks {
+			if err := d.taskRepo.CreateCopy(ctx, copyTask(&t, columnIDs[t.ColumnID], ownerID, opts), copyLabels(&t, labelIDs, ownerID)); err != nil {
+				message := fmt.Sprintf("Task %s-%d: %v", src.board.Key, t.Number, err)
+				if err := d.operationRepo.AppendError(opCtx, operationID, message); err != nil {
+					requestid.Logf(ctx, "⚠️  Failed to record error of operation %s: %v", operationID, err)
+				}
+			}
+			d.advance(opCtx, operationID)
+		}
+		return nil
+	})
+	if err != nil {
+		d.fail(ctx, operationID, fmt.Sprintf("Failed to create board: %v", err))
+		return
+	}
+
+	if err := d.operationRepo.Finish(ctx, operationID, model.OperationSucceeded, &board.ID); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to finish operation %s: %v", operationID, err)
+	}
+}
+
+// checkCopyLimits checks that the copy of a board stays within the limits of its new owner
+// on columns per board and tasks per column
+func checkCopyLimits(ownerLimits limits.Limits, src boardSnapshot, opts DuplicateOptions) error {
+	if limits.Exceeds(ownerLimits.ColumnsPerBoard, int64(len(src.columns))) {
+		return fmt.Errorf("The board has more columns than allowed (%d)", ownerLimits.ColumnsPerBoard)
+	}
+
+	if !opts.IncludeTasks {
+		return nil
+	}
+	counts := make(map[uuid.UUID]int64, len(src.columns))
+	for _, t := range src.tasks {
+		counts[t.ColumnID]++
+		if limits.Exceeds(ownerLimits.TasksPerColumn, counts[t.ColumnID]) {
+			return fmt.Errorf("A column of the board has more tasks than allowed (%d)", ownerLimits.TasksPerColumn)
+		}
+	}
+	return nil
+}
+
+// copyTask builds the copy of a task in the given column of the new board
+func copyTask(t *model.Task, columnID, ownerID uuid.UUID, opts DuplicateOptions) *model.Task {
+	task := &model.Task{
+		ColumnID:      columnID,
+		Title:         t.Title,
+		Description:   t.Description,
+		CreatedBy:     t.CreatedBy,
+		Position:      t.Position,
+		Number:        t.Number,
+		CompletedAt:   t.CompletedAt,
+		Blocked:       t.Blocked,
+		BlockedReason: t.BlockedReason,
+		Priority:      t.Priority,
+		CoverColor:    t.CoverColor,
+		Estimate:      t.Estimate,
+	}
+	if opts.IncludeDueDates {
+		task.StartDate = t.StartDate
+		task.DueDate = t.DueDate
+	}
+	// Участники исходной доски не получают доступ к копии, поэтому назначение сохраняется только для владельца
+	if opts.IncludeAssignees && t.AssignedTo != nil && *t.AssignedTo == ownerID {
+		task.AssignedTo = t.AssignedTo
+	}
+	return task
+}
+
+// copyLabels maps the labels of a task to the copied board labels; workspace labels of the
+// new owner are kept as they are
+func copyLabels(t *model.Task, labelIDs map[uuid.UUID]uuid.UUID, ownerID uuid.UUID) []uuid.UUID {
+	var ids []uuid.UUID
+	for _, l := range t.Labels {
+		if id, ok := labelIDs[l.ID]; ok {
+			ids = append(ids, id)
+		} else if l.OwnerID != nil && *l.OwnerID == ownerID {
+			ids = append(ids, l.ID)
+		}
+	}
+	return ids
+}
+
+func (d *BoardDuplicator) advance(ctx context.Context, operationID uuid.UUID) {
+	advanceOperation(ctx, d.operationRepo, operationID)
+}
+
+func (d *BoardDuplicator) fail(ctx context.Context, operationID uuid.UUID, message string) {
+	failOperation(ctx, d.operationRepo, operationID, message)
+}
+
+func advanceOperation(ctx context.Context, operationRepo *repository.OperationRepository, operationID uuid.UUID) {
+	if err := operationRepo.Advance(ctx, operationID, 1); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to update progress of operation %s: %v", operationID, err)
+	}
+}
+
+// failOperation records the error and marks the operation as failed
+func failOperation(ctx context.Context, operationRepo *repository.OperationRepository, operationID uuid.UUID, message string) {
+	if err := operationRepo.AppendError(ctx, operationID, message); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to record error of operation %s: %v", operationID, err)
+	}
+	if err := operationRepo.Finish(ctx, operationID, model.OperationFailed, nil); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to finish operation %s: %v", operationID, err)
+	}
+}
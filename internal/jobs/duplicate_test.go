@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"kanban/internal/limits"
+	"kanban/internal/model"
+)
+
+func TestCopyTask(t *testing.T) {
+	owner := uuid.New()
+	member := uuid.New()
+	column := uuid.New()
+	due := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	source := &model.Task{Title: "Release", Number: 7, DueDate: &due, AssignedTo: &owner, Priority: model.PriorityHigh}
+
+	full := copyTask(source, column, owner, DuplicateOptions{IncludeAssignees: true, IncludeDueDates: true})
+	assert.Equal(t, column, full.ColumnID)
+	assert.Equal(t, 7, full.Number)
+	assert.Equal(t, &due, full.DueDate)
+	assert.Equal(t, &owner, full.AssignedTo)
+
+	bare := copyTask(source, column, owner, DuplicateOptions{})
+	assert.Nil(t, bare.DueDate)
+	assert.Nil(t, bare.AssignedTo)
+	assert.Equal(t, model.PriorityHigh, bare.Priority)
+
+	// Назначение на участника исходной доски не копируется
+	source.AssignedTo = &member
+	assert.Nil(t, copyTask(source, column, owner, DuplicateOptions{IncludeAssignees: true}).AssignedTo)
+}
+
+func TestCheckCopyLimits(t *testing.T) {
+	todo, done := uuid.New(), uuid.New()
+	src := boardSnapshot{
+		columns: []model.Column{{ID: todo}, {ID: done}},
+		tasks:   []model.Task{{ColumnID: todo}, {ColumnID: todo}, {ColumnID: done}},
+	}
+	withTasks := DuplicateOptions{IncludeTasks: true}
+
+	assert.NoError(t, checkCopyLimits(limits.Limits{}, src, withTasks))
+	assert.NoError(t, checkCopyLimits(limits.Limits{ColumnsPerBoard: 2, TasksPerColumn: 2}, src, withTasks))
+	assert.Error(t, checkCopyLimits(limits.Limits{ColumnsPerBoard: 1}, src, withTasks))
+	assert.Error(t, checkCopyLimits(limits.Limits{TasksPerColumn: 1}, src, withTasks))
+
+	// Без задач лимит задач в колонке не важен
+	assert.NoError(t, checkCopyLimits(limits.Limits{TasksPerColumn: 1}, src, DuplicateOptions{}))
+}
@@ -0,0 +1,287 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/limits"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/requestid"
+)
+
+// MaxImportTitleLength limits the title of an imported task in characters
+const MaxImportTitleLength = 500
+
+// ImportRow is a task in an uploaded import part. Column and labels are matched by name,
+// ignoring case; the due date is a YYYY-MM-DD date.
+type ImportRow struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Column      string   `json:"column"`
+	Priority    string   `json:"priority"`
+	DueDate     string   `json:"due_date"`
+	Labels      []string `json:"labels"`
+}
+
+// ImportTargets resolves the column and label names of import rows on a board
+type ImportTargets struct {
+	columns map[string]uuid.UUID
+	labels  map[string]uuid.UUID
+}
+
+// NewImportTargets indexes the columns of a board and the labels available on it by name
+func NewImportTargets(columns []model.Column, labels []model.Label) *ImportTargets {
+	targets := &ImportTargets{
+		columns: make(map[string]uuid.UUID, len(columns)),
+		labels:  make(map[string]uuid.UUID, len(labels)),
+	}
+	for _, column := range columns {
+		key := strings.ToLower(strings.TrimSpace(column.Title))
+		// При совпадении названий задачи попадают в первую колонку доски
+		if _, taken := targets.columns[key]; !taken {
+			targets.columns[key] = column.ID
+		}
+	}
+	for _, label := range labels {
+		targets.labels[strings.ToLower(strings.TrimSpace(label.Name))] = label.ID
+	}
+	return targets
+}
+
+// Resolve validates a row and builds its task and the IDs of its labels
+func (t *ImportTargets) Resolve(row ImportRow) (*model.Task, []uuid.UUID, error) {
+	title := strings.TrimSpace(row.Title)
+	if title == "" {
+		return nil, nil, errors.New("title is required")
+	}
+	if len([]rune(title)) > MaxImportTitleLength {
+		return nil, nil, fmt.Errorf("title is longer than %d characters", MaxImportTitleLength)
+	}
+
+	columnID, ok := t.columns[strings.ToLower(strings.TrimSpace(row.Column))]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown column %q", row.Column)
+	}
+
+	task := &model.Task{
+		ColumnID:    columnID,
+		Title:       title,
+		Description: row.Description,
+		Priority:    model.PriorityMedium,
+	}
+	if row.Priority != "" {
+		if !slices.Contains(model.TaskPriorities, row.Priority) {
+			return nil, nil, fmt.Errorf("unknown priority %q", row.Priority)
+		}
+		task.Priority = row.Priority
+	}
+	if row.DueDate != "" {
+		dueDate, err := time.Parse("2006-01-02", row.DueDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid due date %q, expected YYYY-MM-DD", row.DueDate)
+		}
+		task.DueDate = &dueDate
+	}
+
+	var labelIDs []uuid.UUID
+	for _, name := range row.Labels {
+		labelID, ok := t.labels[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown label %q", name)
+		}
+		if !slices.Contains(labelIDs, labelID) {
+			labelIDs = append(labelIDs, labelID)
+		}
+	}
+	return task, labelIDs, nil
+}
+
+// TaskImporter imports the uploaded parts of a committed task import as a tracked operation
+type TaskImporter struct {
+	importRepo    *repository.TaskImportRepository
+	boardRepo     repository.BoardRepositoryInterface
+	columnRepo    repository.ColumnRepositoryInterface
+	labelRepo     repository.LabelRepositoryInterface
+	taskRepo      repository.TaskRepositoryInterface
+	operationRepo *repository.OperationRepository
+	txManager     *repository.TxManager
+	limits        *limits.Service
+}
+
+func NewTaskImporter(
+	importRepo *repository.TaskImportRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	operationRepo *repository.OperationRepository,
+	txManager *repository.TxManager,
+	limitService *limits.Service,
+) *TaskImporter {
+	return &TaskImporter{
+		importRepo:    importRepo,
+		boardRepo:     boardRepo,
+		columnRepo:    columnRepo,
+		labelRepo:     labelRepo,
+		taskRepo:      taskRepo,
+		operationRepo: operationRepo,
+		txManager:     txManager,
+		limits:        limitService,
+	}
+}
+
+// Import creates the tasks of the import's parts at the end of their columns. Each part is
+// imported in its own transaction that also removes the part, so a large import never holds
+// locks for long and an interrupted import resumes with the parts not imported yet once it is
+// committed again. Rows whose column or labels were deleted since the upload, or whose column
+// holds as many tasks as the board owner's limit allows, are skipped and recorded as partial errors.
+func (i *TaskImporter) Import(ctx context.Context, operationID, importID uuid.UUID) {
+	taskImport, err := i.importRepo.GetByID(ctx, importID)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read import: %v", err))
+		return
+	}
+
+	parts, err := i.importRepo.GetPartSummaries(ctx, importID)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read import parts: %v", err))
+		return
+	}
+
+	total := 0
+	for _, part := range parts {
+		total += part.TaskCount
+	}
+	if err := i.operationRepo.Start(ctx, operationID, total); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to start operation %s: %v", operationID, err)
+	}
+
+	board, err := i.boardRepo.GetByID(ctx, taskImport.BoardID)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read board: %v", err))
+		return
+	}
+	columns, err := i.columnRepo.GetByBoardID(ctx, board.ID)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read columns: %v", err))
+		return
+	}
+	labels, err := i.labelRepo.GetAvailableForBoard(ctx, board.ID, board.OwnerID)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read labels: %v", err))
+		return
+	}
+	targets := NewImportTargets(columns, labels)
+
+	ownerLimits, err := i.limits.ForUser(ctx, board.OwnerID)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read limits: %v", err))
+		return
+	}
+
+	columnIDs := make([]uuid.UUID, len(columns))
+	for n, column := range columns {
+		columnIDs[n] = column.ID
+	}
+	positions, err := i.taskRepo.GetPositions(ctx, columnIDs)
+	if err != nil {
+		i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to read task positions: %v", err))
+		return
+	}
+	// Новые задачи добавляются в конец своих колонок
+	nextPositions := make(map[uuid.UUID]int, len(columns))
+	taskCounts := make(map[uuid.UUID]int64, len(columns))
+	for _, task := range positions {
+		nextPositions[task.ColumnID] = max(nextPositions[task.ColumnID], task.Position+1)
+		taskCounts[task.ColumnID]++
+	}
+
+	for _, summary := range parts {
+		if err := i.importPart(ctx, operationID, taskImport, summary.PartNumber, targets, nextPositions, taskCounts, ownerLimits.TasksPerColumn); err != nil {
+			i.fail(ctx, operationID, importID, fmt.Sprintf("Failed to import part %d: %v", summary.PartNumber, err))
+			return
+		}
+	}
+
+	if err := i.importRepo.Finish(ctx, importID); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to finish import %s: %v", importID, err)
+	}
+	if err := i.operationRepo.Finish(ctx, operationID, model.OperationSucceeded, &board.ID); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to finish operation %s: %v", operationID, err)
+	}
+}
+
+// importPart creates the tasks of one part in a single transaction, counting them in taskCounts
+// against the limit of tasks per column
+func (i *TaskImporter) importPart(ctx context.Context, operationID uuid.UUID, taskImport *model.TaskImport, partNumber int, targets *ImportTargets, nextPositions map[uuid.UUID]int, taskCounts map[uuid.UUID]int64, maxTasks int) error {
+	opCtx := ctx
+	processed := 0
+	err := i.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		processed = 0
+		part, err := i.importRepo.GetPart(ctx, taskImport.ID, partNumber)
+		if err != nil {
+			return err
+		}
+
+		var rows []ImportRow
+		if err := json.Unmarshal(part.Tasks, &rows); err != nil {
+			return err
+		}
+
+		created := 0
+		for n, row := range rows {
+			processed++
+			task, labelIDs, err := targets.Resolve(row)
+			if err == nil && limits.Reached(maxTasks, taskCounts[task.ColumnID]) {
+				err = fmt.Errorf("the column already holds the maximum number of tasks (%d)", maxTasks)
+			}
+			if err != nil {
+				message := fmt.Sprintf("Part %d, task %d: %v", partNumber, n+1, err)
+				if err := i.operationRepo.AppendError(opCtx, operationID, message); err != nil {
+					requestid.Logf(ctx, "⚠️  Failed to record error of operation %s: %v", operationID, err)
+				}
+				continue
+			}
+
+			task.CreatedBy = taskImport.UserID
+			task.Position = nextPositions[task.ColumnID]
+			if err := i.taskRepo.Create(ctx, task); err != nil {
+				return err
+			}
+			for _, labelID := range labelIDs {
+				if err := i.taskRepo.AddLabel(ctx, task.ID, labelID); err != nil {
+					return err
+				}
+			}
+			nextPositions[task.ColumnID]++
+			taskCounts[task.ColumnID]++
+			created++
+		}
+		return i.importRepo.CompletePart(ctx, taskImport.ID, partNumber, created)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := i.operationRepo.Advance(opCtx, operationID, processed); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to update progress of operation %s: %v", operationID, err)
+	}
+	return nil
+}
+
+// fail marks the operation as failed and reopens the import, so its remaining parts can be
+// committed again
+func (i *TaskImporter) fail(ctx context.Context, operationID, importID uuid.UUID, message string) {
+	failOperation(ctx, i.operationRepo, operationID, message)
+	if err := i.importRepo.Reopen(ctx, importID); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to reopen import %s: %v", importID, err)
+	}
+}
@@ -0,0 +1,50 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"kanban/internal/model"
+)
+
+func TestImportTargetsResolve(t *testing.T) {
+	todo := model.Column{ID: uuid.New(), Title: "To Do"}
+	done := model.Column{ID: uuid.New(), Title: "Done"}
+	bug := model.Label{ID: uuid.New(), Name: "Bug"}
+	targets := NewImportTargets([]model.Column{todo, done}, []model.Label{bug})
+
+	task, labelIDs, err := targets.Resolve(ImportRow{
+		Title:    "  Fix login ",
+		Column:   "to do",
+		Priority: model.PriorityHigh,
+		DueDate:  "2024-06-01",
+		Labels:   []string{"bug", "BUG"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, todo.ID, task.ColumnID)
+	assert.Equal(t, "Fix login", task.Title)
+	assert.Equal(t, model.PriorityHigh, task.Priority)
+	assert.Equal(t, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), *task.DueDate)
+	// Повторы меток схлопываются
+	assert.Equal(t, []uuid.UUID{bug.ID}, labelIDs)
+
+	task, _, err = targets.Resolve(ImportRow{Title: "Ship", Column: "Done"})
+	require.NoError(t, err)
+	assert.Equal(t, model.PriorityMedium, task.Priority)
+	assert.Nil(t, task.DueDate)
+
+	for _, row := range []ImportRow{
+		{Title: " ", Column: "Done"},
+		{Title: "Ship", Column: "Backlog"},
+		{Title: "Ship", Column: "Done", Priority: "critical"},
+		{Title: "Ship", Column: "Done", DueDate: "01.06.2024"},
+		{Title: "Ship", Column: "Done", Labels: []string{"Feature"}},
+	} {
+		_, _, err := targets.Resolve(row)
+		assert.Error(t, err, "%+v", row)
+	}
+}
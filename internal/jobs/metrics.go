@@ -0,0 +1,102 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"kanban/internal/repository"
+)
+
+// MetricsRollupConfig controls the nightly metrics roll-up. The job only runs while the UTC
+// hour is in [WindowStartHour, WindowEndHour), like position compaction. On its first run it
+// also rolls up the last BackfillDays days of history.
+type MetricsRollupConfig struct {
+	Interval        time.Duration
+	WindowStartHour int
+	WindowEndHour   int
+	BackfillDays    int
+}
+
+// MetricsRollup rolls up the daily metrics of all boards once each UTC day is over, so the
+// analytics endpoints read summary tables instead of the whole column entry history
+type MetricsRollup struct {
+	analyticsRepo *repository.AnalyticsRepository
+	txManager     *repository.TxManager
+	cfg           MetricsRollupConfig
+}
+
+func NewMetricsRollup(
+	analyticsRepo *repository.AnalyticsRepository,
+	txManager *repository.TxManager,
+	cfg MetricsRollupConfig,
+) *MetricsRollup {
+	return &MetricsRollup{
+		analyticsRepo: analyticsRepo,
+		txManager:     txManager,
+		cfg:           cfg,
+	}
+}
+
+// Run rolls up finished days on every tick inside the configured window until ctx is cancelled
+func (m *MetricsRollup) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if !inWindow(now.UTC().Hour(), m.cfg.WindowStartHour, m.cfg.WindowEndHour) {
+				continue
+			}
+			if err := m.RunOnce(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Metrics roll-up failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce rolls up every finished day after the last rolled-up one, each in its own transaction
+func (m *MetricsRollup) RunOnce(ctx context.Context, now time.Time) error {
+	rollups, err := m.analyticsRepo.GetRollupRange(ctx)
+	if err != nil {
+		return err
+	}
+
+	var last *time.Time
+	if rollups != nil {
+		last = &rollups.Last
+	}
+	days := pendingRollupDays(last, now, m.cfg.BackfillDays)
+	for _, day := range days {
+		err := m.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			return m.analyticsRepo.RollUpDay(ctx, day)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(days) > 0 {
+		log.Printf("✅ Rolled up board metrics of %d days through %s", len(days), days[len(days)-1].Format("2006-01-02"))
+	}
+	return nil
+}
+
+// pendingRollupDays lists the finished UTC days to roll up: the days after last through
+// yesterday, or the last backfillDays days before the first roll-up
+func pendingRollupDays(last *time.Time, now time.Time, backfillDays int) []time.Time {
+	today := now.UTC().Truncate(24 * time.Hour)
+	next := today.AddDate(0, 0, -max(backfillDays, 1))
+	if last != nil {
+		next = last.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	}
+
+	var days []time.Time
+	for day := next; day.Before(today); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingRollupDays(t *testing.T) {
+	now := time.Date(2024, 3, 10, 1, 30, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	// Первый запуск сворачивает историю за последние дни, кроме текущего
+	assert.Equal(t, []time.Time{day(7), day(8), day(9)}, pendingRollupDays(nil, now, 3))
+
+	// Далее сворачиваются дни после последнего свернутого
+	last := day(8)
+	assert.Equal(t, []time.Time{day(9)}, pendingRollupDays(&last, now, 3))
+
+	last = day(9)
+	assert.Empty(t, pendingRollupDays(&last, now, 3))
+}
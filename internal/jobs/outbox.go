@@ -0,0 +1,191 @@
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/tracing"
+)
+
+const (
+	// OutboxMaxAttempts is how often delivery of an event is tried before it is given up
+	OutboxMaxAttempts = 20
+	// OutboxMaxBackoff caps the wait between delivery attempts of an event
+	OutboxMaxBackoff = time.Hour
+	// OutboxRetention is how long delivered events are kept
+	OutboxRetention = 7 * 24 * time.Hour
+)
+
+// Publisher delivers outbox events to the notification service
+type Publisher interface {
+	Publish(ctx context.Context, event model.OutboxEvent) error
+}
+
+// LogPublisher writes events to the server log. It is used when no webhook is configured.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(_ context.Context, event model.OutboxEvent) error {
+	log.Printf("📨 %s #%d: %s", event.Event, event.ID, event.Payload)
+	return nil
+}
+
+// WebhookPublisher posts events as JSON to a URL. With a secret, the body is signed with
+// HMAC-SHA256 in the X-Kanban-Signature header, like GitHub signs its webhooks.
+type WebhookPublisher struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewWebhookPublisher(url, secret string) *WebhookPublisher {
+	return &WebhookPublisher{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: 10 * time.Second, Transport: tracing.Transport(http.DefaultTransport)},
+	}
+}
+
+// webhookEvent is the body posted for an event; the ID lets the receiver drop duplicates,
+// since an event may be delivered more than once
+type webhookEvent struct {
+	ID        int64           `json:"id"`
+	Event     string          `json:"event"`
+	CreatedAt time.Time       `json:"created_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, event model.OutboxEvent) error {
+	body, err := json.Marshal(webhookEvent{
+		ID:        event.ID,
+		Event:     event.Event,
+		CreatedAt: event.CreatedAt,
+		Payload:   event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.secret != "" {
+		mac := hmac.New(sha256.New, []byte(p.secret))
+		mac.Write(body)
+		req.Header.Set("X-Kanban-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// OutboxConfig controls how often and how much the outbox dispatcher delivers
+type OutboxConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// OutboxDispatcher delivers the events of the outbox at least once. Events are locked while
+// they are delivered, so several server instances can run dispatchers side by side.
+type OutboxDispatcher struct {
+	outboxRepo *repository.OutboxRepository
+	txManager  *repository.TxManager
+	publisher  Publisher
+	cfg        OutboxConfig
+}
+
+func NewOutboxDispatcher(
+	outboxRepo *repository.OutboxRepository,
+	txManager *repository.TxManager,
+	publisher Publisher,
+	cfg OutboxConfig,
+) *OutboxDispatcher {
+	return &OutboxDispatcher{
+		outboxRepo: outboxRepo,
+		txManager:  txManager,
+		publisher:  publisher,
+		cfg:        cfg,
+	}
+}
+
+// Run delivers pending events on every tick until ctx is cancelled
+func (d *OutboxDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.RunOnce(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Outbox delivery failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce delivers one batch of pending events and prunes old delivered ones
+func (d *OutboxDispatcher) RunOnce(ctx context.Context) error {
+	var delivered, failed int
+	err := d.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		events, err := d.outboxRepo.ClaimPending(ctx, d.cfg.BatchSize, OutboxMaxAttempts)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := d.publisher.Publish(ctx, event); err != nil {
+				failed++
+				retryAt := time.Now().Add(outboxBackoff(event.Attempts))
+				if err := d.outboxRepo.MarkFailed(ctx, event.ID, err, retryAt); err != nil {
+					return err
+				}
+				continue
+			}
+			delivered++
+			if err := d.outboxRepo.MarkDelivered(ctx, event.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		log.Printf("⚠️  Outbox: %d events delivered, %d failed and will be retried", delivered, failed)
+	}
+
+	_, err = d.outboxRepo.PruneDelivered(ctx, time.Now().Add(-OutboxRetention))
+	return err
+}
+
+// outboxBackoff is the wait before the next delivery attempt of an event that failed attempts
+// times before: 10 seconds, doubling with every failure up to OutboxMaxBackoff
+func outboxBackoff(attempts int) time.Duration {
+	backoff := 10 * time.Second
+	for i := 0; i < attempts && backoff < OutboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, OutboxMaxBackoff)
+}
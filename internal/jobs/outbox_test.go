@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, outboxBackoff(0))
+	assert.Equal(t, 20*time.Second, outboxBackoff(1))
+	assert.Equal(t, 160*time.Second, outboxBackoff(4))
+	// Ожидание не растёт больше часа
+	assert.Equal(t, OutboxMaxBackoff, outboxBackoff(OutboxMaxAttempts))
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	var body []byte
+	var signature string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get("X-Kanban-Signature")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	event := model.OutboxEvent{
+		ID:        7,
+		Event:     model.EventMentionCreated,
+		Payload:   json.RawMessage(`{"task_id":"t1"}`),
+		CreatedAt: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, NewWebhookPublisher(server.URL, "s3cret").Publish(context.Background(), event))
+
+	assert.JSONEq(t, `{"id": 7, "event": "mention.created", "created_at": "2024-05-01T12:00:00Z", "payload": {"task_id": "t1"}}`, string(body))
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+
+	// Ответ с ошибкой оставляет событие для повторной доставки
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	assert.EqualError(t, NewWebhookPublisher(failing.URL, "").Publish(context.Background(), event), "webhook returned 502 Bad Gateway")
+}
@@ -0,0 +1,118 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"kanban/internal/requestid"
+)
+
+var (
+	// ErrQueueFull is returned by Enqueue when no more jobs can be buffered
+	ErrQueueFull = errors.New("job queue is full")
+	// ErrQueueClosed is returned by Enqueue once the queue is shutting down
+	ErrQueueClosed = errors.New("job queue is shutting down")
+)
+
+// Job is a unit of background work; ctx is cancelled when the server shuts down before the job
+// finished
+type Job func(ctx context.Context)
+
+// queuedJob is a job with the ID of the request that queued it
+type queuedJob struct {
+	run       Job
+	requestID string
+}
+
+// Queue runs jobs on a fixed number of workers in the server process
+type Queue struct {
+	jobs    chan queuedJob
+	workers int
+	done    chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewQueue(workers, size int) *Queue {
+	return &Queue{
+		jobs:    make(chan queuedJob, size),
+		workers: workers,
+		done:    make(chan struct{}),
+	}
+}
+
+// Enqueue schedules a job without blocking. The job's context carries the request ID of ctx,
+// which is otherwise not used: the job outlives the request.
+func (q *Queue) Enqueue(ctx context.Context, job Job) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	select {
+	case q.jobs <- queuedJob{run: job, requestID: requestid.FromContext(ctx)}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Run processes jobs until the queue is shut down and drained, or until ctx is cancelled, and
+// waits for running jobs to return. Jobs run with ctx, so cancelling it aborts them.
+func (q *Queue) Run(ctx context.Context) {
+	defer close(q.done)
+
+	var wg sync.WaitGroup
+	for i := 0; i < q.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job, ok := <-q.jobs:
+					if !ok {
+						return
+					}
+					runJob(ctx, job)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// Shutdown stops accepting jobs and waits until Run has finished the queued and running ones,
+// or until ctx is done. Jobs still running then are aborted by cancelling the context of Run.
+func (q *Queue) Shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// runJob keeps a panicking job from taking the worker down
+func runJob(ctx context.Context, job queuedJob) {
+	if job.requestID != "" {
+		ctx = requestid.WithID(ctx, job.requestID)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			requestid.Logf(ctx, "❌ Background job panicked: %v", r)
+		}
+	}()
+	job.run(ctx)
+}
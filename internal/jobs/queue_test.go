@@ -0,0 +1,89 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kanban/internal/requestid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	q := NewQueue(2, 2)
+	done := make(chan int, 3)
+
+	background := context.Background()
+	require.NoError(t, q.Enqueue(background, func(ctx context.Context) { panic("boom") }))
+	require.NoError(t, q.Enqueue(background, func(ctx context.Context) { done <- 1 }))
+	// Буфер заполнен, пока воркеры не запущены
+	assert.ErrorIs(t, q.Enqueue(background, func(ctx context.Context) {}), ErrQueueFull)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		q.Run(ctx)
+		close(stopped)
+	}()
+
+	// Паника одной задачи не останавливает воркеры
+	assert.Equal(t, 1, <-done)
+	require.NoError(t, q.Enqueue(background, func(ctx context.Context) { done <- 2 }))
+	assert.Equal(t, 2, <-done)
+
+	// Задача получает ID запроса, который ее поставил в очередь
+	requestIDs := make(chan string, 1)
+	require.NoError(t, q.Enqueue(requestid.WithID(background, "req-1"), func(ctx context.Context) {
+		requestIDs <- requestid.FromContext(ctx)
+	}))
+	assert.Equal(t, "req-1", <-requestIDs)
+
+	cancel()
+	<-stopped
+}
+
+func TestQueue_Shutdown(t *testing.T) {
+	q := NewQueue(1, 2)
+	release := make(chan struct{})
+	var finished []int
+	background := context.Background()
+	require.NoError(t, q.Enqueue(background, func(ctx context.Context) {
+		<-release
+		finished = append(finished, 1)
+	}))
+	require.NoError(t, q.Enqueue(background, func(ctx context.Context) {
+		finished = append(finished, 2)
+	}))
+
+	go q.Run(background)
+	shutdown := make(chan error)
+	go func() { shutdown <- q.Shutdown(background) }()
+
+	// Новые задачи не принимаются, а поставленные выполняются до конца
+	require.Eventually(t, func() bool {
+		return errors.Is(q.Enqueue(background, func(ctx context.Context) {}), ErrQueueClosed)
+	}, time.Second, time.Millisecond)
+	close(release)
+	require.NoError(t, <-shutdown)
+	assert.Equal(t, []int{1, 2}, finished)
+}
+
+func TestQueue_ShutdownDeadline(t *testing.T) {
+	q := NewQueue(1, 1)
+	require.NoError(t, q.Enqueue(context.Background(), func(ctx context.Context) {
+		<-ctx.Done()
+	}))
+
+	runCtx, abort := context.WithCancel(context.Background())
+	go q.Run(runCtx)
+
+	// Задача не успела за отведённое время и отменяется
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, q.Shutdown(ctx), context.DeadlineExceeded)
+	abort()
+	require.NoError(t, q.Shutdown(context.Background()))
+}
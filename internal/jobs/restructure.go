@@ -0,0 +1,374 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/limits"
+	"kanban/internal/model"
+	"kanban/internal/permission"
+	"kanban/internal/repository"
+	"kanban/internal/requestid"
+)
+
+var (
+	// ErrBoardLimitReached is returned when a split would exceed the owner's board limit
+	ErrBoardLimitReached = errors.New("maximum number of boards reached")
+
+	// ErrColumnLimitReached is returned when a split or merge would leave a board with more
+	// columns than its owner's limit allows
+	ErrColumnLimitReached = errors.New("maximum number of columns per board reached")
+
+	// ErrInvalidSelection is returned when split columns or tasks are missing or not on the source board
+	ErrInvalidSelection = errors.New("selected columns and tasks must belong to the board")
+
+	// errDryRun rolls back the transaction of a preview
+	errDryRun = errors.New("dry run")
+)
+
+// SplitParams describes a board split. Selected columns move to the new board with all their
+// tasks; selected tasks of other columns move into a column of the same name on the new board.
+type SplitParams struct {
+	SourceID  uuid.UUID
+	OwnerID   uuid.UUID
+	Title     string
+	ColumnIDs []uuid.UUID
+	TaskIDs   []uuid.UUID
+}
+
+// MergeParams describes a merge of the source board into the target board
+type MergeParams struct {
+	TargetID uuid.UUID
+	SourceID uuid.UUID
+}
+
+// RestructureResult summarizes the changes made, or previewed, by a split or merge
+type RestructureResult struct {
+	// BoardID is the new board of a split or the target of a merge; unset for previews of a split
+	BoardID       uuid.UUID
+	Columns       int
+	Tasks         int64
+	LabelsCreated int
+	SharesChanged int
+
+	// boards and columns to drop from the permission cache once the changes are committed
+	changedBoards  []uuid.UUID
+	changedColumns []uuid.UUID
+}
+
+// BoardRestructurer splits boards and merges them. Each split or merge runs in one transaction;
+// a dry run executes it the same way and rolls it back, so the preview matches the real result.
+type BoardRestructurer struct {
+	boardRepo      repository.BoardRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	taskRepo       repository.TaskRepositoryInterface
+	labelRepo      repository.LabelRepositoryInterface
+	relationRepo   *repository.TaskRelationRepository
+	userRepo       *repository.UserRepository
+	operationRepo  *repository.OperationRepository
+	txManager      *repository.TxManager
+	perms          *permission.Service
+	limits         *limits.Service
+}
+
+func NewBoardRestructurer(
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	taskRepo repository.TaskRepositoryInterface,
+	labelRepo repository.LabelRepositoryInterface,
+	relationRepo *repository.TaskRelationRepository,
+	userRepo *repository.UserRepository,
+	operationRepo *repository.OperationRepository,
+	txManager *repository.TxManager,
+	perms *permission.Service,
+	limitService *limits.Service,
+) *BoardRestructurer {
+	return &BoardRestructurer{
+		boardRepo:      boardRepo,
+		boardShareRepo: boardShareRepo,
+		columnRepo:     columnRepo,
+		taskRepo:       taskRepo,
+		labelRepo:      labelRepo,
+		relationRepo:   relationRepo,
+		userRepo:       userRepo,
+		operationRepo:  operationRepo,
+		txManager:      txManager,
+		perms:          perms,
+		limits:         limitService,
+	}
+}
+
+// Split moves the selection of the source board into a new board owned by params.OwnerID.
+// Members of the source board get the same access to the new board.
+func (r *BoardRestructurer) Split(ctx context.Context, params SplitParams, dryRun bool) (*RestructureResult, error) {
+	result, err := r.run(ctx, dryRun, func(ctx context.Context) (*RestructureResult, error) {
+		return r.split(ctx, params)
+	})
+	if result != nil && dryRun {
+		result.BoardID = uuid.Nil
+	}
+	return result, err
+}
+
+// Merge appends the columns of the source board with their tasks to the target board, maps the
+// source labels to target labels of the same name, gives source members access to the target
+// and deletes the source board.
+func (r *BoardRestructurer) Merge(ctx context.Context, params MergeParams, dryRun bool) (*RestructureResult, error) {
+	return r.run(ctx, dryRun, func(ctx context.Context) (*RestructureResult, error) {
+		return r.merge(ctx, params)
+	})
+}
+
+// RunSplit performs a split as the given operation
+func (r *BoardRestructurer) RunSplit(ctx context.Context, operationID uuid.UUID, params SplitParams) {
+	r.runOperation(ctx, operationID, func(ctx context.Context) (*RestructureResult, error) {
+		return r.Split(ctx, params, false)
+	})
+}
+
+// RunMerge performs a merge as the given operation
+func (r *BoardRestructurer) RunMerge(ctx context.Context, operationID uuid.UUID, params MergeParams) {
+	r.runOperation(ctx, operationID, func(ctx context.Context) (*RestructureResult, error) {
+		return r.Merge(ctx, params, false)
+	})
+}
+
+func (r *BoardRestructurer) run(ctx context.Context, dryRun bool, fn func(ctx context.Context) (*RestructureResult, error)) (*RestructureResult, error) {
+	var result *RestructureResult
+	err := r.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if result, err = fn(ctx); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+	if !dryRun {
+		r.perms.InvalidateBoard(ctx, result.changedBoards...)
+		r.perms.InvalidateColumns(ctx, result.changedColumns...)
+	}
+	return result, nil
+}
+
+func (r *BoardRestructurer) runOperation(ctx context.Context, operationID uuid.UUID, fn func(ctx context.Context) (*RestructureResult, error)) {
+	if err := r.operationRepo.Start(ctx, operationID, 1); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to start operation %s: %v", operationID, err)
+	}
+
+	result, err := fn(ctx)
+	if err != nil {
+		failOperation(ctx, r.operationRepo, operationID, err.Error())
+		return
+	}
+
+	advanceOperation(ctx, r.operationRepo, operationID)
+	if err := r.operationRepo.Finish(ctx, operationID, model.OperationSucceeded, &result.BoardID); err != nil {
+		requestid.Logf(ctx, "⚠️  Failed to finish operation %s: %v", operationID, err)
+	}
+}
+
+func (r *BoardRestructurer) split(ctx context.Context, params SplitParams) (*RestructureResult, error) {
+	if len(params.ColumnIDs) == 0 && len(params.TaskIDs) == 0 {
+		return nil, ErrInvalidSelection
+	}
+
+	// Блокировка пользователя не даёт параллельным операциям превысить лимит досок
+	if err := r.userRepo.Lock(ctx, params.OwnerID); err != nil {
+		return nil, err
+	}
+	ownerLimits, err := r.limits.ForUser(ctx, params.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	count, err := r.boardRepo.CountOwned(ctx, params.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if limits.Reached(ownerLimits.Boards, count) {
+		return nil, fmt.Errorf("%w (%d)", ErrBoardLimitReached, ownerLimits.Boards)
+	}
+
+	columns, err := r.columnRepo.GetByBoardID(ctx, params.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := r.taskRepo.GetByBoardID(ctx, params.SourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceColumns := make(map[uuid.UUID]model.Column, len(columns))
+	for _, column := range columns {
+		sourceColumns[column.ID] = column
+	}
+	for _, id := range params.ColumnIDs {
+		if _, ok := sourceColumns[id]; !ok {
+			return nil, ErrInvalidSelection
+		}
+	}
+	var selectedTasks []model.Task
+	for _, task := range tasks {
+		if slices.Contains(params.TaskIDs, task.ID) && !slices.Contains(params.ColumnIDs, task.ColumnID) {
+			selectedTasks = append(selectedTasks, task)
+		}
+	}
+	for _, id := range params.TaskIDs {
+		if !slices.ContainsFunc(tasks, func(t model.Task) bool { return t.ID == id }) {
+			return nil, ErrInvalidSelection
+		}
+	}
+
+	// Новая доска получает выбранные колонки и по колонке на каждую исходную колонку отдельных задач
+	newColumns := make(map[uuid.UUID]bool)
+	for _, id := range params.ColumnIDs {
+		newColumns[id] = true
+	}
+	for _, task := range selectedTasks {
+		newColumns[task.ColumnID] = true
+	}
+	if limits.Exceeds(ownerLimits.ColumnsPerBoard, int64(len(newColumns))) {
+		return nil, fmt.Errorf("%w (%d)", ErrColumnLimitReached, ownerLimits.ColumnsPerBoard)
+	}
+
+	board := &model.Board{Title: params.Title, OwnerID: params.OwnerID, Key: model.DeriveBoardKey(params.Title)}
+	if err := r.boardRepo.Create(ctx, board); err != nil {
+		return nil, err
+	}
+
+	result := &RestructureResult{BoardID: board.ID}
+	if result.SharesChanged, err = r.boardShareRepo.CopyShares(ctx, params.SourceID, board.ID, params.OwnerID); err != nil {
+		return nil, err
+	}
+
+	var moved []*model.Column
+	for i := range columns {
+		if !slices.Contains(params.ColumnIDs, columns[i].ID) {
+			continue
+		}
+		if err := r.columnRepo.MoveToBoard(ctx, &columns[i], board.ID, 0); err != nil {
+			return nil, err
+		}
+		moved = append(moved, &columns[i])
+		result.changedColumns = append(result.changedColumns, columns[i].ID)
+	}
+
+	// Отдельные задачи переезжают в колонку с тем же названием, созданную на новой доске
+	created := make(map[uuid.UUID]*model.Column)
+	filled := make(map[uuid.UUID]int)
+	for _, task := range selectedTasks {
+		column, ok := created[task.ColumnID]
+		if !ok {
+			source := sourceColumns[task.ColumnID]
+			column = &model.Column{BoardID: board.ID, Title: source.Title, Position: len(moved) + 1, IsDone: source.IsDone, Color: source.Color, Description: source.Description}
+			if err := r.columnRepo.Create(ctx, column); err != nil {
+				return nil, err
+			}
+			created[task.ColumnID] = column
+			moved = append(moved, column)
+		}
+
+		if err := r.taskRepo.MoveTask(ctx, task.ID, column.ID, filled[column.ID]); err != nil {
+			return nil, err
+		}
+		filled[column.ID]++
+	}
+
+	if err := r.adoptColumns(ctx, result, moved, board); err != nil {
+		return nil, err
+	}
+	if err := r.relationRepo.DeleteAcrossBoards(ctx, board.ID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *BoardRestructurer) merge(ctx context.Context, params MergeParams) (*RestructureResult, error) {
+	target, err := r.boardRepo.GetByID(ctx, params.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RestructureResult{BoardID: target.ID, changedBoards: []uuid.UUID{params.SourceID, target.ID}}
+	if result.SharesChanged, err = r.boardShareRepo.CopyShares(ctx, params.SourceID, target.ID, target.OwnerID); err != nil {
+		return nil, err
+	}
+
+	columns, err := r.columnRepo.GetByBoardID(ctx, params.SourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerLimits, err := r.limits.ForUser(ctx, target.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerLimits.ColumnsPerBoard > 0 {
+		count, err := r.columnRepo.CountByBoard(ctx, target.ID)
+		if err != nil {
+			return nil, err
+		}
+		if limits.Exceeds(ownerLimits.ColumnsPerBoard, count+int64(len(columns))) {
+			return nil, fmt.Errorf("%w (%d)", ErrColumnLimitReached, ownerLimits.ColumnsPerBoard)
+		}
+	}
+
+	moved := make([]*model.Column, len(columns))
+	for i := range columns {
+		if err := r.columnRepo.MoveToBoard(ctx, &columns[i], target.ID, 0); err != nil {
+			return nil, err
+		}
+		moved[i] = &columns[i]
+		result.changedColumns = append(result.changedColumns, columns[i].ID)
+	}
+
+	if err := r.adoptColumns(ctx, result, moved, target); err != nil {
+		return nil, err
+	}
+	if err := r.boardRepo.Delete(ctx, params.SourceID); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// adoptColumns makes the tasks of columns moved to the board part of it: numbers on the board,
+// labels and swimlanes usable there, no sprints of the old board and assignees that can access it
+func (r *BoardRestructurer) adoptColumns(ctx context.Context, result *RestructureResult, columns []*model.Column, board *model.Board) error {
+	for _, column := range columns {
+		tasks, err := r.taskRepo.RenumberForBoard(ctx, column.ID, board.ID)
+		if err != nil {
+			return err
+		}
+		result.Tasks += tasks
+
+		labels, err := r.labelRepo.RemapColumnLabels(ctx, column.ID, board.ID, board.OwnerID)
+		if err != nil {
+			return err
+		}
+		result.LabelsCreated += labels
+
+		if err := r.taskRepo.RemapSwimlanes(ctx, column.ID, board.ID); err != nil {
+			return err
+		}
+
+		if err := r.taskRepo.ClearSprints(ctx, column.ID); err != nil {
+			return err
+		}
+
+		if err := r.taskRepo.UnassignNonMembers(ctx, column.ID, board.ID); err != nil {
+			return err
+		}
+	}
+	result.Columns = len(columns)
+	return nil
+}
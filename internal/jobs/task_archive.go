@@ -0,0 +1,193 @@
+package jobs
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+)
+
+// TaskArchiveInterval is how often completed tasks are checked for archiving
+const TaskArchiveInterval = time.Hour
+
+// MaxTaskArchiveBatchSize bounds the batches, whose tasks are removed in a single transaction
+const MaxTaskArchiveBatchSize = 1000
+
+// ErrArchivedTaskMissing is returned by Read when the archive file does not hold the task
+var ErrArchivedTaskMissing = errors.New("archived task is missing from its archive file")
+
+// TaskArchiveConfig controls the task archive. Tasks completed more than After ago are moved
+// with their labels, relations and history to gzipped NDJSON files in Dir, a directory meant to
+// be synced to cold storage, BatchSize tasks at a time.
+type TaskArchiveConfig struct {
+	After     time.Duration
+	Dir       string
+	BatchSize int
+}
+
+// TaskArchiver moves long completed tasks out of the database into the archive and reads them
+// back for restoring
+type TaskArchiver struct {
+	archiveRepo *repository.ArchivedTaskRepository
+	cfg         TaskArchiveConfig
+}
+
+// NewTaskArchiver returns an error for a configuration that would archive nothing, or every
+// completed task at once
+func NewTaskArchiver(archiveRepo *repository.ArchivedTaskRepository, cfg TaskArchiveConfig) (*TaskArchiver, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("task archive directory is not set")
+	}
+	if cfg.After <= 0 {
+		return nil, fmt.Errorf("tasks must be archived a positive time after completion, got %s", cfg.After)
+	}
+	if cfg.BatchSize <= 0 || cfg.BatchSize > MaxTaskArchiveBatchSize {
+		return nil, fmt.Errorf("task archive batch size must be between 1 and %d, got %d", MaxTaskArchiveBatchSize, cfg.BatchSize)
+	}
+	return &TaskArchiver{archiveRepo: archiveRepo, cfg: cfg}, nil
+}
+
+// Run archives tasks every TaskArchiveInterval until ctx is cancelled
+func (a *TaskArchiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(TaskArchiveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := a.RunOnce(ctx, now); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Task archiving failed: %v", err)
+			}
+		}
+	}
+}
+
+// RunOnce archives the tasks completed more than the configured time before now. Each batch is
+// written to the archive file before it is removed, so a failed run archives some tasks again
+// on the next run rather than losing them.
+func (a *TaskArchiver) RunOnce(ctx context.Context, now time.Time) error {
+	before := now.Add(-a.cfg.After)
+
+	var archive *taskArchive
+	defer func() {
+		if archive != nil {
+			archive.close()
+		}
+	}()
+
+	archived := 0
+	for {
+		records, err := a.archiveRepo.GetArchivable(ctx, before, a.cfg.BatchSize)
+		if err != nil || len(records) == 0 {
+			a.logArchived(archived, archive)
+			return err
+		}
+
+		if archive == nil {
+			if archive, err = openTaskArchive(a.cfg.Dir, now); err != nil {
+				return err
+			}
+		}
+		if err := archive.write(records); err != nil {
+			return err
+		}
+		if err := a.archiveRepo.Archive(ctx, archive.name, records); err != nil {
+			return err
+		}
+		archived += len(records)
+
+		if len(records) < a.cfg.BatchSize {
+			a.logArchived(archived, archive)
+			return nil
+		}
+	}
+}
+
+// Read finds an archived task in its archive file
+func (a *TaskArchiver) Read(archived *model.ArchivedTask) (*repository.TaskArchiveRecord, error) {
+	file, err := os.Open(filepath.Join(a.cfg.Dir, filepath.Base(archived.Archive)))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	decoder := json.NewDecoder(gz)
+	for {
+		var record repository.TaskArchiveRecord
+		if err := decoder.Decode(&record); err != nil {
+			if err == io.EOF {
+				return nil, ErrArchivedTaskMissing
+			}
+			return nil, err
+		}
+		// Задача, заархивированная повторно после сбоя, записана в новый файл; берется запись из ее файла
+		if record.Task.ID == archived.ID {
+			return &record, nil
+		}
+	}
+}
+
+func (a *TaskArchiver) logArchived(archived int, archive *taskArchive) {
+	if archived > 0 {
+		log.Printf("✅ Archived %d tasks to %s", archived, archive.path)
+	}
+}
+
+// taskArchive is a gzipped NDJSON file of the tasks archived by one run
+type taskArchive struct {
+	name string
+	path string
+	file *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+}
+
+func openTaskArchive(dir string, now time.Time) (*taskArchive, error) {
+	name := fmt.Sprintf("tasks-%s.ndjson.gz", now.UTC().Format("20060102T150405Z"))
+	path := filepath.Join(dir, name)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(file)
+	return &taskArchive{name: name, path: path, file: file, gz: gz, enc: json.NewEncoder(gz)}, nil
+}
+
+// write appends the tasks and flushes them to disk before they are removed from the database
+func (a *taskArchive) write(records []repository.TaskArchiveRecord) error {
+	for i := range records {
+		if err := a.enc.Encode(&records[i]); err != nil {
+			return err
+		}
+	}
+	if err := a.gz.Flush(); err != nil {
+		return err
+	}
+	return a.file.Sync()
+}
+
+func (a *taskArchive) close() {
+	if err := a.gz.Close(); err != nil {
+		log.Printf("⚠️  Failed to finish task archive %s: %v", a.path, err)
+	}
+	if err := a.file.Close(); err != nil {
+		log.Printf("⚠️  Failed to close task archive %s: %v", a.path, err)
+	}
+}
@@ -0,0 +1,31 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTaskArchiver_RejectsConfig(t *testing.T) {
+	valid := TaskArchiveConfig{After: 24 * time.Hour, Dir: t.TempDir(), BatchSize: 100}
+	_, err := NewTaskArchiver(nil, valid)
+	assert.NoError(t, err)
+
+	for _, size := range []int{0, -1, MaxTaskArchiveBatchSize + 1} {
+		cfg := valid
+		cfg.BatchSize = size
+		_, err := NewTaskArchiver(nil, cfg)
+		assert.Error(t, err, size)
+	}
+
+	noDir := valid
+	noDir.Dir = ""
+	_, err = NewTaskArchiver(nil, noDir)
+	assert.Error(t, err)
+
+	immediate := valid
+	immediate.After = 0
+	_, err = NewTaskArchiver(nil, immediate)
+	assert.Error(t, err)
+}
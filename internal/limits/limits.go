@@ -0,0 +1,77 @@
+// Package limits resolves the quotas on how many boards a user owns, how many columns a board
+// has and how many tasks a column holds. The instance configures the defaults and
+// administrators can override them for single users. Columns and tasks count against the
+// limits of the board owner. The defaults can change at runtime through the instance settings.
+package limits
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+)
+
+// Limits are the quotas of a user; zero means unlimited
+type Limits struct {
+	Boards          int `json:"boards"`
+	ColumnsPerBoard int `json:"columns_per_board"`
+	TasksPerColumn  int `json:"tasks_per_column"`
+}
+
+// Override replaces the limits the user has overrides for
+func (l Limits) Override(user *model.User) Limits {
+	if user == nil {
+		return l
+	}
+	if user.MaxBoards != nil {
+		l.Boards = *user.MaxBoards
+	}
+	if user.MaxColumnsPerBoard != nil {
+		l.ColumnsPerBoard = *user.MaxColumnsPerBoard
+	}
+	if user.MaxTasksPerColumn != nil {
+		l.TasksPerColumn = *user.MaxTasksPerColumn
+	}
+	return l
+}
+
+// Reached reports whether count already uses up limit, so nothing more may be added
+func Reached(limit int, count int64) bool {
+	return Exceeds(limit, count+1)
+}
+
+// Exceeds reports whether count is more than limit allows
+func Exceeds(limit int, count int64) bool {
+	return limit > 0 && count > int64(limit)
+}
+
+// Service resolves the limits of users
+type Service struct {
+	defaults func() Limits
+	userRepo *repository.UserRepository
+}
+
+func NewService(defaults Limits, userRepo *repository.UserRepository) *Service {
+	return NewReloadableService(func() Limits { return defaults }, userRepo)
+}
+
+// NewReloadableService creates a service whose defaults are read from defaults on every use
+func NewReloadableService(defaults func() Limits, userRepo *repository.UserRepository) *Service {
+	return &Service{defaults: defaults, userRepo: userRepo}
+}
+
+// Defaults returns the instance limits of users without overrides
+func (s *Service) Defaults() Limits {
+	return s.defaults()
+}
+
+// ForUser returns the limits of a user: the instance defaults with the user's overrides
+func (s *Service) ForUser(ctx context.Context, userID uuid.UUID) (Limits, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return Limits{}, err
+	}
+	return s.defaults().Override(user), nil
+}
@@ -0,0 +1,32 @@
+package limits
+
+import (
+	"testing"
+
+	"kanban/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsOverride(t *testing.T) {
+	defaults := Limits{Boards: 5, ColumnsPerBoard: 20, TasksPerColumn: 0}
+	boards, unlimited := 10, 0
+
+	assert.Equal(t, defaults, defaults.Override(nil))
+	assert.Equal(t, defaults, defaults.Override(&model.User{}))
+
+	// Переопределяются только заданные лимиты, 0 снимает ограничение
+	user := &model.User{MaxBoards: &boards, MaxColumnsPerBoard: &unlimited}
+	assert.Equal(t, Limits{Boards: 10, ColumnsPerBoard: 0, TasksPerColumn: 0}, defaults.Override(user))
+}
+
+func TestReached(t *testing.T) {
+	assert.False(t, Reached(5, 4))
+	assert.True(t, Reached(5, 5))
+	assert.True(t, Reached(5, 7))
+	assert.False(t, Reached(0, 1000))
+
+	assert.False(t, Exceeds(5, 5))
+	assert.True(t, Exceeds(5, 6))
+	assert.False(t, Exceeds(0, 1000))
+}
@@ -0,0 +1,81 @@
+// Package mail sends plain text emails to users.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Message is a plain text email to one recipient
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogMailer writes emails to the server log. It is used when no SMTP server is configured.
+type LogMailer struct{}
+
+func (LogMailer) Send(_ context.Context, msg Message) error {
+	log.Printf("✉️  To %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+// SMTPMailer sends emails through an SMTP server, authenticating with PLAIN auth when a
+// username is set
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+	// sender is the bare address of from, used as the envelope sender
+	sender string
+}
+
+// NewSMTPMailer creates a mailer for the server at addr (host:port) that sends as from, an
+// address with an optional display name such as "Kanban <noreply@example.com>"
+func NewSMTPMailer(addr, username, password, from string) *SMTPMailer {
+	m := &SMTPMailer{addr: addr, from: from, sender: from}
+	if address, err := netmail.ParseAddress(from); err == nil {
+		m.sender = address.Address
+	}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+func (m *SMTPMailer) Send(_ context.Context, msg Message) error {
+	if strings.ContainsAny(msg.To, "\r\n") {
+		return fmt.Errorf("invalid recipient %q", msg.To)
+	}
+	return smtp.SendMail(m.addr, m.auth, m.sender, []string{msg.To}, Format(m.from, msg, time.Now()))
+}
+
+// Format writes the message as a MIME email with UTF-8 subject and body
+func Format(from string, msg Message, date time.Time) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
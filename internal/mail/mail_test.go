@@ -0,0 +1,25 @@
+package mail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	date := time.Date(2024, 4, 10, 7, 0, 0, 0, time.UTC)
+	out := string(Format("Kanban <noreply@example.com>", Message{
+		To:      "anna@example.com",
+		Subject: "Дайджест\nBcc: evil@example.com",
+		Body:    "line one\nline two",
+	}, date))
+
+	assert.Contains(t, out, "From: Kanban <noreply@example.com>\r\n")
+	assert.Contains(t, out, "To: anna@example.com\r\n")
+	assert.Contains(t, out, "Date: Wed, 10 Apr 2024 07:00:00 +0000\r\n")
+	// Тема кодируется, поэтому перевод строки в ней не добавляет заголовков
+	assert.NotContains(t, out, "\r\nBcc:")
+	assert.Contains(t, out, "Subject: =?utf-8?q?")
+	assert.Contains(t, out, "\r\n\r\nline one\r\nline two")
+}
@@ -0,0 +1,190 @@
+// Package markdown renders the markdown of task descriptions as HTML that is safe to insert
+// into pages, so every client shows descriptions the same way.
+//
+// It supports a GitHub-like subset: paragraphs with line breaks kept, headings, block quotes,
+// flat lists, fenced code blocks, horizontal rules, emphasis, strikethrough, code spans, links and
+// bare URLs. Raw HTML is never passed through: all text is escaped and only the renderer's own tags
+// are emitted. Links are kept only for http, https and mailto URLs.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingPattern       = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	unorderedPattern     = regexp.MustCompile(`^\s{0,3}[-*+]\s+(.*)$`)
+	orderedPattern       = regexp.MustCompile(`^\s{0,3}\d{1,9}[.)]\s+(.*)$`)
+	rulePattern          = regexp.MustCompile(`^\s{0,3}([-*_])(\s*[-*_]){2,}\s*$`)
+	linkPattern          = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)|https?://[^\s<>()]+`)
+	strongPattern        = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	emphasisPattern      = regexp.MustCompile(`\*([^*\s][^*]*)\*|(^|[^\w])_([^_\s][^_]*)_([^\w]|$)`)
+	strikethroughPattern = regexp.MustCompile(`~~([^~]+)~~`)
+)
+
+// Render converts markdown to sanitized HTML
+func Render(source string) string {
+	lines := strings.Split(strings.ReplaceAll(source, "\r\n", "\n"), "\n")
+
+	var b strings.Builder
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			i++
+
+		case strings.HasPrefix(trimmed, "```"):
+			i++
+			var code []string
+			for ; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			i++ // закрывающая ограда; незакрытый блок идёт до конца текста
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+
+		case headingPattern.MatchString(trimmed):
+			match := headingPattern.FindStringSubmatch(trimmed)
+			level := len(match[1])
+			fmt.Fprintf(&b, "<h%d>%s</h%d>\n", level, renderInline(match[2]), level)
+			i++
+
+		case rulePattern.MatchString(line):
+			b.WriteString("<hr>\n")
+			i++
+
+		case strings.HasPrefix(trimmed, ">"):
+			var quoted []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				quoted = append(quoted, strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">"), " "))
+			}
+			b.WriteString("<blockquote>\n" + Render(strings.Join(quoted, "\n")) + "</blockquote>\n")
+
+		case unorderedPattern.MatchString(line):
+			i = renderList(&b, lines, i, "ul", unorderedPattern)
+
+		case orderedPattern.MatchString(line):
+			i = renderList(&b, lines, i, "ol", orderedPattern)
+
+		default:
+			var paragraph []string
+			for ; i < len(lines) && !startsBlock(lines[i]); i++ {
+				paragraph = append(paragraph, renderInline(strings.TrimSpace(lines[i])))
+			}
+			b.WriteString("<p>" + strings.Join(paragraph, "<br>\n") + "</p>\n")
+		}
+	}
+	return b.String()
+}
+
+// startsBlock reports whether the line ends a paragraph
+func startsBlock(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return trimmed == "" ||
+		strings.HasPrefix(trimmed, "```") ||
+		strings.HasPrefix(trimmed, ">") ||
+		headingPattern.MatchString(trimmed) ||
+		rulePattern.MatchString(line) ||
+		unorderedPattern.MatchString(line) ||
+		orderedPattern.MatchString(line)
+}
+
+// renderList writes the list items starting at line i and returns the line after the list
+func renderList(b *strings.Builder, lines []string, i int, tag string, item *regexp.Regexp) int {
+	b.WriteString("<" + tag + ">\n")
+	for ; i < len(lines); i++ {
+		match := item.FindStringSubmatch(lines[i])
+		if match == nil {
+			break
+		}
+		b.WriteString("<li>" + renderInline(strings.TrimSpace(match[1])) + "</li>\n")
+	}
+	b.WriteString("</" + tag + ">\n")
+	return i
+}
+
+// renderInline renders code spans, links and emphasis of a line of text
+func renderInline(text string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(text, "`")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(text[start+1:], "`")
+		if end < 0 {
+			break
+		}
+		b.WriteString(renderLinks(text[:start]))
+		b.WriteString("<code>" + html.EscapeString(text[start+1:start+1+end]) + "</code>")
+		text = text[start+1+end+1:]
+	}
+	b.WriteString(renderLinks(text))
+	return b.String()
+}
+
+// renderLinks renders markdown links and bare URLs; text around them gets emphasis
+func renderLinks(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range linkPattern.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(renderEmphasis(text[last:m[0]]))
+		last = m[1]
+
+		if m[2] >= 0 {
+			label, target := text[m[2]:m[3]], text[m[4]:m[5]]
+			if safeURL(target) {
+				b.WriteString(anchor(target, renderEmphasis(label)))
+			} else {
+				b.WriteString(renderEmphasis(label))
+			}
+			continue
+		}
+
+		// Знаки препинания в конце голой ссылки относятся к предложению
+		target := strings.TrimRight(text[m[0]:m[1]], ".,;:!?'\"")
+		last = m[0] + len(target)
+		b.WriteString(anchor(target, html.EscapeString(target)))
+	}
+	b.WriteString(renderEmphasis(text[last:]))
+	return b.String()
+}
+
+// renderEmphasis escapes the text and renders strong, emphasized and struck-through parts
+func renderEmphasis(text string) string {
+	escaped := html.EscapeString(text)
+	escaped = strongPattern.ReplaceAllString(escaped, "<strong>$1$2</strong>")
+	escaped = emphasisPattern.ReplaceAllStringFunc(escaped, func(match string) string {
+		// Подчёркивания внутри слов, как в snake_case, выделением не считаются
+		sub := emphasisPattern.FindStringSubmatch(match)
+		if sub[1] != "" {
+			return "<em>" + sub[1] + "</em>"
+		}
+		return sub[2] + "<em>" + sub[3] + "</em>" + sub[4]
+	})
+	return strikethroughPattern.ReplaceAllString(escaped, "<del>$1</del>")
+}
+
+func anchor(target, label string) string {
+	return `<a href="` + html.EscapeString(target) + `" rel="nofollow noopener noreferrer">` + label + "</a>"
+}
+
+// safeURL reports whether a link target may be rendered as a link
+func safeURL(target string) bool {
+	u, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return u.Host != ""
+	case "mailto":
+		return u.Opaque != ""
+	}
+	return false
+}
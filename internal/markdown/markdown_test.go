@@ -0,0 +1,50 @@
+package markdown_test
+
+import (
+	"testing"
+
+	"kanban/internal/markdown"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	source := "# Release *1.2*\n" +
+		"Ship **today**, see [docs](https://example.com/a?b=1&c=2)\n" +
+		"and `go test ./...` first.\n" +
+		"\n" +
+		"- fix_login_bug\n" +
+		"- ~~old~~ _new_\n" +
+		"\n" +
+		"1. one\n" +
+		"2. two\n" +
+		"\n" +
+		"> quoted\n" +
+		"\n" +
+		"```\n" +
+		"if a < b {\n" +
+		"```\n" +
+		"---\n" +
+		"Mail mailto:nobody or https://example.com/x."
+
+	assert.Equal(t, "<h1>Release <em>1.2</em></h1>\n"+
+		"<p>Ship <strong>today</strong>, see <a href=\"https://example.com/a?b=1&amp;c=2\" rel=\"nofollow noopener noreferrer\">docs</a><br>\n"+
+		"and <code>go test ./...</code> first.</p>\n"+
+		"<ul>\n<li>fix_login_bug</li>\n<li><del>old</del> <em>new</em></li>\n</ul>\n"+
+		"<ol>\n<li>one</li>\n<li>two</li>\n</ol>\n"+
+		"<blockquote>\n<p>quoted</p>\n</blockquote>\n"+
+		"<pre><code>if a &lt; b {</code></pre>\n"+
+		"<hr>\n"+
+		"<p>Mail mailto:nobody or <a href=\"https://example.com/x\" rel=\"nofollow noopener noreferrer\">https://example.com/x</a>.</p>\n",
+		markdown.Render(source))
+}
+
+func TestRender_Sanitizes(t *testing.T) {
+	// HTML в тексте экранируется, опасные ссылки выводятся обычным текстом
+	assert.Equal(t, "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n", markdown.Render("<script>alert(1)</script>"))
+	assert.Equal(t, "<p>click</p>\n", markdown.Render("[click](javascript:void)"))
+	assert.Equal(t, "<p><code>&lt;img src=x onerror=alert(1)&gt;</code></p>\n", markdown.Render("`<img src=x onerror=alert(1)>`"))
+	assert.Equal(t, "<p><a href=\"https://example.com/&#34;onmouseover=&#34;x\" rel=\"nofollow noopener noreferrer\">a</a></p>\n",
+		markdown.Render(`[a](https://example.com/"onmouseover="x)`))
+	assert.Empty(t, markdown.Render(""))
+}
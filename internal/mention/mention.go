@@ -0,0 +1,45 @@
+// Package mention detects user mentions such as "@alice" or "@alice@example.com" in free text.
+package mention
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Mention is a single user mention found in text
+type Mention struct {
+	// Handle is the mention without the leading "@": a username or an email address
+	Handle string
+	// Token is the mention exactly as written
+	Token string
+}
+
+// IsEmail reports whether the mention names a user by email address
+func (m Mention) IsEmail() bool {
+	return strings.Contains(m.Handle, "@")
+}
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}|\w[\w.-]*)`)
+
+// Parse returns the distinct mentions in text in order of first appearance.
+// Mentions are compared case-insensitively.
+func Parse(text string) []Mention {
+	var mentions []Mention
+	seen := make(map[string]struct{})
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		// Точка или дефис в конце относятся к предложению, а не к имени
+		handle := strings.TrimRight(match[1], ".-")
+		if handle == "" {
+			continue
+		}
+
+		key := strings.ToLower(handle)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		mentions = append(mentions, Mention{Handle: handle, Token: "@" + handle})
+	}
+	return mentions
+}
@@ -0,0 +1,28 @@
+package mention_test
+
+import (
+	"testing"
+
+	"kanban/internal/mention"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	mentions := mention.Parse("Ping @alice and @bob@example.com. Thanks, @Alice! (cc @carol.)")
+
+	assert.Equal(t, []mention.Mention{
+		{Handle: "alice", Token: "@alice"},
+		{Handle: "bob@example.com", Token: "@bob@example.com"},
+		{Handle: "carol", Token: "@carol"},
+	}, mentions)
+	assert.True(t, mentions[1].IsEmail())
+	assert.False(t, mentions[0].IsEmail())
+}
+
+func TestParse_IgnoresNonMentions(t *testing.T) {
+	// Адреса почты без @ перед ними и одиночный @ не считаются упоминаниями
+	mentions := mention.Parse("write to dave@example.com or @ later")
+
+	assert.Empty(t, mentions)
+}
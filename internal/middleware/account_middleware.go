@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UserCheck reports whether an authenticated user passes a check, such as having an active account
+type UserCheck func(ctx context.Context, userID uuid.UUID) (bool, error)
+
+// RequireActiveUser rejects the tokens of disabled and deleted accounts. It must run after
+// JWTAuthMiddleware.
+func RequireActiveUser(active UserCheck) gin.HandlerFunc {
+	return requireUser(active, http.StatusUnauthorized, "Account is disabled or no longer exists")
+}
+
+// RequireAdmin restricts routes to instance administrators. It must run after JWTAuthMiddleware.
+func RequireAdmin(admin UserCheck) gin.HandlerFunc {
+	return requireUser(admin, http.StatusForbidden, "Administrator access required")
+}
+
+func requireUser(check UserCheck, status int, message string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, _ := c.Get(UserIDKey)
+		userID, ok := value.(uuid.UUID)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+			return
+		}
+
+		passed, err := check(c.Request.Context(), userID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to check account"})
+			return
+		}
+		if !passed {
+			c.AbortWithStatusJSON(status, gin.H{"error": message})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,57 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAccountRouter(userID *uuid.UUID, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if userID != nil {
+			c.Set(middleware.UserIDKey, *userID)
+		}
+	})
+	r.Use(handler)
+	r.GET("/admin/users", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func serveAccountRequest(r *gin.Engine) int {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/users", nil))
+	return w.Code
+}
+
+func TestRequireAdmin(t *testing.T) {
+	admin, member := uuid.New(), uuid.New()
+	isAdmin := func(_ context.Context, userID uuid.UUID) (bool, error) {
+		return userID == admin, nil
+	}
+
+	assert.Equal(t, http.StatusOK, serveAccountRequest(newAccountRouter(&admin, middleware.RequireAdmin(isAdmin))))
+	assert.Equal(t, http.StatusForbidden, serveAccountRequest(newAccountRouter(&member, middleware.RequireAdmin(isAdmin))))
+	assert.Equal(t, http.StatusUnauthorized, serveAccountRequest(newAccountRouter(nil, middleware.RequireAdmin(isAdmin))))
+}
+
+func TestRequireActiveUser(t *testing.T) {
+	userID := uuid.New()
+	inactive := func(context.Context, uuid.UUID) (bool, error) { return false, nil }
+	failing := func(context.Context, uuid.UUID) (bool, error) { return false, errors.New("db down") }
+
+	// Токен отключённого пользователя больше не действует
+	assert.Equal(t, http.StatusUnauthorized, serveAccountRequest(newAccountRouter(&userID, middleware.RequireActiveUser(inactive))))
+	assert.Equal(t, http.StatusInternalServerError, serveAccountRequest(newAccountRouter(&userID, middleware.RequireActiveUser(failing))))
+}
@@ -12,6 +12,8 @@ import (
 
 const (
 	UserIDKey = "user_id"
+	// GuestKey is set for the tokens of guest link sessions
+	GuestKey = "guest"
 )
 
 func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
@@ -59,6 +61,9 @@ func JWTAuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			}
 
 			c.Set(UserIDKey, userID)
+			if guest, _ := claims["guest"].(bool); guest {
+				c.Set(GuestKey, true)
+			}
 			c.Next()
 		} else {
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
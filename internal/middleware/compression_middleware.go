@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// CompressionConfig describes which responses are compressed
+type CompressionConfig struct {
+	// MinSize is the smallest body worth compressing, in bytes
+	MinSize int
+	// ContentTypes are the media types compressed, like "application/json"
+	ContentTypes []string
+}
+
+var (
+	gzipWriters   = sync.Pool{New: func() interface{} { return gzip.NewWriter(io.Discard) }}
+	brotliWriters = sync.Pool{New: func() interface{} { return brotli.NewWriterLevel(io.Discard, 4) }}
+)
+
+// Compress compresses responses with brotli or gzip, whichever the client prefers in its
+// Accept-Encoding header. Bodies are buffered up to cfg.MinSize to decide; smaller ones and
+// other content types are sent as they are.
+func Compress(cfg CompressionConfig) gin.HandlerFunc {
+	types := make(map[string]bool, len(cfg.ContentTypes))
+	for _, contentType := range cfg.ContentTypes {
+		types[strings.ToLower(contentType)] = true
+	}
+
+	return func(c *gin.Context) {
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+
+		w := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, minSize: cfg.MinSize, types: types}
+		c.Writer = w
+		defer w.close()
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks "br" or "gzip" from an Accept-Encoding header by quality, preferring
+// brotli on a tie, or returns "" when the client accepts neither
+func negotiateEncoding(header string) string {
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		qualities[strings.ToLower(strings.TrimSpace(name))] = q
+	}
+
+	best, bestQ := "", 0.0
+	for _, name := range []string{"br", "gzip"} {
+		q, listed := qualities[name]
+		// "*" covers the encodings not listed by name
+		if !listed {
+			q = qualities["*"]
+		}
+		if q > bestQ {
+			best, bestQ = name, q
+		}
+	}
+	return best
+}
+
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding string
+	minSize  int
+	types    map[string]bool
+
+	buf     bytes.Buffer
+	decided bool
+	encoder io.WriteCloser
+}
+
+// decide chooses once, before the headers are sent, whether the body is compressed, and sends
+// the headers and what was buffered so far
+func (w *compressWriter) decide(final bool) error {
+	if w.decided {
+		return nil
+	}
+	w.decided = true
+
+	header := w.ResponseWriter.Header()
+	mediaType, _, _ := strings.Cut(header.Get("Content-Type"), ";")
+	compressible := w.types[strings.ToLower(strings.TrimSpace(mediaType))]
+	if compressible {
+		header.Add("Vary", "Accept-Encoding")
+	}
+	status := w.ResponseWriter.Status()
+	if compressible && header.Get("Content-Encoding") == "" &&
+		status != http.StatusNoContent && status != http.StatusNotModified &&
+		!(final && w.buf.Len() < w.minSize) {
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+		w.encoder = w.newEncoder()
+	}
+
+	w.ResponseWriter.WriteHeaderNow()
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	_, err := w.writeBody(w.buf.Bytes())
+	w.buf.Reset()
+	return err
+}
+
+func (w *compressWriter) newEncoder() io.WriteCloser {
+	if w.encoding == "br" {
+		encoder := brotliWriters.Get().(*brotli.Writer)
+		encoder.Reset(w.ResponseWriter)
+		return encoder
+	}
+	encoder := gzipWriters.Get().(*gzip.Writer)
+	encoder.Reset(w.ResponseWriter)
+	return encoder
+}
+
+func (w *compressWriter) writeBody(data []byte) (int, error) {
+	if w.encoder != nil {
+		return w.encoder.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.decided {
+		w.buf.Write(data)
+		if w.buf.Len() < w.minSize {
+			return len(data), nil
+		}
+		return len(data), w.decide(false)
+	}
+	return w.writeBody(data)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow is deferred until the body shows whether it is compressed
+func (w *compressWriter) WriteHeaderNow() {}
+
+func (w *compressWriter) Written() bool {
+	return w.decided || w.buf.Len() > 0 || w.ResponseWriter.Written()
+}
+
+func (w *compressWriter) Flush() {
+	_ = w.decide(false)
+	if flusher, ok := w.encoder.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// close sends what is still buffered and ends the compressed stream
+func (w *compressWriter) close() {
+	_ = w.decide(true)
+	if w.encoder == nil {
+		return
+	}
+	_ = w.encoder.Close()
+	switch encoder := w.encoder.(type) {
+	case *gzip.Writer:
+		gzipWriters.Put(encoder)
+	case *brotli.Writer:
+		brotliWriters.Put(encoder)
+	}
+}
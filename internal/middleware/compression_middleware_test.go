@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	assert.Equal(t, "br", negotiateEncoding("gzip, deflate, br"))
+	assert.Equal(t, "gzip", negotiateEncoding("gzip;q=1.0, br;q=0.5"))
+	assert.Equal(t, "gzip", negotiateEncoding("br;q=0, *"))
+	assert.Equal(t, "br", negotiateEncoding("*"))
+	assert.Equal(t, "", negotiateEncoding("identity, deflate"))
+	assert.Equal(t, "", negotiateEncoding(""))
+}
+
+func TestCompress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Compress(CompressionConfig{MinSize: 64, ContentTypes: []string{"application/json"}}))
+	large := strings.Repeat("a", 1000)
+	r.GET("/boards", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"title": large})
+	})
+	r.GET("/small", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+	r.GET("/export", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/zip", []byte(large))
+	})
+
+	get := func(path, acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/boards", "gzip")
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	reader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title": "`+large+`"}`, string(body))
+
+	w = get("/boards", "gzip, br")
+	assert.Equal(t, "br", w.Header().Get("Content-Encoding"))
+	body, err = io.ReadAll(brotli.NewReader(w.Body))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title": "`+large+`"}`, string(body))
+
+	// Маленькие ответы и другие типы отправляются как есть
+	w = get("/small", "gzip")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.JSONEq(t, `{"ok": true}`, w.Body.String())
+	w = get("/export", "gzip")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, large, w.Body.String())
+	w = get("/boards", "")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.JSONEq(t, `{"title": "`+large+`"}`, w.Body.String())
+}
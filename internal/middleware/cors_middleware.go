@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CORSConfig describes which cross-origin requests are allowed
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedMethods   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	MaxAge           int
+}
+
+// Validate rejects allowing credentials together with the "*" origin, which would let any site
+// make credentialed requests on behalf of signed in users
+func (cfg CORSConfig) Validate() error {
+	if !cfg.AllowCredentials {
+		return nil
+	}
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			return errors.New("CORS credentials can't be allowed for every origin; list the origins instead of *")
+		}
+	}
+	return nil
+}
+
+// CORSMiddleware answers preflight requests and adds CORS headers for allowed origins.
+// An origin of "*" allows any origin, always without credentials: only origins listed by name
+// get them.
+func CORSMiddleware(cfg CORSConfig) gin.HandlerFunc {
+	allowAll := false
+	allowed := make(map[string]struct{}, len(cfg.AllowedOrigins))
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			allowAll = true
+		}
+		allowed[strings.TrimRight(origin, "/")] = struct{}{}
+	}
+
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		_, listed := allowed[origin]
+		if !listed && !allowAll {
+			if c.Request.Method == http.MethodOptions {
+				c.AbortWithStatus(http.StatusForbidden)
+				return
+			}
+			c.Next()
+			return
+		}
+
+		// Origin из "*" не получает credentials, даже если Validate не вызывали
+		credentials := cfg.AllowCredentials && listed && origin != "*"
+		if allowAll && !credentials {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Vary", "Origin")
+		}
+		if credentials {
+			c.Header("Access-Control-Allow-Credentials", "true")
+		}
+
+		if c.Request.Method == http.MethodOptions {
+			c.Header("Access-Control-Allow-Methods", methods)
+			c.Header("Access-Control-Allow-Headers", headers)
+			if cfg.MaxAge > 0 {
+				c.Header("Access-Control-Max-Age", strconv.Itoa(cfg.MaxAge))
+			}
+			c.AbortWithStatus(http.StatusNoContent)
+			return
+		}
+
+		c.Next()
+	}
+}
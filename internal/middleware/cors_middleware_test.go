@@ -0,0 +1,110 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCORSRouter(cfg middleware.CORSConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.CORSMiddleware(cfg))
+	r.GET("/boards", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func TestCORSMiddleware_AllowedOrigin(t *testing.T) {
+	r := newCORSRouter(middleware.CORSConfig{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowCredentials: true,
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/boards", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestCORSMiddleware_DisallowedOrigin(t *testing.T) {
+	r := newCORSRouter(middleware.CORSConfig{
+		AllowedOrigins: []string{"https://app.example.com"},
+	})
+
+	// Обычный запрос проходит, но без CORS-заголовков
+	req := httptest.NewRequest(http.MethodGet, "/boards", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	// Preflight отклоняется
+	req = httptest.NewRequest(http.MethodOptions, "/boards", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestCORSMiddleware_Preflight(t *testing.T) {
+	r := newCORSRouter(middleware.CORSConfig{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		MaxAge:         600,
+	})
+
+	req := httptest.NewRequest(http.MethodOptions, "/boards", nil)
+	req.Header.Set("Origin", "https://any.example.com")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, POST", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "Authorization, Content-Type", w.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+}
+
+func TestCORSMiddleware_WildcardWithoutCredentials(t *testing.T) {
+	cfg := middleware.CORSConfig{
+		AllowedOrigins:   []string{"https://app.example.com", "*"},
+		AllowCredentials: true,
+	}
+	assert.Error(t, cfg.Validate())
+	assert.NoError(t, middleware.CORSConfig{AllowedOrigins: []string{"*"}}.Validate())
+	assert.NoError(t, middleware.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}, AllowCredentials: true}.Validate())
+
+	// Даже без Validate произвольный сайт не получает credentials
+	r := newCORSRouter(cfg)
+	req := httptest.NewRequest(http.MethodGet, "/boards", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Credentials"))
+
+	// Перечисленный origin получает их как раньше
+	req = httptest.NewRequest(http.MethodGet, "/boards", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+}
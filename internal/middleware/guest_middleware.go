@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+
+	"kanban/internal/apiversion"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RestrictGuests limits guest link sessions to the routes in allowed, given as method and route
+// pattern like "GET /tasks/:id". Other requests pass unchanged. It must run after JWTAuthMiddleware.
+func RestrictGuests(allowed []string) gin.HandlerFunc {
+	routes := make(map[string]bool, len(allowed))
+	for _, route := range allowed {
+		routes[route] = true
+	}
+
+	return func(c *gin.Context) {
+		if c.GetBool(GuestKey) && !routes[c.Request.Method+" "+apiversion.Route(c)] {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Guests cannot use this endpoint"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,45 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGuestRouter(guest bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		if guest {
+			c.Set(middleware.GuestKey, true)
+		}
+	})
+	r.Use(middleware.RestrictGuests([]string{"GET /tasks/:id"}))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	r.GET("/tasks/:id", ok)
+	r.DELETE("/tasks/:id", ok)
+	r.POST("/boards", ok)
+	return r
+}
+
+func serveGuestRequest(r *gin.Engine, method, path string) int {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+	return w.Code
+}
+
+func TestRestrictGuests(t *testing.T) {
+	guest := newGuestRouter(true)
+	assert.Equal(t, http.StatusOK, serveGuestRequest(guest, http.MethodGet, "/tasks/42"))
+	// Маршрут разрешается вместе с методом
+	assert.Equal(t, http.StatusForbidden, serveGuestRequest(guest, http.MethodDelete, "/tasks/42"))
+	assert.Equal(t, http.StatusForbidden, serveGuestRequest(guest, http.MethodPost, "/boards"))
+
+	member := newGuestRouter(false)
+	assert.Equal(t, http.StatusOK, serveGuestRequest(member, http.MethodPost, "/boards"))
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+
+	"kanban/internal/monitor"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MonitorResponses counts every response for the anomaly monitor
+func MonitorResponses(counters *monitor.Counters) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		counters.RecordResponse(c.Writer.Status())
+	}
+}
+
+// CountLoginFailures counts rejected credentials on a login route
+func CountLoginFailures(counters *monitor.Counters) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if c.Writer.Status() == http.StatusUnauthorized {
+			counters.RecordLoginFailure()
+		}
+	}
+}
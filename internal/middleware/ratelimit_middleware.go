@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	"kanban/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RateLimitByIP limits requests per client IP address
+func RateLimitByIP(limiter ratelimit.Limiter) gin.HandlerFunc {
+	return rateLimit(limiter, func(c *gin.Context) string {
+		return "ip:" + c.ClientIP()
+	}, tooManyRequests)
+}
+
+// RateLimitByUser limits requests per authenticated user, falling back to the client IP.
+// It must run after JWTAuthMiddleware.
+func RateLimitByUser(limiter ratelimit.Limiter) gin.HandlerFunc {
+	return rateLimit(limiter, userKey, tooManyRequests)
+}
+
+// RateLimitOperation applies a separate, tighter per-user limit to an expensive operation so it
+// cannot starve interactive traffic. The 429 payload names the operation and its current limit.
+// It must run after JWTAuthMiddleware.
+func RateLimitOperation(limiter ratelimit.Limiter, operation string, currentLimit func() ratelimit.Limit) gin.HandlerFunc {
+	return rateLimit(limiter, userKey, func(c *gin.Context, retryAfter int) {
+		limit := currentLimit()
+		response := gin.H{
+			"error":       "Too many " + operation + " requests, please try again later",
+			"operation":   operation,
+			"retry_after": retryAfter,
+			"burst":       limit.Burst,
+		}
+		if limit.PerMinute > 0 {
+			response["per_minute"] = limit.PerMinute
+		} else {
+			response["per_hour"] = limit.PerHour
+		}
+		c.JSON(http.StatusTooManyRequests, response)
+	})
+}
+
+func userKey(c *gin.Context) string {
+	if userID, ok := c.Get(UserIDKey); ok {
+		if id, ok := userID.(uuid.UUID); ok {
+			return "user:" + id.String()
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func tooManyRequests(c *gin.Context, retryAfter int) {
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": "Too many requests, please try again later"})
+}
+
+func rateLimit(limiter ratelimit.Limiter, keyFunc func(c *gin.Context) string, deny func(c *gin.Context, retryAfter int)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, retryAfter, err := limiter.Allow(c.Request.Context(), keyFunc(c))
+		if err != nil {
+			// Не блокируем запросы, если хранилище лимитов недоступно
+			log.Printf("⚠️  Rate limiter unavailable: %v", err)
+			c.Next()
+			return
+		}
+
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			deny(c, seconds)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,82 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/middleware"
+	"kanban/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitOperation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limit := ratelimit.Limit{PerHour: 2, Burst: 1}
+	userID := uuid.New()
+
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(middleware.UserIDKey, userID)
+	})
+	r.POST("/me/export", middleware.RateLimitOperation(ratelimit.NewMemoryLimiter(limit), "export", func() ratelimit.Limit {
+		return limit
+	}), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/me/export", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// Второй запрос упирается в лимит: ответ описывает операцию и лимит
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/me/export", nil))
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1800", w.Header().Get("Retry-After"))
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "export", body["operation"])
+	assert.Equal(t, float64(1800), body["retry_after"])
+	assert.Equal(t, float64(2), body["per_hour"])
+	assert.Equal(t, float64(1), body["burst"])
+}
+
+func TestRateLimitByIP_TrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(trustedProxies []string) *gin.Engine {
+		r := gin.New()
+		require.NoError(t, r.SetTrustedProxies(trustedProxies))
+		limiter := ratelimit.NewMemoryLimiter(ratelimit.Limit{PerMinute: 1, Burst: 1})
+		r.POST("/auth/login", middleware.RateLimitByIP(limiter), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return r
+	}
+	login := func(r *gin.Engine, remoteAddr, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// Без доверенных прокси подменённый заголовок не дает нового лимита
+	r := newRouter(nil)
+	assert.Equal(t, http.StatusOK, login(r, "203.0.113.7:5000", "198.51.100.1"))
+	assert.Equal(t, http.StatusTooManyRequests, login(r, "203.0.113.7:5000", "198.51.100.2"))
+
+	// За доверенным прокси клиенты различаются по X-Forwarded-For
+	r = newRouter([]string{"10.0.0.0/8"})
+	assert.Equal(t, http.StatusOK, login(r, "10.0.0.5:5000", "198.51.100.1"))
+	assert.Equal(t, http.StatusOK, login(r, "10.0.0.5:5000", "198.51.100.2"))
+	assert.Equal(t, http.StatusTooManyRequests, login(r, "10.0.0.5:5000", "198.51.100.1"))
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"runtime/debug"
+
+	"kanban/internal/errorreport"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Recover turns panics of handlers into 500 responses with the usual error body, and reports them
+// to reporter along with the responses that failed with a server error
+func Recover(reporter errorreport.Reporter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			// net/http aborts the response on this panic by design
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			report := newReport(c, http.StatusInternalServerError)
+			report.Panic = recovered
+			report.Stack = debug.Stack()
+			reporter.Report(c.Request.Context(), report)
+
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+		}()
+
+		c.Next()
+
+		if status := c.Writer.Status(); status >= http.StatusInternalServerError {
+			reporter.Report(c.Request.Context(), newReport(c, status))
+		}
+	}
+}
+
+func newReport(c *gin.Context, status int) errorreport.Report {
+	report := errorreport.Report{
+		Request:   c.Request,
+		Route:     c.FullPath(),
+		Status:    status,
+		RequestID: c.GetString(RequestIDKey),
+	}
+	if report.Route == "" {
+		report.Route = c.Request.URL.Path
+	}
+	if userID, ok := c.Get(UserIDKey); ok {
+		report.UserID = userID.(uuid.UUID).String()
+	}
+	for _, err := range c.Errors {
+		report.Err = errors.Join(report.Err, err)
+	}
+	return report
+}
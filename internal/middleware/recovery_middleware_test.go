@@ -0,0 +1,62 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/errorreport"
+	"kanban/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingReporter struct {
+	reports []errorreport.Report
+}
+
+func (r *recordingReporter) Report(_ context.Context, report errorreport.Report) {
+	r.reports = append(r.reports, report)
+}
+
+func TestRecover(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	reporter := &recordingReporter{}
+	r := gin.New()
+	r.Use(middleware.RequestID(), middleware.Recover(reporter))
+	r.GET("/boards/:id", func(c *gin.Context) {
+		panic("nil board")
+	})
+	r.GET("/columns/:id", func(c *gin.Context) {
+		_ = c.Error(errors.New("connection refused"))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to fetch column"})
+	})
+	r.GET("/tasks/:id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/boards/42", nil)
+	req.Header.Set("X-Request-ID", "edge-42")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error": "Internal server error"}`, w.Body.String())
+
+	require.Len(t, reporter.reports, 1)
+	report := reporter.reports[0]
+	assert.Equal(t, "nil board", report.Panic)
+	assert.NotEmpty(t, report.Stack)
+	assert.Equal(t, "/boards/:id", report.Route)
+	assert.Equal(t, "edge-42", report.RequestID)
+	assert.Equal(t, "panic in GET /boards/:id: nil board", report.Message())
+
+	// Ответы с ошибкой сервера тоже отправляются, клиентские ошибки — нет
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/columns/7", nil))
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/tasks/7", nil))
+	require.Len(t, reporter.reports, 2)
+	assert.Equal(t, "GET /columns/:id returned 503: connection refused", reporter.reports[1].Message())
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"kanban/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDKey is the gin context key of the request ID
+const RequestIDKey = "request_id"
+
+// RequestID gives every request an ID: the one supplied in the X-Request-ID header if it is
+// valid, or a new one. The ID is returned in the response header and carried by the request
+// context, so background jobs queued by the request log it too.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.Header)
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		c.Set(RequestIDKey, id)
+		c.Request = c.Request.WithContext(requestid.WithID(c.Request.Context(), id))
+		c.Header(requestid.Header, id)
+		c.Next()
+	}
+}
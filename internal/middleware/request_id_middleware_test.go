@@ -0,0 +1,46 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/middleware"
+	"kanban/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRequestIDRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.RequestID())
+	r.GET("/boards", func(c *gin.Context) {
+		c.String(http.StatusOK, requestid.FromContext(c.Request.Context()))
+	})
+	return r
+}
+
+func TestRequestID_Supplied(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/boards", nil)
+	req.Header.Set(requestid.Header, "edge-42")
+	w := httptest.NewRecorder()
+	newRequestIDRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, "edge-42", w.Header().Get(requestid.Header))
+	assert.Equal(t, "edge-42", w.Body.String())
+}
+
+func TestRequestID_Generated(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/boards", nil)
+	// Недопустимый ID заменяется новым
+	req.Header.Set(requestid.Header, "bad id")
+	w := httptest.NewRecorder()
+	newRequestIDRouter().ServeHTTP(w, req)
+
+	id := w.Header().Get(requestid.Header)
+	assert.NotEqual(t, "bad id", id)
+	assert.True(t, requestid.Valid(id))
+	assert.Equal(t, id, w.Body.String())
+}
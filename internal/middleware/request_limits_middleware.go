@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"kanban/internal/apiversion"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Timeout gives every request a deadline: timeout, or the one in routes for its method and route
+// pattern like "POST /me/export". The deadline is carried by the request context, so repository
+// queries still running are cancelled, and also bounds reading the request body. Responses of
+// requests that missed it are replaced with 408. A timeout of 0 leaves requests unbounded.
+func Timeout(timeout time.Duration, routes map[string]time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := timeout
+		if routeLimit, ok := routes[c.Request.Method+" "+apiversion.Route(c)]; ok {
+			limit = routeLimit
+		}
+		if limit <= 0 {
+			c.Next()
+			return
+		}
+
+		deadline := time.Now().Add(limit)
+		ctx, cancel := context.WithDeadline(c.Request.Context(), deadline)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+		// Медленный клиент не держит обработчик дольше срока; не все writer'ы это умеют
+		_ = http.NewResponseController(c.Writer).SetReadDeadline(deadline)
+
+		guardResponse(c, http.StatusRequestTimeout, "Request timed out", func() bool {
+			return !time.Now().Before(deadline)
+		})
+	}
+}
+
+// LimitBody rejects request bodies larger than limit, or the limit in routes for the method and
+// route pattern, with 413. Bodies with a declared length are rejected before the handler runs;
+// others when the handler reads past the limit.
+func LimitBody(limit int64, routes map[string]int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Body == nil {
+			c.Next()
+			return
+		}
+		maxBytes := limit
+		if routeLimit, ok := routes[c.Request.Method+" "+apiversion.Route(c)]; ok {
+			maxBytes = routeLimit
+		}
+		message := fmt.Sprintf("Request body exceeds %d bytes", maxBytes)
+
+		if c.Request.ContentLength > maxBytes {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{"error": message})
+			return
+		}
+		body := &limitedBody{ReadCloser: http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes)}
+		c.Request.Body = body
+
+		guardResponse(c, http.StatusRequestEntityTooLarge, message, func() bool {
+			return body.exceeded
+		})
+	}
+}
+
+// limitedBody remembers that the handler read past the limit, whatever it made of the error
+type limitedBody struct {
+	io.ReadCloser
+	exceeded bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		b.exceeded = true
+	}
+	return n, err
+}
+
+// guardResponse runs the rest of the chain and replaces its response with status and message
+// when failed reports true by the time the response is written. Responses that already have
+// status are kept, since the handler reported the failure itself.
+func guardResponse(c *gin.Context, status int, message string, failed func() bool) {
+	w := &guardedWriter{ResponseWriter: c.Writer, status: status, message: message, failed: failed}
+	c.Writer = w
+	c.Next()
+	if !w.Written() {
+		w.WriteHeaderNow()
+	}
+}
+
+type guardedWriter struct {
+	gin.ResponseWriter
+	status   int
+	message  string
+	failed   func() bool
+	checked  bool
+	rejected bool
+}
+
+// check decides once, before anything is sent, whether the response is replaced
+func (w *guardedWriter) check() {
+	if w.checked {
+		return
+	}
+	w.checked = true
+	if w.ResponseWriter.Status() == w.status || !w.failed() {
+		return
+	}
+
+	w.rejected = true
+	header := w.ResponseWriter.Header()
+	header.Del("Content-Length")
+	header.Del("Content-Disposition")
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	w.ResponseWriter.WriteHeader(w.status)
+	body, _ := json.Marshal(gin.H{"error": w.message})
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+func (w *guardedWriter) WriteHeaderNow() {
+	w.check()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *guardedWriter) Write(data []byte) (int, error) {
+	if w.check(); w.rejected {
+		return len(data), nil
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *guardedWriter) WriteString(s string) (int, error) {
+	if w.check(); w.rejected {
+		return len(s), nil
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *guardedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
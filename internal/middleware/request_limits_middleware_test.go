@@ -0,0 +1,85 @@
+package middleware_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"kanban/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.Timeout(20*time.Millisecond, map[string]time.Duration{"GET /exports": time.Second, "GET /streams": 0}))
+	slow := func(c *gin.Context) {
+		select {
+		case <-c.Request.Context().Done():
+			// Как репозиторий, получивший отменённый контекст
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch boards"})
+		case <-time.After(50 * time.Millisecond):
+			c.JSON(http.StatusOK, gin.H{"ok": true})
+		}
+	}
+	r.GET("/boards", slow)
+	r.GET("/exports", slow)
+	r.GET("/streams", slow)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/boards", nil))
+	assert.Equal(t, http.StatusRequestTimeout, w.Code)
+	assert.JSONEq(t, `{"error": "Request timed out"}`, w.Body.String())
+
+	// Маршрут со своим сроком успевает
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/exports", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// Нулевой срок снимает ограничение
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/streams", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestLimitBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.LimitBody(8, map[string]int64{"POST /imports": 64}))
+	echo := func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request"})
+			return
+		}
+		c.String(http.StatusOK, string(body))
+	}
+	r.POST("/tasks", echo)
+	r.POST("/imports", echo)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader("12345678")))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader("123456789")))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.JSONEq(t, `{"error": "Request body exceeds 8 bytes"}`, w.Body.String())
+
+	// Без заявленной длины тело отклоняется, когда обработчик читает лишнее
+	req := httptest.NewRequest(http.MethodPost, "/tasks", strings.NewReader("123456789"))
+	req.ContentLength = -1
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.JSONEq(t, `{"error": "Request body exceeds 8 bytes"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/imports", strings.NewReader("123456789")))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,50 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kanban/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTrace(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(middleware.Trace(provider.Tracer("test")))
+	r.GET("/boards/:id", func(c *gin.Context) {
+		// Обработчик видит span запроса в контексте
+		assert.True(t, trace.SpanContextFromContext(c.Request.Context()).IsValid())
+		c.Status(http.StatusInternalServerError)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/boards/42", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /boards/:id", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	// Трасса продолжает трассу вызывающего
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", http.StatusInternalServerError))
+	assert.Equal(t, codes.Error, span.Status().Code)
+}
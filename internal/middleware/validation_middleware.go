@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"kanban/internal/apiversion"
+	"kanban/internal/openapi"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxValidatedBodyBytes is the largest body checked against the API document; larger bodies,
+// such as import parts, are left to the handlers' own limits and checks
+const MaxValidatedBodyBytes = 1 << 20
+
+// ValidateRequest rejects requests whose parameters or JSON body don't match the API document
+// with 400. Routes missing from the document are let through.
+func ValidateRequest(spec *openapi.Spec) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op := spec.Operation(c.Request.Method, apiversion.Route(c))
+		if op == nil {
+			c.Next()
+			return
+		}
+
+		req := openapi.Request{
+			PathParams: make(map[string]string, len(c.Params)),
+			Query:      c.Request.URL.Query(),
+		}
+		for _, param := range c.Params {
+			req.PathParams[param.Key] = param.Value
+		}
+
+		// Прочитанное тело возвращается в запрос, чтобы обработчик прочитал его снова
+		if op.HasBody() && isJSON(c.ContentType()) && c.Request.Body != nil {
+			body, err := io.ReadAll(io.LimitReader(c.Request.Body, MaxValidatedBodyBytes+1))
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+				return
+			}
+			if len(body) > MaxValidatedBodyBytes {
+				c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(body), c.Request.Body), c.Request.Body}
+				c.Next()
+				return
+			}
+			c.Request.Body = readCloser{bytes.NewReader(body), c.Request.Body}
+			req.Body = body
+		}
+
+		if err := spec.Validate(op, req); err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Invalid request: " + err.Error()})
+			return
+		}
+		c.Next()
+	}
+}
+
+// readCloser reads the body from a reader while closing the original body
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// isJSON reports whether a request with the content type carries a JSON body; requests
+// without one are read as JSON by the handlers too
+func isJSON(contentType string) bool {
+	return contentType == "" || contentType == "application/json" || strings.HasSuffix(contentType, "+json")
+}
@@ -0,0 +1,115 @@
+package migration
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	"kanban/internal/database"
+	"kanban/migrations"
+)
+
+// New builds a migrator over the embedded SQL migrations for a database of the given driver.
+// SQLite databases get their own migrations, which start from the schema of version 45.
+func New(db *sql.DB, driver string) (*migrate.Migrate, error) {
+	if driver == database.DriverSQLite {
+		source, err := iofs.New(migrations.SQLite, "sqlite")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load embedded migrations: %w", err)
+		}
+		sqliteDriver, err := newSQLiteDriver(db)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create migration driver: %w", err)
+		}
+		return migrate.NewWithInstance("iofs", source, "sqlite", sqliteDriver)
+	}
+
+	source, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedded migrations: %w", err)
+	}
+
+	pgxDriver, err := pgx.WithInstance(db, &pgx.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration driver: %w", err)
+	}
+
+	return migrate.NewWithInstance("iofs", source, "pgx5", pgxDriver)
+}
+
+// Up applies all pending migrations. Having nothing to apply is not an error.
+func Up(db *sql.DB, driver string) error {
+	m, err := New(db, driver)
+	if err != nil {
+		return err
+	}
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return logVersion(m)
+}
+
+// Run executes a migrate subcommand: up, down [N], version or force V
+func Run(db *sql.DB, driver string, args []string) error {
+	m, err := New(db, driver)
+	if err != nil {
+		return err
+	}
+
+	command := "up"
+	if len(args) > 0 {
+		command = args[0]
+	}
+
+	switch command {
+	case "up":
+		err = m.Up()
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps: %s", args[1])
+			}
+		}
+		err = m.Steps(-steps)
+	case "version":
+		return logVersion(m)
+	case "force":
+		if len(args) < 2 {
+			return errors.New("force requires a version")
+		}
+		version, convErr := strconv.Atoi(args[1])
+		if convErr != nil {
+			return fmt.Errorf("invalid version: %s", args[1])
+		}
+		err = m.Force(version)
+	default:
+		return fmt.Errorf("unknown migrate command %q (expected up, down, version or force)", command)
+	}
+
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return logVersion(m)
+}
+
+func logVersion(m *migrate.Migrate) error {
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		log.Println("📦 Database schema has no migrations applied")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("📦 Database schema at version %d (dirty: %t)\n", version, dirty)
+	return nil
+}
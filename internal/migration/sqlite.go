@@ -0,0 +1,142 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"github.com/golang-migrate/migrate/v4/database"
+)
+
+// sqliteDriver applies migrations to a SQLite database over an open connection pool. The SQLite
+// drivers of golang-migrate each link their own SQLite library; this one works with whichever
+// library the server is built with.
+type sqliteDriver struct {
+	db     *sql.DB
+	locked atomic.Bool
+}
+
+func newSQLiteDriver(db *sql.DB) (*sqliteDriver, error) {
+	_, err := db.Exec("CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)")
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteDriver{db: db}, nil
+}
+
+func (d *sqliteDriver) Open(string) (database.Driver, error) {
+	return nil, errors.New("the SQLite migration driver only works with an open database")
+}
+
+// Close leaves the connection pool open, it belongs to the caller
+func (d *sqliteDriver) Close() error {
+	return nil
+}
+
+// Lock only guards against concurrent migrations of this process. Migrations of other processes
+// wait for the write lock SQLite takes on the whole database.
+func (d *sqliteDriver) Lock() error {
+	if !d.locked.CompareAndSwap(false, true) {
+		return database.ErrLocked
+	}
+	return nil
+}
+
+func (d *sqliteDriver) Unlock() error {
+	if !d.locked.CompareAndSwap(true, false) {
+		return database.ErrNotLocked
+	}
+	return nil
+}
+
+// Run applies a migration in a transaction, so a failed one leaves no partial schema behind
+func (d *sqliteDriver) Run(migration io.Reader) error {
+	query, err := io.ReadAll(migration)
+	if err != nil {
+		return err
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(string(query)); err != nil {
+		tx.Rollback()
+		return database.Error{OrigErr: err, Err: "migration failed", Query: query}
+	}
+	return tx.Commit()
+}
+
+func (d *sqliteDriver) SetVersion(version int, dirty bool) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM schema_migrations"); err != nil {
+		return err
+	}
+	// Как и драйверы golang-migrate, сохраняем неудачный откат первой миграции
+	if version >= 0 || (version == database.NilVersion && dirty) {
+		if _, err := tx.Exec("INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)", version, dirty); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *sqliteDriver) Version() (int, bool, error) {
+	var (
+		version int
+		dirty   bool
+	)
+	err := d.db.QueryRow("SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return database.NilVersion, false, nil
+	}
+	return version, dirty, err
+}
+
+// Drop deletes every table of the database, foreign keys aside
+func (d *sqliteDriver) Drop() error {
+	rows, err := d.db.Query("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return err
+	}
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			rows.Close()
+			return err
+		}
+		tables = append(tables, table)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Внешние ключи отключаются на время удаления только для одного соединения
+	ctx := context.Background()
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+		return err
+	}
+	defer conn.ExecContext(ctx, "PRAGMA foreign_keys = ON")
+
+	for _, table := range tables {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP TABLE %q", table)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
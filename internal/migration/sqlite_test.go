@@ -0,0 +1,52 @@
+package migration_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"kanban/internal/database"
+	"kanban/internal/migration"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestSQLiteMigrations(t *testing.T) {
+	dialector, err := database.Open(database.DriverSQLite, filepath.Join(t.TempDir(), "kanban.db"))
+	require.NoError(t, err)
+	db, err := gorm.Open(dialector, &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	tables := func() []string {
+		var names []string
+		require.NoError(t, db.Raw("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' "+
+			"AND name <> 'schema_migrations' ORDER BY name").Scan(&names).Error)
+		return names
+	}
+
+	require.NoError(t, migration.Up(sqlDB, database.DriverSQLite))
+	created := tables()
+	assert.Contains(t, created, "tasks")
+	assert.Contains(t, created, "board_changes")
+
+	// Откат миграций после общей схемы удаляет их таблицы и сохраняет перестроенные, откат всех - всю схему;
+	// повторное применение восстанавливает ее
+	require.NoError(t, migration.Run(sqlDB, database.DriverSQLite, []string{"down", "2"}))
+	assert.NotContains(t, tables(), "archived_tasks")
+	assert.Contains(t, tables(), "share_links")
+	assert.Contains(t, tables(), "tasks")
+	require.NoError(t, migration.Run(sqlDB, database.DriverSQLite, []string{"down"}))
+	assert.Empty(t, tables())
+	require.NoError(t, migration.Run(sqlDB, database.DriverSQLite, []string{"up"}))
+	assert.Equal(t, created, tables())
+
+	// Внешние ключи включены
+	var foreignKeys bool
+	require.NoError(t, db.Raw("PRAGMA foreign_keys").Scan(&foreignKeys).Error)
+	assert.True(t, foreignKeys)
+}
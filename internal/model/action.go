@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Action records a destructive change a user made to a board, with what is needed to revert it
+type Action struct {
+	ID        uuid.UUID     `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID    uuid.UUID     `gorm:"type:uuid;not null;index"`
+	BoardID   uuid.UUID     `gorm:"type:uuid;not null"`
+	Kind      string        `gorm:"not null"`
+	Payload   ActionPayload `gorm:"type:jsonb;serializer:json;not null"`
+	CreatedAt time.Time
+	UndoneAt  *time.Time
+}
+
+// ActionPayload holds the state an action changed. Which fields are set depends on the kind.
+type ActionPayload struct {
+	TaskID uuid.UUID `json:"task_id"`
+
+	// Task, LabelIDs and Relations are the deleted task as it was
+	Task      *Task          `json:"task,omitempty"`
+	LabelIDs  []uuid.UUID    `json:"label_ids,omitempty"`
+	Relations []TaskRelation `json:"relations,omitempty"`
+
+	// FromColumnID and FromPosition are where a moved task was, ToColumnID where it went
+	FromColumnID *uuid.UUID `json:"from_column_id,omitempty"`
+	FromPosition int        `json:"from_position,omitempty"`
+	ToColumnID   *uuid.UUID `json:"to_column_id,omitempty"`
+
+	// LabelID is the label removed from the task
+	LabelID *uuid.UUID `json:"label_id,omitempty"`
+}
+
+// Action kinds
+const (
+	ActionTaskDelete      = "task_delete"
+	ActionTaskMove        = "task_move"
+	ActionTaskLabelRemove = "task_label_remove"
+)
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionPayload_JSON(t *testing.T) {
+	assignee := uuid.New()
+	task := &Task{ID: uuid.New(), ColumnID: uuid.New(), Title: "Deploy", AssignedTo: &assignee, Position: 3}
+	task.Creator = User{Email: "alice@example.com", HashedPassword: "secret"}
+	payload := ActionPayload{TaskID: task.ID, Task: task, LabelIDs: []uuid.UUID{uuid.New()}}
+
+	encoded, err := json.Marshal(payload)
+	require.NoError(t, err)
+	// Связанные записи в снимок задачи не попадают
+	assert.NotContains(t, string(encoded), "secret")
+	assert.NotContains(t, string(encoded), "alice@example.com")
+
+	var decoded ActionPayload
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.NotNil(t, decoded.Task)
+	assert.Equal(t, task.ID, decoded.Task.ID)
+	assert.Equal(t, "Deploy", decoded.Task.Title)
+	assert.Equal(t, assignee, *decoded.Task.AssignedTo)
+	assert.Equal(t, 3, decoded.Task.Position)
+	assert.Equal(t, payload.LabelIDs, decoded.LabelIDs)
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArchivedTask is a task moved with its history to a file in the task archive directory. Its ID
+// is the ID the task had and keeps when restored. A restored task stays on its board, it is not
+// archived again.
+type ArchivedTask struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	BoardID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	Number      int       `gorm:"not null"`
+	Title       string    `gorm:"not null"`
+	CompletedAt time.Time `gorm:"not null"`
+	// Archive is the name of the file that holds the task
+	Archive    string    `gorm:"not null"`
+	ArchivedAt time.Time `gorm:"autoCreateTime"`
+	RestoredAt *time.Time
+}
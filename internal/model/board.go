@@ -1,7 +1,9 @@
 package model
 
 import (
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 )
@@ -11,8 +13,71 @@ type Board struct {
 	Title       string    `gorm:"not null"`
 	Description string
 	OwnerID     uuid.UUID `gorm:"type:uuid;not null"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// Key prefixes human-readable task references, e.g. PROJ in PROJ-12
+	Key         string `gorm:"not null;default:'TASK'"`
+	TaskCounter int    `gorm:"not null;default:0"`
+	// CardFields lists the fields shown on task cards in display order; nil means DefaultCardFields
+	CardFields []string `gorm:"type:jsonb;serializer:json"`
+	// ReadReceipts shows editors how many viewers have seen the latest changes
+	ReadReceipts bool `gorm:"not null;default:false"`
+	// FrozenAt is set while the owner keeps the board frozen; a frozen board rejects all changes to its content
+	FrozenAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
 
 	Owner User `gorm:"foreignKey:OwnerID"`
 }
+
+// DefaultBoardKey is used when no key can be derived from the board title
+const DefaultBoardKey = "TASK"
+
+// Task fields that can be shown on cards besides the title
+const (
+	CardFieldNumber   = "number"
+	CardFieldLabels   = "labels"
+	CardFieldAssignee = "assignee"
+	CardFieldDueDate  = "due_date"
+	CardFieldPriority = "priority"
+	CardFieldBlocked  = "blocked"
+	CardFieldBlockers = "blockers"
+)
+
+var (
+	// BoardCardFields lists the supported card fields
+	BoardCardFields = []string{
+		CardFieldNumber, CardFieldLabels, CardFieldAssignee, CardFieldDueDate,
+		CardFieldPriority, CardFieldBlocked, CardFieldBlockers,
+	}
+	// DefaultCardFields is the card layout of boards that have not configured one
+	DefaultCardFields = []string{CardFieldNumber, CardFieldLabels, CardFieldAssignee, CardFieldDueDate}
+)
+
+// Frozen reports whether the owner froze the board
+func (b *Board) Frozen() bool {
+	return b.FrozenAt != nil
+}
+
+// CardLayout returns the fields shown on the board's task cards
+func (b *Board) CardLayout() []string {
+	if b.CardFields == nil {
+		return DefaultCardFields
+	}
+	return b.CardFields
+}
+
+// DeriveBoardKey builds an upper-case board key from the first (up to four) ASCII letters of the title
+func DeriveBoardKey(title string) string {
+	var key strings.Builder
+	for _, r := range strings.ToUpper(title) {
+		if r <= unicode.MaxASCII && unicode.IsLetter(r) {
+			key.WriteRune(r)
+		}
+		if key.Len() == 4 {
+			break
+		}
+	}
+	if key.Len() < 2 {
+		return DefaultBoardKey
+	}
+	return key.String()
+}
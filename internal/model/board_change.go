@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardChange is an entry of a board's change feed, logged by database triggers whenever a
+// task, column, label or share of the board is written. TxID is the ID of the writing transaction;
+// SQLite databases don't log it.
+type BoardChange struct {
+	ID        int64     `gorm:"primaryKey"`
+	BoardID   uuid.UUID `gorm:"type:uuid;not null"`
+	Entity    string    `gorm:"not null"`
+	EntityID  uuid.UUID `gorm:"type:uuid;not null"`
+	TxID      int64     `gorm:"column:txid;not null"`
+	ChangedAt time.Time `gorm:"not null"`
+}
+
+// Entities of the board change feed
+const (
+	ChangedTask   = "task"
+	ChangedColumn = "column"
+	ChangedLabel  = "label"
+	ChangedShare  = "share"
+	ChangedLock   = "lock"
+)
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardDailyMetrics is the nightly roll-up of a board's activity on a UTC day: tasks created,
+// completed and moved to another column, and the cycle time in days of the completed ones
+type BoardDailyMetrics struct {
+	BoardID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Day         time.Time `gorm:"type:date;primaryKey"`
+	Created     int       `gorm:"not null;default:0"`
+	Completed   int       `gorm:"not null;default:0"`
+	Moved       int       `gorm:"not null;default:0"`
+	CycleMedian *float64
+	CycleP85    *float64
+}
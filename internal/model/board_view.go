@@ -0,0 +1,30 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardView is a user's last-used sorting, filtering and grouping of a board,
+// stored server-side so the board looks the same on every device
+type BoardView struct {
+	UserID    uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	BoardID   uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	SortBy    string          `gorm:"not null;default:'position'"`
+	SortOrder string          `gorm:"not null;default:'asc'"`
+	GroupBy   string          `gorm:"not null;default:''"`
+	Filters   json.RawMessage `gorm:"type:jsonb;not null;default:'{}'"`
+	UpdatedAt time.Time
+
+	User  User  `gorm:"foreignKey:UserID"`
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+// Supported board view settings
+var (
+	BoardViewSortFields = []string{"position", "number", "title", "due_date", "assignee", "created_at", "priority"}
+	BoardViewSortOrders = []string{"asc", "desc"}
+	BoardViewGroupings  = []string{"", "column", "assignee", "label", "due_date", "priority", "swimlane"}
+)
@@ -11,7 +11,7 @@ type BoardShare struct {
 	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
 	BoardID   uuid.UUID `gorm:"type:uuid;not null;index"`
 	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
-	Role      string    `gorm:"not null;check:role IN ('viewer', 'editor')"`
+	Role      string    `gorm:"not null;check:role IN ('viewer', 'commenter', 'editor')"`
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 
 	Board Board `gorm:"foreignKey:BoardID"`
@@ -20,6 +20,20 @@ type BoardShare struct {
 
 // Роли пользователей для доски
 const (
-	RoleViewer = "viewer" // может только просматривать
-	RoleEditor = "editor" // может редактировать
-)
\ No newline at end of file
+	RoleViewer    = "viewer"    // может только просматривать
+	RoleCommenter = "commenter" // может просматривать и комментировать
+	RoleEditor    = "editor"    // может редактировать
+)
+
+// RoleRank orders roles by the access they grant; unknown roles rank lowest
+func RoleRank(role string) int {
+	switch role {
+	case RoleViewer:
+		return 1
+	case RoleCommenter:
+		return 2
+	case RoleEditor:
+		return 3
+	}
+	return 0
+}
\ No newline at end of file
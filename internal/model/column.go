@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -9,6 +11,12 @@ type Column struct {
 	BoardID  uuid.UUID `gorm:"type:uuid;not null;index"`
 	Title    string    `gorm:"not null"`
 	Position int       `gorm:"not null"`
+	IsDone   bool      `gorm:"not null;default:false"`
+	// Color is a hex color such as #4caf50, empty for the default
+	Color       string `gorm:"not null;default:''"`
+	Description string `gorm:"not null;default:''"`
+	// UpdatedAt also changes when tasks enter, leave or change in the column
+	UpdatedAt time.Time
 
 	Board Board `gorm:"foreignKey:BoardID"`
 }
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DescriptionDoc is the live text of a task description edited collaboratively. Version
+// counts the operations applied to it; the text is saved to the task's description
+// periodically, SavedVersion being the version saved last.
+type DescriptionDoc struct {
+	TaskID       uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	Version      int64      `gorm:"not null"`
+	Content      string     `gorm:"not null"`
+	SavedVersion int64      `gorm:"not null"`
+	SavedAt      time.Time  `gorm:"not null;default:now()"`
+	UpdatedBy    *uuid.UUID `gorm:"type:uuid"`
+	UpdatedAt    time.Time
+}
+
+// Unsaved reports whether the document has edits not yet saved to the task
+func (d *DescriptionDoc) Unsaved() bool {
+	return d.Version > d.SavedVersion
+}
+
+// DescriptionOp is an operation of a description document's log; applying it to version
+// Version-1 of the document gives version Version
+type DescriptionOp struct {
+	TaskID    uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	Version   int64           `gorm:"primaryKey;autoIncrement:false"`
+	UserID    *uuid.UUID      `gorm:"type:uuid"`
+	Ops       json.RawMessage `gorm:"type:jsonb;not null"`
+	CreatedAt time.Time
+}
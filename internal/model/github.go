@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GitHubIntegration receives the GitHub webhooks of a board. Commits and pull requests whose
+// messages, titles or branches mention task keys of the board are linked to the tasks, and
+// pull requests can move their tasks to a review and a done column.
+type GitHubIntegration struct {
+	ID      uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	// Secret signs the webhook payloads
+	Secret         string     `gorm:"not null"`
+	ReviewColumnID *uuid.UUID `gorm:"type:uuid"`
+	DoneColumnID   *uuid.UUID `gorm:"type:uuid"`
+	CreatedBy      uuid.UUID  `gorm:"type:uuid;not null"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TableName keeps GORM from splitting GitHub into git_hub
+func (GitHubIntegration) TableName() string {
+	return "github_integrations"
+}
+
+// TaskGitHubLink links a task to a commit or pull request that mentions it
+type TaskGitHubLink struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TaskID     uuid.UUID `gorm:"type:uuid;not null"`
+	Kind       string    `gorm:"not null"`
+	Repository string    `gorm:"not null"`
+	// Ref is the commit SHA or the pull request number
+	Ref       string `gorm:"not null"`
+	URL       string `gorm:"not null"`
+	Title     string `gorm:"not null"`
+	State     string `gorm:"not null;default:''"`
+	Author    string `gorm:"not null;default:''"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (TaskGitHubLink) TableName() string {
+	return "task_github_links"
+}
+
+// GitHub link kinds
+const (
+	GitHubLinkCommit      = "commit"
+	GitHubLinkPullRequest = "pull_request"
+)
+
+// Pull request states
+const (
+	PullRequestOpen   = "open"
+	PullRequestClosed = "closed"
+	PullRequestMerged = "merged"
+)
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GuestLink lets anyone who has it edit a board without an account. Opening the link starts a
+// session of the link's guest user, which is an editor of the board and nothing else. A link
+// stops working once it has expired or been revoked.
+type GuestLink struct {
+	ID      uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID  uuid.UUID `gorm:"type:uuid;not null;uniqueIndex"`
+	Token   string    `gorm:"uniqueIndex;not null"`
+	// Name tells the owner who the link was made for
+	Name          string    `gorm:"not null"`
+	ExpiresAt     time.Time `gorm:"not null"`
+	CreatedBy     uuid.UUID `gorm:"type:uuid;not null"`
+	RevokedAt     *time.Time
+	Sessions      int `gorm:"not null;default:0"`
+	LastSessionAt *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// Active reports whether guests can still use the link at the given time
+func (l *GuestLink) Active(now time.Time) bool {
+	return l.RevokedAt == nil && now.Before(l.ExpiresAt)
+}
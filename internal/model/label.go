@@ -4,12 +4,30 @@ import (
 	"github.com/google/uuid"
 )
 
+// Label belongs either to a single board (BoardID set) or to a workspace
+// (OwnerID set). Workspace labels are shared by every board of their owner.
 type Label struct {
-	ID      uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	BoardID uuid.UUID `gorm:"type:uuid;not null;index"`
-	Name    string    `gorm:"not null"`
-	Color   string    `gorm:"not null"`
+	ID      uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID *uuid.UUID `gorm:"type:uuid;index"`
+	OwnerID *uuid.UUID `gorm:"type:uuid;index"`
+	Name    string     `gorm:"not null"`
+	Color   string     `gorm:"not null"`
 
-	Board Board `gorm:"foreignKey:BoardID"`
+	Board Board  `gorm:"foreignKey:BoardID"`
+	Owner User   `gorm:"foreignKey:OwnerID"`
 	Tasks []Task `gorm:"many2many:task_labels"`
-}
\ No newline at end of file
+}
+
+// Области видимости меток
+const (
+	LabelScopeBoard     = "board"     // метка конкретной доски
+	LabelScopeWorkspace = "workspace" // метка рабочего пространства владельца
+)
+
+// Scope returns the label scope constant matching the label's ownership.
+func (l *Label) Scope() string {
+	if l.OwnerID != nil {
+		return LabelScopeWorkspace
+	}
+	return LabelScopeBoard
+}
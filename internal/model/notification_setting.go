@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationSetting chooses the channels that deliver an event's notifications to a user,
+// on one board or, without BoardID, by default on all boards
+type NotificationSetting struct {
+	ID      uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID  uuid.UUID  `gorm:"type:uuid;not null"`
+	BoardID *uuid.UUID `gorm:"type:uuid"`
+	Event   string     `gorm:"not null"`
+	InApp   bool       `gorm:"not null"`
+	Email   bool       `gorm:"not null"`
+}
+
+// NotificationMute silences all notifications of a board for a user
+type NotificationMute struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	BoardID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// NotificationDigest subscribes a user to a periodic email digest
+type NotificationDigest struct {
+	UserID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	// Frequency is "daily" or "weekly"
+	Frequency string `gorm:"not null"`
+	// LastSentAt is when the last digest was sent, nil before the first one
+	LastSentAt *time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Operation tracks a long-running job started by a user, e.g. a board duplication.
+// Errors holds a JSON array of messages for the items that could not be processed.
+type Operation struct {
+	ID            uuid.UUID       `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID        uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Kind          string          `gorm:"not null"`
+	Status        string          `gorm:"not null;default:'pending'"`
+	Total         int             `gorm:"not null;default:0"`
+	Completed     int             `gorm:"not null;default:0"`
+	Errors        json.RawMessage `gorm:"type:jsonb;not null;default:'[]'"`
+	ResultBoardID *uuid.UUID      `gorm:"type:uuid"`
+	// RequestID is the ID of the request that started the operation, logged by its job
+	RequestID string `gorm:"not null;default:''"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	User User `gorm:"foreignKey:UserID"`
+}
+
+// Operation kinds
+const (
+	OperationBoardDuplicate = "board_duplicate"
+	OperationBoardSplit     = "board_split"
+	OperationBoardMerge     = "board_merge"
+	OperationTaskImport     = "task_import"
+)
+
+// Operation statuses
+const (
+	OperationPending   = "pending"
+	OperationRunning   = "running"
+	OperationSucceeded = "succeeded"
+	OperationFailed    = "failed"
+)
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is an event waiting for delivery to the notification service. It is written in
+// the same transaction as the change it describes, so committed changes never lose their event.
+type OutboxEvent struct {
+	ID            int64           `gorm:"primaryKey"`
+	Event         string          `gorm:"not null"`
+	Payload       json.RawMessage `gorm:"type:jsonb;not null"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime"`
+	Attempts      int             `gorm:"not null;default:0"`
+	NextAttemptAt time.Time       `gorm:"not null;default:now()"`
+	LastError     *string
+	DeliveredAt   *time.Time
+}
+
+// Outbox event types
+const (
+	// EventMentionCreated is published when a user is newly mentioned in a task
+	EventMentionCreated = "mention.created"
+
+	// Label events are published when a board or workspace label is created, renamed or
+	// recolored, and deleted or merged into another label
+	EventLabelCreated = "label.created"
+	EventLabelUpdated = "label.updated"
+	EventLabelDeleted = "label.deleted"
+
+	// Share events are published when a user gets access to a board, through a share, a share link
+	// or a guest link, gets another role on it, or loses access to it
+	EventShareGranted     = "share.granted"
+	EventShareRoleChanged = "share.role_changed"
+	EventShareRevoked     = "share.revoked"
+
+	// EventBoardUpdated is published when the settings of a board change: its title and
+	// description, whether it is frozen, the layout of its cards and its read receipts
+	EventBoardUpdated = "board.updated"
+)
+
+// MentionCreatedPayload describes a new mention of a user
+type MentionCreatedPayload struct {
+	MentionID   string `json:"mention_id"`
+	TaskID      string `json:"task_id"`
+	TaskTitle   string `json:"task_title"`
+	BoardID     string `json:"board_id"`
+	UserID      string `json:"user_id"`
+	MentionedBy string `json:"mentioned_by"`
+}
+
+// LabelPayload describes a label as it is after the event. Board labels have a board ID,
+// workspace labels an owner ID.
+type LabelPayload struct {
+	LabelID string  `json:"label_id"`
+	BoardID *string `json:"board_id,omitempty"`
+	OwnerID *string `json:"owner_id,omitempty"`
+	Name    string  `json:"name"`
+	Color   string  `json:"color"`
+	// PreviousName is set when the label was renamed
+	PreviousName string `json:"previous_name,omitempty"`
+	// MergedInto is the label that replaced a label deleted by merging
+	MergedInto string `json:"merged_into,omitempty"`
+	ChangedBy  string `json:"changed_by"`
+}
+
+// NewLabelPayload describes the label as changed by the user
+func NewLabelPayload(label *Label, changedBy uuid.UUID) LabelPayload {
+	payload := LabelPayload{LabelID: label.ID.String(), Name: label.Name, Color: label.Color, ChangedBy: changedBy.String()}
+	if label.BoardID != nil {
+		boardID := label.BoardID.String()
+		payload.BoardID = &boardID
+	}
+	if label.OwnerID != nil {
+		ownerID := label.OwnerID.String()
+		payload.OwnerID = &ownerID
+	}
+	return payload
+}
+
+// SharePayload describes the access of a user to a board. Role is the role granted, changed to
+// or revoked.
+type SharePayload struct {
+	BoardID string `json:"board_id"`
+	UserID  string `json:"user_id"`
+	Role    string `json:"role"`
+	// PreviousRole is set when the role changed
+	PreviousRole string `json:"previous_role,omitempty"`
+	ChangedBy    string `json:"changed_by"`
+}
+
+// ShareEvent returns the event and payload of a change of a user's role on a board from
+// previous to role, either of them empty for no access. It returns no event when the role is
+// unchanged.
+func ShareEvent(boardID, userID uuid.UUID, previous, role string, changedBy uuid.UUID) (string, SharePayload) {
+	payload := SharePayload{BoardID: boardID.String(), UserID: userID.String(), Role: role, ChangedBy: changedBy.String()}
+	switch {
+	case previous == role:
+		return "", payload
+	case previous == "":
+		return EventShareGranted, payload
+	case role == "":
+		payload.Role = previous
+		return EventShareRevoked, payload
+	default:
+		payload.PreviousRole = previous
+		return EventShareRoleChanged, payload
+	}
+}
+
+// BoardUpdatedPayload describes the settings of a board after they changed
+type BoardUpdatedPayload struct {
+	BoardID      string     `json:"board_id"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	FrozenAt     *time.Time `json:"frozen_at"`
+	CardFields   []string   `json:"card_fields"`
+	ReadReceipts bool       `json:"read_receipts"`
+	ChangedBy    string     `json:"changed_by"`
+}
+
+// NewBoardUpdatedPayload describes the board as changed by the user
+func NewBoardUpdatedPayload(board *Board, changedBy uuid.UUID) BoardUpdatedPayload {
+	return BoardUpdatedPayload{
+		BoardID:      board.ID.String(),
+		Title:        board.Title,
+		Description:  board.Description,
+		FrozenAt:     board.FrozenAt,
+		CardFields:   board.CardLayout(),
+		ReadReceipts: board.ReadReceipts,
+		ChangedBy:    changedBy.String(),
+	}
+}
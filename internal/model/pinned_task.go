@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PinnedTask is a personal bookmark of a task; pins are never visible to other users
+type PinnedTask struct {
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TaskID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	User User `gorm:"foreignKey:UserID"`
+	Task Task `gorm:"foreignKey:TaskID"`
+}
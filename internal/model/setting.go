@@ -0,0 +1,17 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Setting overrides a runtime setting of the instance, whose default comes from the configuration.
+// Value is the JSON of the setting, or the part of it that differs from the default.
+type Setting struct {
+	Key       string          `gorm:"primaryKey"`
+	Value     json.RawMessage `gorm:"type:jsonb;not null"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime"`
+	UpdatedBy *uuid.UUID      `gorm:"type:uuid"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShareLink invites any authenticated user who opens it to a board with Role. A link stops
+// working once it has been used MaxUses times, has expired or has been revoked.
+type ShareLink struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	Token     string    `gorm:"uniqueIndex;not null"`
+	Role      string    `gorm:"not null"`
+	MaxUses   int       `gorm:"not null"`
+	Uses      int       `gorm:"not null;default:0"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedBy uuid.UUID `gorm:"type:uuid;not null"`
+	RevokedAt *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+// Active reports whether the link can still be redeemed at the given time
+func (l *ShareLink) Active(now time.Time) bool {
+	return l.RevokedAt == nil && now.Before(l.ExpiresAt) && l.Uses < l.MaxUses
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShareLink_Active(t *testing.T) {
+	now := time.Now()
+	link := ShareLink{MaxUses: 2, Uses: 1, ExpiresAt: now.Add(time.Hour)}
+	assert.True(t, link.Active(now))
+
+	// Ссылка перестаёт работать после исчерпания лимита, истечения срока или отзыва
+	used := link
+	used.Uses = 2
+	assert.False(t, used.Active(now))
+	assert.False(t, link.Active(now.Add(2*time.Hour)))
+	revoked := link
+	revoked.RevokedAt = &now
+	assert.False(t, revoked.Active(now))
+}
+
+func TestRoleRank(t *testing.T) {
+	assert.Less(t, RoleRank(RoleViewer), RoleRank(RoleCommenter))
+	assert.Less(t, RoleRank(RoleCommenter), RoleRank(RoleEditor))
+	assert.Zero(t, RoleRank("owner"))
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Sprint is a time box of a board that tasks are planned into. Start and end dates are days
+// in UTC; the sprint includes both.
+type Sprint struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	Name      string    `gorm:"not null"`
+	Goal      string    `gorm:"not null;default:''"`
+	StartDate time.Time `gorm:"type:date;not null"`
+	EndDate   time.Time `gorm:"type:date;not null"`
+	ClosedAt  *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+// Closed reports whether the sprint was closed; tasks can no longer be added to it
+func (s *Sprint) Closed() bool {
+	return s.ClosedAt != nil
+}
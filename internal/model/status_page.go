@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusPage publishes the tasks of selected columns of a board, e.g. a roadmap with
+// Planned/In Progress/Shipped, to anyone who knows its slug
+type StatusPage struct {
+	ID        uuid.UUID   `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID   uuid.UUID   `gorm:"type:uuid;not null;uniqueIndex"`
+	Slug      string      `gorm:"uniqueIndex;not null"`
+	Title     string      `gorm:"not null"`
+	ColumnIDs []uuid.UUID `gorm:"type:jsonb;serializer:json;not null"`
+	CreatedBy uuid.UUID   `gorm:"type:uuid;not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Swimlane is a horizontal grouping of a board's tasks across all its columns.
+// Tasks without a swimlane belong to the board's default lane.
+type Swimlane struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	Title     string    `gorm:"not null"`
+	Position  int       `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
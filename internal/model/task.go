@@ -7,17 +7,46 @@ import (
 )
 
 type Task struct {
-	ID          uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
-	ColumnID    uuid.UUID  `gorm:"type:uuid;not null;index"`
-	Title       string     `gorm:"not null"`
-	Description string
-	AssignedTo  *uuid.UUID `gorm:"type:uuid"`
-	CreatedBy   uuid.UUID  `gorm:"type:uuid;not null"`
-	DueDate     *time.Time
-	Position    int        `gorm:"not null"`
+	ID            uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	ColumnID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Title         string    `gorm:"not null"`
+	Description   string
+	AssignedTo    *uuid.UUID `gorm:"type:uuid"`
+	CreatedBy     uuid.UUID  `gorm:"type:uuid;not null"`
+	StartDate     *time.Time
+	DueDate       *time.Time
+	Position      int `gorm:"not null"`
+	Number        int `gorm:"not null;default:0"`
+	CompletedAt   *time.Time
+	Blocked       bool       `gorm:"not null;default:false"`
+	BlockedReason string     `gorm:"not null;default:''"`
+	Priority      string     `gorm:"not null;default:'medium'"`
+	SwimlaneID    *uuid.UUID `gorm:"type:uuid;index"`
+	// CoverColor is a hex color shown as the card cover, empty for no cover
+	CoverColor string `gorm:"not null;default:''"`
+	// Estimate is the task's size in story points, nil if not estimated
+	Estimate *int
+	SprintID *uuid.UUID `gorm:"type:uuid;index"`
 
-	Column     Column `gorm:"foreignKey:ColumnID"`
-	Assignee   User   `gorm:"foreignKey:AssignedTo"`
-	Creator    User   `gorm:"foreignKey:CreatedBy"`
-	Labels     []Label `gorm:"many2many:task_labels"`
-}
\ No newline at end of file
+	// Associations are left out of JSON, so snapshots of deleted tasks hold the task alone
+	Column   Column  `gorm:"foreignKey:ColumnID" json:"-"`
+	Assignee User    `gorm:"foreignKey:AssignedTo" json:"-"`
+	Creator  User    `gorm:"foreignKey:CreatedBy" json:"-"`
+	Labels   []Label `gorm:"many2many:task_labels" json:"-"`
+}
+
+// Overdue reports whether the task is open and its due date has passed
+func (t *Task) Overdue(now time.Time) bool {
+	return t.CompletedAt == nil && t.DueDate != nil && t.DueDate.Before(now)
+}
+
+// Task priorities, from least to most important
+const (
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+	PriorityUrgent = "urgent"
+)
+
+// TaskPriorities lists the supported priorities from least to most important
+var TaskPriorities = []string{PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskChange records a change of one field of a task. Values are stored as text,
+// nil meaning the field was not set.
+type TaskChange struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TaskID uuid.UUID `gorm:"type:uuid;not null;index"`
+	// UserID is the user who made the change, nil once the user is deleted
+	UserID    *uuid.UUID `gorm:"type:uuid"`
+	Field     string     `gorm:"not null"`
+	OldValue  *string
+	NewValue  *string
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// User is left out of JSON, so archived tasks hold their history alone
+	User User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// Task fields tracked in the task history
+const (
+	TaskFieldTitle         = "title"
+	TaskFieldDescription   = "description"
+	TaskFieldColumn        = "column"
+	TaskFieldBoard         = "board"
+	TaskFieldAssignee      = "assignee"
+	TaskFieldStartDate     = "start_date"
+	TaskFieldDueDate       = "due_date"
+	TaskFieldPriority      = "priority"
+	TaskFieldBlocked       = "blocked"
+	TaskFieldBlockedReason = "blocked_reason"
+	TaskFieldEstimate      = "estimate"
+	TaskFieldCover         = "cover"
+	TaskFieldCompleted     = "completed"
+)
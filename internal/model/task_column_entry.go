@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskColumnEntry records a task's stay in a column. ExitedAt is nil while the task is
+// still in the column.
+type TaskColumnEntry struct {
+	ID        uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TaskID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	ColumnID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	EnteredAt time.Time `gorm:"not null"`
+	ExitedAt  *time.Time
+
+	Task   Task   `gorm:"foreignKey:TaskID"`
+	Column Column `gorm:"foreignKey:ColumnID"`
+}
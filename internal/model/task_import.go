@@ -0,0 +1,46 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskImport collects tasks uploaded in numbered parts and imports them into a board once
+// committed. Parts can be re-uploaded until then, so an interrupted upload can be resumed.
+type TaskImport struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID       uuid.UUID  `gorm:"type:uuid;not null"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Status        string     `gorm:"not null;default:'uploading'"`
+	OperationID   *uuid.UUID `gorm:"type:uuid"`
+	ImportedTasks int        `gorm:"not null;default:0"`
+	ExpiresAt     time.Time  `gorm:"not null"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+// TaskImportPart is an uploaded part of an import that has not been imported yet.
+// Tasks holds the JSON array of the part's task rows.
+type TaskImportPart struct {
+	ImportID   uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	PartNumber int             `gorm:"primaryKey"`
+	Tasks      json.RawMessage `gorm:"type:jsonb;not null"`
+	TaskCount  int             `gorm:"not null"`
+	CreatedAt  time.Time
+}
+
+// Task import statuses
+const (
+	TaskImportUploading = "uploading"
+	TaskImportCommitted = "committed"
+	TaskImportImported  = "imported"
+)
+
+// Expired reports whether the import can no longer be uploaded to or committed
+func (i *TaskImport) Expired(now time.Time) bool {
+	return i.Status == TaskImportUploading && !now.Before(i.ExpiresAt)
+}
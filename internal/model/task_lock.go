@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskLock is a soft lock a user holds on a task while editing its description. Others can't
+// change the description until the lock is released or expires.
+type TaskLock struct {
+	TaskID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	User User `gorm:"foreignKey:UserID"`
+}
+
+// Active reports whether the lock has not expired at now
+func (l *TaskLock) Active(now time.Time) bool {
+	return l.ExpiresAt.After(now)
+}
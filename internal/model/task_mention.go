@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskMention records a user mentioned in a task's text (e.g. "@alice"). Mentions the user
+// has not read yet are their notifications.
+type TaskMention struct {
+	ID          uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TaskID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	MentionedBy uuid.UUID `gorm:"type:uuid;not null"`
+	Origin      string    `gorm:"not null"`
+	Token       string    `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	ReadAt      *time.Time
+
+	Task   Task `gorm:"foreignKey:TaskID"`
+	User   User `gorm:"foreignKey:UserID"`
+	Author User `gorm:"foreignKey:MentionedBy"`
+}
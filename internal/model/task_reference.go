@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskReference links a task to another task mentioned in its text (e.g. "#12" or "PROJ-12")
+type TaskReference struct {
+	ID           uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TaskID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	TargetTaskID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Origin       string    `gorm:"not null"`
+	Token        string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+
+	Task       Task `gorm:"foreignKey:TaskID"`
+	TargetTask Task `gorm:"foreignKey:TargetTaskID"`
+}
+
+// Откуда была извлечена ссылка
+const (
+	ReferenceOriginDescription = "description" // описание задачи
+)
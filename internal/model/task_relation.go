@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskRelation links two tasks of a board. For RelationBlocks, TaskID blocks RelatedTaskID;
+// RelationRelatesTo is symmetric and stored once.
+type TaskRelation struct {
+	ID            uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	TaskID        uuid.UUID  `gorm:"type:uuid;not null;index"`
+	RelatedTaskID uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Type          string     `gorm:"not null"`
+	CreatedBy     *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+
+	Task        Task `gorm:"foreignKey:TaskID" json:"-"`
+	RelatedTask Task `gorm:"foreignKey:RelatedTaskID" json:"-"`
+}
+
+// Stored relation types
+const (
+	RelationBlocks    = "blocks"
+	RelationRelatesTo = "relates_to"
+)
+
+// RelationBlockedBy is the inverse of RelationBlocks as seen from the blocked task.
+// It is accepted by the API and stored as RelationBlocks with the tasks swapped.
+const RelationBlockedBy = "blocked_by"
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskTemplate pre-fills tasks of a recurring kind on a board. Labels that were deleted since
+// the template was saved are skipped when a task is created from it.
+type TaskTemplate struct {
+	ID           uuid.UUID   `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	BoardID      uuid.UUID   `gorm:"type:uuid;not null;index"`
+	Name         string      `gorm:"not null"`
+	TitlePattern string      `gorm:"not null"`
+	Description  string      `gorm:"not null;default:''"`
+	Priority     string      `gorm:"not null;default:'medium'"`
+	LabelIDs     []uuid.UUID `gorm:"type:jsonb;serializer:json;not null"`
+	Checklist    []string    `gorm:"type:jsonb;serializer:json;not null"`
+	CreatedBy    *uuid.UUID  `gorm:"type:uuid"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+// Title fills in the placeholders of the title pattern: {date} is the UTC day as YYYY-MM-DD,
+// {week} the ISO week such as 2024-W07 and {user} the name of the user creating the task
+func (t *TaskTemplate) Title(now time.Time, userName string) string {
+	year, week := now.UTC().ISOWeek()
+	return strings.NewReplacer(
+		"{date}", now.UTC().Format("2006-01-02"),
+		"{week}", fmt.Sprintf("%d-W%02d", year, week),
+		"{user}", userName,
+	).Replace(t.TitlePattern)
+}
+
+// TaskDescription returns the description with the checklist appended as a Markdown task list
+func (t *TaskTemplate) TaskDescription() string {
+	if len(t.Checklist) == 0 {
+		return t.Description
+	}
+
+	var b strings.Builder
+	b.WriteString(t.Description)
+	if t.Description != "" {
+		b.WriteString("\n\n")
+	}
+	for i, item := range t.Checklist {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("- [ ] " + item)
+	}
+	return b.String()
+}
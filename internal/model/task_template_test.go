@@ -0,0 +1,27 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskTemplate_Title(t *testing.T) {
+	template := &TaskTemplate{TitlePattern: "Release {week} ({date}) by {user}"}
+	now := time.Date(2024, 2, 14, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	// День и неделя считаются в UTC
+	assert.Equal(t, "Release 2024-W07 (2024-02-15) by Alice", template.Title(now, "Alice"))
+}
+
+func TestTaskTemplate_TaskDescription(t *testing.T) {
+	template := &TaskTemplate{Description: "Steps to reproduce:", Checklist: []string{"Reproduce", "Fix"}}
+	assert.Equal(t, "Steps to reproduce:\n\n- [ ] Reproduce\n- [ ] Fix", template.TaskDescription())
+
+	template.Description = ""
+	assert.Equal(t, "- [ ] Reproduce\n- [ ] Fix", template.TaskDescription())
+
+	template.Checklist = nil
+	assert.Empty(t, template.TaskDescription())
+}
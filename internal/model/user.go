@@ -12,4 +12,28 @@ type User struct {
 	HashedPassword string    `gorm:"not null"`
 	Name           string    `gorm:"not null"`
 	CreatedAt      time.Time `gorm:"autoCreateTime"`
+
+	SampleBoardCreatedAt *time.Time
+
+	// DefaultBoardID is the board the user chose to open on start, LastBoardID the board opened last
+	DefaultBoardID *uuid.UUID `gorm:"type:uuid"`
+	LastBoardID    *uuid.UUID `gorm:"type:uuid"`
+
+	// CalendarToken authenticates the user's iCal feed URLs, which calendar apps fetch without a login
+	CalendarToken *string `gorm:"uniqueIndex"`
+
+	// HideReadReceipts keeps the user's board views out of read receipts
+	HideReadReceipts bool `gorm:"not null;default:false"`
+
+	// IsAdmin grants access to the instance administration API
+	IsAdmin bool `gorm:"not null;default:false"`
+	// DisabledAt is set while an administrator has disabled the account
+	DisabledAt *time.Time
+	// IsGuest marks the user of a guest link, which has no password and edits only the link's board
+	IsGuest bool `gorm:"not null;default:false"`
+
+	// Overrides of the instance limits set by an administrator; nil keeps the default, 0 is unlimited
+	MaxBoards          *int
+	MaxColumnsPerBoard *int
+	MaxTasksPerColumn  *int
 }
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserBoardPrefs holds a user's presentation preferences of a board that do not affect
+// what other members see, such as collapsed columns, whether the board is starred and where it
+// is in the user's boards list
+type UserBoardPrefs struct {
+	UserID           uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	BoardID          uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	CollapsedColumns []uuid.UUID `gorm:"type:jsonb;not null;serializer:json"`
+	Starred          bool        `gorm:"not null;default:false"`
+	// SortOrder is the 1-based position of the board in the user's boards list, nil if unordered
+	SortOrder *int
+	UpdatedAt time.Time
+
+	User  User  `gorm:"foreignKey:UserID"`
+	Board Board `gorm:"foreignKey:BoardID"`
+}
+
+// IsCollapsed reports whether the user collapsed the column. Prefs may be nil.
+func (p *UserBoardPrefs) IsCollapsed(columnID uuid.UUID) bool {
+	return p != nil && slices.Contains(p.CollapsedColumns, columnID)
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links a user to an account at an external OAuth provider
+type UserIdentity struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	Provider   string    `gorm:"not null"`
+	ProviderID string    `gorm:"not null"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+
+	User User `gorm:"foreignKey:UserID"`
+}
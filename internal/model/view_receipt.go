@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ViewReceipt records when a user last opened a board, or one of its columns if ColumnID is set
+type ViewReceipt struct {
+	BoardID  uuid.UUID  `gorm:"type:uuid;not null"`
+	ColumnID *uuid.UUID `gorm:"type:uuid"`
+	UserID   uuid.UUID  `gorm:"type:uuid;not null"`
+	ViewedAt time.Time  `gorm:"not null"`
+}
+
+// ReadReceiptRoles are the roles whose views are counted in read receipts: members who read
+// a board without editing it
+var ReadReceiptRoles = []string{RoleViewer, RoleCommenter}
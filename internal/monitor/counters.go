@@ -0,0 +1,44 @@
+// Package monitor counts request outcomes and raises alerts when they cross configured thresholds.
+package monitor
+
+import "sync/atomic"
+
+// Counters accumulate request outcomes between two monitor checks. They are safe for concurrent use.
+type Counters struct {
+	requests      atomic.Int64
+	serverErrors  atomic.Int64
+	loginFailures atomic.Int64
+}
+
+func NewCounters() *Counters {
+	return &Counters{}
+}
+
+// RecordResponse counts a finished request and whether it failed with a server error
+func (c *Counters) RecordResponse(status int) {
+	c.requests.Add(1)
+	if status >= 500 {
+		c.serverErrors.Add(1)
+	}
+}
+
+// RecordLoginFailure counts a rejected login attempt
+func (c *Counters) RecordLoginFailure() {
+	c.loginFailures.Add(1)
+}
+
+// Sample is a snapshot of the counters over one check interval
+type Sample struct {
+	Requests      int64
+	ServerErrors  int64
+	LoginFailures int64
+}
+
+// Take returns the counts since the previous call and resets them
+func (c *Counters) Take() Sample {
+	return Sample{
+		Requests:      c.requests.Swap(0),
+		ServerErrors:  c.serverErrors.Swap(0),
+		LoginFailures: c.loginFailures.Swap(0),
+	}
+}
@@ -0,0 +1,106 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Alert kinds
+const (
+	AlertErrorRate     = "error_rate"
+	AlertLoginFailures = "login_failures"
+)
+
+// Alert is a threshold violation found in one sample
+type Alert struct {
+	Kind    string
+	Message string
+}
+
+// Thresholds configure when a sample raises alerts. A zero threshold disables its rule.
+type Thresholds struct {
+	// ErrorRate is the share of 5xx responses, checked once MinRequests were served in the interval
+	ErrorRate   float64
+	MinRequests int64
+	// LoginFailures is the number of rejected logins per interval
+	LoginFailures int64
+}
+
+// Evaluate returns the alerts raised by a sample
+func (t Thresholds) Evaluate(s Sample, interval time.Duration) []Alert {
+	var alerts []Alert
+
+	if t.ErrorRate > 0 && s.Requests > 0 && s.Requests >= t.MinRequests {
+		rate := float64(s.ServerErrors) / float64(s.Requests)
+		if rate >= t.ErrorRate {
+			alerts = append(alerts, Alert{
+				Kind: AlertErrorRate,
+				Message: fmt.Sprintf("Error rate spike: %.1f%% of %d requests failed with 5xx in the last %s (threshold %.1f%%)",
+					rate*100, s.Requests, interval, t.ErrorRate*100),
+			})
+		}
+	}
+
+	if t.LoginFailures > 0 && s.LoginFailures >= t.LoginFailures {
+		alerts = append(alerts, Alert{
+			Kind: AlertLoginFailures,
+			Message: fmt.Sprintf("Login failure burst: %d failed logins in the last %s (threshold %d)",
+				s.LoginFailures, interval, t.LoginFailures),
+		})
+	}
+
+	return alerts
+}
+
+// Monitor periodically checks the counters and sends alerts, at most one per kind per cooldown
+type Monitor struct {
+	counters   *Counters
+	notifier   Notifier
+	thresholds Thresholds
+	interval   time.Duration
+	cooldown   time.Duration
+
+	lastSent map[string]time.Time
+}
+
+func NewMonitor(counters *Counters, notifier Notifier, thresholds Thresholds, interval, cooldown time.Duration) *Monitor {
+	return &Monitor{
+		counters:   counters,
+		notifier:   notifier,
+		thresholds: thresholds,
+		interval:   interval,
+		cooldown:   cooldown,
+		lastSent:   make(map[string]time.Time),
+	}
+}
+
+// Run checks the counters every interval until ctx is cancelled
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.check(ctx, now)
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context, now time.Time) {
+	for _, alert := range m.thresholds.Evaluate(m.counters.Take(), m.interval) {
+		if last, ok := m.lastSent[alert.Kind]; ok && now.Sub(last) < m.cooldown {
+			continue
+		}
+
+		if err := m.notifier.Notify(ctx, alert); err != nil {
+			log.Printf("⚠️  Failed to send %s alert: %v", alert.Kind, err)
+			continue
+		}
+		m.lastSent[alert.Kind] = now
+	}
+}
@@ -0,0 +1,52 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotifier struct {
+	alerts []Alert
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, alert Alert) error {
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func TestThresholds_Evaluate(t *testing.T) {
+	thresholds := Thresholds{ErrorRate: 0.1, MinRequests: 20, LoginFailures: 5}
+
+	// Мало запросов: доля ошибок не проверяется
+	assert.Empty(t, thresholds.Evaluate(Sample{Requests: 10, ServerErrors: 10}, time.Minute))
+
+	alerts := thresholds.Evaluate(Sample{Requests: 40, ServerErrors: 4, LoginFailures: 5}, time.Minute)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, AlertErrorRate, alerts[0].Kind)
+	assert.Contains(t, alerts[0].Message, "10.0% of 40 requests")
+	assert.Equal(t, AlertLoginFailures, alerts[1].Kind)
+}
+
+func TestMonitor_Cooldown(t *testing.T) {
+	counters := NewCounters()
+	notifier := &recordingNotifier{}
+	m := NewMonitor(counters, notifier, Thresholds{LoginFailures: 1}, time.Minute, 10*time.Minute)
+
+	now := time.Now()
+	counters.RecordLoginFailure()
+	m.check(context.Background(), now)
+
+	// Повтор в пределах паузы не отправляется
+	counters.RecordLoginFailure()
+	m.check(context.Background(), now.Add(time.Minute))
+
+	counters.RecordLoginFailure()
+	m.check(context.Background(), now.Add(11*time.Minute))
+
+	assert.Len(t, notifier.alerts, 2)
+	assert.Zero(t, counters.Take().LoginFailures)
+}
@@ -0,0 +1,63 @@
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"kanban/internal/tracing"
+)
+
+// Notifier delivers alerts to the channel watched by admins
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// LogNotifier writes alerts to the server log. It is used when no channel is configured.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(_ context.Context, alert Alert) error {
+	log.Printf("🚨 %s", alert.Message)
+	return nil
+}
+
+// SlackNotifier posts alerts to a Slack incoming webhook
+type SlackNotifier struct {
+	webhookURL string
+	client     *http.Client
+}
+
+func NewSlackNotifier(webhookURL string) *SlackNotifier {
+	return &SlackNotifier{
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: 10 * time.Second, Transport: tracing.Transport(http.DefaultTransport)},
+	}
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, alert Alert) error {
+	body, err := json.Marshal(map[string]string{"text": "🚨 " + alert.Message})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack webhook returned %s", resp.Status)
+	}
+	return nil
+}
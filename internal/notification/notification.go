@@ -0,0 +1,130 @@
+// Package notification decides whether a notification reaches a user, and through which
+// channels, according to the user's notification settings.
+package notification
+
+import (
+	"github.com/google/uuid"
+
+	"kanban/internal/model"
+)
+
+// Delivery channels
+const (
+	ChannelInApp = "in_app"
+	ChannelEmail = "email"
+)
+
+// Channels lists the delivery channels
+var Channels = []string{ChannelInApp, ChannelEmail}
+
+// Event types that notify users
+const (
+	// EventMention notifies a user mentioned in a task by someone else
+	EventMention = "mention"
+)
+
+// Events lists the event types that notify users
+var Events = []string{EventMention}
+
+// Digest frequencies; without a digest the user gets none
+const (
+	DigestOff    = "off"
+	DigestDaily  = "daily"
+	DigestWeekly = "weekly"
+)
+
+// DigestFrequencies lists the digest frequencies
+var DigestFrequencies = []string{DigestOff, DigestDaily, DigestWeekly}
+
+// Default returns the channels of an event the user has no settings for: in-app only
+func Default(event string) model.NotificationSetting {
+	return model.NotificationSetting{Event: event, InApp: true}
+}
+
+// Preferences resolves a user's notification settings. A muted board delivers nothing; otherwise
+// the board's setting for an event wins over the user's default, which wins over Default.
+type Preferences struct {
+	defaults map[string]model.NotificationSetting
+	boards   map[uuid.UUID]map[string]model.NotificationSetting
+	muted    map[uuid.UUID]bool
+}
+
+// NewPreferences indexes the settings and muted boards of a user
+func NewPreferences(settings []model.NotificationSetting, mutes []model.NotificationMute) *Preferences {
+	p := &Preferences{
+		defaults: make(map[string]model.NotificationSetting),
+		boards:   make(map[uuid.UUID]map[string]model.NotificationSetting),
+		muted:    make(map[uuid.UUID]bool, len(mutes)),
+	}
+	for _, setting := range settings {
+		if setting.BoardID == nil {
+			p.defaults[setting.Event] = setting
+			continue
+		}
+		if p.boards[*setting.BoardID] == nil {
+			p.boards[*setting.BoardID] = make(map[string]model.NotificationSetting)
+		}
+		p.boards[*setting.BoardID][setting.Event] = setting
+	}
+	for _, mute := range mutes {
+		p.muted[mute.BoardID] = true
+	}
+	return p
+}
+
+// Delivers reports whether an event on a board reaches the user through a channel
+func (p *Preferences) Delivers(boardID uuid.UUID, event, channel string) bool {
+	if p.muted[boardID] {
+		return false
+	}
+	setting, ok := p.boards[boardID][event]
+	if !ok {
+		setting = p.defaultSetting(event)
+	}
+	return enabled(setting, channel)
+}
+
+// Exceptions returns whether an event reaches the user through a channel on boards without
+// settings of their own, and the boards where it does not. The boards are the ones with
+// settings or a mute that deliver differently, so a query can select the boards that deliver
+// without knowing every board of the user.
+func (p *Preferences) Exceptions(event, channel string) (bool, []uuid.UUID) {
+	byDefault := enabled(p.defaultSetting(event), channel)
+
+	var boards []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	check := func(boardID uuid.UUID) {
+		if seen[boardID] {
+			return
+		}
+		seen[boardID] = true
+		if p.Delivers(boardID, event, channel) != byDefault {
+			boards = append(boards, boardID)
+		}
+	}
+	for boardID := range p.boards {
+		check(boardID)
+	}
+	for boardID := range p.muted {
+		check(boardID)
+	}
+	return byDefault, boards
+}
+
+func (p *Preferences) defaultSetting(event string) model.NotificationSetting {
+	if setting, ok := p.defaults[event]; ok {
+		return setting
+	}
+	return Default(event)
+}
+
+func enabled(setting model.NotificationSetting, channel string) bool {
+	switch channel {
+	case ChannelInApp:
+		return setting.InApp
+	case ChannelEmail:
+		return setting.Email
+	default:
+		return false
+	}
+}
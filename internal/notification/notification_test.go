@@ -0,0 +1,51 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"kanban/internal/model"
+)
+
+func TestPreferencesDelivers(t *testing.T) {
+	quiet, loud, muted, other := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	// Без настроек упоминания приходят только в приложении
+	p := NewPreferences(nil, nil)
+	assert.True(t, p.Delivers(other, EventMention, ChannelInApp))
+	assert.False(t, p.Delivers(other, EventMention, ChannelEmail))
+
+	p = NewPreferences([]model.NotificationSetting{
+		{Event: EventMention, InApp: true, Email: true},
+		{BoardID: &quiet, Event: EventMention},
+		{BoardID: &loud, Event: EventMention, Email: true},
+		{BoardID: &muted, Event: EventMention, InApp: true},
+	}, []model.NotificationMute{{BoardID: muted}})
+
+	assert.True(t, p.Delivers(other, EventMention, ChannelEmail))
+	assert.False(t, p.Delivers(quiet, EventMention, ChannelInApp))
+	assert.False(t, p.Delivers(loud, EventMention, ChannelInApp))
+	assert.True(t, p.Delivers(loud, EventMention, ChannelEmail))
+
+	// Заглушенная доска ничего не доставляет, даже при включенных каналах
+	assert.False(t, p.Delivers(muted, EventMention, ChannelInApp))
+}
+
+func TestPreferencesExceptions(t *testing.T) {
+	quiet, same, muted := uuid.New(), uuid.New(), uuid.New()
+	p := NewPreferences([]model.NotificationSetting{
+		{BoardID: &quiet, Event: EventMention},
+		{BoardID: &same, Event: EventMention, InApp: true},
+	}, []model.NotificationMute{{BoardID: muted}})
+
+	byDefault, boards := p.Exceptions(EventMention, ChannelInApp)
+	assert.True(t, byDefault)
+	assert.ElementsMatch(t, []uuid.UUID{quiet, muted}, boards)
+
+	// Если по умолчанию канал выключен, исключениями становятся доски, где он включен
+	byDefault, boards = p.Exceptions(EventMention, ChannelEmail)
+	assert.False(t, byDefault)
+	assert.Empty(t, boards)
+}
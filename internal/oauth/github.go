@@ -0,0 +1,91 @@
+package oauth
+
+import (
+	"context"
+	"strconv"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+)
+
+const githubAPIURL = "https://api.github.com"
+
+type githubProvider struct {
+	config *oauth2.Config
+	apiURL string
+}
+
+// NewGitHub creates a GitHub login provider
+func NewGitHub(clientID, clientSecret, redirectURL string) Provider {
+	return newGitHub(clientID, clientSecret, redirectURL, endpoints.GitHub, githubAPIURL)
+}
+
+func newGitHub(clientID, clientSecret, redirectURL string, endpoint oauth2.Endpoint, apiURL string) *githubProvider {
+	return &githubProvider{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Endpoint:     endpoint,
+			Scopes:       []string{"read:user", "user:email"},
+		},
+		apiURL: apiURL,
+	}
+}
+
+func (p *githubProvider) Name() string {
+	return "github"
+}
+
+func (p *githubProvider) AuthCodeURL(state string) string {
+	return p.config.AuthCodeURL(state)
+}
+
+func (p *githubProvider) Authenticate(ctx context.Context, code string) (*UserInfo, error) {
+	client, err := exchange(ctx, p.config, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err := getJSON(ctx, client, p.apiURL+"/user", &user); err != nil {
+		return nil, err
+	}
+
+	// Публичный email профиля может быть не подтверждён, поэтому берём основной подтверждённый
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, client, p.apiURL+"/user/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	email := ""
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			email = e.Email
+			break
+		}
+	}
+	if email == "" {
+		return nil, ErrUnverifiedEmail
+	}
+
+	name := user.Name
+	if name == "" {
+		name = user.Login
+	}
+
+	return &UserInfo{
+		Provider:   p.Name(),
+		ProviderID: strconv.FormatInt(user.ID, 10),
+		Email:      email,
+		Name:       name,
+	}, nil
+}
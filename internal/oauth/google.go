@@ -0,0 +1,69 @@
+package oauth
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+)
+
+const googleUserInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+
+type googleProvider struct {
+	config      *oauth2.Config
+	userInfoURL string
+}
+
+// NewGoogle creates a Google login provider
+func NewGoogle(clientID, clientSecret, redirectURL string) Provider {
+	return newGoogle(clientID, clientSecret, redirectURL, endpoints.Google, googleUserInfoURL)
+}
+
+func newGoogle(clientID, clientSecret, redirectURL string, endpoint oauth2.Endpoint, userInfoURL string) *googleProvider {
+	return &googleProvider{
+		config: &oauth2.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  redirectURL,
+			Endpoint:     endpoint,
+			Scopes:       []string{"openid", "email", "profile"},
+		},
+		userInfoURL: userInfoURL,
+	}
+}
+
+func (p *googleProvider) Name() string {
+	return "google"
+}
+
+func (p *googleProvider) AuthCodeURL(state string) string {
+	return p.config.AuthCodeURL(state)
+}
+
+func (p *googleProvider) Authenticate(ctx context.Context, code string) (*UserInfo, error) {
+	client, err := exchange(ctx, p.config, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := getJSON(ctx, client, p.userInfoURL, &info); err != nil {
+		return nil, err
+	}
+
+	if info.Email == "" || !info.EmailVerified {
+		return nil, ErrUnverifiedEmail
+	}
+
+	return &UserInfo{
+		Provider:   p.Name(),
+		ProviderID: info.Sub,
+		Email:      info.Email,
+		Name:       info.Name,
+	}, nil
+}
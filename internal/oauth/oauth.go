@@ -0,0 +1,74 @@
+// Package oauth implements the OAuth2 authorization code flow for third-party login providers.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"kanban/internal/tracing"
+
+	"golang.org/x/oauth2"
+)
+
+// ErrUnverifiedEmail is returned when the provider account has no verified email address
+var ErrUnverifiedEmail = errors.New("provider account has no verified email")
+
+// UserInfo is the identity returned by a provider after a successful login
+type UserInfo struct {
+	Provider   string
+	ProviderID string
+	Email      string
+	Name       string
+}
+
+// Provider is a configured OAuth2 login provider
+type Provider interface {
+	Name() string
+	AuthCodeURL(state string) string
+	// Authenticate exchanges the authorization code and fetches the user's identity
+	Authenticate(ctx context.Context, code string) (*UserInfo, error)
+}
+
+// NewState returns a random value for the OAuth2 state parameter
+func NewState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// getJSON performs an authenticated GET request and decodes the JSON response into v
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func exchange(ctx context.Context, cfg *oauth2.Config, code string) (*http.Client, error) {
+	// Calls to the provider continue the trace of the login request
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: tracing.Transport(http.DefaultTransport)})
+	token, err := cfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	return cfg.Client(ctx, token), nil
+}
@@ -0,0 +1,123 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+// stubProvider serves the token endpoint and the API of a provider. The token endpoint accepts
+// only the code "good"; API requests need the token it issues.
+func stubProvider(t *testing.T, api map[string]interface{}) (*httptest.Server, oauth2.Endpoint) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "good" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "stub-token", "token_type": "bearer"}`))
+	})
+	for path, body := range api {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer stub-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if status, ok := body.(int); ok {
+				w.WriteHeader(status)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(body)
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, oauth2.Endpoint{
+		AuthURL:   server.URL + "/authorize",
+		TokenURL:  server.URL + "/token",
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+}
+
+func TestGoogleAuthenticate(t *testing.T) {
+	userInfo := map[string]interface{}{
+		"sub": "1001", "email": "anna@example.com", "email_verified": true, "name": "Anna",
+	}
+	server, endpoint := stubProvider(t, map[string]interface{}{"/userinfo": userInfo})
+	provider := newGoogle("client", "secret", "http://localhost/callback", endpoint, server.URL+"/userinfo")
+
+	info, err := provider.Authenticate(context.Background(), "good")
+	require.NoError(t, err)
+	assert.Equal(t, &UserInfo{Provider: "google", ProviderID: "1001", Email: "anna@example.com", Name: "Anna"}, info)
+
+	// Неверный код отклоняется провайдером
+	_, err = provider.Authenticate(context.Background(), "bad")
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrUnverifiedEmail))
+
+	// Неподтверждённый email не годится для входа
+	userInfo["email_verified"] = false
+	_, err = provider.Authenticate(context.Background(), "good")
+	assert.ErrorIs(t, err, ErrUnverifiedEmail)
+}
+
+func TestGitHubAuthenticate(t *testing.T) {
+	emails := []map[string]interface{}{
+		{"email": "public@example.com", "primary": false, "verified": true},
+		{"email": "anna@example.com", "primary": true, "verified": true},
+	}
+	api := map[string]interface{}{
+		"/user":        map[string]interface{}{"id": 42, "login": "anna", "name": ""},
+		"/user/emails": emails,
+	}
+	server, endpoint := stubProvider(t, api)
+	provider := newGitHub("client", "secret", "http://localhost/callback", endpoint, server.URL)
+
+	info, err := provider.Authenticate(context.Background(), "good")
+	require.NoError(t, err)
+	// Без имени в профиле берётся логин
+	assert.Equal(t, &UserInfo{Provider: "github", ProviderID: "42", Email: "anna@example.com", Name: "anna"}, info)
+
+	// Основной email должен быть подтверждён, другие подтверждённые не подходят
+	emails[1]["verified"] = false
+	_, err = provider.Authenticate(context.Background(), "good")
+	assert.ErrorIs(t, err, ErrUnverifiedEmail)
+}
+
+func TestAuthenticate_ProviderError(t *testing.T) {
+	server, endpoint := stubProvider(t, map[string]interface{}{
+		"/user":        http.StatusInternalServerError,
+		"/user/emails": []interface{}{},
+	})
+	provider := newGitHub("client", "secret", "http://localhost/callback", endpoint, server.URL)
+
+	_, err := provider.Authenticate(context.Background(), "good")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrUnverifiedEmail))
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	_, endpoint := stubProvider(t, nil)
+	provider := newGoogle("client", "secret", "http://localhost/callback", endpoint, "")
+
+	authURL, err := url.Parse(provider.AuthCodeURL("some-state"))
+	require.NoError(t, err)
+	assert.Equal(t, "some-state", authURL.Query().Get("state"))
+	assert.Equal(t, "client", authURL.Query().Get("client_id"))
+	assert.Equal(t, "http://localhost/callback", authURL.Query().Get("redirect_uri"))
+}
@@ -0,0 +1,215 @@
+// Package openapi validates requests against the Swagger 2.0 document that swag generates from
+// the handler annotations, so the documented API and the accepted one can't drift apart.
+//
+// Only the parts of the schema language swag emits are supported: types, formats, enums,
+// required properties, length, range and item count limits, $ref, allOf and additionalProperties.
+// Header parameters are not checked; authentication is left to the auth middleware.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Spec is the part of a Swagger document needed to validate requests
+type Spec struct {
+	Paths       map[string]map[string]*Operation `json:"paths"`
+	Definitions map[string]*Schema               `json:"definitions"`
+}
+
+// Operation is a documented method of a path
+type Operation struct {
+	Parameters []*Parameter `json:"parameters"`
+}
+
+// Parameter is a path, query, header or body parameter of an operation
+type Parameter struct {
+	Name             string        `json:"name"`
+	In               string        `json:"in"`
+	Required         bool          `json:"required"`
+	Type             string        `json:"type"`
+	Format           string        `json:"format"`
+	Enum             []interface{} `json:"enum"`
+	Minimum          *float64      `json:"minimum"`
+	Maximum          *float64      `json:"maximum"`
+	Items            *Schema       `json:"items"`
+	CollectionFormat string        `json:"collectionFormat"`
+	// Schema is the schema of a body parameter
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema of a body or a part of it
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Enum                 []interface{}      `json:"enum"`
+	Required             []string           `json:"required"`
+	Properties           map[string]*Schema `json:"properties"`
+	AdditionalProperties *Schema            `json:"-"`
+	Items                *Schema            `json:"items"`
+	AllOf                []*Schema          `json:"allOf"`
+	MinLength            *int               `json:"minLength"`
+	MaxLength            *int               `json:"maxLength"`
+	Minimum              *float64           `json:"minimum"`
+	Maximum              *float64           `json:"maximum"`
+	MinItems             *int               `json:"minItems"`
+	MaxItems             *int               `json:"maxItems"`
+}
+
+// UnmarshalJSON reads the schema; additionalProperties may be a schema or a boolean, and only
+// schemas are kept
+func (s *Schema) UnmarshalJSON(data []byte) error {
+	type plain Schema
+	var raw struct {
+		*plain
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}
+	raw.plain = (*plain)(s)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw.AdditionalProperties) > 0 && raw.AdditionalProperties[0] == '{' {
+		return json.Unmarshal(raw.AdditionalProperties, &s.AdditionalProperties)
+	}
+	return nil
+}
+
+// Load parses a Swagger 2.0 document
+func Load(doc []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(doc, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse the API document: %w", err)
+	}
+	return &spec, nil
+}
+
+var routeParamPattern = regexp.MustCompile(`[:*]([^/]+)`)
+
+// Operation returns the documented operation of a gin route such as /boards/:id, given without
+// the version prefix the document's base path stands for, or nil when the route is not documented
+func (s *Spec) Operation(method, route string) *Operation {
+	path := routeParamPattern.ReplaceAllString(route, "{$1}")
+	return s.Paths[path][strings.ToLower(method)]
+}
+
+// HasBody reports whether the operation takes a JSON body
+func (o *Operation) HasBody() bool {
+	for _, p := range o.Parameters {
+		if p.In == "body" {
+			return true
+		}
+	}
+	return false
+}
+
+// Request is what is validated of an incoming request
+type Request struct {
+	PathParams map[string]string
+	Query      url.Values
+	// Body is nil when the request has no JSON body
+	Body []byte
+}
+
+// ValidationError lists every way a request doesn't match its operation
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return strings.Join(e.Problems, "; ")
+}
+
+// Validate checks the path and query parameters and the JSON body of a request against the
+// operation. It returns a *ValidationError when they don't match.
+func (s *Spec) Validate(op *Operation, req Request) error {
+	v := &validator{spec: s}
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			v.param(p, []string{req.PathParams[p.Name]})
+		case "query":
+			values, ok := req.Query[p.Name]
+			if !ok {
+				if p.Required {
+					v.fail("query parameter %s is required", p.Name)
+				}
+				continue
+			}
+			v.param(p, values)
+		case "body":
+			v.body(p, req.Body)
+		}
+	}
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+type validator struct {
+	spec     *Spec
+	problems []string
+}
+
+func (v *validator) fail(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+// param checks the values of a path or query parameter
+func (v *validator) param(p *Parameter, values []string) {
+	if p.Type == "array" && p.Items != nil {
+		var items []string
+		for _, value := range values {
+			if p.CollectionFormat == "multi" {
+				items = append(items, value)
+			} else {
+				items = append(items, strings.Split(value, ",")...)
+			}
+		}
+		for _, item := range items {
+			v.paramValue(p.In+" parameter "+p.Name, p.Items.Type, p.Items.Format, p.Items.Enum, p.Items.Minimum, p.Items.Maximum, item)
+		}
+		return
+	}
+	for _, value := range values {
+		v.paramValue(p.In+" parameter "+p.Name, p.Type, p.Format, p.Enum, p.Minimum, p.Maximum, value)
+	}
+}
+
+func (v *validator) paramValue(name, typ, format string, enum []interface{}, minimum, maximum *float64, value string) {
+	// Пустой необязательный параметр обработчики считают отсутствующим
+	if value == "" {
+		return
+	}
+
+	switch typ {
+	case "integer", "number":
+		number := json.Number(value)
+		if typ == "integer" {
+			if _, err := number.Int64(); err != nil {
+				v.fail("%s must be an integer", name)
+				return
+			}
+		} else if _, err := number.Float64(); err != nil {
+			v.fail("%s must be a number", name)
+			return
+		}
+		v.checkRange(name, number, minimum, maximum)
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			v.fail("%s must be true or false", name)
+		}
+	case "string":
+		v.checkFormat(name, format, value)
+	}
+
+	if len(enum) > 0 && !inEnum(enum, value) {
+		v.fail("%s must be one of %s", name, formatEnum(enum))
+	}
+}
@@ -0,0 +1,125 @@
+package openapi_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"kanban/docs"
+	"kanban/internal/openapi"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDoc = `{
+	"basePath": "/",
+	"paths": {
+		"/boards/{id}/share": {
+			"post": {
+				"parameters": [
+					{"name": "id", "in": "path", "required": true, "type": "string", "format": "uuid"},
+					{"name": "notify", "in": "query", "type": "boolean"},
+					{"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/handler.ShareBoardRequest"}}
+				]
+			}
+		}
+	},
+	"definitions": {
+		"handler.ShareBoardRequest": {
+			"type": "object",
+			"required": ["email", "role"],
+			"properties": {
+				"email": {"type": "string", "maxLength": 10},
+				"role": {"type": "string", "enum": ["viewer", "editor"]},
+				"positions": {"type": "array", "items": {"type": "integer", "minimum": 0}},
+				"extra": {"type": "object", "additionalProperties": {"type": "boolean"}}
+			}
+		}
+	}
+}`
+
+func TestValidate(t *testing.T) {
+	spec, err := openapi.Load([]byte(testDoc))
+	require.NoError(t, err)
+
+	assert.Nil(t, spec.Operation("GET", "/boards/:id/share"))
+	op := spec.Operation("POST", "/boards/:id/share")
+	require.NotNil(t, op)
+	assert.True(t, op.HasBody())
+
+	validID := map[string]string{"id": "7f8c2c1e-3b4a-4c5d-9e6f-0a1b2c3d4e5f"}
+
+	err = spec.Validate(op, openapi.Request{
+		PathParams: validID,
+		Query:      url.Values{"notify": {"true"}},
+		Body:       []byte(`{"email": "a@b.c", "role": "viewer", "positions": [0, 3], "extra": {"x": true}, "unknown": 1}`),
+	})
+	assert.NoError(t, err)
+
+	// Необязательные поля могут быть null
+	err = spec.Validate(op, openapi.Request{PathParams: validID, Body: []byte(`{"email": "a@b.c", "role": "editor", "positions": null}`)})
+	assert.NoError(t, err)
+
+	err = spec.Validate(op, openapi.Request{
+		PathParams: map[string]string{"id": "nope"},
+		Query:      url.Values{"notify": {"maybe"}},
+		Body:       []byte(`{"email": "someone@example.com", "role": "owner", "positions": [1.5, -1], "extra": {"x": "y"}}`),
+	})
+	var validationErr *openapi.ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.ElementsMatch(t, []string{
+		"path parameter id must be a UUID",
+		"query parameter notify must be true or false",
+		"body.email must be at most 10 characters long",
+		"body.role must be one of viewer, editor",
+		"body.positions[0] must be an integer",
+		"body.positions[1] must be at least 0",
+		"body.extra.x must be a boolean",
+	}, validationErr.Problems)
+
+	err = spec.Validate(op, openapi.Request{PathParams: validID, Body: []byte(`{"role": "viewer"}`)})
+	assert.EqualError(t, err, "body.email is required")
+
+	err = spec.Validate(op, openapi.Request{PathParams: validID})
+	assert.EqualError(t, err, "request body is required")
+
+	err = spec.Validate(op, openapi.Request{PathParams: validID, Body: []byte(`[{`)})
+	assert.EqualError(t, err, "request body is not valid JSON")
+}
+
+func TestGeneratedDocument(t *testing.T) {
+	// Сгенерированный документ должен читаться, а все ссылки на схемы разрешаться
+	spec, err := openapi.Load([]byte(docs.SwaggerInfo.ReadDoc()))
+	require.NoError(t, err)
+	require.NotNil(t, spec.Operation("POST", "/boards/:id/share"))
+
+	for name, definition := range spec.Definitions {
+		assertRefsResolve(t, spec, name, definition)
+	}
+	for path, methods := range spec.Paths {
+		for method, op := range methods {
+			for _, p := range op.Parameters {
+				assertRefsResolve(t, spec, method+" "+path, p.Schema)
+			}
+		}
+	}
+}
+
+func assertRefsResolve(t *testing.T, spec *openapi.Spec, where string, schema *openapi.Schema) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		_, ok := spec.Definitions[schema.Ref[len("#/definitions/"):]]
+		assert.True(t, ok, "%s refers to missing %s", where, schema.Ref)
+	}
+	for _, property := range schema.Properties {
+		assertRefsResolve(t, spec, where, property)
+	}
+	for _, part := range schema.AllOf {
+		assertRefsResolve(t, spec, where, part)
+	}
+	assertRefsResolve(t, spec, where, schema.Items)
+	assertRefsResolve(t, spec, where, schema.AdditionalProperties)
+}
@@ -0,0 +1,181 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// body checks a JSON body against the schema of the body parameter
+func (v *validator) body(p *Parameter, body []byte) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		if p.Required {
+			v.fail("request body is required")
+		}
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		v.fail("request body is not valid JSON")
+		return
+	}
+	v.value("body", p.Schema, value)
+}
+
+// value checks a decoded JSON value against a schema
+func (v *validator) value(name string, schema *Schema, value interface{}) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		v.value(name, v.spec.resolve(schema.Ref), value)
+		return
+	}
+	for _, part := range schema.AllOf {
+		v.value(name, part, value)
+	}
+
+	// null обработчики читают как нулевое значение, обязательность проверяет binding
+	if value == nil {
+		return
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			v.fail("%s must be an object", name)
+			return
+		}
+		for _, property := range schema.Required {
+			if _, ok := object[property]; !ok {
+				v.fail("%s.%s is required", name, property)
+			}
+		}
+		for key, item := range object {
+			if property, ok := schema.Properties[key]; ok {
+				v.value(name+"."+key, property, item)
+			} else if schema.AdditionalProperties != nil {
+				v.value(name+"."+key, schema.AdditionalProperties, item)
+			}
+		}
+
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			v.fail("%s must be an array", name)
+			return
+		}
+		if schema.MinItems != nil && len(array) < *schema.MinItems {
+			v.fail("%s must have at least %d items", name, *schema.MinItems)
+		}
+		if schema.MaxItems != nil && len(array) > *schema.MaxItems {
+			v.fail("%s must have at most %d items", name, *schema.MaxItems)
+		}
+		for i, item := range array {
+			v.value(fmt.Sprintf("%s[%d]", name, i), schema.Items, item)
+		}
+
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			v.fail("%s must be a string", name)
+			return
+		}
+		length := utf8.RuneCountInString(s)
+		if schema.MinLength != nil && length < *schema.MinLength {
+			v.fail("%s must be at least %d characters long", name, *schema.MinLength)
+		}
+		if schema.MaxLength != nil && length > *schema.MaxLength {
+			v.fail("%s must be at most %d characters long", name, *schema.MaxLength)
+		}
+		if s != "" {
+			v.checkFormat(name, schema.Format, s)
+		}
+
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if _, err := number.Int64(); schema.Type == "integer" && (!ok || err != nil) {
+			v.fail("%s must be an integer", name)
+			return
+		}
+		if !ok {
+			v.fail("%s must be a number", name)
+			return
+		}
+		v.checkRange(name, number, schema.Minimum, schema.Maximum)
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			v.fail("%s must be a boolean", name)
+			return
+		}
+	}
+
+	if len(schema.Enum) > 0 && !inEnum(schema.Enum, value) {
+		v.fail("%s must be one of %s", name, formatEnum(schema.Enum))
+	}
+}
+
+// resolve returns the definition a local $ref points to
+func (s *Spec) resolve(ref string) *Schema {
+	return s.Definitions[strings.TrimPrefix(ref, "#/definitions/")]
+}
+
+func (v *validator) checkFormat(name, format, value string) {
+	switch format {
+	case "uuid":
+		if _, err := uuid.Parse(value); err != nil {
+			v.fail("%s must be a UUID", name)
+		}
+	case "date":
+		if _, err := time.Parse(time.DateOnly, value); err != nil {
+			v.fail("%s must be a date in the YYYY-MM-DD format", name)
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			v.fail("%s must be an RFC 3339 time", name)
+		}
+	}
+}
+
+func (v *validator) checkRange(name string, number json.Number, minimum, maximum *float64) {
+	f, err := number.Float64()
+	if err != nil {
+		return
+	}
+	if minimum != nil && f < *minimum {
+		v.fail("%s must be at least %v", name, *minimum)
+	}
+	if maximum != nil && f > *maximum {
+		v.fail("%s must be at most %v", name, *maximum)
+	}
+}
+
+// inEnum reports whether the value is one of the enum values; numbers and query strings are
+// compared by their text
+func inEnum(enum []interface{}, value interface{}) bool {
+	text := fmt.Sprint(value)
+	for _, allowed := range enum {
+		if fmt.Sprint(allowed) == text {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(enum []interface{}) string {
+	values := make([]string, len(enum))
+	for i, value := range enum {
+		values[i] = fmt.Sprint(value)
+	}
+	return strings.Join(values, ", ")
+}
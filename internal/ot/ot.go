@@ -0,0 +1,236 @@
+// Package ot implements operational transformation of plain text, so several users can edit
+// the same document at once. Lengths and positions count Unicode code points.
+package ot
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Component is one step of an operation: exactly one of its fields is set
+type Component struct {
+	// Retain skips over that many characters, keeping them
+	Retain int `json:"retain,omitempty"`
+	// Insert inserts the text at the current position
+	Insert string `json:"insert,omitempty"`
+	// Delete removes that many characters
+	Delete int `json:"delete,omitempty"`
+}
+
+// Op is an edit of a whole document: its retains and deletes cover every character of the
+// document it applies to
+type Op []Component
+
+// ErrLengthMismatch is returned when an operation does not fit the document or operation it
+// is applied to or transformed against
+var ErrLengthMismatch = errors.New("operation length does not match the document")
+
+// Validate checks that every component sets exactly one field to a positive length
+func (op Op) Validate() error {
+	for i, c := range op {
+		set := 0
+		if c.Retain != 0 {
+			set++
+		}
+		if c.Insert != "" {
+			set++
+		}
+		if c.Delete != 0 {
+			set++
+		}
+		if set != 1 || c.Retain < 0 || c.Delete < 0 {
+			return fmt.Errorf("component %d must set exactly one of retain, insert or delete", i)
+		}
+		if !utf8.ValidString(c.Insert) {
+			return fmt.Errorf("component %d inserts invalid UTF-8", i)
+		}
+	}
+	return nil
+}
+
+// BaseLen is the length of the documents the operation applies to
+func (op Op) BaseLen() int {
+	n := 0
+	for _, c := range op {
+		n += c.Retain + c.Delete
+	}
+	return n
+}
+
+// TargetLen is the length of the document after the operation
+func (op Op) TargetLen() int {
+	n := 0
+	for _, c := range op {
+		n += c.Retain + utf8.RuneCountInString(c.Insert)
+	}
+	return n
+}
+
+// Noop reports whether the operation leaves the document unchanged
+func (op Op) Noop() bool {
+	for _, c := range op {
+		if c.Retain == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Replace returns the operation that replaces the whole document of length baseLen with text
+func Replace(baseLen int, text string) Op {
+	var b builder
+	b.delete(baseLen)
+	b.insert(text)
+	return b.op
+}
+
+// Apply applies the operation to the document
+func Apply(doc string, op Op) (string, error) {
+	runes := []rune(doc)
+	if op.BaseLen() != len(runes) {
+		return "", ErrLengthMismatch
+	}
+
+	out := make([]rune, 0, op.TargetLen())
+	pos := 0
+	for _, c := range op {
+		switch {
+		case c.Retain > 0:
+			out = append(out, runes[pos:pos+c.Retain]...)
+			pos += c.Retain
+		case c.Insert != "":
+			out = append(out, []rune(c.Insert)...)
+		default:
+			pos += c.Delete
+		}
+	}
+	return string(out), nil
+}
+
+// Transform transforms two concurrent operations on the same document into a' and b', such
+// that applying a then b' gives the same document as applying b then a'. When both insert at
+// the same position, a's text comes first.
+func Transform(a, b Op) (Op, Op, error) {
+	if a.BaseLen() != b.BaseLen() {
+		return nil, nil, ErrLengthMismatch
+	}
+
+	var a1, b1 builder
+	ia, ib := iterator{op: a}, iterator{op: b}
+	for {
+		ca, okA := ia.peek()
+		cb, okB := ib.peek()
+		if !okA && !okB {
+			break
+		}
+
+		// Вставки не зависят от другой операции и пропускаются ею
+		if okA && ca.Insert != "" {
+			a1.insert(ca.Insert)
+			b1.retain(utf8.RuneCountInString(ca.Insert))
+			ia.next(0)
+			continue
+		}
+		if okB && cb.Insert != "" {
+			a1.retain(utf8.RuneCountInString(cb.Insert))
+			b1.insert(cb.Insert)
+			ib.next(0)
+			continue
+		}
+		if !okA || !okB {
+			return nil, nil, ErrLengthMismatch
+		}
+
+		n := min(ca.Retain+ca.Delete, cb.Retain+cb.Delete)
+		switch {
+		case ca.Retain > 0 && cb.Retain > 0:
+			a1.retain(n)
+			b1.retain(n)
+		case ca.Delete > 0 && cb.Retain > 0:
+			a1.delete(n)
+		case ca.Retain > 0 && cb.Delete > 0:
+			b1.delete(n)
+		}
+		// Текст, удалённый обеими операциями, просто пропадает
+		ia.next(n)
+		ib.next(n)
+	}
+	return a1.op, b1.op, nil
+}
+
+// iterator walks the components of an operation, splitting retains and deletes as needed
+type iterator struct {
+	op     Op
+	index  int
+	offset int
+}
+
+// peek returns the rest of the current component
+func (it *iterator) peek() (Component, bool) {
+	if it.index >= len(it.op) {
+		return Component{}, false
+	}
+	c := it.op[it.index]
+	if c.Retain > 0 {
+		c.Retain -= it.offset
+	} else if c.Delete > 0 {
+		c.Delete -= it.offset
+	}
+	return c, true
+}
+
+// next consumes n characters of the current retain or delete, or the whole current insert when n is 0
+func (it *iterator) next(n int) {
+	c := it.op[it.index]
+	it.offset += n
+	if n == 0 || it.offset == c.Retain+c.Delete {
+		it.index++
+		it.offset = 0
+	}
+}
+
+// builder appends components, merging neighbours of the same kind
+type builder struct {
+	op Op
+}
+
+func (b *builder) last() *Component {
+	if len(b.op) == 0 {
+		return nil
+	}
+	return &b.op[len(b.op)-1]
+}
+
+func (b *builder) retain(n int) {
+	if n == 0 {
+		return
+	}
+	if last := b.last(); last != nil && last.Retain > 0 {
+		last.Retain += n
+		return
+	}
+	b.op = append(b.op, Component{Retain: n})
+}
+
+func (b *builder) insert(text string) {
+	if text == "" {
+		return
+	}
+	if last := b.last(); last != nil && last.Insert != "" {
+		last.Insert += text
+		return
+	}
+	b.op = append(b.op, Component{Insert: text})
+}
+
+func (b *builder) delete(n int) {
+	if n == 0 {
+		return
+	}
+	if last := b.last(); last != nil && last.Delete > 0 {
+		last.Delete += n
+		return
+	}
+	b.op = append(b.op, Component{Delete: n})
+}
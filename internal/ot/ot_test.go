@@ -0,0 +1,62 @@
+package ot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApply(t *testing.T) {
+	doc, err := Apply("Привет, мир", Op{{Retain: 8}, {Delete: 3}, {Insert: "world"}})
+	require.NoError(t, err)
+	assert.Equal(t, "Привет, world", doc)
+
+	_, err = Apply("short", Op{{Retain: 6}})
+	assert.Equal(t, ErrLengthMismatch, err)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Op{{Retain: 1}, {Insert: "a"}, {Delete: 2}}.Validate())
+	assert.Error(t, Op{{}}.Validate())
+	assert.Error(t, Op{{Retain: 1, Insert: "a"}}.Validate())
+	assert.Error(t, Op{{Delete: -1}}.Validate())
+}
+
+func TestTransform(t *testing.T) {
+	cases := []struct {
+		name string
+		doc  string
+		a, b Op
+		want string
+	}{
+		{"inserts at the same position", "ac", Op{{Retain: 1}, {Insert: "A"}, {Retain: 1}}, Op{{Retain: 1}, {Insert: "B"}, {Retain: 1}}, "aABc"},
+		{"insert inside a deletion", "abcdef", Op{{Retain: 3}, {Insert: "X"}, {Retain: 3}}, Op{{Retain: 1}, {Delete: 4}, {Retain: 1}}, "aXf"},
+		{"overlapping deletions", "abcdef", Op{{Retain: 1}, {Delete: 3}, {Retain: 2}}, Op{{Retain: 2}, {Delete: 3}, {Retain: 1}}, "af"},
+		{"replace against an edit", "hello", Replace(5, "bye"), Op{{Retain: 5}, {Insert: "!"}}, "bye!"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a1, b1, err := Transform(tc.a, tc.b)
+			require.NoError(t, err)
+
+			// Оба порядка применения сходятся к одному документу
+			afterA, err := Apply(tc.doc, tc.a)
+			require.NoError(t, err)
+			left, err := Apply(afterA, b1)
+			require.NoError(t, err)
+
+			afterB, err := Apply(tc.doc, tc.b)
+			require.NoError(t, err)
+			right, err := Apply(afterB, a1)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.want, left)
+			assert.Equal(t, tc.want, right)
+		})
+	}
+
+	_, _, err := Transform(Op{{Retain: 1}}, Op{{Retain: 2}})
+	assert.Equal(t, ErrLengthMismatch, err)
+}
@@ -0,0 +1,125 @@
+// Package palette derives theme variants of user-chosen colors so that every client
+// renders labels the same way in light and dark mode.
+package palette
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Lightness bounds of dark-mode variants: dark enough for light text on top,
+// light enough to stand out from a dark background
+const (
+	darkMinLightness = 0.30
+	darkMaxLightness = 0.45
+)
+
+// DarkVariant returns the dark-mode variant of a #rgb or #rrggbb color as #rrggbb.
+// The hue is kept, lightness is mirrored into a muted range and saturation slightly
+// reduced. Colors that are not hex codes are returned unchanged.
+func DarkVariant(color string) string {
+	r, g, b, ok := parseHex(color)
+	if !ok {
+		return color
+	}
+
+	h, s, l := toHSL(r, g, b)
+	l = math.Min(math.Max(1-l, darkMinLightness), darkMaxLightness)
+	s *= 0.85
+
+	r, g, b = fromHSL(h, s, l)
+	return fmt.Sprintf("#%02x%02x%02x", toByte(r), toByte(g), toByte(b))
+}
+
+// IsHex reports whether color is a #rgb or #rrggbb hex code
+func IsHex(color string) bool {
+	_, _, _, ok := parseHex(color)
+	return ok
+}
+
+func parseHex(color string) (r, g, b float64, ok bool) {
+	hex, found := strings.CutPrefix(strings.TrimSpace(color), "#")
+	if !found {
+		return 0, 0, 0, false
+	}
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return float64(value>>16&0xff) / 255, float64(value>>8&0xff) / 255, float64(value&0xff) / 255, true
+}
+
+func toHSL(r, g, b float64) (h, s, l float64) {
+	maxC := math.Max(r, math.Max(g, b))
+	minC := math.Min(r, math.Min(g, b))
+	l = (maxC + minC) / 2
+	if maxC == minC {
+		return 0, 0, l
+	}
+
+	d := maxC - minC
+	if l > 0.5 {
+		s = d / (2 - maxC - minC)
+	} else {
+		s = d / (maxC + minC)
+	}
+
+	switch maxC {
+	case r:
+		h = (g - b) / d
+		if g < b {
+			h += 6
+		}
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h / 6, s, l
+}
+
+func fromHSL(h, s, l float64) (r, g, b float64) {
+	if s == 0 {
+		return l, l, l
+	}
+
+	var q float64
+	if l < 0.5 {
+		q = l * (1 + s)
+	} else {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	return hueToRGB(p, q, h+1.0/3), hueToRGB(p, q, h), hueToRGB(p, q, h-1.0/3)
+}
+
+func hueToRGB(p, q, t float64) float64 {
+	if t < 0 {
+		t++
+	}
+	if t > 1 {
+		t--
+	}
+	switch {
+	case t < 1.0/6:
+		return p + (q-p)*6*t
+	case t < 1.0/2:
+		return q
+	case t < 2.0/3:
+		return p + (q-p)*(2.0/3-t)*6
+	}
+	return p
+}
+
+func toByte(v float64) int {
+	return int(math.Round(v * 255))
+}
@@ -0,0 +1,31 @@
+package palette
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDarkVariant(t *testing.T) {
+	// Светлые цвета затемняются, темные осветляются, оттенок сохраняется
+	assert.Equal(t, "#ae1d1d", DarkVariant("#ef4444"))
+	assert.Equal(t, "#ae1d1d", DarkVariant("#EF4444"))
+	assert.Equal(t, DarkVariant("#ffcc00"), DarkVariant("#fc0"))
+
+	assert.Equal(t, "#737373", DarkVariant("#000000"))
+	assert.Equal(t, "#4d4d4d", DarkVariant("#ffffff"))
+
+	// Не-hex значения возвращаются без изменений
+	assert.Equal(t, "red", DarkVariant("red"))
+	assert.Equal(t, "#12345", DarkVariant("#12345"))
+}
+
+func TestIsHex(t *testing.T) {
+	assert.True(t, IsHex("#4caf50"))
+	assert.True(t, IsHex("#FC0"))
+
+	assert.False(t, IsHex(""))
+	assert.False(t, IsHex("4caf50"))
+	assert.False(t, IsHex("#12345"))
+	assert.False(t, IsHex("#ggghhh"))
+}
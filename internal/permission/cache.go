@@ -0,0 +1,92 @@
+package permission
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache stores entries made of named fields. An entry expires with all its fields a TTL after
+// it was created, so setting more fields never extends the life of older ones.
+type Cache interface {
+	// Get returns a field of an entry, reporting false if it is not cached
+	Get(ctx context.Context, key, field string) ([]byte, bool, error)
+	// Set stores a field of an entry, creating the entry with the given TTL if needed
+	Set(ctx context.Context, key, field string, value []byte, ttl time.Duration) error
+	// Delete drops entries with all their fields
+	Delete(ctx context.Context, keys ...string) error
+}
+
+type memoryEntry struct {
+	fields  map[string][]byte
+	expires time.Time
+}
+
+// MemoryCache keeps entries in process memory. Other instances do not see its invalidations,
+// so with several instances changes may take up to the TTL to apply everywhere.
+type MemoryCache struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]*memoryEntry
+	lastSweep time.Time
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		now:     time.Now,
+		entries: make(map[string]*memoryEntry),
+	}
+}
+
+func (m *MemoryCache) Get(_ context.Context, key, field string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok || !m.now().Before(entry.expires) {
+		return nil, false, nil
+	}
+	value, ok := entry.fields[field]
+	return value, ok, nil
+}
+
+func (m *MemoryCache) Set(_ context.Context, key, field string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now, ttl)
+
+	entry, ok := m.entries[key]
+	if !ok || !now.Before(entry.expires) {
+		entry = &memoryEntry{fields: make(map[string][]byte), expires: now.Add(ttl)}
+		m.entries[key] = entry
+	}
+	entry.fields[field] = value
+	return nil
+}
+
+func (m *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, key := range keys {
+		delete(m.entries, key)
+	}
+	return nil
+}
+
+// sweep drops expired entries, at most once per TTL
+func (m *MemoryCache) sweep(now time.Time, ttl time.Duration) {
+	if now.Sub(m.lastSweep) < ttl {
+		return
+	}
+	m.lastSweep = now
+
+	for key, entry := range m.entries {
+		if !now.Before(entry.expires) {
+			delete(m.entries, key)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package permission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCache_EntryExpiresWithAllFields(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache()
+	cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	assert.NoError(t, cache.Set(ctx, "board:1", "board", []byte("a"), 30*time.Second))
+
+	// Новое поле не продлевает жизнь записи
+	now = now.Add(20 * time.Second)
+	assert.NoError(t, cache.Set(ctx, "board:1", "access:2", []byte("b"), 30*time.Second))
+
+	value, ok, err := cache.Get(ctx, "board:1", "access:2")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("b"), value)
+
+	now = now.Add(10 * time.Second)
+	for _, field := range []string{"board", "access:2"} {
+		_, ok, err = cache.Get(ctx, "board:1", field)
+		assert.NoError(t, err)
+		assert.False(t, ok, field)
+	}
+}
+
+func TestMemoryCache_DeleteDropsAllFields(t *testing.T) {
+	cache := NewMemoryCache()
+	ctx := context.Background()
+
+	assert.NoError(t, cache.Set(ctx, "board:1", "board", []byte("a"), time.Minute))
+	assert.NoError(t, cache.Set(ctx, "board:1", "access:2", []byte("b"), time.Minute))
+	assert.NoError(t, cache.Set(ctx, "column:3", "column", []byte("c"), time.Minute))
+
+	assert.NoError(t, cache.Delete(ctx, "board:1"))
+
+	_, ok, _ := cache.Get(ctx, "board:1", "access:2")
+	assert.False(t, ok)
+	_, ok, _ = cache.Get(ctx, "column:3", "column")
+	assert.True(t, ok)
+}
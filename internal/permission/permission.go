@@ -0,0 +1,195 @@
+// Package permission answers the access checks of task requests from a short-lived cache of
+// board membership and board and column metadata, so resolving a task's board and the user's
+// role on it does not take a query per step. It also caches whether accounts are active, which
+// every authenticated request checks.
+package permission
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+)
+
+// Fields of the cached entry of a board: the board itself and the access of each user
+const (
+	boardField        = "board"
+	accessFieldPrefix = "access:"
+	columnField       = "column"
+	activeField       = "active"
+)
+
+// Service looks up boards, columns and board access through the cache. Handlers that change
+// boards, their members or columns invalidate the affected entries; the TTL bounds how long
+// changes made elsewhere, e.g. by other instances with a memory cache, take to apply.
+type Service struct {
+	boardRepo      repository.BoardRepositoryInterface
+	columnRepo     repository.ColumnRepositoryInterface
+	boardShareRepo repository.BoardShareRepositoryInterface
+	userRepo       *repository.UserRepository
+	// cache is nil when caching is disabled
+	cache Cache
+	ttl   time.Duration
+}
+
+func NewService(
+	boardRepo repository.BoardRepositoryInterface,
+	columnRepo repository.ColumnRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	userRepo *repository.UserRepository,
+	cache Cache,
+	ttl time.Duration,
+) *Service {
+	return &Service{
+		boardRepo:      boardRepo,
+		columnRepo:     columnRepo,
+		boardShareRepo: boardShareRepo,
+		userRepo:       userRepo,
+		cache:          cache,
+		ttl:            ttl,
+	}
+}
+
+// GetBoard retrieves a board like BoardRepository.GetByID
+func (s *Service) GetBoard(ctx context.Context, id uuid.UUID) (*model.Board, error) {
+	var board model.Board
+	if s.get(ctx, boardKey(id), boardField, &board) {
+		return &board, nil
+	}
+
+	found, err := s.boardRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.set(ctx, boardKey(id), boardField, found)
+	return found, nil
+}
+
+// GetColumn retrieves a column like ColumnRepository.GetByID, nil if it does not exist
+func (s *Service) GetColumn(ctx context.Context, id uuid.UUID) (*model.Column, error) {
+	var column model.Column
+	if s.get(ctx, columnKey(id), columnField, &column) {
+		return &column, nil
+	}
+
+	found, err := s.columnRepo.GetByID(ctx, id)
+	if err != nil || found == nil {
+		return found, err
+	}
+	s.set(ctx, columnKey(id), columnField, found)
+	return found, nil
+}
+
+// CheckAccess reports whether the user has the required role or higher on the board, like
+// BoardShareRepository.CheckAccess
+func (s *Service) CheckAccess(ctx context.Context, boardID, userID uuid.UUID, requiredRole string) (bool, error) {
+	field := accessFieldPrefix + userID.String()
+
+	var access repository.BoardAccess
+	if s.get(ctx, boardKey(boardID), field, &access) {
+		return access.Allows(userID, requiredRole), nil
+	}
+
+	found, err := s.boardShareRepo.GetAccess(ctx, boardID, userID)
+	if err != nil || found == nil {
+		return false, err
+	}
+	s.set(ctx, boardKey(boardID), field, found)
+	return found.Allows(userID, requiredRole), nil
+}
+
+// UserActive reports whether the user exists and is not disabled
+func (s *Service) UserActive(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var active bool
+	if s.get(ctx, userKey(userID), activeField, &active) {
+		return active, nil
+	}
+
+	active, err := s.userRepo.IsActive(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	s.set(ctx, userKey(userID), activeField, active)
+	return active, nil
+}
+
+// InvalidateBoard drops a board and the access of all users to it from the cache. Call it after
+// changing the board, its owner or its members.
+func (s *Service) InvalidateBoard(ctx context.Context, boardIDs ...uuid.UUID) {
+	keys := make([]string, len(boardIDs))
+	for i, id := range boardIDs {
+		keys[i] = boardKey(id)
+	}
+	s.delete(ctx, keys)
+}
+
+// InvalidateColumns drops columns from the cache. Call it after changing, moving or deleting them.
+func (s *Service) InvalidateColumns(ctx context.Context, columnIDs ...uuid.UUID) {
+	keys := make([]string, len(columnIDs))
+	for i, id := range columnIDs {
+		keys[i] = columnKey(id)
+	}
+	s.delete(ctx, keys)
+}
+
+// InvalidateUser drops the account status of users from the cache. Call it after disabling,
+// enabling or deleting them.
+func (s *Service) InvalidateUser(ctx context.Context, userIDs ...uuid.UUID) {
+	keys := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		keys[i] = userKey(id)
+	}
+	s.delete(ctx, keys)
+}
+
+// get reads a cached value into dest. Cache errors count as misses, the database has the answer.
+func (s *Service) get(ctx context.Context, key, field string, dest interface{}) bool {
+	if s.cache == nil {
+		return false
+	}
+	value, ok, err := s.cache.Get(ctx, key, field)
+	if err != nil || !ok {
+		return false
+	}
+	return json.Unmarshal(value, dest) == nil
+}
+
+func (s *Service) set(ctx context.Context, key, field string, value interface{}) {
+	if s.cache == nil {
+		return
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return
+	}
+	if err := s.cache.Set(ctx, key, field, encoded, s.ttl); err != nil {
+		log.Printf("⚠️  Failed to cache %s: %v", key, err)
+	}
+}
+
+func (s *Service) delete(ctx context.Context, keys []string) {
+	if s.cache == nil || len(keys) == 0 {
+		return
+	}
+	// Без инвалидации изменения применятся только по истечении TTL
+	if err := s.cache.Delete(ctx, keys...); err != nil {
+		log.Printf("⚠️  Failed to invalidate %v, changes apply within %s: %v", keys, s.ttl, err)
+	}
+}
+
+func boardKey(id uuid.UUID) string {
+	return "board:" + id.String()
+}
+
+func columnKey(id uuid.UUID) string {
+	return "column:" + id.String()
+}
+
+func userKey(id uuid.UUID) string {
+	return "user:" + id.String()
+}
@@ -0,0 +1,55 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// setFieldScript sets a field of an entry stored as a hash and gives a new entry its TTL
+var setFieldScript = redis.NewScript(`
+local created = redis.call("EXISTS", KEYS[1]) == 0
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+if created then
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+end
+return 0
+`)
+
+// RedisCache keeps entries in Redis so invalidations apply to all instances at once
+type RedisCache struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedisCache(client *redis.Client, prefix string) *RedisCache {
+	return &RedisCache{client: client, prefix: prefix}
+}
+
+func (r *RedisCache) Get(ctx context.Context, key, field string) ([]byte, bool, error) {
+	value, err := r.client.HGet(ctx, r.prefix+key, field).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (r *RedisCache) Set(ctx context.Context, key, field string, value []byte, ttl time.Duration) error {
+	return setFieldScript.Run(ctx, r.client, []string{r.prefix + key}, field, value, max(ttl.Milliseconds(), 1)).Err()
+}
+
+func (r *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = r.prefix + key
+	}
+	return r.client.Del(ctx, prefixed...).Err()
+}
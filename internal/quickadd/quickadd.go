@@ -0,0 +1,103 @@
+// Package quickadd parses a single line of quick task entry such as
+// "Fix login bug #backend @anna !high due:fri" into a task title and its attributes.
+package quickadd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry is a parsed quick-add line
+type Entry struct {
+	// Title is the text left after taking out the recognized tokens
+	Title string
+	// Labels are the names given as #name, distinct case-insensitively, in order of appearance
+	Labels []string
+	// Assignee is the first @handle without the "@": a username or an email address
+	Assignee string
+	// Priority is given as !low, !medium, !high or !urgent
+	Priority string
+	// DueDate is given as due:<date> and is midnight UTC of the day
+	DueDate *time.Time
+}
+
+var priorities = map[string]bool{"low": true, "medium": true, "high": true, "urgent": true}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// Parse splits line into the title and the attributes given by tokens. Relative due dates are
+// counted from the UTC day of now. Tokens that are not recognized, including a second @handle,
+// stay in the title. It fails only on a due date it cannot read.
+func Parse(line string, now time.Time) (Entry, error) {
+	var entry Entry
+	var title []string
+	seen := make(map[string]bool)
+
+	for _, token := range strings.Fields(line) {
+		switch {
+		case len(token) > 1 && token[0] == '#':
+			name := token[1:]
+			if key := strings.ToLower(name); !seen[key] {
+				seen[key] = true
+				entry.Labels = append(entry.Labels, name)
+			}
+		case len(token) > 1 && token[0] == '@' && entry.Assignee == "":
+			entry.Assignee = token[1:]
+		case len(token) > 1 && token[0] == '!' && priorities[strings.ToLower(token[1:])]:
+			entry.Priority = strings.ToLower(token[1:])
+		case len(token) > 4 && strings.EqualFold(token[:4], "due:"):
+			due, err := parseDate(token[4:], now)
+			if err != nil {
+				return Entry{}, err
+			}
+			entry.DueDate = &due
+		default:
+			title = append(title, token)
+		}
+	}
+
+	entry.Title = strings.Join(title, " ")
+	return entry, nil
+}
+
+// parseDate reads today, tomorrow, a weekday (the nearest one, today included), a number of days
+// ahead such as +3d, or a date in YYYY-MM-DD format
+func parseDate(value string, now time.Time) (time.Time, error) {
+	today := now.UTC().Truncate(24 * time.Hour)
+	value = strings.ToLower(value)
+
+	switch value {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	}
+
+	if weekday, ok := weekdays[value]; ok {
+		ahead := (int(weekday) - int(today.Weekday()) + 7) % 7
+		return today.AddDate(0, 0, ahead), nil
+	}
+
+	if strings.HasPrefix(value, "+") && strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(value[1 : len(value)-1])
+		if err == nil && days >= 0 && days <= 3650 {
+			return today.AddDate(0, 0, days), nil
+		}
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unknown due date %q, use today, tomorrow, a weekday, +Nd or YYYY-MM-DD", value)
+	}
+	return date, nil
+}
@@ -0,0 +1,57 @@
+package quickadd_test
+
+import (
+	"testing"
+	"time"
+
+	"kanban/internal/quickadd"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Среда, 14 октября 2026
+var now = time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+func TestParse(t *testing.T) {
+	entry, err := quickadd.Parse("Fix login bug #backend @anna !high due:fri #Backend ask @bob", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Fix login bug ask @bob", entry.Title)
+	assert.Equal(t, []string{"backend"}, entry.Labels)
+	assert.Equal(t, "anna", entry.Assignee)
+	assert.Equal(t, "high", entry.Priority)
+	require.NotNil(t, entry.DueDate)
+	assert.Equal(t, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), *entry.DueDate)
+}
+
+func TestParse_DueDates(t *testing.T) {
+	for value, want := range map[string]time.Time{
+		"today":      time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+		"Tomorrow":   time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC),
+		"wed":        time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC),
+		"monday":     time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		"+10d":       time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC),
+		"2026-12-31": time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC),
+	} {
+		entry, err := quickadd.Parse("Task due:"+value, now)
+		require.NoError(t, err, value)
+		require.NotNil(t, entry.DueDate, value)
+		assert.Equal(t, want, *entry.DueDate, value)
+	}
+
+	_, err := quickadd.Parse("Task due:someday", now)
+	assert.Error(t, err)
+}
+
+func TestParse_KeepsUnknownTokens(t *testing.T) {
+	// Неизвестный приоритет и одиночные символы остаются в названии
+	entry, err := quickadd.Parse("Deploy ! # @ !asap", now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Deploy ! # @ !asap", entry.Title)
+	assert.Empty(t, entry.Labels)
+	assert.Empty(t, entry.Assignee)
+	assert.Empty(t, entry.Priority)
+	assert.Nil(t, entry.DueDate)
+}
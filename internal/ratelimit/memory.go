@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// MemoryLimiter keeps buckets in process memory. It is suitable for a single instance only.
+type MemoryLimiter struct {
+	limit Limit
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func NewMemoryLimiter(limit Limit) *MemoryLimiter {
+	return &MemoryLimiter{
+		limit:   limit,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string) (bool, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	interval := l.limit.refillInterval()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+
+	// Пополняем корзину за прошедшее время
+	b.tokens += float64(now.Sub(b.last)) / float64(interval)
+	if b.tokens > float64(l.limit.Burst) {
+		b.tokens = float64(l.limit.Burst)
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+
+	retryAfter := time.Duration((1 - b.tokens) * float64(interval))
+	return false, retryAfter, nil
+}
+
+// sweep drops buckets that have been idle long enough to be full again
+func (l *MemoryLimiter) sweep(now time.Time) {
+	fullAfter := l.limit.refillInterval() * time.Duration(l.limit.Burst)
+	if now.Sub(l.lastSweep) < fullAfter {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= fullAfter {
+			delete(l.buckets, key)
+		}
+	}
+}
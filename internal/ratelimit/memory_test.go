@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryLimiter_BurstThenRefill(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter(Limit{PerMinute: 60, Burst: 2})
+	limiter.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	// Два запроса укладываются в burst
+	for i := 0; i < 2; i++ {
+		allowed, _, err := limiter.Allow(ctx, "ip:1.2.3.4")
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	}
+
+	// Третий отклоняется, повторить можно через секунду
+	allowed, retryAfter, err := limiter.Allow(ctx, "ip:1.2.3.4")
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// Другой ключ имеет свою корзину
+	allowed, _, _ = limiter.Allow(ctx, "ip:5.6.7.8")
+	assert.True(t, allowed)
+
+	// Через секунду появляется один токен
+	now = now.Add(time.Second)
+	allowed, _, _ = limiter.Allow(ctx, "ip:1.2.3.4")
+	assert.True(t, allowed)
+	allowed, _, _ = limiter.Allow(ctx, "ip:1.2.3.4")
+	assert.False(t, allowed)
+}
+
+func TestMemoryLimiter_SweepsIdleBuckets(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter(Limit{PerMinute: 60, Burst: 1})
+	limiter.now = func() time.Time { return now }
+
+	_, _, _ = limiter.Allow(context.Background(), "user:a")
+	now = now.Add(time.Minute)
+	_, _, _ = limiter.Allow(context.Background(), "user:b")
+
+	assert.NotContains(t, limiter.buckets, "user:a")
+	assert.Contains(t, limiter.buckets, "user:b")
+}
+
+func TestReloadable_RebuildsOnLimitChange(t *testing.T) {
+	limit := Limit{PerHour: 1, Burst: 1}
+	limiter := NewReloadable(func() Limit { return limit }, func(limit Limit) Limiter {
+		return NewMemoryLimiter(limit)
+	})
+	ctx := context.Background()
+
+	allowed, _, err := limiter.Allow(ctx, "user:1")
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	allowed, _, _ = limiter.Allow(ctx, "user:1")
+	assert.False(t, allowed)
+
+	// Новый лимит действует сразу, без перезапуска
+	limit = Limit{PerHour: 10, Burst: 3}
+	for i := 0; i < 3; i++ {
+		allowed, _, err = limiter.Allow(ctx, "user:1")
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	}
+	assert.Equal(t, limit, limiter.Limit())
+}
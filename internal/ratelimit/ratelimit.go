@@ -0,0 +1,34 @@
+// Package ratelimit implements token bucket rate limiting with in-memory and Redis backends.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit describes a token bucket: Burst tokens at most, refilled at PerMinute tokens per minute.
+// Slow buckets for expensive operations set PerHour instead and leave PerMinute at zero.
+type Limit struct {
+	PerMinute int `json:"per_minute"`
+	PerHour   int `json:"per_hour"`
+	Burst     int `json:"burst"`
+}
+
+// Valid reports whether the bucket holds tokens and refills
+func (l Limit) Valid() bool {
+	return l.Burst > 0 && l.PerMinute >= 0 && l.PerHour >= 0 && (l.PerMinute > 0 || l.PerHour > 0)
+}
+
+// refillInterval is the time it takes to regain one token
+func (l Limit) refillInterval() time.Duration {
+	if l.PerMinute == 0 && l.PerHour > 0 {
+		return time.Hour / time.Duration(l.PerHour)
+	}
+	return time.Minute / time.Duration(l.PerMinute)
+}
+
+// Limiter takes a token from the bucket identified by key. When the bucket is
+// empty it reports how long the caller should wait before retrying.
+type Limiter interface {
+	Allow(ctx context.Context, key string) (allowed bool, retryAfter time.Duration, err error)
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript atomically refills and takes a token from a bucket stored as a hash.
+// Returns {allowed (0/1), milliseconds until the next token}.
+var tokenBucketScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "last")
+local tokens = tonumber(state[1]) or burst
+local last = tonumber(state[2]) or now
+
+tokens = math.min(burst, tokens + (now - last) / interval)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * interval)
+end
+
+redis.call("HSET", KEYS[1], "tokens", tokens, "last", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * interval))
+return {allowed, wait}
+`)
+
+// RedisLimiter keeps buckets in Redis so limits are shared between instances
+type RedisLimiter struct {
+	client *redis.Client
+	limit  Limit
+	prefix string
+}
+
+func NewRedisLimiter(client *redis.Client, limit Limit, prefix string) *RedisLimiter {
+	return &RedisLimiter{client: client, limit: limit, prefix: prefix}
+}
+
+func (l *RedisLimiter) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	interval := l.limit.refillInterval().Milliseconds()
+	if interval < 1 {
+		interval = 1
+	}
+
+	result, err := tokenBucketScript.Run(ctx, l.client,
+		[]string{l.prefix + key},
+		l.limit.Burst, interval, time.Now().UnixMilli(),
+	).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
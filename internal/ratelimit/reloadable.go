@@ -0,0 +1,42 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Reloadable is a Limiter whose limit can change while the server runs. It builds a new limiter
+// when the limit returned by its source changes; in-memory buckets start full again then.
+type Reloadable struct {
+	source func() Limit
+	build  func(limit Limit) Limiter
+
+	mu      sync.Mutex
+	limit   Limit
+	limiter Limiter
+}
+
+func NewReloadable(source func() Limit, build func(limit Limit) Limiter) *Reloadable {
+	return &Reloadable{source: source, build: build}
+}
+
+// Limit returns the current limit
+func (r *Reloadable) Limit() Limit {
+	return r.source()
+}
+
+func (r *Reloadable) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	return r.current().Allow(ctx, key)
+}
+
+func (r *Reloadable) current() Limiter {
+	limit := r.source()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.limiter == nil || limit != r.limit {
+		r.limit, r.limiter = limit, r.build(limit)
+	}
+	return r.limiter
+}
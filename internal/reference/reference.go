@@ -0,0 +1,45 @@
+// Package reference detects task references such as "#12" or "PROJ-12" in free text.
+package reference
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Ref is a single task reference found in text
+type Ref struct {
+	// BoardKey is empty for board-local references like "#12"
+	BoardKey string
+	Number   int
+	// Token is the reference exactly as written
+	Token string
+}
+
+var refPattern = regexp.MustCompile(`(?:^|[^\w#-])(?:#|([A-Z][A-Z0-9]{1,9})-)(\d{1,9})\b`)
+
+// Parse returns the distinct task references in text in order of first appearance
+func Parse(text string) []Ref {
+	var refs []Ref
+	seen := make(map[string]struct{})
+
+	for _, match := range refPattern.FindAllStringSubmatch(text, -1) {
+		number, err := strconv.Atoi(match[2])
+		if err != nil || number <= 0 {
+			continue
+		}
+
+		ref := Ref{BoardKey: match[1], Number: number}
+		if ref.BoardKey == "" {
+			ref.Token = "#" + match[2]
+		} else {
+			ref.Token = ref.BoardKey + "-" + match[2]
+		}
+
+		if _, ok := seen[ref.Token]; ok {
+			continue
+		}
+		seen[ref.Token] = struct{}{}
+		refs = append(refs, ref)
+	}
+	return refs
+}
@@ -0,0 +1,30 @@
+package reference_test
+
+import (
+	"testing"
+
+	"kanban/internal/reference"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	refs := reference.Parse("Blocked by #12 and PROJ-7, see also #12 (dup) and OPS-300.")
+
+	assert.Equal(t, []reference.Ref{
+		{Number: 12, Token: "#12"},
+		{BoardKey: "PROJ", Number: 7, Token: "PROJ-7"},
+		{BoardKey: "OPS", Number: 300, Token: "OPS-300"},
+	}, refs)
+}
+
+func TestParse_IgnoresNonReferences(t *testing.T) {
+	// Номера внутри слов, нулевые номера и ключи в нижнем регистре не считаются ссылками
+	refs := reference.Parse("issue#5 proj-5 PROJ-0 ##3 X-1 sha-256 color: #ffcc00")
+
+	assert.Empty(t, refs)
+}
+
+func TestParse_Empty(t *testing.T) {
+	assert.Empty(t, reference.Parse(""))
+}
@@ -0,0 +1,75 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"kanban/internal/tracing"
+)
+
+// MaxPDFBytes limits the documents accepted from the rendering service
+const MaxPDFBytes = 20 << 20
+
+// PDFRenderer turns an HTML page into a PDF document
+type PDFRenderer interface {
+	RenderPDF(ctx context.Context, html []byte) ([]byte, error)
+}
+
+// HTTPRenderer renders PDFs with an HTML-to-PDF service such as Gotenberg: the page is posted
+// to the service URL as the index.html file of a multipart form and the response is the PDF.
+type HTTPRenderer struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPRenderer(url string) *HTTPRenderer {
+	return &HTTPRenderer{
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second, Transport: tracing.Transport(http.DefaultTransport)},
+	}
+}
+
+func (r *HTTPRenderer) RenderPDF(ctx context.Context, html []byte) ([]byte, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	file, err := form.CreateFormFile("files", "index.html")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Write(html); err != nil {
+		return nil, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rendering service returned %s", resp.Status)
+	}
+
+	pdf, err := io.ReadAll(io.LimitReader(resp.Body, MaxPDFBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(pdf) > MaxPDFBytes {
+		return nil, fmt.Errorf("rendered PDF exceeds %d bytes", MaxPDFBytes)
+	}
+	return pdf, nil
+}
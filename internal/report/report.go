@@ -0,0 +1,201 @@
+// Package report lays out a board as a printable report for stakeholders: the tasks of each
+// column, the overdue tasks and a few figures. Reports are written as HTML and can be turned
+// into PDF by an HTML-to-PDF rendering service.
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+)
+
+// RecentDays is the period, ending when the report is generated, of the "completed recently" figure
+const RecentDays = 7
+
+// Report is a board laid out for printing
+type Report struct {
+	BoardTitle  string
+	GeneratedAt time.Time
+	Stats       Stats
+	// Overdue lists the open tasks past their due date, the most overdue first
+	Overdue []Task
+	Columns []Column
+}
+
+// Stats are the figures at the top of a report
+type Stats struct {
+	Total int
+	Open  int
+	// Completed counts the completed tasks and the tasks in done columns
+	Completed int
+	// CompletedRecently counts the tasks completed in the last RecentDays days
+	CompletedRecently int
+	Overdue           int
+	Blocked           int
+	Unassigned        int
+}
+
+// Column is a column with its tasks in board order
+type Column struct {
+	Title  string
+	IsDone bool
+	Tasks  []Task
+}
+
+// Task is a line of a report
+type Task struct {
+	Key      string
+	Title    string
+	Column   string
+	Assignee string
+	Priority string
+	DueDate  *time.Time
+	Done     bool
+	Overdue  bool
+	Blocked  bool
+}
+
+// Build lays out the board's tasks by column as of now. Tasks need Column and Assignee loaded
+// and come in board order; tasks of columns not listed are left out.
+func Build(board *model.Board, columns []model.Column, tasks []model.Task, now time.Time) *Report {
+	report := &Report{BoardTitle: board.Title, GeneratedAt: now, Columns: make([]Column, len(columns))}
+	index := make(map[uuid.UUID]int, len(columns))
+	for i, column := range columns {
+		report.Columns[i] = Column{Title: column.Title, IsDone: column.IsDone}
+		index[column.ID] = i
+	}
+
+	recent := now.AddDate(0, 0, -RecentDays)
+	for i := range tasks {
+		task := &tasks[i]
+		column, ok := index[task.ColumnID]
+		if !ok {
+			continue
+		}
+
+		item := Task{
+			Key:      fmt.Sprintf("%s-%d", board.Key, task.Number),
+			Title:    task.Title,
+			Column:   task.Column.Title,
+			Priority: task.Priority,
+			DueDate:  task.DueDate,
+			Done:     task.CompletedAt != nil || task.Column.IsDone,
+			Blocked:  task.Blocked,
+		}
+		if task.AssignedTo != nil {
+			item.Assignee = task.Assignee.Name
+		}
+		item.Overdue = !item.Done && task.Overdue(now)
+
+		report.Stats.Total++
+		switch {
+		case item.Done:
+			report.Stats.Completed++
+			if task.CompletedAt != nil && task.CompletedAt.After(recent) {
+				report.Stats.CompletedRecently++
+			}
+		default:
+			report.Stats.Open++
+			if item.Blocked {
+				report.Stats.Blocked++
+			}
+			if task.AssignedTo == nil {
+				report.Stats.Unassigned++
+			}
+		}
+		if item.Overdue {
+			report.Stats.Overdue++
+			report.Overdue = append(report.Overdue, item)
+		}
+
+		report.Columns[column].Tasks = append(report.Columns[column].Tasks, item)
+	}
+
+	// Самые просроченные задачи первыми
+	sort.SliceStable(report.Overdue, func(i, j int) bool {
+		return report.Overdue[i].DueDate.Before(*report.Overdue[j].DueDate)
+	})
+	return report
+}
+
+// WriteHTML writes the report as a standalone HTML page styled for printing
+func WriteHTML(w io.Writer, report *Report) error {
+	return htmlTemplate.Execute(w, report)
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"date": func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("Jan 2, 2006")
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.BoardTitle}} report</title>
+<style>
+@page { size: A4; margin: 16mm; }
+body { font-family: system-ui, sans-serif; font-size: 11pt; color: #1f2328; margin: 0; }
+h1 { margin: 0; }
+h2 { border-bottom: 1px solid #d0d7de; padding-bottom: .2rem; margin-top: 1.5rem; break-after: avoid; }
+.generated { color: #656d76; margin: .2rem 0 1rem; }
+.stats { display: flex; flex-wrap: wrap; gap: .5rem; }
+.stat { border: 1px solid #d0d7de; border-radius: 6px; padding: .4rem .8rem; min-width: 6rem; }
+.stat b { display: block; font-size: 1.4em; }
+table { width: 100%; border-collapse: collapse; }
+tr { break-inside: avoid; }
+th, td { text-align: left; padding: .3rem .4rem; border-bottom: 1px solid #eaeef2; vertical-align: top; }
+th { font-size: .85em; color: #656d76; }
+.key { white-space: nowrap; color: #656d76; }
+.done td { color: #656d76; }
+.overdue { color: #cf222e; }
+.empty { color: #656d76; font-style: italic; }
+</style>
+</head>
+<body>
+<h1>{{.BoardTitle}}</h1>
+<p class="generated">Generated {{.GeneratedAt.Format "Jan 2, 2006 15:04 MST"}}</p>
+<div class="stats">
+<div class="stat"><b>{{.Stats.Total}}</b>tasks</div>
+<div class="stat"><b>{{.Stats.Open}}</b>open</div>
+<div class="stat"><b>{{.Stats.Completed}}</b>done</div>
+<div class="stat"><b>{{.Stats.CompletedRecently}}</b>done in 7 days</div>
+<div class="stat"><b>{{.Stats.Overdue}}</b>overdue</div>
+<div class="stat"><b>{{.Stats.Blocked}}</b>blocked</div>
+<div class="stat"><b>{{.Stats.Unassigned}}</b>unassigned</div>
+</div>
+<h2 class="overdue">Overdue ({{len .Overdue}})</h2>
+{{- if .Overdue}}
+<table>
+<tr><th>Task</th><th>Title</th><th>Column</th><th>Assignee</th><th>Due</th></tr>
+{{- range .Overdue}}
+<tr><td class="key">{{.Key}}</td><td>{{.Title}}</td><td>{{.Column}}</td><td>{{.Assignee}}</td><td class="overdue">{{date .DueDate}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p class="empty">No overdue tasks</p>
+{{- end}}
+{{- range .Columns}}
+<h2>{{.Title}} ({{len .Tasks}})</h2>
+{{- if .Tasks}}
+<table>
+<tr><th>Task</th><th>Title</th><th>Assignee</th><th>Priority</th><th>Due</th></tr>
+{{- range .Tasks}}
+<tr{{if .Done}} class="done"{{end}}><td class="key">{{.Key}}</td><td>{{.Title}}{{if .Blocked}} (blocked){{end}}</td><td>{{.Assignee}}</td><td>{{.Priority}}</td><td{{if .Overdue}} class="overdue"{{end}}>{{date .DueDate}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p class="empty">No tasks</p>
+{{- end}}
+{{- end}}
+</body>
+</html>
+`))
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	now := time.Date(2026, 5, 15, 12, 0, 0, 0, time.UTC)
+	day := func(offset int) *time.Time {
+		date := time.Date(2026, 5, 15+offset, 0, 0, 0, 0, time.UTC)
+		return &date
+	}
+	assignee := uuid.New()
+
+	board := &model.Board{Title: "Ops", Key: "OPS"}
+	todo := model.Column{ID: uuid.New(), Title: "To Do"}
+	done := model.Column{ID: uuid.New(), Title: "Done", IsDone: true}
+	tasks := []model.Task{
+		{ColumnID: todo.ID, Column: todo, Number: 1, Title: "Late", DueDate: day(-1), Priority: "high"},
+		{ColumnID: todo.ID, Column: todo, Number: 2, Title: "Later", DueDate: day(-5), Blocked: true,
+			AssignedTo: &assignee, Assignee: model.User{Name: "Anna"}},
+		{ColumnID: todo.ID, Column: todo, Number: 3, Title: "Upcoming", DueDate: day(3)},
+		{ColumnID: done.ID, Column: done, Number: 4, Title: "Shipped", DueDate: day(-3), CompletedAt: day(-2)},
+		{ColumnID: done.ID, Column: done, Number: 5, Title: "Old", CompletedAt: day(-30)},
+		// Колонка не из списка: задача в отчёт не попадает
+		{ColumnID: uuid.New(), Number: 6, Title: "Elsewhere"},
+	}
+
+	report := Build(board, []model.Column{todo, done}, tasks, now)
+
+	assert.Equal(t, Stats{Total: 5, Open: 3, Completed: 2, CompletedRecently: 1, Overdue: 2, Blocked: 1, Unassigned: 2}, report.Stats)
+	require.Len(t, report.Overdue, 2)
+	// Самая просроченная задача первой, выполненные не считаются просроченными
+	assert.Equal(t, "OPS-2", report.Overdue[0].Key)
+	assert.Equal(t, "Anna", report.Overdue[0].Assignee)
+	assert.Equal(t, "OPS-1", report.Overdue[1].Key)
+
+	require.Len(t, report.Columns, 2)
+	assert.Len(t, report.Columns[0].Tasks, 3)
+	assert.Len(t, report.Columns[1].Tasks, 2)
+	assert.True(t, report.Columns[1].Tasks[0].Done)
+}
+
+func TestWriteHTML(t *testing.T) {
+	board := &model.Board{Title: "<Ops>", Key: "OPS"}
+	column := model.Column{ID: uuid.New(), Title: "To Do"}
+	tasks := []model.Task{{ColumnID: column.ID, Column: column, Number: 1, Title: "Fix <script>"}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteHTML(&buf, Build(board, []model.Column{column}, tasks, time.Now())))
+
+	out := buf.String()
+	assert.Contains(t, out, "<h1>&lt;Ops&gt;</h1>")
+	assert.Contains(t, out, "Fix &lt;script&gt;")
+	assert.Contains(t, out, "No overdue tasks")
+}
+
+func TestHTTPRenderer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile("files")
+		require.NoError(t, err)
+		assert.Equal(t, "index.html", header.Filename)
+		page, _ := io.ReadAll(file)
+		if string(page) != "<p>report</p>" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte("%PDF-1.7"))
+	}))
+	defer server.Close()
+
+	renderer := NewHTTPRenderer(server.URL)
+	pdf, err := renderer.RenderPDF(context.Background(), []byte("<p>report</p>"))
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.7", string(pdf))
+
+	_, err = renderer.RenderPDF(context.Background(), []byte("<p>other</p>"))
+	assert.ErrorContains(t, err, "400 Bad Request")
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type ActionRepository struct {
+	db *gorm.DB
+}
+
+func NewActionRepository(db *gorm.DB) *ActionRepository {
+	return &ActionRepository{db: db}
+}
+
+// Create records an action and drops the user's actions created before expiredBefore,
+// which can no longer be undone
+func (r *ActionRepository) Create(ctx context.Context, action *model.Action, expiredBefore time.Time) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("user_id = ? AND created_at < ?", action.UserID, expiredBefore).Delete(&model.Action{}).Error; err != nil {
+		return err
+	}
+	return db.Create(action).Error
+}
+
+// GetByID retrieves an action by its ID
+func (r *ActionRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Action, error) {
+	var action model.Action
+	if err := dbFromContext(ctx, r.db).First(&action, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrActionNotFound
+		}
+		return nil, err
+	}
+	return &action, nil
+}
+
+// GetUndoable retrieves the user's actions created since the given time that are not undone, latest first
+func (r *ActionRepository) GetUndoable(ctx context.Context, userID uuid.UUID, since time.Time) ([]model.Action, error) {
+	var actions []model.Action
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND created_at >= ? AND undone_at IS NULL", userID, since).
+		Order("created_at DESC").
+		Find(&actions).Error
+	return actions, err
+}
+
+// MarkUndone marks the action as undone. It fails with ErrActionUndone when the action
+// is already undone, so concurrent requests cannot revert it twice.
+func (r *ActionRepository) MarkUndone(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Model(&model.Action{}).
+		Where("id = ? AND undone_at IS NULL", id).
+		Update("undone_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrActionUndone
+	}
+	return nil
+}
@@ -0,0 +1,307 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/database"
+	"kanban/internal/model"
+)
+
+// AnalyticsRepository computes flow metrics of a board from the column entries of its tasks
+type AnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{db: db}
+}
+
+// FlowTimes summarizes lead and cycle times in days of the tasks completed since a time.
+// The percentiles and averages are nil when no task qualifies.
+type FlowTimes struct {
+	Completed   int
+	LeadAvg     *float64
+	LeadMedian  *float64
+	LeadP85     *float64
+	CycleAvg    *float64
+	CycleMedian *float64
+	CycleP85    *float64
+}
+
+// WeeklyThroughput is the number of tasks completed in the week starting on Monday Week
+type WeeklyThroughput struct {
+	Week      time.Time
+	Completed int
+}
+
+// ColumnDayCount is the number of tasks in a column at the end of a day
+type ColumnDayCount struct {
+	Day      time.Time
+	ColumnID uuid.UUID
+	Tasks    int
+}
+
+// RollupRange is the range of UTC days, First through Last, whose metrics are rolled up
+type RollupRange struct {
+	First time.Time
+	Last  time.Time
+}
+
+// GetFlowTimes computes lead time (first column entry to completion) and cycle time (first
+// entry into a column after the board's first one to completion) of the board's tasks
+// completed since the given time. Tasks that were created completed have no flow time.
+func (r *AnalyticsRepository) GetFlowTimes(ctx context.Context, boardID uuid.UUID, since time.Time) (*FlowTimes, error) {
+	db := dbFromContext(ctx, r.db)
+	if database.IsSQLite(db) {
+		return r.getFlowTimesSQLite(db, boardID, since)
+	}
+
+	var times FlowTimes
+	err := db.Raw(
+		"WITH board_columns AS ("+
+			"SELECT id, position FROM columns WHERE board_id = @board"+
+			"), backlog AS ("+
+			"SELECT id FROM board_columns ORDER BY position LIMIT 1"+
+			"), durations AS ("+
+			"SELECT EXTRACT(EPOCH FROM tasks.completed_at - MIN(entries.entered_at)) / 86400 AS lead_days, "+
+			"EXTRACT(EPOCH FROM tasks.completed_at - MIN(entries.entered_at) FILTER ("+
+			"WHERE entries.column_id <> backlog.id)) / 86400 AS cycle_days "+
+			"FROM tasks JOIN board_columns ON board_columns.id = tasks.column_id CROSS JOIN backlog "+
+			"JOIN task_column_entries entries ON entries.task_id = tasks.id "+
+			"WHERE tasks.completed_at >= @since "+
+			"GROUP BY tasks.id, tasks.completed_at, backlog.id "+
+			"HAVING MIN(entries.entered_at) < tasks.completed_at"+
+			") SELECT COUNT(*) AS completed, "+
+			"AVG(lead_days) AS lead_avg, "+
+			"PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY lead_days) AS lead_median, "+
+			"PERCENTILE_CONT(0.85) WITHIN GROUP (ORDER BY lead_days) AS lead_p85, "+
+			"AVG(cycle_days) AS cycle_avg, "+
+			"PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY cycle_days) AS cycle_median, "+
+			"PERCENTILE_CONT(0.85) WITHIN GROUP (ORDER BY cycle_days) AS cycle_p85 "+
+			"FROM durations",
+		map[string]interface{}{"board": boardID, "since": since},
+	).Scan(&times).Error
+	if err != nil {
+		return nil, err
+	}
+	return &times, nil
+}
+
+// GetWeeklyThroughput counts the board's tasks completed per week since the given time.
+// Weeks without completed tasks are omitted.
+func (r *AnalyticsRepository) GetWeeklyThroughput(ctx context.Context, boardID uuid.UUID, since time.Time) ([]WeeklyThroughput, error) {
+	db := dbFromContext(ctx, r.db)
+	if database.IsSQLite(db) {
+		return r.getWeeklyThroughputSQLite(db, boardID, since)
+	}
+
+	var weeks []WeeklyThroughput
+	err := db.Raw(
+		"SELECT DATE_TRUNC('week', tasks.completed_at AT TIME ZONE 'UTC') AS week, COUNT(*) AS completed "+
+			"FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"WHERE columns.board_id = ? AND tasks.completed_at >= ? "+
+			"GROUP BY week ORDER BY week",
+		boardID, since,
+	).Scan(&weeks).Error
+	return weeks, err
+}
+
+// GetCumulativeFlow counts the tasks in each column of the board at the end of every UTC day
+// from the day of since through today. Columns without tasks on a day are omitted.
+func (r *AnalyticsRepository) GetCumulativeFlow(ctx context.Context, boardID uuid.UUID, since time.Time) ([]ColumnDayCount, error) {
+	db := dbFromContext(ctx, r.db)
+	if database.IsSQLite(db) {
+		return r.getCumulativeFlowSQLite(db, boardID, since)
+	}
+
+	var counts []ColumnDayCount
+	err := db.Raw(
+		"SELECT days.day, entries.column_id, COUNT(*) AS tasks "+
+			"FROM GENERATE_SERIES(CAST(? AS timestamp), CAST(CAST(NOW() AT TIME ZONE 'UTC' AS date) AS timestamp), INTERVAL '1 day') AS days(day) "+
+			"JOIN task_column_entries entries ON entries.entered_at < (days.day + INTERVAL '1 day') AT TIME ZONE 'UTC' "+
+			"AND (entries.exited_at IS NULL OR entries.exited_at >= (days.day + INTERVAL '1 day') AT TIME ZONE 'UTC') "+
+			"JOIN columns ON columns.id = entries.column_id "+
+			"WHERE columns.board_id = ? "+
+			"GROUP BY days.day, entries.column_id ORDER BY days.day",
+		since.UTC().Format("2006-01-02"), boardID,
+	).Scan(&counts).Error
+	return counts, err
+}
+
+// GetRollupRange returns the days rolled up so far, or nil before the first roll-up
+func (r *AnalyticsRepository) GetRollupRange(ctx context.Context) (*RollupRange, error) {
+	db := dbFromContext(ctx, r.db)
+	if database.IsSQLite(db) {
+		return r.getRollupRangeSQLite(db)
+	}
+
+	var days struct {
+		First *time.Time
+		Last  *time.Time
+	}
+	err := db.Raw("SELECT MIN(day) AS first, MAX(day) AS last FROM metrics_rollups").
+		Scan(&days).Error
+	if err != nil || days.First == nil || days.Last == nil {
+		return nil, err
+	}
+	return &RollupRange{First: *days.First, Last: *days.Last}, nil
+}
+
+// RollUpDay stores the metrics of every board on a finished UTC day: the tasks created, moved
+// to another column and completed with their cycle times, and the number of tasks in each
+// column at the end of the day. Rolling up a day again replaces its metrics. Call it in a
+// transaction.
+func (r *AnalyticsRepository) RollUpDay(ctx context.Context, day time.Time) error {
+	db := dbFromContext(ctx, r.db)
+	day = day.UTC().Truncate(24 * time.Hour)
+	if database.IsSQLite(db) {
+		return r.rollUpDaySQLite(db, day)
+	}
+
+	params := map[string]interface{}{
+		"day":   day.Format("2006-01-02"),
+		"start": day,
+		"end":   day.AddDate(0, 0, 1),
+	}
+
+	if err := db.Exec("DELETE FROM board_daily_metrics WHERE day = CAST(@day AS date)", params).Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM column_daily_counts WHERE day = CAST(@day AS date)", params).Error; err != nil {
+		return err
+	}
+
+	// Первое вхождение задачи в колонку означает ее создание, остальные - перемещения
+	err := db.Exec(
+		"WITH entries AS ("+
+			"SELECT columns.board_id, NOT EXISTS ("+
+			"SELECT 1 FROM task_column_entries earlier "+
+			"WHERE earlier.task_id = entries.task_id AND earlier.entered_at < entries.entered_at"+
+			") AS first_entry "+
+			"FROM task_column_entries entries JOIN columns ON columns.id = entries.column_id "+
+			"WHERE entries.entered_at >= @start AND entries.entered_at < @end"+
+			"), activity AS ("+
+			"SELECT board_id, COUNT(*) FILTER (WHERE first_entry) AS created, "+
+			"COUNT(*) FILTER (WHERE NOT first_entry) AS moved "+
+			"FROM entries GROUP BY board_id"+
+			"), durations AS ("+
+			"SELECT columns.board_id, CASE WHEN MIN(entries.entered_at) < tasks.completed_at THEN "+
+			"EXTRACT(EPOCH FROM tasks.completed_at - MIN(entries.entered_at) FILTER ("+
+			"WHERE entries.column_id <> backlog.id)) / 86400 END AS cycle_days "+
+			"FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"CROSS JOIN LATERAL ("+
+			"SELECT id FROM columns board_columns WHERE board_columns.board_id = columns.board_id "+
+			"ORDER BY position LIMIT 1"+
+			") backlog "+
+			"JOIN task_column_entries entries ON entries.task_id = tasks.id "+
+			"WHERE tasks.completed_at >= @start AND tasks.completed_at < @end "+
+			"GROUP BY tasks.id, tasks.completed_at, columns.board_id"+
+			"), completions AS ("+
+			"SELECT board_id, COUNT(*) AS completed, "+
+			"PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY cycle_days) AS cycle_median, "+
+			"PERCENTILE_CONT(0.85) WITHIN GROUP (ORDER BY cycle_days) AS cycle_p85 "+
+			"FROM durations GROUP BY board_id"+
+			") INSERT INTO board_daily_metrics (board_id, day, created, completed, moved, cycle_median, cycle_p85) "+
+			"SELECT COALESCE(activity.board_id, completions.board_id), CAST(@day AS date), "+
+			"COALESCE(activity.created, 0), COALESCE(completions.completed, 0), COALESCE(activity.moved, 0), "+
+			"completions.cycle_median, completions.cycle_p85 "+
+			"FROM activity FULL JOIN completions ON completions.board_id = activity.board_id",
+		params,
+	).Error
+	if err != nil {
+		return err
+	}
+
+	// Открытые на конец дня вхождения выбираются двумя ветками, чтобы обе шли по индексу exited_at
+	err = db.Exec(
+		"INSERT INTO column_daily_counts (column_id, day, tasks) "+
+			"SELECT column_id, CAST(@day AS date), COUNT(*) FROM ("+
+			"SELECT column_id FROM task_column_entries WHERE exited_at IS NULL AND entered_at < @end "+
+			"UNION ALL "+
+			"SELECT column_id FROM task_column_entries WHERE exited_at >= @end AND entered_at < @end"+
+			") open_entries GROUP BY column_id",
+		params,
+	).Error
+	if err != nil {
+		return err
+	}
+
+	return db.Exec(
+		"INSERT INTO metrics_rollups (day) VALUES (CAST(@day AS date)) "+
+			"ON CONFLICT (day) DO UPDATE SET rolled_up_at = NOW()",
+		params,
+	).Error
+}
+
+// GetDailyMetrics returns the rolled-up metrics of the board from the day of from through the
+// day of to. Days without activity have no metrics.
+func (r *AnalyticsRepository) GetDailyMetrics(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]model.BoardDailyMetrics, error) {
+	db := dbFromContext(ctx, r.db)
+	if database.IsSQLite(db) {
+		return r.getDailyMetricsSQLite(db, boardID, from, to)
+	}
+
+	var metrics []model.BoardDailyMetrics
+	err := db.
+		Where("board_id = ? AND day BETWEEN CAST(? AS date) AND CAST(? AS date)",
+			boardID, from.UTC().Format("2006-01-02"), to.UTC().Format("2006-01-02")).
+		Order("day").
+		Find(&metrics).Error
+	return metrics, err
+}
+
+// GetRolledUpCumulativeFlow returns the rolled-up number of tasks in each column of the board
+// at the end of the days from the day of from through the day of to. Columns without tasks on
+// a day are omitted.
+func (r *AnalyticsRepository) GetRolledUpCumulativeFlow(ctx context.Context, boardID uuid.UUID, from, to time.Time) ([]ColumnDayCount, error) {
+	db := dbFromContext(ctx, r.db)
+	if database.IsSQLite(db) {
+		return r.getRolledUpCumulativeFlowSQLite(db, boardID, from, to)
+	}
+
+	var counts []ColumnDayCount
+	err := db.Raw(
+		"SELECT counts.day, counts.column_id, counts.tasks "+
+			"FROM column_daily_counts counts JOIN columns ON columns.id = counts.column_id "+
+			"WHERE columns.board_id = ? AND counts.day BETWEEN CAST(? AS date) AND CAST(? AS date) "+
+			"ORDER BY counts.day",
+		boardID, from.UTC().Format("2006-01-02"), to.UTC().Format("2006-01-02"),
+	).Scan(&counts).Error
+	return counts, err
+}
+
+// EstimateRollup sums up the estimates of the board's tasks in a column assigned to a user,
+// or unassigned when AssignedTo is nil. Name and Email are those of the assignee.
+type EstimateRollup struct {
+	ColumnID   uuid.UUID
+	AssignedTo *uuid.UUID
+	Name       string
+	Email      string
+	// Tasks counts all tasks, Estimated only those with an estimate
+	Tasks     int
+	Estimated int
+	Estimate  int
+	// OpenEstimate sums the estimates of the tasks not completed yet
+	OpenEstimate int
+}
+
+// GetEstimateRollups sums up the estimates of the board's tasks per column and assignee in one
+// query. Combinations without tasks are omitted.
+func (r *AnalyticsRepository) GetEstimateRollups(ctx context.Context, boardID uuid.UUID) ([]EstimateRollup, error) {
+	var rollups []EstimateRollup
+	err := dbFromContext(ctx, r.db).Raw(
+		"SELECT tasks.column_id, tasks.assigned_to, COALESCE(users.name, '') AS name, COALESCE(users.email, '') AS email, COUNT(*) AS tasks, "+
+			"COUNT(tasks.estimate) AS estimated, COALESCE(SUM(tasks.estimate), 0) AS estimate, "+
+			"COALESCE(SUM(tasks.estimate) FILTER (WHERE tasks.completed_at IS NULL), 0) AS open_estimate "+
+			"FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"LEFT JOIN users ON users.id = tasks.assigned_to "+
+			"WHERE columns.board_id = ? "+
+			"GROUP BY tasks.column_id, tasks.assigned_to, users.name, users.email",
+		boardID,
+	).Scan(&rollups).Error
+	return rollups, err
+}
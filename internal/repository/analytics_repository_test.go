@@ -0,0 +1,119 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsRepository_Metrics(t *testing.T) {
+	db := testutil.OpenDB(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	repo := repository.NewAnalyticsRepository(db)
+	ctx := context.Background()
+
+	board := &model.Board{Title: "Analytics", OwnerID: owner.ID}
+	require.NoError(t, db.Create(board).Error)
+	columns := make([]model.Column, 3)
+	for i, title := range []string{"Backlog", "Doing", "Done"} {
+		columns[i] = model.Column{BoardID: board.ID, Title: title, Position: i + 1, IsDone: i == 2}
+		require.NoError(t, db.Create(&columns[i]).Error)
+	}
+	backlog, doing, done := columns[0].ID, columns[1].ID, columns[2].ID
+
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -2)
+	at := func(hours int) *time.Time {
+		moment := day.Add(time.Duration(hours) * time.Hour)
+		return &moment
+	}
+
+	// Первая задача прошла все колонки за 8 часов, вторая за 12 минуя "Doing", третья открыта
+	tasks := []struct {
+		entries   []model.TaskColumnEntry
+		completed *time.Time
+	}{
+		{[]model.TaskColumnEntry{
+			{ColumnID: backlog, EnteredAt: *at(1), ExitedAt: at(3)},
+			{ColumnID: doing, EnteredAt: *at(3), ExitedAt: at(9)},
+			{ColumnID: done, EnteredAt: *at(9)},
+		}, at(9)},
+		{[]model.TaskColumnEntry{
+			{ColumnID: backlog, EnteredAt: *at(2), ExitedAt: at(14)},
+			{ColumnID: done, EnteredAt: *at(14)},
+		}, at(14)},
+		{[]model.TaskColumnEntry{
+			{ColumnID: backlog, EnteredAt: *at(4)},
+		}, nil},
+	}
+	for i, seed := range tasks {
+		last := seed.entries[len(seed.entries)-1]
+		task := &model.Task{ColumnID: last.ColumnID, Title: "Task", CreatedBy: owner.ID, Number: i + 1, CompletedAt: seed.completed}
+		require.NoError(t, db.Create(task).Error)
+		for _, entry := range seed.entries {
+			entry.TaskID = task.ID
+			require.NoError(t, db.Create(&entry).Error)
+		}
+	}
+
+	// Время выполнения - 1/3 и 1/2 дня, время цикла - 1/4 дня и ноль
+	times, err := repo.GetFlowTimes(ctx, board.ID, day)
+	require.NoError(t, err)
+	assert.Equal(t, 2, times.Completed)
+	for expected, actual := range map[float64]*float64{
+		5.0 / 12: times.LeadMedian,
+		0.475:    times.LeadP85,
+		0.125:    times.CycleMedian,
+		0.2125:   times.CycleP85,
+	} {
+		require.NotNil(t, actual)
+		assert.InDelta(t, expected, *actual, 1e-4)
+	}
+	require.NotNil(t, times.LeadAvg)
+	assert.InDelta(t, 5.0/12, *times.LeadAvg, 1e-4)
+
+	weeks, err := repo.GetWeeklyThroughput(ctx, board.ID, day)
+	require.NoError(t, err)
+	require.Len(t, weeks, 1)
+	monday := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	assert.True(t, monday.Equal(weeks[0].Week), weeks[0].Week)
+	assert.Equal(t, 2, weeks[0].Completed)
+
+	flow, err := repo.GetCumulativeFlow(ctx, board.ID, day)
+	require.NoError(t, err)
+	require.Len(t, flow, 6)
+	assert.True(t, day.Equal(flow[0].Day), flow[0].Day)
+
+	// Свертка дня сохраняет те же метрики
+	require.NoError(t, repo.RollUpDay(ctx, day.Add(12*time.Hour)))
+	rollups, err := repo.GetRollupRange(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, rollups)
+	assert.True(t, day.Equal(rollups.Last), rollups.Last)
+
+	metrics, err := repo.GetDailyMetrics(ctx, board.ID, day, day)
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+	assert.Equal(t, 3, metrics[0].Created)
+	assert.Equal(t, 3, metrics[0].Moved)
+	assert.Equal(t, 2, metrics[0].Completed)
+	require.NotNil(t, metrics[0].CycleMedian)
+	assert.InDelta(t, 0.125, *metrics[0].CycleMedian, 1e-4)
+	require.NotNil(t, metrics[0].CycleP85)
+	assert.InDelta(t, 0.2125, *metrics[0].CycleP85, 1e-4)
+
+	counts, err := repo.GetRolledUpCumulativeFlow(ctx, board.ID, day, day)
+	require.NoError(t, err)
+	tasksByColumn := map[string]int{}
+	for _, count := range counts {
+		assert.True(t, day.Equal(count.Day), count.Day)
+		tasksByColumn[count.ColumnID.String()] = count.Tasks
+	}
+	assert.Equal(t, map[string]int{backlog.String(): 1, done.String(): 2}, tasksByColumn)
+}
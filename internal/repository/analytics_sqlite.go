@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+// The SQLite versions of the analytics queries. SQLite has no PERCENTILE_CONT, GENERATE_SERIES
+// or date types: durations come from julianday, percentiles from ranked rows, and days are
+// compared as the UTC times the database driver writes.
+
+// sqliteTimeLayouts are the layouts of times and days SQLite returns as text
+var sqliteTimeLayouts = []string{"2006-01-02 15:04:05.999999999-07:00", "2006-01-02"}
+
+func parseSQLiteTime(value string) (time.Time, error) {
+	var err error
+	for _, layout := range sqliteTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// sqlitePercentile aggregates the rows like PERCENTILE_CONT: it interpolates between the two
+// values ranked around the fraction. Ranks start at 0 and count is the number of non-null values.
+func sqlitePercentile(fraction float64, value, rank, count string) string {
+	position := fmt.Sprintf("%g * (%s - 1)", fraction, count)
+	return fmt.Sprintf(
+		"SUM(CASE %[2]s WHEN CAST(%[3]s AS INTEGER) THEN %[1]s * (1 - (%[3]s - %[2]s)) "+
+			"WHEN CAST(%[3]s AS INTEGER) + 1 THEN %[1]s * (%[3]s - %[2]s + 1) END)",
+		value, rank, position,
+	)
+}
+
+func (r *AnalyticsRepository) getFlowTimesSQLite(db *gorm.DB, boardID uuid.UUID, since time.Time) (*FlowTimes, error) {
+	var times FlowTimes
+	err := db.Raw(
+		"WITH board_columns AS ("+
+			"SELECT id, position FROM columns WHERE board_id = @board"+
+			"), backlog AS ("+
+			"SELECT id FROM board_columns ORDER BY position LIMIT 1"+
+			"), durations AS ("+
+			"SELECT julianday(tasks.completed_at) - julianday(MIN(entries.entered_at)) AS lead_days, "+
+			"julianday(tasks.completed_at) - julianday(MIN(entries.entered_at) FILTER ("+
+			"WHERE entries.column_id <> backlog.id)) AS cycle_days "+
+			"FROM tasks JOIN board_columns ON board_columns.id = tasks.column_id CROSS JOIN backlog "+
+			"JOIN task_column_entries entries ON entries.task_id = tasks.id "+
+			"WHERE tasks.completed_at >= @since "+
+			"GROUP BY tasks.id, tasks.completed_at, backlog.id "+
+			"HAVING MIN(entries.entered_at) < tasks.completed_at"+
+			"), ranked AS ("+
+			"SELECT lead_days, cycle_days, "+
+			"ROW_NUMBER() OVER (ORDER BY lead_days) - 1 AS lead_rank, COUNT(lead_days) OVER () AS lead_count, "+
+			"ROW_NUMBER() OVER (ORDER BY cycle_days NULLS LAST) - 1 AS cycle_rank, COUNT(cycle_days) OVER () AS cycle_count "+
+			"FROM durations"+
+			") SELECT COUNT(*) AS completed, "+
+			"AVG(lead_days) AS lead_avg, "+
+			sqlitePercentile(0.5, "lead_days", "lead_rank", "lead_count")+" AS lead_median, "+
+			sqlitePercentile(0.85, "lead_days", "lead_rank", "lead_count")+" AS lead_p85, "+
+			"AVG(cycle_days) AS cycle_avg, "+
+			sqlitePercentile(0.5, "cycle_days", "cycle_rank", "cycle_count")+" AS cycle_median, "+
+			sqlitePercentile(0.85, "cycle_days", "cycle_rank", "cycle_count")+" AS cycle_p85 "+
+			"FROM ranked",
+		map[string]interface{}{"board": boardID, "since": since},
+	).Scan(&times).Error
+	if err != nil {
+		return nil, err
+	}
+	return &times, nil
+}
+
+func (r *AnalyticsRepository) getWeeklyThroughputSQLite(db *gorm.DB, boardID uuid.UUID, since time.Time) ([]WeeklyThroughput, error) {
+	var rows []struct {
+		Week      string
+		Completed int
+	}
+	// Воскресенье недели минус шесть дней - ее понедельник
+	err := db.Raw(
+		"SELECT date(tasks.completed_at, 'weekday 0', '-6 days') AS week, COUNT(*) AS completed "+
+			"FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"WHERE columns.board_id = ? AND tasks.completed_at >= ? "+
+			"GROUP BY week ORDER BY week",
+		boardID, since,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	weeks := make([]WeeklyThroughput, len(rows))
+	for i, row := range rows {
+		week, err := parseSQLiteTime(row.Week)
+		if err != nil {
+			return nil, err
+		}
+		weeks[i] = WeeklyThroughput{Week: week, Completed: row.Completed}
+	}
+	return weeks, nil
+}
+
+func (r *AnalyticsRepository) getCumulativeFlowSQLite(db *gorm.DB, boardID uuid.UUID, since time.Time) ([]ColumnDayCount, error) {
+	var rows []struct {
+		Day      string
+		ColumnID uuid.UUID
+		Tasks    int
+	}
+	// Времена хранятся в UTC, так что текст вхождения сравнивается с началом следующего дня
+	err := db.Raw(
+		"WITH RECURSIVE days(day) AS ("+
+			"SELECT date(?) UNION ALL SELECT date(day, '+1 day') FROM days WHERE day < date('now')"+
+			") SELECT days.day, entries.column_id, COUNT(*) AS tasks "+
+			"FROM days JOIN task_column_entries entries ON entries.entered_at < date(days.day, '+1 day') "+
+			"AND (entries.exited_at IS NULL OR entries.exited_at >= date(days.day, '+1 day')) "+
+			"JOIN columns ON columns.id = entries.column_id "+
+			"WHERE columns.board_id = ? "+
+			"GROUP BY days.day, entries.column_id ORDER BY days.day",
+		since.UTC().Format("2006-01-02"), boardID,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make([]ColumnDayCount, len(rows))
+	for i, row := range rows {
+		day, err := parseSQLiteTime(row.Day)
+		if err != nil {
+			return nil, err
+		}
+		counts[i] = ColumnDayCount{Day: day, ColumnID: row.ColumnID, Tasks: row.Tasks}
+	}
+	return counts, nil
+}
+
+func (r *AnalyticsRepository) getRollupRangeSQLite(db *gorm.DB) (*RollupRange, error) {
+	var days struct {
+		First *string
+		Last  *string
+	}
+	err := db.Raw("SELECT MIN(day) AS first, MAX(day) AS last FROM metrics_rollups").Scan(&days).Error
+	if err != nil || days.First == nil || days.Last == nil {
+		return nil, err
+	}
+
+	first, err := parseSQLiteTime(*days.First)
+	if err != nil {
+		return nil, err
+	}
+	last, err := parseSQLiteTime(*days.Last)
+	if err != nil {
+		return nil, err
+	}
+	return &RollupRange{First: first, Last: last}, nil
+}
+
+// rollUpDaySQLite stores days as the start of the day, the way the driver writes them for the
+// models
+func (r *AnalyticsRepository) rollUpDaySQLite(db *gorm.DB, day time.Time) error {
+	params := map[string]interface{}{
+		"start": day,
+		"end":   day.AddDate(0, 0, 1),
+		"now":   time.Now(),
+	}
+
+	if err := db.Exec("DELETE FROM board_daily_metrics WHERE day = @start", params).Error; err != nil {
+		return err
+	}
+	if err := db.Exec("DELETE FROM column_daily_counts WHERE day = @start", params).Error; err != nil {
+		return err
+	}
+
+	err := db.Exec(
+		"WITH entries AS ("+
+			"SELECT columns.board_id, NOT EXISTS ("+
+			"SELECT 1 FROM task_column_entries earlier "+
+			"WHERE earlier.task_id = entries.task_id AND earlier.entered_at < entries.entered_at"+
+			") AS first_entry "+
+			"FROM task_column_entries entries JOIN columns ON columns.id = entries.column_id "+
+			"WHERE entries.entered_at >= @start AND entries.entered_at < @end"+
+			"), activity AS ("+
+			"SELECT board_id, COUNT(*) FILTER (WHERE first_entry) AS created, "+
+			"COUNT(*) FILTER (WHERE NOT first_entry) AS moved "+
+			"FROM entries GROUP BY board_id"+
+			"), durations AS ("+
+			"SELECT columns.board_id, CASE WHEN MIN(entries.entered_at) < tasks.completed_at THEN "+
+			"julianday(tasks.completed_at) - julianday(MIN(entries.entered_at) FILTER ("+
+			"WHERE entries.column_id <> backlog.id)) END AS cycle_days "+
+			"FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"JOIN columns backlog ON backlog.id = ("+
+			"SELECT id FROM columns board_columns WHERE board_columns.board_id = columns.board_id "+
+			"ORDER BY position LIMIT 1"+
+			") "+
+			"JOIN task_column_entries entries ON entries.task_id = tasks.id "+
+			"WHERE tasks.completed_at >= @start AND tasks.completed_at < @end "+
+			"GROUP BY tasks.id, tasks.completed_at, columns.board_id"+
+			"), ranked AS ("+
+			"SELECT board_id, cycle_days, "+
+			"ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY cycle_days NULLS LAST) - 1 AS cycle_rank, "+
+			"COUNT(cycle_days) OVER (PARTITION BY board_id) AS cycle_count "+
+			"FROM durations"+
+			"), completions AS ("+
+			"SELECT board_id, COUNT(*) AS completed, "+
+			sqlitePercentile(0.5, "cycle_days", "cycle_rank", "cycle_count")+" AS cycle_median, "+
+			sqlitePercentile(0.85, "cycle_days", "cycle_rank", "cycle_count")+" AS cycle_p85 "+
+			"FROM ranked GROUP BY board_id"+
+			") INSERT INTO board_daily_metrics (board_id, day, created, completed, moved, cycle_median, cycle_p85) "+
+			"SELECT COALESCE(activity.board_id, completions.board_id), @start, "+
+			"COALESCE(activity.created, 0), COALESCE(completions.completed, 0), COALESCE(activity.moved, 0), "+
+			"completions.cycle_median, completions.cycle_p85 "+
+			"FROM activity FULL JOIN completions ON completions.board_id = activity.board_id",
+		params,
+	).Error
+	if err != nil {
+		return err
+	}
+
+	err = db.Exec(
+		"INSERT INTO column_daily_counts (column_id, day, tasks) "+
+			"SELECT column_id, @start, COUNT(*) FROM ("+
+			"SELECT column_id FROM task_column_entries WHERE exited_at IS NULL AND entered_at < @end "+
+			"UNION ALL "+
+			"SELECT column_id FROM task_column_entries WHERE exited_at >= @end AND entered_at < @end"+
+			") open_entries GROUP BY column_id",
+		params,
+	).Error
+	if err != nil {
+		return err
+	}
+
+	return db.Exec(
+		"INSERT INTO metrics_rollups (day) VALUES (@start) "+
+			"ON CONFLICT (day) DO UPDATE SET rolled_up_at = @now",
+		params,
+	).Error
+}
+
+func (r *AnalyticsRepository) getDailyMetricsSQLite(db *gorm.DB, boardID uuid.UUID, from, to time.Time) ([]model.BoardDailyMetrics, error) {
+	var metrics []model.BoardDailyMetrics
+	err := db.
+		Where("board_id = ? AND day BETWEEN ? AND ?",
+			boardID, from.UTC().Truncate(24*time.Hour), to.UTC().Truncate(24*time.Hour)).
+		Order("day").
+		Find(&metrics).Error
+	return metrics, err
+}
+
+func (r *AnalyticsRepository) getRolledUpCumulativeFlowSQLite(db *gorm.DB, boardID uuid.UUID, from, to time.Time) ([]ColumnDayCount, error) {
+	var counts []ColumnDayCount
+	err := db.Raw(
+		"SELECT counts.day, counts.column_id, counts.tasks "+
+			"FROM column_daily_counts counts JOIN columns ON columns.id = counts.column_id "+
+			"WHERE columns.board_id = ? AND counts.day BETWEEN ? AND ? "+
+			"ORDER BY counts.day",
+		boardID, from.UTC().Truncate(24*time.Hour), to.UTC().Truncate(24*time.Hour),
+	).Scan(&counts).Error
+	return counts, err
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+var ErrArchivedTaskNotFound = errors.New("archived task not found")
+
+type ArchivedTaskRepository struct {
+	db *gorm.DB
+}
+
+func NewArchivedTaskRepository(db *gorm.DB) *ArchivedTaskRepository {
+	return &ArchivedTaskRepository{db: db}
+}
+
+// TaskArchiveRecord is a task as it is kept in the task archive: the task, the board it was on,
+// the IDs of its labels, the relations it takes part in and its history
+type TaskArchiveRecord struct {
+	BoardID   uuid.UUID            `json:"board_id"`
+	Task      model.Task           `json:"task"`
+	LabelIDs  []uuid.UUID          `json:"label_ids"`
+	Relations []model.TaskRelation `json:"relations"`
+	Changes   []model.TaskChange   `json:"changes"`
+}
+
+// GetArchivable retrieves up to limit tasks completed before the given time, longest completed
+// first, as they are archived. Restored tasks are left out. It takes four queries however many
+// tasks are returned.
+func (r *ArchivedTaskRepository) GetArchivable(ctx context.Context, before time.Time, limit int) ([]TaskArchiveRecord, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var tasks []model.Task
+	err := db.
+		Joins("Column").
+		Where("tasks.completed_at < ?", before.UTC()).
+		Where("NOT EXISTS (SELECT 1 FROM archived_tasks WHERE archived_tasks.id = tasks.id)").
+		Order("tasks.completed_at, tasks.id").
+		Limit(limit).
+		Find(&tasks).Error
+	if err != nil || len(tasks) == 0 {
+		return nil, err
+	}
+
+	records := make([]TaskArchiveRecord, len(tasks))
+	ids := make([]uuid.UUID, len(tasks))
+	byID := make(map[uuid.UUID]*TaskArchiveRecord, len(tasks))
+	for i := range tasks {
+		records[i] = TaskArchiveRecord{BoardID: tasks[i].Column.BoardID, Task: tasks[i]}
+		ids[i] = tasks[i].ID
+		byID[tasks[i].ID] = &records[i]
+	}
+
+	var labels []struct {
+		TaskID  uuid.UUID
+		LabelID uuid.UUID
+	}
+	if err := db.Table("task_labels").Select("task_id, label_id").Where("task_id IN ?", ids).Scan(&labels).Error; err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		record := byID[label.TaskID]
+		record.LabelIDs = append(record.LabelIDs, label.LabelID)
+	}
+
+	// Связь двух архивируемых задач сохраняется у обеих, ее восстанавливает вторая из них
+	var relations []model.TaskRelation
+	if err := db.Where("task_id IN ? OR related_task_id IN ?", ids, ids).Order("created_at").Find(&relations).Error; err != nil {
+		return nil, err
+	}
+	for _, relation := range relations {
+		for _, id := range []uuid.UUID{relation.TaskID, relation.RelatedTaskID} {
+			if record, ok := byID[id]; ok {
+				record.Relations = append(record.Relations, relation)
+			}
+		}
+	}
+
+	var changes []model.TaskChange
+	if err := db.Where("task_id IN ?", ids).Order("created_at, id").Find(&changes).Error; err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		record := byID[change.TaskID]
+		record.Changes = append(record.Changes, change)
+	}
+	return records, nil
+}
+
+// Archive records the tasks as kept in the archive file and removes them, with everything that
+// belongs to them, from their boards
+func (r *ArchivedTaskRepository) Archive(ctx context.Context, archive string, records []TaskArchiveRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	archived := make([]model.ArchivedTask, len(records))
+	ids := make([]uuid.UUID, len(records))
+	for i, record := range records {
+		archived[i] = model.ArchivedTask{
+			ID:          record.Task.ID,
+			BoardID:     record.BoardID,
+			Number:      record.Task.Number,
+			Title:       record.Task.Title,
+			CompletedAt: *record.Task.CompletedAt,
+			Archive:     archive,
+		}
+		ids[i] = record.Task.ID
+	}
+
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&archived).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&model.Task{}).Error
+	})
+}
+
+// GetByBoard lists the archived tasks of a board that are not restored by number
+func (r *ArchivedTaskRepository) GetByBoard(ctx context.Context, boardID uuid.UUID) ([]model.ArchivedTask, error) {
+	var archived []model.ArchivedTask
+	err := dbFromContext(ctx, r.db).
+		Where("board_id = ? AND restored_at IS NULL", boardID).
+		Order("number").
+		Find(&archived).Error
+	return archived, err
+}
+
+// GetByID retrieves an archived task that is not restored by the ID the task had
+func (r *ArchivedTaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.ArchivedTask, error) {
+	var archived model.ArchivedTask
+	if err := dbFromContext(ctx, r.db).Where("id = ? AND restored_at IS NULL", id).First(&archived).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrArchivedTaskNotFound
+		}
+		return nil, err
+	}
+	return &archived, nil
+}
+
+// MarkRestored records that an archived task is back on its board. It returns
+// ErrArchivedTaskNotFound when the task was restored meanwhile.
+func (r *ArchivedTaskRepository) MarkRestored(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&model.ArchivedTask{}).
+		Where("id = ? AND restored_at IS NULL", id).
+		Update("restored_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrArchivedTaskNotFound
+	}
+	return nil
+}
+
+// RestoreHistory adds the archived history back to a restored task. Changes made by users deleted
+// since keep no user, as they would have if the task had stayed.
+func (r *ArchivedTaskRepository) RestoreHistory(ctx context.Context, changes []model.TaskChange) error {
+	db := dbFromContext(ctx, r.db)
+	for _, change := range changes {
+		if err := db.Exec(
+			"INSERT INTO task_changes (id, task_id, user_id, field, old_value, new_value, created_at) "+
+				"VALUES (?, ?, (SELECT id FROM users WHERE id = ?), ?, ?, ?, ?)",
+			change.ID, change.TaskID, change.UserID, change.Field, change.OldValue, change.NewValue, change.CreatedAt,
+		).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/database"
+	"kanban/internal/model"
+)
+
+type BoardChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewBoardChangeRepository(db *gorm.DB) *BoardChangeRepository {
+	return &BoardChangeRepository{db: db}
+}
+
+// CompletedBefore returns the ID below which every transaction has finished, as seen by the
+// current snapshot. Changes of those transactions are all visible to reads in the same snapshot.
+// SQLite commits one transaction at a time, so there it returns the ID of the next change.
+func (r *BoardChangeRepository) CompletedBefore(ctx context.Context) (int64, error) {
+	db := dbFromContext(ctx, r.db)
+	query := "SELECT txid_snapshot_xmin(txid_current_snapshot())"
+	if database.IsSQLite(db) {
+		query = "SELECT COALESCE(MAX(id), 0) + 1 FROM board_changes"
+	}
+
+	var txID int64
+	err := db.Raw(query).Scan(&txID).Error
+	return txID, err
+}
+
+// GetSinceTx retrieves the board's changes made by transactions with the given ID or later.
+// On SQLite the ID is one returned by CompletedBefore, compared with the IDs of the changes.
+func (r *BoardChangeRepository) GetSinceTx(ctx context.Context, boardID uuid.UUID, txID int64) ([]model.BoardChange, error) {
+	db := dbFromContext(ctx, r.db)
+	column := "txid"
+	if database.IsSQLite(db) {
+		column = "id"
+	}
+
+	var changes []model.BoardChange
+	err := db.
+		Where("board_id = ? AND "+column+" >= ?", boardID, txID).
+		Order("id").
+		Find(&changes).Error
+	return changes, err
+}
+
+// GetSinceTime retrieves the board's changes logged after the given time. Transactions are
+// logged with their start time, so changes of transactions running at that time may be missed.
+func (r *BoardChangeRepository) GetSinceTime(ctx context.Context, boardID uuid.UUID, since time.Time) ([]model.BoardChange, error) {
+	var changes []model.BoardChange
+	err := dbFromContext(ctx, r.db).
+		Where("board_id = ? AND changed_at > ?", boardID, since).
+		Order("id").
+		Find(&changes).Error
+	return changes, err
+}
+
+// BoardVersion identifies the state of boards for ETags. It changes with every change logged in
+// their feeds, every update of the boards themselves and every open task that becomes overdue,
+// which nothing writes. The count of changes covers transactions that commit after a later
+// change, and those that are pruned.
+type BoardVersion struct {
+	Changes    int64
+	LastChange int64
+	Boards     int64
+	UpdatedAt  string
+	Overdue    int64
+}
+
+// Version returns the version of the given boards at now
+func (r *BoardChangeRepository) Version(ctx context.Context, boardIDs []uuid.UUID, now time.Time) (BoardVersion, error) {
+	return r.version(ctx, boardIDs, now)
+}
+
+// UserVersion returns the version of every board the user owns or is a member of at now
+func (r *BoardChangeRepository) UserVersion(ctx context.Context, userID uuid.UUID, now time.Time) (BoardVersion, error) {
+	db := dbFromContext(ctx, r.db)
+	boards := db.Table("boards").Select("id").Where(
+		"owner_id = ? OR id IN (?)", userID, db.Table("board_shares").Select("board_id").Where("user_id = ?", userID),
+	)
+	return r.version(ctx, gorm.Expr("(?)", boards), now)
+}
+
+// TaskVersion returns the version of the board of the task and of the boards of the tasks it
+// references at now
+func (r *BoardChangeRepository) TaskVersion(ctx context.Context, taskID uuid.UUID, now time.Time) (BoardVersion, error) {
+	db := dbFromContext(ctx, r.db)
+	boards := db.Table("tasks").
+		Select("columns.board_id").
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Where("tasks.id = ? OR tasks.id IN (?)", taskID,
+			db.Table("task_references").Select("target_task_id").Where("task_id = ?", taskID))
+	return r.version(ctx, gorm.Expr("(?)", boards), now)
+}
+
+// version reads the version of the boards given as a list of IDs or a subquery in one query
+func (r *BoardChangeRepository) version(ctx context.Context, boards interface{}, now time.Time) (BoardVersion, error) {
+	var version BoardVersion
+	// Время обновления читается как текст: в SQLite агрегат теряет тип столбца
+	err := dbFromContext(ctx, r.db).Raw(
+		"SELECT "+
+			"(SELECT COUNT(*) FROM board_changes WHERE board_id IN @boards) AS changes, "+
+			"(SELECT COALESCE(MAX(id), 0) FROM board_changes WHERE board_id IN @boards) AS last_change, "+
+			"(SELECT COUNT(*) FROM boards WHERE id IN @boards) AS boards, "+
+			"(SELECT COALESCE(CAST(MAX(updated_at) AS TEXT), '') FROM boards WHERE id IN @boards) AS updated_at, "+
+			"(SELECT COUNT(*) FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"WHERE columns.board_id IN @boards AND tasks.completed_at IS NULL AND tasks.due_date < @now) AS overdue",
+		map[string]interface{}{"boards": boards, "now": now},
+	).Scan(&version).Error
+	return version, err
+}
+
+// Prune deletes the changes logged before the given time and returns how many were deleted
+func (r *BoardChangeRepository) Prune(ctx context.Context, before time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).Where("changed_at < ?", before).Delete(&model.BoardChange{})
+	return result.RowsAffected, result.Error
+}
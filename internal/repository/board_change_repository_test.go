@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardChangeRepository_Version(t *testing.T) {
+	db := testutil.OpenDB(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+	repo := repository.NewBoardChangeRepository(db)
+	boardRepo := repository.NewBoardRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	board := &model.Board{Title: "Board", OwnerID: owner.ID}
+	other := &model.Board{Title: "Other", OwnerID: owner.ID}
+	require.NoError(t, db.Create(board).Error)
+	require.NoError(t, db.Create(other).Error)
+	column := &model.Column{BoardID: board.ID, Title: "To Do", Position: 1}
+	require.NoError(t, db.Create(column).Error)
+	task := &model.Task{ColumnID: column.ID, Title: "Task", CreatedBy: owner.ID, Number: 1}
+	require.NoError(t, db.Create(task).Error)
+
+	version := func() repository.BoardVersion {
+		version, err := repo.Version(ctx, []uuid.UUID{board.ID}, now)
+		require.NoError(t, err)
+		return version
+	}
+	userVersion := func() repository.BoardVersion {
+		version, err := repo.UserVersion(ctx, member.ID, now)
+		require.NoError(t, err)
+		return version
+	}
+
+	// Без изменений версия та же
+	initial := version()
+	assert.Equal(t, initial, version())
+	assert.Equal(t, int64(1), initial.Boards)
+
+	// Изменения задач, меток и самой доски меняют версию, изменения другой доски - нет
+	require.NoError(t, db.Model(task).Update("title", "Renamed").Error)
+	renamed := version()
+	assert.NotEqual(t, initial, renamed)
+	require.NoError(t, db.Create(&model.Label{BoardID: &other.ID, Name: "bug", Color: "#ff0000"}).Error)
+	assert.Equal(t, renamed, version())
+	require.NoError(t, db.Create(&model.Label{OwnerID: &owner.ID, Name: "ops", Color: "#00ff00"}).Error)
+	labelled := version()
+	assert.NotEqual(t, renamed, labelled)
+	board.Title = "Roadmap"
+	require.NoError(t, boardRepo.Update(ctx, board))
+	retitled := version()
+	assert.NotEqual(t, labelled, retitled)
+	require.NoError(t, boardRepo.SetCardFields(ctx, board.ID, []string{"assignee"}))
+	assert.NotEqual(t, retitled, version())
+
+	// Просроченная задача меняет версию без записи
+	require.NoError(t, db.Model(task).Update("due_date", now.Add(time.Hour)).Error)
+	due := version()
+	later, err := repo.Version(ctx, []uuid.UUID{board.ID}, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), due.Overdue)
+	assert.Equal(t, int64(1), later.Overdue)
+
+	// Версия досок пользователя меняется, когда доска становится доступна
+	before := userVersion()
+	assert.Equal(t, int64(0), before.Boards)
+	require.NoError(t, repository.NewBoardShareRepository(db).ShareBoard(ctx, board.ID, member.ID, model.RoleViewer))
+	shared := userVersion()
+	assert.Equal(t, int64(1), shared.Boards)
+	assert.NotEqual(t, before, shared)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"kanban/internal/model"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -13,29 +14,69 @@ type BoardRepository struct {
 	db *gorm.DB
 }
 
+type BoardRepositoryInterface interface {
+	Create(ctx context.Context, board *model.Board) error
+	GetOwned(ctx context.Context, ownerID uuid.UUID) ([]model.Board, error)
+	TransferOwnership(ctx context.Context, boardID, newOwnerID uuid.UUID) error
+	DeleteOwned(ctx context.Context, ownerID uuid.UUID) (int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	CountOwned(ctx context.Context, ownerID uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*model.Board, error)
+	GetSummaries(ctx context.Context, userID uuid.UUID) ([]BoardSummary, error)
+	GetAccessibleByKey(ctx context.Context, userID uuid.UUID, key string) ([]model.Board, error)
+	GetAccessibleIDs(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error)
+	Update(ctx context.Context, board *model.Board) error
+	SetCardFields(ctx context.Context, boardID uuid.UUID, fields []string) error
+	SetReadReceipts(ctx context.Context, boardID uuid.UUID, enabled bool) error
+	SetFrozen(ctx context.Context, boardID uuid.UUID, frozenAt *time.Time) error
+}
+
+var _ BoardRepositoryInterface = (*BoardRepository)(nil)
+
 func NewBoardRepository(db *gorm.DB) *BoardRepository {
 	return &BoardRepository{db: db}
 }
 
 func (r *BoardRepository) Create(ctx context.Context, board *model.Board) error {
-	return r.db.WithContext(ctx).Create(board).Error
+	return dbFromContext(ctx, r.db).Create(board).Error
 }
 
 func (r *BoardRepository) GetOwned(ctx context.Context, ownerID uuid.UUID) ([]model.Board, error) {
 	var boards []model.Board
-	err := r.db.WithContext(ctx).Where("owner_id = ?", ownerID).Find(&boards).Error
+	err := dbFromContext(ctx, r.db).Where("owner_id = ?", ownerID).Find(&boards).Error
 	return boards, err
 }
 
+// TransferOwnership makes newOwnerID the owner of the board. The new owner's share
+// of the board becomes redundant and is removed.
+func (r *BoardRepository) TransferOwnership(ctx context.Context, boardID, newOwnerID uuid.UUID) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Model(&model.Board{}).Where("id = ?", boardID).Update("owner_id", newOwnerID).Error; err != nil {
+		return err
+	}
+	return db.Where("board_id = ? AND user_id = ?", boardID, newOwnerID).Delete(&model.BoardShare{}).Error
+}
+
+// DeleteOwned deletes every board of the owner with all of its content and returns how many were deleted
+func (r *BoardRepository) DeleteOwned(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	result := dbFromContext(ctx, r.db).Where("owner_id = ?", ownerID).Delete(&model.Board{})
+	return result.RowsAffected, result.Error
+}
+
+// Delete deletes a board with all of its content
+func (r *BoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&model.Board{}, "id = ?", id).Error
+}
+
 func (r *BoardRepository) CountOwned(ctx context.Context, ownerID uuid.UUID) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&model.Board{}).Where("owner_id = ?", ownerID).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Model(&model.Board{}).Where("owner_id = ?", ownerID).Count(&count).Error
 	return count, err
 }
 
 func (r *BoardRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Board, error) {
 	var board model.Board
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&board).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&board).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrBoardNotFound
 		}
@@ -44,6 +85,77 @@ func (r *BoardRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Boa
 	return &board, nil
 }
 
+// BoardSummary is a board the user can access with the user's role and the size of the board
+type BoardSummary struct {
+	model.Board   `gorm:"embedded"`
+	Role          string
+	ColumnCount   int
+	TaskCount     int
+	OpenTaskCount int
+}
+
+// GetSummaries returns the boards the user owns or is a member of, oldest first, with the
+// user's role ("owner" for own boards) and column and task counts, in a single query
+func (r *BoardRepository) GetSummaries(ctx context.Context, userID uuid.UUID) ([]BoardSummary, error) {
+	var summaries []BoardSummary
+	err := dbFromContext(ctx, r.db).
+		Table("boards").
+		Select("boards.*, COALESCE(board_shares.role, 'owner') AS role, "+
+			"(SELECT COUNT(*) FROM columns WHERE columns.board_id = boards.id) AS column_count, "+
+			"(SELECT COUNT(*) FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"WHERE columns.board_id = boards.id) AS task_count, "+
+			"(SELECT COUNT(*) FROM tasks JOIN columns ON columns.id = tasks.column_id "+
+			"WHERE columns.board_id = boards.id AND tasks.completed_at IS NULL) AS open_task_count").
+		Joins("LEFT JOIN board_shares ON board_shares.board_id = boards.id AND board_shares.user_id = ?", userID).
+		Where("boards.owner_id = ? OR board_shares.user_id IS NOT NULL", userID).
+		Order("boards.created_at").
+		Scan(&summaries).Error
+	return summaries, err
+}
+
+// GetAccessibleByKey returns boards with the given key that the user owns or has been shared
+func (r *BoardRepository) GetAccessibleByKey(ctx context.Context, userID uuid.UUID, key string) ([]model.Board, error) {
+	var boards []model.Board
+	err := dbFromContext(ctx, r.db).
+		Where("key = ?", key).
+		Where("owner_id = ? OR id IN (SELECT board_id FROM board_shares WHERE user_id = ?)", userID, userID).
+		Order("created_at").
+		Find(&boards).Error
+	return boards, err
+}
+
+// GetAccessibleIDs returns the IDs among ids of the boards that the user owns or has been shared
+func (r *BoardRepository) GetAccessibleIDs(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error) {
+	accessible := []uuid.UUID{}
+	if len(ids) == 0 {
+		return accessible, nil
+	}
+	err := dbFromContext(ctx, r.db).Model(&model.Board{}).
+		Where("id IN ?", ids).
+		Where("owner_id = ? OR id IN (SELECT board_id FROM board_shares WHERE user_id = ?)", userID, userID).
+		Pluck("id", &accessible).Error
+	return accessible, err
+}
+
+// Update saves board fields. The task counter is owned by TaskRepository.Create and never overwritten here, nor is
+// the freeze, which only SetFrozen changes.
 func (r *BoardRepository) Update(ctx context.Context, board *model.Board) error {
-	return r.db.WithContext(ctx).Save(board).Error
-}
\ No newline at end of file
+	return dbFromContext(ctx, r.db).Omit("TaskCounter", "FrozenAt").Save(board).Error
+}
+// SetCardFields replaces the card layout of the board; nil restores the default layout
+func (r *BoardRepository) SetCardFields(ctx context.Context, boardID uuid.UUID, fields []string) error {
+	return dbFromContext(ctx, r.db).Model(&model.Board{ID: boardID}).
+		Select("CardFields").
+		Updates(&model.Board{CardFields: fields}).Error
+}
+
+// SetReadReceipts turns read receipts of the board on or off
+func (r *BoardRepository) SetReadReceipts(ctx context.Context, boardID uuid.UUID, enabled bool) error {
+	// Переключение не считается изменением доски и не сдвигает updated_at
+	return dbFromContext(ctx, r.db).Model(&model.Board{ID: boardID}).UpdateColumn("read_receipts", enabled).Error
+}
+
+// SetFrozen freezes the board since frozenAt, or unfreezes it for nil
+func (r *BoardRepository) SetFrozen(ctx context.Context, boardID uuid.UUID, frozenAt *time.Time) error {
+	return dbFromContext(ctx, r.db).Model(&model.Board{ID: boardID}).UpdateColumn("frozen_at", frozenAt).Error
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/boardtemplate"
+	"kanban/internal/model"
+)
+
+type BoardTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewBoardTemplateRepository(db *gorm.DB) *BoardTemplateRepository {
+	return &BoardTemplateRepository{db: db}
+}
+
+// Instantiate creates a board owned by ownerID with all columns, labels and tasks
+// of the template in a single transaction
+func (r *BoardTemplateRepository) Instantiate(ctx context.Context, tpl boardtemplate.Template, ownerID uuid.UUID) (*model.Board, error) {
+	board := &model.Board{
+		Title:       tpl.Title,
+		Description: tpl.Description,
+		OwnerID:     ownerID,
+		Key:         model.DeriveBoardKey(tpl.Title),
+	}
+
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(board).Error; err != nil {
+			return err
+		}
+
+		labelIDs := make(map[string]uuid.UUID, len(tpl.Labels))
+		for _, l := range tpl.Labels {
+			label := model.Label{BoardID: &board.ID, Name: l.Name, Color: l.Color}
+			if err := tx.Create(&label).Error; err != nil {
+				return err
+			}
+			labelIDs[l.Name] = label.ID
+		}
+
+		now := time.Now()
+		number := 0
+		for i, c := range tpl.Columns {
+			column := model.Column{BoardID: board.ID, Title: c.Title, Position: i + 1, IsDone: c.Done}
+			if err := tx.Create(&column).Error; err != nil {
+				return err
+			}
+
+			for j, t := range c.Tasks {
+				number++
+				task := model.Task{
+					ColumnID:    column.ID,
+					Title:       t.Title,
+					Description: t.Description,
+					CreatedBy:   ownerID,
+					Position:    j,
+					Number:      number,
+				}
+				if c.Done {
+					task.CompletedAt = &now
+				}
+				if t.DueInDays != nil {
+					dueDate := now.AddDate(0, 0, *t.DueInDays)
+					task.DueDate = &dueDate
+				}
+				if err := tx.Create(&task).Error; err != nil {
+					return err
+				}
+
+				for _, name := range t.Labels {
+					labelID, ok := labelIDs[name]
+					if !ok {
+						continue
+					}
+					if err := tx.Exec(
+						"INSERT INTO task_labels (task_id, label_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+						task.ID, labelID,
+					).Error; err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		board.TaskCounter = number
+		return tx.Model(board).Update("task_counter", number).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return board, nil
+}
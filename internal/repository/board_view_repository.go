@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type BoardViewRepository struct {
+	db *gorm.DB
+}
+
+func NewBoardViewRepository(db *gorm.DB) *BoardViewRepository {
+	return &BoardViewRepository{db: db}
+}
+
+// Get returns the user's saved view of a board, or nil if none was saved
+func (r *BoardViewRepository) Get(ctx context.Context, userID, boardID uuid.UUID) (*model.BoardView, error) {
+	var view model.BoardView
+	err := dbFromContext(ctx, r.db).Where("user_id = ? AND board_id = ?", userID, boardID).First(&view).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &view, nil
+}
+
+// Save creates or replaces the user's view of a board
+func (r *BoardViewRepository) Save(ctx context.Context, view *model.BoardView) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "board_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sort_by", "sort_order", "group_by", "filters", "updated_at"}),
+	}).Create(view).Error
+}
+
+// Delete resets the user's view of a board to the defaults
+func (r *BoardViewRepository) Delete(ctx context.Context, userID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).
+		Where("user_id = ? AND board_id = ?", userID, boardID).
+		Delete(&model.BoardView{}).Error
+}
+
+// GetByUserID returns all saved board views of a user
+func (r *BoardViewRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]model.BoardView, error) {
+	var views []model.BoardView
+	err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Find(&views).Error
+	return views, err
+}
@@ -13,6 +13,20 @@ type BoardShareRepository struct {
 	db *gorm.DB
 }
 
+type BoardShareRepositoryInterface interface {
+	ShareBoard(ctx context.Context, boardID, userID uuid.UUID, role string) error
+	RemoveShare(ctx context.Context, boardID, userID uuid.UUID) error
+	GetBoardShares(ctx context.Context, boardID uuid.UUID) ([]model.BoardShare, error)
+	GetSharedBoards(ctx context.Context, userID uuid.UUID) ([]model.Board, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]model.BoardShare, error)
+	CopyShares(ctx context.Context, fromBoardID, toBoardID, toOwnerID uuid.UUID) (int, error)
+	GetUserRole(ctx context.Context, boardID, userID uuid.UUID) (string, error)
+	GetAccess(ctx context.Context, boardID, userID uuid.UUID) (*BoardAccess, error)
+	CheckAccess(ctx context.Context, boardID, userID uuid.UUID, requiredRole string) (bool, error)
+}
+
+var _ BoardShareRepositoryInterface = (*BoardShareRepository)(nil)
+
 func NewBoardShareRepository(db *gorm.DB) *BoardShareRepository {
 	return &BoardShareRepository{db: db}
 }
@@ -26,7 +40,7 @@ func (r *BoardShareRepository) ShareBoard(ctx context.Context, boardID, userID u
 	}
 	
 	// Используем транзакцию для предотвращения гонок
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// Проверяем, существует ли уже доступ
 		var existingShare model.BoardShare
 		err := tx.Where("board_id = ? AND user_id = ?", boardID, userID).First(&existingShare).Error
@@ -49,14 +63,14 @@ func (r *BoardShareRepository) ShareBoard(ctx context.Context, boardID, userID u
 
 // RemoveShare удаляет доступ пользователя к доске
 func (r *BoardShareRepository) RemoveShare(ctx context.Context, boardID, userID uuid.UUID) error {
-	return r.db.WithContext(ctx).Where("board_id = ? AND user_id = ?", boardID, userID).Delete(&model.BoardShare{}).Error
+	return dbFromContext(ctx, r.db).Where("board_id = ? AND user_id = ?", boardID, userID).Delete(&model.BoardShare{}).Error
 }
 
 // GetBoardShares возвращает список пользователей с доступом к доске
 func (r *BoardShareRepository) GetBoardShares(ctx context.Context, boardID uuid.UUID) ([]model.BoardShare, error) {
 	var shares []model.BoardShare
 	
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Preload("User").
 		Where("board_id = ?", boardID).
 		Find(&shares).Error
@@ -68,7 +82,7 @@ func (r *BoardShareRepository) GetBoardShares(ctx context.Context, boardID uuid.
 func (r *BoardShareRepository) GetSharedBoards(ctx context.Context, userID uuid.UUID) ([]model.Board, error) {
 	var boards []model.Board
 	
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Joins("JOIN board_shares ON board_shares.board_id = boards.id").
 		Where("board_shares.user_id = ?", userID).
 		Find(&boards).Error
@@ -76,11 +90,64 @@ func (r *BoardShareRepository) GetSharedBoards(ctx context.Context, userID uuid.
 	return boards, err
 }
 
+// GetByUserID возвращает все доступы пользователя к чужим доскам вместе с досками
+func (r *BoardShareRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]model.BoardShare, error) {
+	var shares []model.BoardShare
+	err := dbFromContext(ctx, r.db).
+		Preload("Board").
+		Where("user_id = ?", userID).
+		Find(&shares).Error
+	return shares, err
+}
+
+// CopyShares даёт участникам одной доски доступ к другой с той же ролью. Более высокая роль
+// на целевой доске сохраняется, её владелец и гости пропускаются: гостевая ссылка даёт доступ только к своей доске.
+// Возвращает число добавленных или повышенных участников.
+func (r *BoardShareRepository) CopyShares(ctx context.Context, fromBoardID, toBoardID, toOwnerID uuid.UUID) (int, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var shares []model.BoardShare
+	err := db.Where("board_id = ? AND user_id <> ?", fromBoardID, toOwnerID).
+		Where("user_id NOT IN (SELECT id FROM users WHERE is_guest)").
+		Find(&shares).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var existing []model.BoardShare
+	if err := db.Where("board_id = ?", toBoardID).Find(&existing).Error; err != nil {
+		return 0, err
+	}
+	byUser := make(map[uuid.UUID]*model.BoardShare, len(existing))
+	for i := range existing {
+		byUser[existing[i].UserID] = &existing[i]
+	}
+
+	changed := 0
+	for _, share := range shares {
+		current, ok := byUser[share.UserID]
+		switch {
+		case !ok:
+			if err := db.Create(&model.BoardShare{BoardID: toBoardID, UserID: share.UserID, Role: share.Role}).Error; err != nil {
+				return 0, err
+			}
+		case model.RoleRank(share.Role) > model.RoleRank(current.Role):
+			if err := db.Model(current).Update("role", share.Role).Error; err != nil {
+				return 0, err
+			}
+		default:
+			continue
+		}
+		changed++
+	}
+	return changed, nil
+}
+
 // GetUserRole возвращает роль пользователя для доски (или пустую строку, если нет доступа)
 func (r *BoardShareRepository) GetUserRole(ctx context.Context, boardID, userID uuid.UUID) (string, error) {
 	var share model.BoardShare
 	
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		First(&share).Error
 	
@@ -95,44 +162,56 @@ func (r *BoardShareRepository) GetUserRole(ctx context.Context, boardID, userID
 	return share.Role, nil
 }
 
-// CheckAccess проверяет, имеет ли пользователь доступ к доске с указанной ролью или выше
-func (r *BoardShareRepository) CheckAccess(ctx context.Context, boardID, userID uuid.UUID, requiredRole string) (bool, error) {
-	// Проверяем, является ли пользователь владельцем
-	var board model.Board
-	err := r.db.WithContext(ctx).
-		Where("id = ? AND owner_id = ?", boardID, userID).
-		First(&board).Error
-	
+// BoardAccess is the owner of a board and the role of a user on it, nil if the user is no member
+type BoardAccess struct {
+	OwnerID uuid.UUID
+	Role    *string
+}
+
+// Allows reports whether the user has the required role or higher on the board
+func (a *BoardAccess) Allows(userID uuid.UUID, requiredRole string) bool {
 	// Владелец всегда имеет полный доступ
-	if err == nil {
-		return true, nil
+	if a.OwnerID == userID {
+		return true
 	}
-	
-	// Если ошибка не связана с отсутствием записи, возвращаем ее
-	if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return false, err
-	}
-	
-	// Проверяем права по таблице доступа
-	var share model.BoardShare
-	err = r.db.WithContext(ctx).
-		Where("board_id = ? AND user_id = ?", boardID, userID).
-		First(&share).Error
-	
+
 	// Нет доступа
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		return false, nil
+	if a.Role == nil {
+		return false
 	}
-	
-	if err != nil {
-		return false, err
+
+	// Роль пользователя должна быть не ниже требуемой: viewer < commenter < editor
+	return model.RoleRank(*a.Role) >= model.RoleRank(requiredRole)
+}
+
+// GetAccess возвращает владельца доски и роль пользователя на ней одним запросом,
+// или nil, если доски нет
+func (r *BoardShareRepository) GetAccess(ctx context.Context, boardID, userID uuid.UUID) (*BoardAccess, error) {
+	var access BoardAccess
+	result := dbFromContext(ctx, r.db).
+		Table("boards").
+		Select("boards.owner_id, board_shares.role").
+		Joins("LEFT JOIN board_shares ON board_shares.board_id = boards.id AND board_shares.user_id = ?", userID).
+		Where("boards.id = ?", boardID).
+		Limit(1).
+		Scan(&access)
+	if result.Error != nil {
+		return nil, result.Error
 	}
-	
-	// Если требуется роль "viewer", то подойдет любая роль
-	if requiredRole == model.RoleViewer {
-		return true, nil
+
+	// Доски нет
+	if result.RowsAffected == 0 {
+		return nil, nil
 	}
-	
-	// Если требуется роль "editor", то проверяем что у пользователя роль "editor"
-	return share.Role == model.RoleEditor, nil
+	return &access, nil
+}
+
+// CheckAccess проверяет, имеет ли пользователь доступ к доске с указанной ролью или выше.
+// Владение и роль проверяются одним запросом.
+func (r *BoardShareRepository) CheckAccess(ctx context.Context, boardID, userID uuid.UUID, requiredRole string) (bool, error) {
+	access, err := r.GetAccess(ctx, boardID, userID)
+	if err != nil || access == nil {
+		return false, err
+	}
+	return access.Allows(userID, requiredRole), nil
 }
\ No newline at end of file
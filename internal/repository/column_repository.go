@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"kanban/internal/model"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -13,17 +14,47 @@ type ColumnRepository struct {
 	db *gorm.DB
 }
 
+type ColumnRepositoryInterface interface {
+	Create(ctx context.Context, column *model.Column) error
+	GetByID(ctx context.Context, id uuid.UUID) (*model.Column, error)
+	GetWithBoard(ctx context.Context, id uuid.UUID) (*model.Column, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Column, error)
+	GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Column, error)
+	CountByBoard(ctx context.Context, boardID uuid.UUID) (int64, error)
+	Update(ctx context.Context, column *model.Column) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetMaxPosition(ctx context.Context, boardID uuid.UUID) (int, error)
+	ReorderColumns(ctx context.Context, columns []model.Column) error
+	CompactFragmentedBoards(ctx context.Context, limit int) (int, int64, error)
+	MoveToBoard(ctx context.Context, column *model.Column, boardID uuid.UUID, position int) error
+	CreateAt(ctx context.Context, column *model.Column, position int) error
+}
+
+var _ ColumnRepositoryInterface = (*ColumnRepository)(nil)
+
 func NewColumnRepository(db *gorm.DB) *ColumnRepository {
 	return &ColumnRepository{db: db}
 }
 
 func (r *ColumnRepository) Create(ctx context.Context, column *model.Column) error {
-	return r.db.WithContext(ctx).Create(column).Error
+	return dbFromContext(ctx, r.db).Create(column).Error
 }
 
 func (r *ColumnRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Column, error) {
 	var column model.Column
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&column).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&column).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &column, nil
+}
+
+// GetWithBoard retrieves a column together with its board, or nil if there is no such column
+func (r *ColumnRepository) GetWithBoard(ctx context.Context, id uuid.UUID) (*model.Column, error) {
+	var column model.Column
+	if err := dbFromContext(ctx, r.db).Joins("Board").Where("columns.id = ?", id).First(&column).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -34,29 +65,47 @@ func (r *ColumnRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Co
 
 func (r *ColumnRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Column, error) {
 	var columns []model.Column
-	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&columns).Error
+	err := dbFromContext(ctx, r.db).Where("id IN ?", ids).Find(&columns).Error
 	return columns, err
 }
 
 func (r *ColumnRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Column, error) {
 	var columns []model.Column
-	err := r.db.WithContext(ctx).Where("board_id = ?", boardID).Order("position").Find(&columns).Error
+	err := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Order("position").Find(&columns).Error
 	return columns, err
 }
 
+// CountByBoard counts the columns of a board
+func (r *ColumnRepository) CountByBoard(ctx context.Context, boardID uuid.UUID) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.Column{}).Where("board_id = ?", boardID).Count(&count).Error
+	return count, err
+}
+
+// Update saves the column and keeps completion of its tasks in line with the column's done flag
 func (r *ColumnRepository) Update(ctx context.Context, column *model.Column) error {
-	return r.db.WithContext(ctx).Save(column).Error
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(column).Error; err != nil {
+			return err
+		}
+
+		tasks := tx.Model(&model.Task{}).Where("column_id = ?", column.ID)
+		if column.IsDone {
+			return tasks.Where("completed_at IS NULL").Update("completed_at", time.Now()).Error
+		}
+		return tasks.Where("completed_at IS NOT NULL").Update("completed_at", nil).Error
+	})
 }
 
 func (r *ColumnRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.Column{}, id).Error
+	return dbFromContext(ctx, r.db).Delete(&model.Column{}, id).Error
 }
 
 func (r *ColumnRepository) GetMaxPosition(ctx context.Context, boardID uuid.UUID) (int, error) {
 	var maxPosition struct {
 		Max int
 	}
-	err := r.db.WithContext(ctx).Model(&model.Column{}).
+	err := dbFromContext(ctx, r.db).Model(&model.Column{}).
 		Select("COALESCE(MAX(position), 0) as max").
 		Where("board_id = ?", boardID).
 		Scan(&maxPosition).Error
@@ -65,7 +114,7 @@ func (r *ColumnRepository) GetMaxPosition(ctx context.Context, boardID uuid.UUID
 }
 
 func (r *ColumnRepository) ReorderColumns(ctx context.Context, columns []model.Column) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		for _, column := range columns {
 			if err := tx.Model(&model.Column{}).Where("id = ?", column.ID).
 				Update("position", column.Position).Error; err != nil {
@@ -74,4 +123,90 @@ func (r *ColumnRepository) ReorderColumns(ctx context.Context, columns []model.C
 		}
 		return nil
 	})
+}
+
+// CompactFragmentedBoards renumbers column positions to 1..n in up to limit boards whose
+// columns have gaps or duplicate positions. It returns the number of boards and columns touched.
+func (r *ColumnRepository) CompactFragmentedBoards(ctx context.Context, limit int) (int, int64, error) {
+	var boardIDs []uuid.UUID
+	err := dbFromContext(ctx, r.db).Model(&model.Column{}).
+		Select("board_id").
+		Group("board_id").
+		Having("MIN(position) <> 1 OR MAX(position) <> COUNT(*) OR COUNT(DISTINCT position) <> COUNT(*)").
+		Limit(limit).
+		Pluck("board_id", &boardIDs).Error
+	if err != nil || len(boardIDs) == 0 {
+		return 0, 0, err
+	}
+
+	affected, err := compactColumnPositions(dbFromContext(ctx, r.db), boardIDs)
+	return len(boardIDs), affected, err
+}
+
+// MoveToBoard moves the column to another board at the given 1-based position, or after the
+// last column when position is out of range, and closes the gap it leaves on its old board.
+// The column's tasks are not touched.
+func (r *ColumnRepository) MoveToBoard(ctx context.Context, column *model.Column, boardID uuid.UUID, position int) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		position, err := makeColumnRoom(tx, boardID, position)
+		if err != nil {
+			return err
+		}
+
+		oldBoardID := column.BoardID
+		if err := tx.Model(column).Updates(map[string]interface{}{"board_id": boardID, "position": position}).Error; err != nil {
+			return err
+		}
+		column.BoardID = boardID
+		column.Position = position
+
+		_, err = compactColumnPositions(tx, []uuid.UUID{oldBoardID})
+		return err
+	})
+}
+
+// CreateAt adds the column to its board at the given 1-based position, or after the last
+// column when position is out of range, shifting the columns after it
+func (r *ColumnRepository) CreateAt(ctx context.Context, column *model.Column, position int) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		position, err := makeColumnRoom(tx, column.BoardID, position)
+		if err != nil {
+			return err
+		}
+		column.Position = position
+		return tx.Create(column).Error
+	})
+}
+
+// makeColumnRoom frees the given 1-based position on the board by shifting the columns at and
+// after it, and returns the position, moved after the last column when out of range
+func makeColumnRoom(tx *gorm.DB, boardID uuid.UUID, position int) (int, error) {
+	var maxPosition int
+	if err := tx.Model(&model.Column{}).
+		Select("COALESCE(MAX(position), 0)").
+		Where("board_id = ?", boardID).
+		Scan(&maxPosition).Error; err != nil {
+		return 0, err
+	}
+	if position < 1 || position > maxPosition {
+		position = maxPosition + 1
+	}
+
+	err := tx.Model(&model.Column{}).
+		Where("board_id = ? AND position >= ?", boardID, position).
+		Update("position", gorm.Expr("position + 1")).Error
+	return position, err
+}
+
+// compactColumnPositions renumbers the column positions of the given boards to 1..n keeping
+// their order and returns the number of columns whose position changed
+func compactColumnPositions(db *gorm.DB, boardIDs []uuid.UUID) (int64, error) {
+	result := db.Exec(
+		"UPDATE columns SET position = ranked.rn FROM ("+
+			"SELECT id, ROW_NUMBER() OVER (PARTITION BY board_id ORDER BY position, id) AS rn "+
+			"FROM columns WHERE board_id IN ?"+
+			") AS ranked WHERE columns.id = ranked.id AND columns.position <> ranked.rn",
+		boardIDs,
+	)
+	return result.RowsAffected, result.Error
 }
\ No newline at end of file
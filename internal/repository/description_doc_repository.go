@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type DescriptionDocRepository struct {
+	db *gorm.DB
+}
+
+func NewDescriptionDocRepository(db *gorm.DB) *DescriptionDocRepository {
+	return &DescriptionDocRepository{db: db}
+}
+
+// Open retrieves the description document of a task, starting it at version 0 with the
+// given description when the task has none yet
+func (r *DescriptionDocRepository) Open(ctx context.Context, taskID uuid.UUID, description string) (*model.DescriptionDoc, error) {
+	db := dbFromContext(ctx, r.db)
+	doc := model.DescriptionDoc{TaskID: taskID, Content: description}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&doc).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Where("task_id = ?", taskID).First(&doc).Error; err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// GetForUpdate retrieves the description document of a task and locks it until the end of
+// the transaction, or returns nil if the task has none
+func (r *DescriptionDocRepository) GetForUpdate(ctx context.Context, taskID uuid.UUID) (*model.DescriptionDoc, error) {
+	var docs []model.DescriptionDoc
+	err := dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("task_id = ?", taskID).
+		Limit(1).
+		Find(&docs).Error
+	if err != nil || len(docs) == 0 {
+		return nil, err
+	}
+	return &docs[0], nil
+}
+
+// GetOps retrieves up to limit operations of a document's log after version since, oldest first
+func (r *DescriptionDocRepository) GetOps(ctx context.Context, taskID uuid.UUID, since int64, limit int) ([]model.DescriptionOp, error) {
+	var ops []model.DescriptionOp
+	err := dbFromContext(ctx, r.db).
+		Where("task_id = ? AND version > ?", taskID, since).
+		Order("version").
+		Limit(limit).
+		Find(&ops).Error
+	return ops, err
+}
+
+// Append adds the operation to the document's log and stores the document as it is after
+// the operation; doc.Version must be op.Version
+func (r *DescriptionDocRepository) Append(ctx context.Context, doc *model.DescriptionDoc, op *model.DescriptionOp) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Create(op).Error; err != nil {
+		return err
+	}
+	return db.Model(doc).Select("version", "content", "saved_version", "saved_at", "updated_by", "updated_at").Updates(doc).Error
+}
+
+// MarkSaved records that version of the document was saved to its task at savedAt
+func (r *DescriptionDocRepository) MarkSaved(ctx context.Context, taskID uuid.UUID, version int64, savedAt time.Time) error {
+	return dbFromContext(ctx, r.db).Model(&model.DescriptionDoc{}).
+		Where("task_id = ?", taskID).
+		Updates(map[string]interface{}{"saved_version": version, "saved_at": savedAt}).Error
+}
+
+// GetUnsaved lists up to limit tasks whose documents have unsaved edits and were last edited
+// at or before before
+func (r *DescriptionDocRepository) GetUnsaved(ctx context.Context, before time.Time, limit int) ([]uuid.UUID, error) {
+	var taskIDs []uuid.UUID
+	err := dbFromContext(ctx, r.db).Model(&model.DescriptionDoc{}).
+		Where("version > saved_version AND updated_at <= ?", before).
+		Order("updated_at").
+		Limit(limit).
+		Pluck("task_id", &taskIDs).Error
+	return taskIDs, err
+}
+
+// PruneOps deletes the operations more than keep versions behind their document and returns
+// how many were deleted
+func (r *DescriptionDocRepository) PruneOps(ctx context.Context, keep int64) (int64, error) {
+	result := dbFromContext(ctx, r.db).Exec(`
+		DELETE FROM description_ops
+		USING description_docs
+		WHERE description_ops.task_id = description_docs.task_id
+			AND description_ops.version <= description_docs.version - ?`, keep)
+	return result.RowsAffected, result.Error
+}
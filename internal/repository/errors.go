@@ -9,4 +9,55 @@ var (
 	
 	// ErrLabelNotFound is returned when a label is not found
 	ErrLabelNotFound = errors.New("label not found")
+	
+	// ErrOperationNotFound is returned when an operation is not found
+	ErrOperationNotFound = errors.New("operation not found")
+
+	// ErrShareLinkNotFound is returned when a share link does not exist
+	ErrShareLinkNotFound = errors.New("share link not found")
+
+	// ErrShareLinkInactive is returned when a share link is revoked, expired or used up
+	ErrShareLinkInactive = errors.New("share link is no longer active")
+
+	// ErrGuestLinkNotFound is returned when a guest link does not exist
+	ErrGuestLinkNotFound = errors.New("guest link not found")
+
+	// ErrGuestLinkInactive is returned when a guest link is revoked or expired
+	ErrGuestLinkInactive = errors.New("guest link is no longer active")
+
+	// ErrRelationNotFound is returned when a task relation is not found
+	ErrRelationNotFound = errors.New("task relation not found")
+
+	// ErrRelationExists is returned when the tasks are already linked the same way
+	ErrRelationExists = errors.New("task relation already exists")
+
+	// ErrRelationCycle is returned when a blocking relation would make tasks block themselves
+	ErrRelationCycle = errors.New("task relation would create a dependency cycle")
+
+	// ErrSwimlaneNotFound is returned when a swimlane is not found
+	ErrSwimlaneNotFound = errors.New("swimlane not found")
+
+	// ErrSprintNotFound is returned when a sprint is not found
+	ErrSprintNotFound = errors.New("sprint not found")
+
+	// ErrSprintClosed is returned when a closed sprint is changed
+	ErrSprintClosed = errors.New("sprint is closed")
+
+	// ErrTaskTemplateNotFound is returned when a task template is not found
+	ErrTaskTemplateNotFound = errors.New("task template not found")
+
+	// ErrStatusPageNotFound is returned when a board has no status page or a slug is unknown
+	ErrStatusPageNotFound = errors.New("status page not found")
+
+	// ErrTaskImportNotFound is returned when a task import does not exist
+	ErrTaskImportNotFound = errors.New("task import not found")
+
+	// ErrGitHubIntegrationNotFound is returned when a board is not connected to GitHub
+	ErrGitHubIntegrationNotFound = errors.New("github integration not found")
+
+	// ErrActionNotFound is returned when an action is not found
+	ErrActionNotFound = errors.New("action not found")
+
+	// ErrActionUndone is returned when an action is undone a second time
+	ErrActionUndone = errors.New("action is already undone")
 )
\ No newline at end of file
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type GitHubRepository struct {
+	db *gorm.DB
+}
+
+func NewGitHubRepository(db *gorm.DB) *GitHubRepository {
+	return &GitHubRepository{db: db}
+}
+
+// GetIntegration retrieves the GitHub integration of a board
+func (r *GitHubRepository) GetIntegration(ctx context.Context, boardID uuid.UUID) (*model.GitHubIntegration, error) {
+	var integration model.GitHubIntegration
+	if err := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).First(&integration).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrGitHubIntegrationNotFound
+		}
+		return nil, err
+	}
+	return &integration, nil
+}
+
+// CreateIntegration connects a board to GitHub
+func (r *GitHubRepository) CreateIntegration(ctx context.Context, integration *model.GitHubIntegration) error {
+	return dbFromContext(ctx, r.db).Create(integration).Error
+}
+
+// UpdateIntegration saves the secret and the columns of the integration
+func (r *GitHubRepository) UpdateIntegration(ctx context.Context, integration *model.GitHubIntegration) error {
+	return dbFromContext(ctx, r.db).Model(integration).
+		Select("Secret", "ReviewColumnID", "DoneColumnID", "UpdatedAt").
+		Updates(integration).Error
+}
+
+// DeleteIntegration disconnects a board from GitHub. Links already made are kept.
+func (r *GitHubRepository) DeleteIntegration(ctx context.Context, boardID uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Delete(&model.GitHubIntegration{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrGitHubIntegrationNotFound
+	}
+	return nil
+}
+
+// SaveLink links a task to a commit or pull request, updating the title and state of an
+// existing link
+func (r *GitHubRepository) SaveLink(ctx context.Context, link *model.TaskGitHubLink) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "task_id"}, {Name: "kind"}, {Name: "repository"}, {Name: "ref"}},
+		DoUpdates: clause.AssignmentColumns([]string{"url", "title", "state", "author", "updated_at"}),
+	}).Create(link).Error
+}
+
+// GetLinksByTaskID lists the commits and pull requests linked to a task, newest first
+func (r *GitHubRepository) GetLinksByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.TaskGitHubLink, error) {
+	var links []model.TaskGitHubLink
+	err := dbFromContext(ctx, r.db).
+		Where("task_id = ?", taskID).
+		Order("created_at DESC").
+		Find(&links).Error
+	return links, err
+}
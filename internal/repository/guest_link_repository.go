@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type GuestLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewGuestLinkRepository(db *gorm.DB) *GuestLinkRepository {
+	return &GuestLinkRepository{db: db}
+}
+
+func (r *GuestLinkRepository) Create(ctx context.Context, link *model.GuestLink) error {
+	return dbFromContext(ctx, r.db).Create(link).Error
+}
+
+// GetByBoardID retrieves the guest links of a board, newest first
+func (r *GuestLinkRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.GuestLink, error) {
+	var links []model.GuestLink
+	err := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Order("created_at DESC").Find(&links).Error
+	return links, err
+}
+
+// Revoke disables a guest link of the board and returns it
+func (r *GuestLinkRepository) Revoke(ctx context.Context, boardID, id uuid.UUID) (*model.GuestLink, error) {
+	var link model.GuestLink
+	result := dbFromContext(ctx, r.db).Model(&link).
+		Clauses(clause.Returning{}).
+		Where("id = ? AND board_id = ?", id, boardID).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", time.Now()))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrGuestLinkNotFound
+	}
+	return &link, nil
+}
+
+// StartSession counts a session of an active guest link and returns the link
+func (r *GuestLinkRepository) StartSession(ctx context.Context, token string) (*model.GuestLink, error) {
+	var link model.GuestLink
+	err := dbFromContext(ctx, r.db).Where("token = ?", token).First(&link).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGuestLinkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !link.Active(now) {
+		return nil, ErrGuestLinkInactive
+	}
+
+	err = dbFromContext(ctx, r.db).Model(&link).Updates(map[string]interface{}{
+		"sessions":        gorm.Expr("sessions + 1"),
+		"last_session_at": now,
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+	link.Sessions++
+	link.LastSessionAt = &now
+	return &link, nil
+}
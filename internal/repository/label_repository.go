@@ -3,10 +3,12 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"kanban/internal/database"
 	"kanban/internal/model"
 )
 
@@ -14,19 +16,41 @@ type LabelRepository struct {
 	db *gorm.DB
 }
 
+type LabelRepositoryInterface interface {
+	Create(ctx context.Context, label *model.Label) error
+	GetByID(ctx context.Context, id uuid.UUID) (*model.Label, error)
+	GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Label, error)
+	GetByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]model.Label, error)
+	CopyWorkspaceLabelsToBoard(ctx context.Context, ownerID, boardID uuid.UUID) error
+	RemapColumnLabels(ctx context.Context, columnID, boardID, ownerID uuid.UUID) (int, error)
+	RemapTaskLabels(ctx context.Context, taskID, boardID, ownerID uuid.UUID) (int, int, error)
+	FindWorkspaceLabelByName(ctx context.Context, ownerID uuid.UUID, name string) (*model.Label, error)
+	GetAvailableForBoard(ctx context.Context, boardID, ownerID uuid.UUID) ([]model.Label, error)
+	GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.Label, error)
+	Update(ctx context.Context, label *model.Label) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) (int64, error)
+	AddMissingToBoard(ctx context.Context, boardID, ownerID uuid.UUID, labels []model.Label) ([]model.Label, error)
+	AttachToTask(ctx context.Context, labelID, taskID uuid.UUID) error
+	DetachFromTask(ctx context.Context, labelID, taskID uuid.UUID) error
+	GetTasksWithLabel(ctx context.Context, labelID uuid.UUID, opts TaskListOptions) ([]model.Task, error)
+}
+
+var _ LabelRepositoryInterface = (*LabelRepository)(nil)
+
 func NewLabelRepository(db *gorm.DB) *LabelRepository {
 	return &LabelRepository{db: db}
 }
 
 // Create adds a new label to the database
 func (r *LabelRepository) Create(ctx context.Context, label *model.Label) error {
-	return r.db.WithContext(ctx).Create(label).Error
+	return dbFromContext(ctx, r.db).Create(label).Error
 }
 
 // GetByID retrieves a label by its ID
 func (r *LabelRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Label, error) {
 	var label model.Label
-	result := r.db.WithContext(ctx).First(&label, "id = ?", id)
+	result := dbFromContext(ctx, r.db).First(&label, "id = ?", id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, ErrLabelNotFound
@@ -39,17 +63,238 @@ func (r *LabelRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Lab
 // GetByBoardID retrieves all labels for a specific board
 func (r *LabelRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Label, error) {
 	var labels []model.Label
-	result := r.db.WithContext(ctx).Where("board_id = ?", boardID).Find(&labels)
+	result := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Find(&labels)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return labels, nil
+}
+
+// GetByOwnerID retrieves all workspace labels owned by a specific user
+func (r *LabelRepository) GetByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]model.Label, error) {
+	var labels []model.Label
+	result := dbFromContext(ctx, r.db).Where("owner_id = ?", ownerID).Order("name").Find(&labels)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return labels, nil
 }
 
+// CopyWorkspaceLabelsToBoard turns the owner's workspace labels used on the board into board labels,
+// so the board keeps them once the workspace is gone. A board label with the same name is reused.
+func (r *LabelRepository) CopyWorkspaceLabelsToBoard(ctx context.Context, ownerID, boardID uuid.UUID) error {
+	db := dbFromContext(ctx, r.db)
+	boardTasks := db.Table("tasks").Select("tasks.id").
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Where("columns.board_id = ?", boardID)
+
+	var used []model.Label
+	err := db.Where("owner_id = ?", ownerID).
+		Where("id IN (?)", db.Table("task_labels").Select("label_id").Where("task_id IN (?)", boardTasks)).
+		Find(&used).Error
+	if err != nil {
+		return err
+	}
+
+	for _, workspaceLabel := range used {
+		var boardLabel model.Label
+		err := db.Where("board_id = ? AND LOWER(name) = LOWER(?)", boardID, workspaceLabel.Name).First(&boardLabel).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			boardLabel = model.Label{BoardID: &boardID, Name: workspaceLabel.Name, Color: workspaceLabel.Color}
+			err = db.Create(&boardLabel).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := db.Exec(
+			"INSERT INTO task_labels (task_id, label_id) "+
+				"SELECT task_labels.task_id, ? FROM task_labels "+
+				"JOIN tasks ON tasks.id = task_labels.task_id JOIN columns ON columns.id = tasks.column_id "+
+				"WHERE task_labels.label_id = ? AND columns.board_id = ? ON CONFLICT DO NOTHING",
+			boardLabel.ID, workspaceLabel.ID, boardID,
+		).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RemapColumnLabels replaces the labels on the column's tasks that are not usable on the board
+// the column moved to by the board's labels of the same name: a workspace label of the board
+// owner or a board label, created when neither exists. It returns the number of labels created.
+func (r *LabelRepository) RemapColumnLabels(ctx context.Context, columnID, boardID, ownerID uuid.UUID) (int, error) {
+	db := dbFromContext(ctx, r.db)
+	columnTasks := db.Table("tasks").Select("id").Where("column_id = ?", columnID)
+
+	var foreign []model.Label
+	err := db.Where("id IN (?)", db.Table("task_labels").Select("label_id").Where("task_id IN (?)", columnTasks)).
+		Where("(board_id IS NULL OR board_id <> ?) AND (owner_id IS NULL OR owner_id <> ?)", boardID, ownerID).
+		Find(&foreign).Error
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, label := range foreign {
+		var target model.Label
+		err := db.Where("(owner_id = ? OR board_id = ?) AND LOWER(name) = LOWER(?)", ownerID, boardID, label.Name).
+			Order("owner_id IS NULL").
+			First(&target).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			target = model.Label{BoardID: &boardID, Name: label.Name, Color: label.Color}
+			err = db.Create(&target).Error
+			created++
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		if err := db.Exec(
+			"INSERT INTO task_labels (task_id, label_id) "+
+				"SELECT task_id, ? FROM task_labels WHERE label_id = ? AND task_id IN (?) ON CONFLICT DO NOTHING",
+			target.ID, label.ID, columnTasks,
+		).Error; err != nil {
+			return 0, err
+		}
+
+		if err := db.Exec(
+			"DELETE FROM task_labels WHERE label_id = ? AND task_id IN (?)", label.ID, columnTasks,
+		).Error; err != nil {
+			return 0, err
+		}
+	}
+	return created, nil
+}
+
+// RemapTaskLabels replaces the labels on the task that are not usable on the board it moved to
+// by the board's labels of the same name, like RemapColumnLabels, but removes the labels the
+// board has no match for instead of creating them. It returns the numbers of labels replaced
+// and removed.
+func (r *LabelRepository) RemapTaskLabels(ctx context.Context, taskID, boardID, ownerID uuid.UUID) (int, int, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var foreign []model.Label
+	err := db.Where("id IN (?)", db.Table("task_labels").Select("label_id").Where("task_id = ?", taskID)).
+		Where("(board_id IS NULL OR board_id <> ?) AND (owner_id IS NULL OR owner_id <> ?)", boardID, ownerID).
+		Find(&foreign).Error
+	if err != nil {
+		return 0, 0, err
+	}
+
+	remapped, dropped := 0, 0
+	for _, label := range foreign {
+		var targets []model.Label
+		err := db.Where("(owner_id = ? OR board_id = ?) AND LOWER(name) = LOWER(?)", ownerID, boardID, label.Name).
+			Order("owner_id IS NULL").
+			Limit(1).
+			Find(&targets).Error
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if len(targets) == 0 {
+			dropped++
+		} else {
+			remapped++
+			if err := db.Exec(
+				"INSERT INTO task_labels (task_id, label_id) VALUES (?, ?) ON CONFLICT DO NOTHING", taskID, targets[0].ID,
+			).Error; err != nil {
+				return 0, 0, err
+			}
+		}
+
+		if err := db.Exec("DELETE FROM task_labels WHERE task_id = ? AND label_id = ?", taskID, label.ID).Error; err != nil {
+			return 0, 0, err
+		}
+	}
+	return remapped, dropped, nil
+}
+
+// AddMissingToBoard creates board labels with the names and colors of labels, skipping the names
+// a label usable on the board already has: a board label or a workspace label of the board owner,
+// compared ignoring case. It returns the created labels.
+func (r *LabelRepository) AddMissingToBoard(ctx context.Context, boardID, ownerID uuid.UUID, labels []model.Label) ([]model.Label, error) {
+	var created []model.Label
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var names []string
+		if err := tx.Model(&model.Label{}).
+			Where("board_id = ? OR owner_id = ?", boardID, ownerID).
+			Pluck("LOWER(name)", &names).Error; err != nil {
+			return err
+		}
+		taken := make(map[string]bool, len(names)+len(labels))
+		for _, name := range names {
+			taken[name] = true
+		}
+
+		for _, label := range labels {
+			key := strings.ToLower(label.Name)
+			if taken[key] {
+				continue
+			}
+			taken[key] = true
+
+			boardLabel := model.Label{BoardID: &boardID, Name: label.Name, Color: label.Color}
+			if err := tx.Create(&boardLabel).Error; err != nil {
+				return err
+			}
+			created = append(created, boardLabel)
+		}
+		return nil
+	})
+	return created, err
+}
+
+// FindWorkspaceLabelByName looks up a workspace label by case-insensitive name.
+// It returns nil if the owner has no such label.
+func (r *LabelRepository) FindWorkspaceLabelByName(ctx context.Context, ownerID uuid.UUID, name string) (*model.Label, error) {
+	var label model.Label
+	err := dbFromContext(ctx, r.db).
+		Where("owner_id = ? AND LOWER(name) = LOWER(?)", ownerID, name).
+		First(&label).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// GetAvailableForBoard retrieves the labels usable on a board: the workspace
+// labels of the board owner followed by the board's own labels. Workspace labels
+// take precedence, so a board label whose name matches a workspace label is omitted.
+func (r *LabelRepository) GetAvailableForBoard(ctx context.Context, boardID, ownerID uuid.UUID) ([]model.Label, error) {
+	workspaceLabels, err := r.GetByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardLabels, err := r.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]struct{}, len(workspaceLabels))
+	for _, label := range workspaceLabels {
+		taken[strings.ToLower(label.Name)] = struct{}{}
+	}
+
+	labels := workspaceLabels
+	for _, label := range boardLabels {
+		if _, shadowed := taken[strings.ToLower(label.Name)]; shadowed {
+			continue
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
 // GetByTaskID retrieves all labels associated with a specific task
 func (r *LabelRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.Label, error) {
 	var labels []model.Label
-	result := r.db.WithContext(ctx).
+	result := dbFromContext(ctx, r.db).
 		Joins("JOIN task_labels ON task_labels.label_id = labels.id").
 		Where("task_labels.task_id = ?", taskID).
 		Find(&labels)
@@ -62,7 +307,7 @@ func (r *LabelRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]
 
 // Update updates an existing label
 func (r *LabelRepository) Update(ctx context.Context, label *model.Label) error {
-	result := r.db.WithContext(ctx).Save(label)
+	result := dbFromContext(ctx, r.db).Save(label)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -74,7 +319,7 @@ func (r *LabelRepository) Update(ctx context.Context, label *model.Label) error
 
 // Delete removes a label by its ID
 func (r *LabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.Label{}, "id = ?", id)
+	result := dbFromContext(ctx, r.db).Delete(&model.Label{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -84,9 +329,58 @@ func (r *LabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Merge moves the source label to every task that has it onto the target label, also in task
+// templates, and deletes the source label, all in one transaction. It returns the number of
+// tasks that had the source label.
+func (r *LabelRepository) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (int64, error) {
+	var tasks int64
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("task_labels").Where("label_id = ?", sourceID).Count(&tasks).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec(
+			"INSERT INTO task_labels (task_id, label_id) "+
+				"SELECT task_id, ? FROM task_labels WHERE label_id = ? ON CONFLICT DO NOTHING",
+			targetID, sourceID,
+		).Error; err != nil {
+			return err
+		}
+
+		// Шаблоны хранят ID меток в JSON; повторы после замены схлопываются
+		query := `
+			UPDATE task_templates SET label_ids = (
+				SELECT COALESCE(jsonb_agg(DISTINCT CASE WHEN id = ? THEN ? ELSE id END), '[]'::jsonb)
+				FROM jsonb_array_elements_text(label_ids) AS ids(id)
+			)
+			WHERE label_ids @> jsonb_build_array(?::text)`
+		if database.IsSQLite(tx) {
+			query = `
+			UPDATE task_templates SET label_ids = (
+				SELECT json_group_array(DISTINCT CASE WHEN value = ? THEN ? ELSE value END)
+				FROM json_each(label_ids)
+			)
+			WHERE EXISTS (SELECT 1 FROM json_each(label_ids) WHERE value = ?)`
+		}
+		if err := tx.Exec(query, sourceID.String(), targetID.String(), sourceID.String()).Error; err != nil {
+			return err
+		}
+
+		result := tx.Delete(&model.Label{}, "id = ?", sourceID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrLabelNotFound
+		}
+		return nil
+	})
+	return tasks, err
+}
+
 // AttachToTask adds a label to a specific task
 func (r *LabelRepository) AttachToTask(ctx context.Context, labelID, taskID uuid.UUID) error {
-	return r.db.WithContext(ctx).Exec(
+	return dbFromContext(ctx, r.db).Exec(
 		"INSERT INTO task_labels (label_id, task_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
 		labelID, taskID,
 	).Error
@@ -94,19 +388,19 @@ func (r *LabelRepository) AttachToTask(ctx context.Context, labelID, taskID uuid
 
 // DetachFromTask removes a label from a specific task
 func (r *LabelRepository) DetachFromTask(ctx context.Context, labelID, taskID uuid.UUID) error {
-	return r.db.WithContext(ctx).Exec(
+	return dbFromContext(ctx, r.db).Exec(
 		"DELETE FROM task_labels WHERE label_id = ? AND task_id = ?",
 		labelID, taskID,
 	).Error
 }
 
 // GetTasksWithLabel retrieves all tasks that have a specific label
-func (r *LabelRepository) GetTasksWithLabel(ctx context.Context, labelID uuid.UUID) ([]model.Task, error) {
+func (r *LabelRepository) GetTasksWithLabel(ctx context.Context, labelID uuid.UUID, opts TaskListOptions) ([]model.Task, error) {
 	var tasks []model.Task
-	result := r.db.WithContext(ctx).
+	query := dbFromContext(ctx, r.db).
 		Joins("JOIN task_labels ON task_labels.task_id = tasks.id").
-		Where("task_labels.label_id = ?", labelID).
-		Find(&tasks)
+		Where("task_labels.label_id = ?", labelID)
+	result := opts.apply(query).Find(&tasks)
 	
 	if result.Error != nil {
 		return nil, result.Error
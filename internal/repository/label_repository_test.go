@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelRepository_WorkspaceLabelPrecedence(t *testing.T) {
+	db := testutil.OpenDB(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	repo := repository.NewLabelRepository(db)
+	ctx := context.Background()
+
+	board := &model.Board{Title: "Labels", OwnerID: owner.ID}
+	other := &model.Board{Title: "Other", OwnerID: owner.ID}
+	require.NoError(t, db.Create(board).Error)
+	require.NoError(t, db.Create(other).Error)
+
+	// Метка пространства "Bug" и одноименная метка доски "bug"
+	workspaceBug := &model.Label{OwnerID: &owner.ID, Name: "Bug", Color: "#ff0000"}
+	boardBug := &model.Label{BoardID: &board.ID, Name: "bug", Color: "#00ff00"}
+	boardUI := &model.Label{BoardID: &board.ID, Name: "ui", Color: "#0000ff"}
+	otherBug := &model.Label{BoardID: &other.ID, Name: "BUG", Color: "#ffff00"}
+	for _, label := range []*model.Label{workspaceBug, boardBug, boardUI, otherBug} {
+		require.NoError(t, db.Create(label).Error)
+	}
+
+	// Метка пространства закрывает метку доски с тем же названием без учета регистра
+	labels, err := repo.GetAvailableForBoard(ctx, board.ID, owner.ID)
+	require.NoError(t, err)
+	ids := make([]uuid.UUID, len(labels))
+	for i, label := range labels {
+		ids[i] = label.ID
+	}
+	assert.Equal(t, []uuid.UUID{workspaceBug.ID, boardUI.ID}, ids)
+
+	// При переносе колонки метка другой доски заменяется меткой пространства, а не доски
+	column := &model.Column{BoardID: other.ID, Title: "To Do", Position: 1}
+	require.NoError(t, db.Create(column).Error)
+	task := &model.Task{ColumnID: column.ID, Title: "Task", CreatedBy: owner.ID, Number: 1}
+	require.NoError(t, db.Create(task).Error)
+	require.NoError(t, db.Exec("INSERT INTO task_labels (task_id, label_id) VALUES (?, ?)", task.ID, otherBug.ID).Error)
+
+	created, err := repo.RemapColumnLabels(ctx, column.ID, board.ID, owner.ID)
+	require.NoError(t, err)
+	assert.Zero(t, created)
+	taskLabels, err := repo.GetByTaskID(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, taskLabels, 1)
+	assert.Equal(t, workspaceBug.ID, taskLabels[0].ID)
+}
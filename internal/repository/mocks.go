@@ -0,0 +1,4 @@
+package repository
+
+// Моки репозиториев для тестов хендлеров и сервисов без базы данных
+//go:generate go run go.uber.org/mock/mockgen@v0.5.2 -destination mocks/mocks.go -package mocks kanban/internal/repository UserRepositoryInterface,BoardRepositoryInterface,ColumnRepositoryInterface,TaskRepositoryInterface,LabelRepositoryInterface,BoardShareRepositoryInterface
@@ -0,0 +1,1596 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: kanban/internal/repository (interfaces: UserRepositoryInterface,BoardRepositoryInterface,ColumnRepositoryInterface,TaskRepositoryInterface,LabelRepositoryInterface,BoardShareRepositoryInterface)
+//
+// Generated by this command:
+//
+//	mockgen -destination mocks/mocks.go -package mocks kanban/internal/repository UserRepositoryInterface,BoardRepositoryInterface,ColumnRepositoryInterface,TaskRepositoryInterface,LabelRepositoryInterface,BoardShareRepositoryInterface
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	model "kanban/internal/model"
+	repository "kanban/internal/repository"
+	reflect "reflect"
+	time "time"
+
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRepositoryInterface is a mock of UserRepositoryInterface interface.
+type MockUserRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserRepositoryInterfaceMockRecorder is the mock recorder for MockUserRepositoryInterface.
+type MockUserRepositoryInterfaceMockRecorder struct {
+	mock *MockUserRepositoryInterface
+}
+
+// NewMockUserRepositoryInterface creates a new mock instance.
+func NewMockUserRepositoryInterface(ctrl *gomock.Controller) *MockUserRepositoryInterface {
+	mock := &MockUserRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockUserRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRepositoryInterface) EXPECT() *MockUserRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUserRepositoryInterface) Create(ctx context.Context, user *model.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUserRepositoryInterfaceMockRecorder) Create(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepositoryInterface)(nil).Create), ctx, user)
+}
+
+// FindByEmail mocks base method.
+func (m *MockUserRepositoryInterface) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", ctx, email)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockUserRepositoryInterfaceMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUserRepositoryInterface)(nil).FindByEmail), ctx, email)
+}
+
+// GetByID mocks base method.
+func (m *MockUserRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserRepositoryInterfaceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// MockBoardRepositoryInterface is a mock of BoardRepositoryInterface interface.
+type MockBoardRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardRepositoryInterfaceMockRecorder is the mock recorder for MockBoardRepositoryInterface.
+type MockBoardRepositoryInterfaceMockRecorder struct {
+	mock *MockBoardRepositoryInterface
+}
+
+// NewMockBoardRepositoryInterface creates a new mock instance.
+func NewMockBoardRepositoryInterface(ctrl *gomock.Controller) *MockBoardRepositoryInterface {
+	mock := &MockBoardRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockBoardRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardRepositoryInterface) EXPECT() *MockBoardRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CountOwned mocks base method.
+func (m *MockBoardRepositoryInterface) CountOwned(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOwned", ctx, ownerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOwned indicates an expected call of CountOwned.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) CountOwned(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOwned", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).CountOwned), ctx, ownerID)
+}
+
+// Create mocks base method.
+func (m *MockBoardRepositoryInterface) Create(ctx context.Context, board *model.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) Create(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).Create), ctx, board)
+}
+
+// Delete mocks base method.
+func (m *MockBoardRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// DeleteOwned mocks base method.
+func (m *MockBoardRepositoryInterface) DeleteOwned(ctx context.Context, ownerID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOwned", ctx, ownerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOwned indicates an expected call of DeleteOwned.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) DeleteOwned(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOwned", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).DeleteOwned), ctx, ownerID)
+}
+
+// GetAccessibleByKey mocks base method.
+func (m *MockBoardRepositoryInterface) GetAccessibleByKey(ctx context.Context, userID uuid.UUID, key string) ([]model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessibleByKey", ctx, userID, key)
+	ret0, _ := ret[0].([]model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessibleByKey indicates an expected call of GetAccessibleByKey.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) GetAccessibleByKey(ctx, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessibleByKey", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).GetAccessibleByKey), ctx, userID, key)
+}
+
+// GetAccessibleIDs mocks base method.
+func (m *MockBoardRepositoryInterface) GetAccessibleIDs(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessibleIDs", ctx, userID, ids)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessibleIDs indicates an expected call of GetAccessibleIDs.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) GetAccessibleIDs(ctx, userID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessibleIDs", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).GetAccessibleIDs), ctx, userID, ids)
+}
+
+// GetByID mocks base method.
+func (m *MockBoardRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// GetOwned mocks base method.
+func (m *MockBoardRepositoryInterface) GetOwned(ctx context.Context, ownerID uuid.UUID) ([]model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwned", ctx, ownerID)
+	ret0, _ := ret[0].([]model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwned indicates an expected call of GetOwned.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) GetOwned(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwned", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).GetOwned), ctx, ownerID)
+}
+
+// GetSummaries mocks base method.
+func (m *MockBoardRepositoryInterface) GetSummaries(ctx context.Context, userID uuid.UUID) ([]repository.BoardSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummaries", ctx, userID)
+	ret0, _ := ret[0].([]repository.BoardSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummaries indicates an expected call of GetSummaries.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) GetSummaries(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummaries", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).GetSummaries), ctx, userID)
+}
+
+// SetCardFields mocks base method.
+func (m *MockBoardRepositoryInterface) SetCardFields(ctx context.Context, boardID uuid.UUID, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardFields", ctx, boardID, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardFields indicates an expected call of SetCardFields.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) SetCardFields(ctx, boardID, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardFields", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).SetCardFields), ctx, boardID, fields)
+}
+
+// SetFrozen mocks base method.
+func (m *MockBoardRepositoryInterface) SetFrozen(ctx context.Context, boardID uuid.UUID, frozenAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFrozen", ctx, boardID, frozenAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFrozen indicates an expected call of SetFrozen.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) SetFrozen(ctx, boardID, frozenAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFrozen", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).SetFrozen), ctx, boardID, frozenAt)
+}
+
+// SetReadReceipts mocks base method.
+func (m *MockBoardRepositoryInterface) SetReadReceipts(ctx context.Context, boardID uuid.UUID, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadReceipts", ctx, boardID, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReadReceipts indicates an expected call of SetReadReceipts.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) SetReadReceipts(ctx, boardID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadReceipts", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).SetReadReceipts), ctx, boardID, enabled)
+}
+
+// TransferOwnership mocks base method.
+func (m *MockBoardRepositoryInterface) TransferOwnership(ctx context.Context, boardID, newOwnerID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ctx, boardID, newOwnerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) TransferOwnership(ctx, boardID, newOwnerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).TransferOwnership), ctx, boardID, newOwnerID)
+}
+
+// Update mocks base method.
+func (m *MockBoardRepositoryInterface) Update(ctx context.Context, board *model.Board) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, board)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockBoardRepositoryInterfaceMockRecorder) Update(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockBoardRepositoryInterface)(nil).Update), ctx, board)
+}
+
+// MockColumnRepositoryInterface is a mock of ColumnRepositoryInterface interface.
+type MockColumnRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockColumnRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockColumnRepositoryInterfaceMockRecorder is the mock recorder for MockColumnRepositoryInterface.
+type MockColumnRepositoryInterfaceMockRecorder struct {
+	mock *MockColumnRepositoryInterface
+}
+
+// NewMockColumnRepositoryInterface creates a new mock instance.
+func NewMockColumnRepositoryInterface(ctrl *gomock.Controller) *MockColumnRepositoryInterface {
+	mock := &MockColumnRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockColumnRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockColumnRepositoryInterface) EXPECT() *MockColumnRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CompactFragmentedBoards mocks base method.
+func (m *MockColumnRepositoryInterface) CompactFragmentedBoards(ctx context.Context, limit int) (int, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactFragmentedBoards", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CompactFragmentedBoards indicates an expected call of CompactFragmentedBoards.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) CompactFragmentedBoards(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactFragmentedBoards", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).CompactFragmentedBoards), ctx, limit)
+}
+
+// CountByBoard mocks base method.
+func (m *MockColumnRepositoryInterface) CountByBoard(ctx context.Context, boardID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByBoard", ctx, boardID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByBoard indicates an expected call of CountByBoard.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) CountByBoard(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByBoard", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).CountByBoard), ctx, boardID)
+}
+
+// Create mocks base method.
+func (m *MockColumnRepositoryInterface) Create(ctx context.Context, column *model.Column) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, column)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) Create(ctx, column any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).Create), ctx, column)
+}
+
+// CreateAt mocks base method.
+func (m *MockColumnRepositoryInterface) CreateAt(ctx context.Context, column *model.Column, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAt", ctx, column, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAt indicates an expected call of CreateAt.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) CreateAt(ctx, column, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAt", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).CreateAt), ctx, column, position)
+}
+
+// Delete mocks base method.
+func (m *MockColumnRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// GetByBoardID mocks base method.
+func (m *MockColumnRepositoryInterface) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBoardID", ctx, boardID)
+	ret0, _ := ret[0].([]model.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBoardID indicates an expected call of GetByBoardID.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) GetByBoardID(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBoardID", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).GetByBoardID), ctx, boardID)
+}
+
+// GetByID mocks base method.
+func (m *MockColumnRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*model.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByIDs mocks base method.
+func (m *MockColumnRepositoryInterface) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]model.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]model.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) GetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).GetByIDs), ctx, ids)
+}
+
+// GetMaxPosition mocks base method.
+func (m *MockColumnRepositoryInterface) GetMaxPosition(ctx context.Context, boardID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaxPosition", ctx, boardID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaxPosition indicates an expected call of GetMaxPosition.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) GetMaxPosition(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaxPosition", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).GetMaxPosition), ctx, boardID)
+}
+
+// GetWithBoard mocks base method.
+func (m *MockColumnRepositoryInterface) GetWithBoard(ctx context.Context, id uuid.UUID) (*model.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithBoard", ctx, id)
+	ret0, _ := ret[0].(*model.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithBoard indicates an expected call of GetWithBoard.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) GetWithBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithBoard", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).GetWithBoard), ctx, id)
+}
+
+// MoveToBoard mocks base method.
+func (m *MockColumnRepositoryInterface) MoveToBoard(ctx context.Context, column *model.Column, boardID uuid.UUID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToBoard", ctx, column, boardID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveToBoard indicates an expected call of MoveToBoard.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) MoveToBoard(ctx, column, boardID, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToBoard", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).MoveToBoard), ctx, column, boardID, position)
+}
+
+// ReorderColumns mocks base method.
+func (m *MockColumnRepositoryInterface) ReorderColumns(ctx context.Context, columns []model.Column) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderColumns", ctx, columns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderColumns indicates an expected call of ReorderColumns.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) ReorderColumns(ctx, columns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderColumns", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).ReorderColumns), ctx, columns)
+}
+
+// Update mocks base method.
+func (m *MockColumnRepositoryInterface) Update(ctx context.Context, column *model.Column) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, column)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockColumnRepositoryInterfaceMockRecorder) Update(ctx, column any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockColumnRepositoryInterface)(nil).Update), ctx, column)
+}
+
+// MockTaskRepositoryInterface is a mock of TaskRepositoryInterface interface.
+type MockTaskRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskRepositoryInterfaceMockRecorder is the mock recorder for MockTaskRepositoryInterface.
+type MockTaskRepositoryInterfaceMockRecorder struct {
+	mock *MockTaskRepositoryInterface
+}
+
+// NewMockTaskRepositoryInterface creates a new mock instance.
+func NewMockTaskRepositoryInterface(ctrl *gomock.Controller) *MockTaskRepositoryInterface {
+	mock := &MockTaskRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockTaskRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskRepositoryInterface) EXPECT() *MockTaskRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddLabel mocks base method.
+func (m *MockTaskRepositoryInterface) AddLabel(ctx context.Context, taskID, labelID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLabel", ctx, taskID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLabel indicates an expected call of AddLabel.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) AddLabel(ctx, taskID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLabel", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).AddLabel), ctx, taskID, labelID)
+}
+
+// AssignUser mocks base method.
+func (m *MockTaskRepositoryInterface) AssignUser(ctx context.Context, taskID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignUser", ctx, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignUser indicates an expected call of AssignUser.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) AssignUser(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignUser", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).AssignUser), ctx, taskID, userID)
+}
+
+// ClearSprints mocks base method.
+func (m *MockTaskRepositoryInterface) ClearSprints(ctx context.Context, columnID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearSprints", ctx, columnID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearSprints indicates an expected call of ClearSprints.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) ClearSprints(ctx, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSprints", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).ClearSprints), ctx, columnID)
+}
+
+// CompactFragmentedColumns mocks base method.
+func (m *MockTaskRepositoryInterface) CompactFragmentedColumns(ctx context.Context, limit int) (int, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactFragmentedColumns", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CompactFragmentedColumns indicates an expected call of CompactFragmentedColumns.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) CompactFragmentedColumns(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactFragmentedColumns", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).CompactFragmentedColumns), ctx, limit)
+}
+
+// CountByColumn mocks base method.
+func (m *MockTaskRepositoryInterface) CountByColumn(ctx context.Context, columnID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByColumn", ctx, columnID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByColumn indicates an expected call of CountByColumn.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) CountByColumn(ctx, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByColumn", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).CountByColumn), ctx, columnID)
+}
+
+// CountBySwimlane mocks base method.
+func (m *MockTaskRepositoryInterface) CountBySwimlane(ctx context.Context, boardID uuid.UUID) ([]repository.SwimlaneTaskCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountBySwimlane", ctx, boardID)
+	ret0, _ := ret[0].([]repository.SwimlaneTaskCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountBySwimlane indicates an expected call of CountBySwimlane.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) CountBySwimlane(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountBySwimlane", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).CountBySwimlane), ctx, boardID)
+}
+
+// Create mocks base method.
+func (m *MockTaskRepositoryInterface) Create(ctx context.Context, task *model.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Create(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Create), ctx, task)
+}
+
+// CreateCopy mocks base method.
+func (m *MockTaskRepositoryInterface) CreateCopy(ctx context.Context, task *model.Task, labelIDs []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCopy", ctx, task, labelIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCopy indicates an expected call of CreateCopy.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) CreateCopy(ctx, task, labelIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCopy", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).CreateCopy), ctx, task, labelIDs)
+}
+
+// Delete mocks base method.
+func (m *MockTaskRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// GetAccessibleByIDs mocks base method.
+func (m *MockTaskRepositoryInterface) GetAccessibleByIDs(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) ([]repository.TaskListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessibleByIDs", ctx, ids, userID)
+	ret0, _ := ret[0].([]repository.TaskListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessibleByIDs indicates an expected call of GetAccessibleByIDs.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetAccessibleByIDs(ctx, ids, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessibleByIDs", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetAccessibleByIDs), ctx, ids, userID)
+}
+
+// GetAssigned mocks base method.
+func (m *MockTaskRepositoryInterface) GetAssigned(ctx context.Context, userID uuid.UUID, filter repository.AssignedTaskFilter) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssigned", ctx, userID, filter)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssigned indicates an expected call of GetAssigned.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetAssigned(ctx, userID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssigned", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetAssigned), ctx, userID, filter)
+}
+
+// GetByAssignee mocks base method.
+func (m *MockTaskRepositoryInterface) GetByAssignee(ctx context.Context, userID uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAssignee", ctx, userID)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAssignee indicates an expected call of GetByAssignee.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByAssignee(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAssignee", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByAssignee), ctx, userID)
+}
+
+// GetByBoardID mocks base method.
+func (m *MockTaskRepositoryInterface) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBoardID", ctx, boardID)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBoardID indicates an expected call of GetByBoardID.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByBoardID(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBoardID", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByBoardID), ctx, boardID)
+}
+
+// GetByColumnID mocks base method.
+func (m *MockTaskRepositoryInterface) GetByColumnID(ctx context.Context, columnID uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByColumnID", ctx, columnID)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByColumnID indicates an expected call of GetByColumnID.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByColumnID(ctx, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByColumnID", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByColumnID), ctx, columnID)
+}
+
+// GetByColumnIDs mocks base method.
+func (m *MockTaskRepositoryInterface) GetByColumnIDs(ctx context.Context, columnIDs []uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByColumnIDs", ctx, columnIDs)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByColumnIDs indicates an expected call of GetByColumnIDs.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByColumnIDs(ctx, columnIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByColumnIDs", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByColumnIDs), ctx, columnIDs)
+}
+
+// GetByCreator mocks base method.
+func (m *MockTaskRepositoryInterface) GetByCreator(ctx context.Context, userID uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCreator", ctx, userID)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCreator indicates an expected call of GetByCreator.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByCreator(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCreator", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByCreator), ctx, userID)
+}
+
+// GetByID mocks base method.
+func (m *MockTaskRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByIDsOnBoard mocks base method.
+func (m *MockTaskRepositoryInterface) GetByIDsOnBoard(ctx context.Context, boardID uuid.UUID, ids []uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDsOnBoard", ctx, boardID, ids)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDsOnBoard indicates an expected call of GetByIDsOnBoard.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByIDsOnBoard(ctx, boardID, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDsOnBoard", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByIDsOnBoard), ctx, boardID, ids)
+}
+
+// GetByNumber mocks base method.
+func (m *MockTaskRepositoryInterface) GetByNumber(ctx context.Context, boardID uuid.UUID, number int) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByNumber", ctx, boardID, number)
+	ret0, _ := ret[0].(*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByNumber indicates an expected call of GetByNumber.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetByNumber(ctx, boardID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByNumber", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetByNumber), ctx, boardID, number)
+}
+
+// GetBySprintID mocks base method.
+func (m *MockTaskRepositoryInterface) GetBySprintID(ctx context.Context, sprintID uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySprintID", ctx, sprintID)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySprintID indicates an expected call of GetBySprintID.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetBySprintID(ctx, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySprintID", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetBySprintID), ctx, sprintID)
+}
+
+// GetPositions mocks base method.
+func (m *MockTaskRepositoryInterface) GetPositions(ctx context.Context, columnIDs []uuid.UUID) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositions", ctx, columnIDs)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetPositions(ctx, columnIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetPositions), ctx, columnIDs)
+}
+
+// GetTasksWithLabels mocks base method.
+func (m *MockTaskRepositoryInterface) GetTasksWithLabels(ctx context.Context, columnID uuid.UUID, opts repository.TaskListOptions) ([]repository.TaskListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasksWithLabels", ctx, columnID, opts)
+	ret0, _ := ret[0].([]repository.TaskListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasksWithLabels indicates an expected call of GetTasksWithLabels.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetTasksWithLabels(ctx, columnID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksWithLabels", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetTasksWithLabels), ctx, columnID, opts)
+}
+
+// GetWithBoard mocks base method.
+func (m *MockTaskRepositoryInterface) GetWithBoard(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithBoard", ctx, id)
+	ret0, _ := ret[0].(*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithBoard indicates an expected call of GetWithBoard.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetWithBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithBoard", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetWithBoard), ctx, id)
+}
+
+// GetWithDetails mocks base method.
+func (m *MockTaskRepositoryInterface) GetWithDetails(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithDetails", ctx, id)
+	ret0, _ := ret[0].(*model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithDetails indicates an expected call of GetWithDetails.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) GetWithDetails(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithDetails", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).GetWithDetails), ctx, id)
+}
+
+// MoveTask mocks base method.
+func (m *MockTaskRepositoryInterface) MoveTask(ctx context.Context, taskID, columnID uuid.UUID, newPosition int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTask", ctx, taskID, columnID, newPosition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTask indicates an expected call of MoveTask.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) MoveTask(ctx, taskID, columnID, newPosition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTask", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).MoveTask), ctx, taskID, columnID, newPosition)
+}
+
+// ReassignCreator mocks base method.
+func (m *MockTaskRepositoryInterface) ReassignCreator(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignCreator", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReassignCreator indicates an expected call of ReassignCreator.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) ReassignCreator(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignCreator", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).ReassignCreator), ctx, userID)
+}
+
+// RemapSwimlanes mocks base method.
+func (m *MockTaskRepositoryInterface) RemapSwimlanes(ctx context.Context, columnID, boardID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemapSwimlanes", ctx, columnID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemapSwimlanes indicates an expected call of RemapSwimlanes.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) RemapSwimlanes(ctx, columnID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemapSwimlanes", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).RemapSwimlanes), ctx, columnID, boardID)
+}
+
+// RemoveFromSprint mocks base method.
+func (m *MockTaskRepositoryInterface) RemoveFromSprint(ctx context.Context, taskID, sprintID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromSprint", ctx, taskID, sprintID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFromSprint indicates an expected call of RemoveFromSprint.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) RemoveFromSprint(ctx, taskID, sprintID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromSprint", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).RemoveFromSprint), ctx, taskID, sprintID)
+}
+
+// RemoveLabel mocks base method.
+func (m *MockTaskRepositoryInterface) RemoveLabel(ctx context.Context, taskID, labelID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveLabel", ctx, taskID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveLabel indicates an expected call of RemoveLabel.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) RemoveLabel(ctx, taskID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLabel", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).RemoveLabel), ctx, taskID, labelID)
+}
+
+// RenumberForBoard mocks base method.
+func (m *MockTaskRepositoryInterface) RenumberForBoard(ctx context.Context, columnID, boardID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenumberForBoard", ctx, columnID, boardID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenumberForBoard indicates an expected call of RenumberForBoard.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) RenumberForBoard(ctx, columnID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenumberForBoard", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).RenumberForBoard), ctx, columnID, boardID)
+}
+
+// Restore mocks base method.
+func (m *MockTaskRepositoryInterface) Restore(ctx context.Context, task *model.Task, labelIDs []uuid.UUID, relations []model.TaskRelation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, task, labelIDs, relations)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Restore(ctx, task, labelIDs, relations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Restore), ctx, task, labelIDs, relations)
+}
+
+// SetDescription mocks base method.
+func (m *MockTaskRepositoryInterface) SetDescription(ctx context.Context, taskID uuid.UUID, description string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDescription", ctx, taskID, description)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDescription indicates an expected call of SetDescription.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) SetDescription(ctx, taskID, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDescription", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).SetDescription), ctx, taskID, description)
+}
+
+// SetSprint mocks base method.
+func (m *MockTaskRepositoryInterface) SetSprint(ctx context.Context, taskIDs []uuid.UUID, sprint *model.Sprint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSprint", ctx, taskIDs, sprint)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSprint indicates an expected call of SetSprint.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) SetSprint(ctx, taskIDs, sprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSprint", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).SetSprint), ctx, taskIDs, sprint)
+}
+
+// SetSwimlane mocks base method.
+func (m *MockTaskRepositoryInterface) SetSwimlane(ctx context.Context, taskID uuid.UUID, swimlaneID *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSwimlane", ctx, taskID, swimlaneID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSwimlane indicates an expected call of SetSwimlane.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) SetSwimlane(ctx, taskID, swimlaneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSwimlane", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).SetSwimlane), ctx, taskID, swimlaneID)
+}
+
+// SettleOnBoard mocks base method.
+func (m *MockTaskRepositoryInterface) SettleOnBoard(ctx context.Context, taskID, boardID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleOnBoard", ctx, taskID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SettleOnBoard indicates an expected call of SettleOnBoard.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) SettleOnBoard(ctx, taskID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleOnBoard", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).SettleOnBoard), ctx, taskID, boardID)
+}
+
+// SuggestAssignees mocks base method.
+func (m *MockTaskRepositoryInterface) SuggestAssignees(ctx context.Context, task *model.Task, boardID uuid.UUID, since time.Time, limit int) ([]repository.AssigneeSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestAssignees", ctx, task, boardID, since, limit)
+	ret0, _ := ret[0].([]repository.AssigneeSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestAssignees indicates an expected call of SuggestAssignees.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) SuggestAssignees(ctx, task, boardID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestAssignees", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).SuggestAssignees), ctx, task, boardID, since, limit)
+}
+
+// SummarizeColumns mocks base method.
+func (m *MockTaskRepositoryInterface) SummarizeColumns(ctx context.Context, boardID uuid.UUID, now time.Time) ([]repository.ColumnTaskSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SummarizeColumns", ctx, boardID, now)
+	ret0, _ := ret[0].([]repository.ColumnTaskSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SummarizeColumns indicates an expected call of SummarizeColumns.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) SummarizeColumns(ctx, boardID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SummarizeColumns", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).SummarizeColumns), ctx, boardID, now)
+}
+
+// UnassignEverywhere mocks base method.
+func (m *MockTaskRepositoryInterface) UnassignEverywhere(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignEverywhere", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignEverywhere indicates an expected call of UnassignEverywhere.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) UnassignEverywhere(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignEverywhere", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).UnassignEverywhere), ctx, userID)
+}
+
+// UnassignNonMembers mocks base method.
+func (m *MockTaskRepositoryInterface) UnassignNonMembers(ctx context.Context, columnID, boardID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignNonMembers", ctx, columnID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignNonMembers indicates an expected call of UnassignNonMembers.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) UnassignNonMembers(ctx, columnID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignNonMembers", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).UnassignNonMembers), ctx, columnID, boardID)
+}
+
+// UnassignOnBoard mocks base method.
+func (m *MockTaskRepositoryInterface) UnassignOnBoard(ctx context.Context, boardID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignOnBoard", ctx, boardID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignOnBoard indicates an expected call of UnassignOnBoard.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) UnassignOnBoard(ctx, boardID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignOnBoard", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).UnassignOnBoard), ctx, boardID, userID)
+}
+
+// UnassignUser mocks base method.
+func (m *MockTaskRepositoryInterface) UnassignUser(ctx context.Context, taskID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignUser", ctx, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignUser indicates an expected call of UnassignUser.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) UnassignUser(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignUser", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).UnassignUser), ctx, taskID)
+}
+
+// Update mocks base method.
+func (m *MockTaskRepositoryInterface) Update(ctx context.Context, task *model.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTaskRepositoryInterfaceMockRecorder) Update(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepositoryInterface)(nil).Update), ctx, task)
+}
+
+// MockLabelRepositoryInterface is a mock of LabelRepositoryInterface interface.
+type MockLabelRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockLabelRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockLabelRepositoryInterfaceMockRecorder is the mock recorder for MockLabelRepositoryInterface.
+type MockLabelRepositoryInterfaceMockRecorder struct {
+	mock *MockLabelRepositoryInterface
+}
+
+// NewMockLabelRepositoryInterface creates a new mock instance.
+func NewMockLabelRepositoryInterface(ctrl *gomock.Controller) *MockLabelRepositoryInterface {
+	mock := &MockLabelRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockLabelRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLabelRepositoryInterface) EXPECT() *MockLabelRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddMissingToBoard mocks base method.
+func (m *MockLabelRepositoryInterface) AddMissingToBoard(ctx context.Context, boardID, ownerID uuid.UUID, labels []model.Label) ([]model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMissingToBoard", ctx, boardID, ownerID, labels)
+	ret0, _ := ret[0].([]model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMissingToBoard indicates an expected call of AddMissingToBoard.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) AddMissingToBoard(ctx, boardID, ownerID, labels any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMissingToBoard", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).AddMissingToBoard), ctx, boardID, ownerID, labels)
+}
+
+// AttachToTask mocks base method.
+func (m *MockLabelRepositoryInterface) AttachToTask(ctx context.Context, labelID, taskID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachToTask", ctx, labelID, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachToTask indicates an expected call of AttachToTask.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) AttachToTask(ctx, labelID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachToTask", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).AttachToTask), ctx, labelID, taskID)
+}
+
+// CopyWorkspaceLabelsToBoard mocks base method.
+func (m *MockLabelRepositoryInterface) CopyWorkspaceLabelsToBoard(ctx context.Context, ownerID, boardID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyWorkspaceLabelsToBoard", ctx, ownerID, boardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyWorkspaceLabelsToBoard indicates an expected call of CopyWorkspaceLabelsToBoard.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) CopyWorkspaceLabelsToBoard(ctx, ownerID, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyWorkspaceLabelsToBoard", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).CopyWorkspaceLabelsToBoard), ctx, ownerID, boardID)
+}
+
+// Create mocks base method.
+func (m *MockLabelRepositoryInterface) Create(ctx context.Context, label *model.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) Create(ctx, label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).Create), ctx, label)
+}
+
+// Delete mocks base method.
+func (m *MockLabelRepositoryInterface) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).Delete), ctx, id)
+}
+
+// DetachFromTask mocks base method.
+func (m *MockLabelRepositoryInterface) DetachFromTask(ctx context.Context, labelID, taskID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachFromTask", ctx, labelID, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachFromTask indicates an expected call of DetachFromTask.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) DetachFromTask(ctx, labelID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachFromTask", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).DetachFromTask), ctx, labelID, taskID)
+}
+
+// FindWorkspaceLabelByName mocks base method.
+func (m *MockLabelRepositoryInterface) FindWorkspaceLabelByName(ctx context.Context, ownerID uuid.UUID, name string) (*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWorkspaceLabelByName", ctx, ownerID, name)
+	ret0, _ := ret[0].(*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWorkspaceLabelByName indicates an expected call of FindWorkspaceLabelByName.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) FindWorkspaceLabelByName(ctx, ownerID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWorkspaceLabelByName", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).FindWorkspaceLabelByName), ctx, ownerID, name)
+}
+
+// GetAvailableForBoard mocks base method.
+func (m *MockLabelRepositoryInterface) GetAvailableForBoard(ctx context.Context, boardID, ownerID uuid.UUID) ([]model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableForBoard", ctx, boardID, ownerID)
+	ret0, _ := ret[0].([]model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableForBoard indicates an expected call of GetAvailableForBoard.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) GetAvailableForBoard(ctx, boardID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableForBoard", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).GetAvailableForBoard), ctx, boardID, ownerID)
+}
+
+// GetByBoardID mocks base method.
+func (m *MockLabelRepositoryInterface) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByBoardID", ctx, boardID)
+	ret0, _ := ret[0].([]model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByBoardID indicates an expected call of GetByBoardID.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) GetByBoardID(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByBoardID", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).GetByBoardID), ctx, boardID)
+}
+
+// GetByID mocks base method.
+func (m *MockLabelRepositoryInterface) GetByID(ctx context.Context, id uuid.UUID) (*model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).GetByID), ctx, id)
+}
+
+// GetByOwnerID mocks base method.
+func (m *MockLabelRepositoryInterface) GetByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByOwnerID", ctx, ownerID)
+	ret0, _ := ret[0].([]model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByOwnerID indicates an expected call of GetByOwnerID.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) GetByOwnerID(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwnerID", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).GetByOwnerID), ctx, ownerID)
+}
+
+// GetByTaskID mocks base method.
+func (m *MockLabelRepositoryInterface) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.Label, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByTaskID", ctx, taskID)
+	ret0, _ := ret[0].([]model.Label)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByTaskID indicates an expected call of GetByTaskID.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) GetByTaskID(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByTaskID", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).GetByTaskID), ctx, taskID)
+}
+
+// GetTasksWithLabel mocks base method.
+func (m *MockLabelRepositoryInterface) GetTasksWithLabel(ctx context.Context, labelID uuid.UUID, opts repository.TaskListOptions) ([]model.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasksWithLabel", ctx, labelID, opts)
+	ret0, _ := ret[0].([]model.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasksWithLabel indicates an expected call of GetTasksWithLabel.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) GetTasksWithLabel(ctx, labelID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksWithLabel", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).GetTasksWithLabel), ctx, labelID, opts)
+}
+
+// Merge mocks base method.
+func (m *MockLabelRepositoryInterface) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, sourceID, targetID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) Merge(ctx, sourceID, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).Merge), ctx, sourceID, targetID)
+}
+
+// RemapColumnLabels mocks base method.
+func (m *MockLabelRepositoryInterface) RemapColumnLabels(ctx context.Context, columnID, boardID, ownerID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemapColumnLabels", ctx, columnID, boardID, ownerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemapColumnLabels indicates an expected call of RemapColumnLabels.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) RemapColumnLabels(ctx, columnID, boardID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemapColumnLabels", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).RemapColumnLabels), ctx, columnID, boardID, ownerID)
+}
+
+// RemapTaskLabels mocks base method.
+func (m *MockLabelRepositoryInterface) RemapTaskLabels(ctx context.Context, taskID, boardID, ownerID uuid.UUID) (int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemapTaskLabels", ctx, taskID, boardID, ownerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RemapTaskLabels indicates an expected call of RemapTaskLabels.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) RemapTaskLabels(ctx, taskID, boardID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemapTaskLabels", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).RemapTaskLabels), ctx, taskID, boardID, ownerID)
+}
+
+// Update mocks base method.
+func (m *MockLabelRepositoryInterface) Update(ctx context.Context, label *model.Label) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, label)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLabelRepositoryInterfaceMockRecorder) Update(ctx, label any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLabelRepositoryInterface)(nil).Update), ctx, label)
+}
+
+// MockBoardShareRepositoryInterface is a mock of BoardShareRepositoryInterface interface.
+type MockBoardShareRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardShareRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardShareRepositoryInterfaceMockRecorder is the mock recorder for MockBoardShareRepositoryInterface.
+type MockBoardShareRepositoryInterfaceMockRecorder struct {
+	mock *MockBoardShareRepositoryInterface
+}
+
+// NewMockBoardShareRepositoryInterface creates a new mock instance.
+func NewMockBoardShareRepositoryInterface(ctrl *gomock.Controller) *MockBoardShareRepositoryInterface {
+	mock := &MockBoardShareRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockBoardShareRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardShareRepositoryInterface) EXPECT() *MockBoardShareRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockBoardShareRepositoryInterface) CheckAccess(ctx context.Context, boardID, userID uuid.UUID, requiredRole string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, boardID, userID, requiredRole)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) CheckAccess(ctx, boardID, userID, requiredRole any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).CheckAccess), ctx, boardID, userID, requiredRole)
+}
+
+// CopyShares mocks base method.
+func (m *MockBoardShareRepositoryInterface) CopyShares(ctx context.Context, fromBoardID, toBoardID, toOwnerID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyShares", ctx, fromBoardID, toBoardID, toOwnerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyShares indicates an expected call of CopyShares.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) CopyShares(ctx, fromBoardID, toBoardID, toOwnerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyShares", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).CopyShares), ctx, fromBoardID, toBoardID, toOwnerID)
+}
+
+// GetAccess mocks base method.
+func (m *MockBoardShareRepositoryInterface) GetAccess(ctx context.Context, boardID, userID uuid.UUID) (*repository.BoardAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccess", ctx, boardID, userID)
+	ret0, _ := ret[0].(*repository.BoardAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccess indicates an expected call of GetAccess.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) GetAccess(ctx, boardID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccess", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).GetAccess), ctx, boardID, userID)
+}
+
+// GetBoardShares mocks base method.
+func (m *MockBoardShareRepositoryInterface) GetBoardShares(ctx context.Context, boardID uuid.UUID) ([]model.BoardShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardShares", ctx, boardID)
+	ret0, _ := ret[0].([]model.BoardShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardShares indicates an expected call of GetBoardShares.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) GetBoardShares(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardShares", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).GetBoardShares), ctx, boardID)
+}
+
+// GetByUserID mocks base method.
+func (m *MockBoardShareRepositoryInterface) GetByUserID(ctx context.Context, userID uuid.UUID) ([]model.BoardShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userID)
+	ret0, _ := ret[0].([]model.BoardShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).GetByUserID), ctx, userID)
+}
+
+// GetSharedBoards mocks base method.
+func (m *MockBoardShareRepositoryInterface) GetSharedBoards(ctx context.Context, userID uuid.UUID) ([]model.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedBoards", ctx, userID)
+	ret0, _ := ret[0].([]model.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedBoards indicates an expected call of GetSharedBoards.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) GetSharedBoards(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedBoards", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).GetSharedBoards), ctx, userID)
+}
+
+// GetUserRole mocks base method.
+func (m *MockBoardShareRepositoryInterface) GetUserRole(ctx context.Context, boardID, userID uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", ctx, boardID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) GetUserRole(ctx, boardID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).GetUserRole), ctx, boardID, userID)
+}
+
+// RemoveShare mocks base method.
+func (m *MockBoardShareRepositoryInterface) RemoveShare(ctx context.Context, boardID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveShare", ctx, boardID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveShare indicates an expected call of RemoveShare.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) RemoveShare(ctx, boardID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveShare", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).RemoveShare), ctx, boardID, userID)
+}
+
+// ShareBoard mocks base method.
+func (m *MockBoardShareRepositoryInterface) ShareBoard(ctx context.Context, boardID, userID uuid.UUID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareBoard", ctx, boardID, userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareBoard indicates an expected call of ShareBoard.
+func (mr *MockBoardShareRepositoryInterfaceMockRecorder) ShareBoard(ctx, boardID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareBoard", reflect.TypeOf((*MockBoardShareRepositoryInterface)(nil).ShareBoard), ctx, boardID, userID, role)
+}
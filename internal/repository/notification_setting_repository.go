@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+	"kanban/internal/notification"
+)
+
+type NotificationSettingRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationSettingRepository(db *gorm.DB) *NotificationSettingRepository {
+	return &NotificationSettingRepository{db: db}
+}
+
+// GetForUser retrieves the notification settings and muted boards of a user
+func (r *NotificationSettingRepository) GetForUser(ctx context.Context, userID uuid.UUID) ([]model.NotificationSetting, []model.NotificationMute, error) {
+	db := dbFromContext(ctx, r.db)
+
+	var settings []model.NotificationSetting
+	if err := db.Where("user_id = ?", userID).Order("board_id NULLS FIRST, event").Find(&settings).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var mutes []model.NotificationMute
+	if err := db.Where("user_id = ?", userID).Order("board_id").Find(&mutes).Error; err != nil {
+		return nil, nil, err
+	}
+	return settings, mutes, nil
+}
+
+// GetDigestFrequency retrieves how often the user gets an email digest, notification.DigestOff if never
+func (r *NotificationSettingRepository) GetDigestFrequency(ctx context.Context, userID uuid.UUID) (string, error) {
+	var digests []model.NotificationDigest
+	if err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Limit(1).Find(&digests).Error; err != nil {
+		return "", err
+	}
+	if len(digests) == 0 {
+		return notification.DigestOff, nil
+	}
+	return digests[0].Frequency, nil
+}
+
+// GetDueDigests retrieves up to limit digests of a frequency not sent since scheduledAt, with
+// their users loaded. Digests of disabled users are skipped.
+func (r *NotificationSettingRepository) GetDueDigests(ctx context.Context, frequency string, scheduledAt time.Time, limit int) ([]model.NotificationDigest, error) {
+	var digests []model.NotificationDigest
+	err := dbFromContext(ctx, r.db).
+		Joins("User").
+		Where("notification_digests.frequency = ?", frequency).
+		Where("notification_digests.last_sent_at IS NULL OR notification_digests.last_sent_at < ?", scheduledAt).
+		Where("\"User\".disabled_at IS NULL").
+		Order("notification_digests.user_id").
+		Limit(limit).
+		Find(&digests).Error
+	return digests, err
+}
+
+// MarkDigestSent records when the user's digest was last sent
+func (r *NotificationSettingRepository) MarkDigestSent(ctx context.Context, userID uuid.UUID, sentAt time.Time) error {
+	return dbFromContext(ctx, r.db).
+		Model(&model.NotificationDigest{}).
+		Where("user_id = ?", userID).
+		Update("last_sent_at", sentAt).Error
+}
+
+// DeleteForBoard removes the notification settings of a user for one board and unmutes it; the
+// user's default settings stay
+func (r *NotificationSettingRepository) DeleteForBoard(ctx context.Context, userID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND board_id = ?", userID, boardID).Delete(&model.NotificationSetting{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ? AND board_id = ?", userID, boardID).Delete(&model.NotificationMute{}).Error
+	})
+}
+
+// Replace swaps all notification settings and muted boards of a user for new ones and sets the
+// frequency of the user's email digest, keeping when the digest was last sent
+func (r *NotificationSettingRepository) Replace(ctx context.Context, userID uuid.UUID, settings []model.NotificationSetting, mutes []model.NotificationMute, digest string) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if digest == notification.DigestOff {
+			if err := tx.Where("user_id = ?", userID).Delete(&model.NotificationDigest{}).Error; err != nil {
+				return err
+			}
+		} else {
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{"frequency": digest}),
+			}).Create(&model.NotificationDigest{UserID: userID, Frequency: digest}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("user_id = ?", userID).Delete(&model.NotificationSetting{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&model.NotificationMute{}).Error; err != nil {
+			return err
+		}
+
+		if len(settings) > 0 {
+			if err := tx.Create(&settings).Error; err != nil {
+				return err
+			}
+		}
+		if len(mutes) > 0 {
+			return tx.Create(&mutes).Error
+		}
+		return nil
+	})
+}
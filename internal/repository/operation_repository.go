@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/database"
+	"kanban/internal/model"
+)
+
+type OperationRepository struct {
+	db *gorm.DB
+}
+
+func NewOperationRepository(db *gorm.DB) *OperationRepository {
+	return &OperationRepository{db: db}
+}
+
+func (r *OperationRepository) Create(ctx context.Context, op *model.Operation) error {
+	return dbFromContext(ctx, r.db).Create(op).Error
+}
+
+func (r *OperationRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Operation, error) {
+	var op model.Operation
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&op).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrOperationNotFound
+		}
+		return nil, err
+	}
+	return &op, nil
+}
+
+// Start marks the operation as running with total items to process
+func (r *OperationRepository) Start(ctx context.Context, id uuid.UUID, total int) error {
+	return dbFromContext(ctx, r.db).Model(&model.Operation{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"status": model.OperationRunning, "total": total}).Error
+}
+
+// Advance records that n more items have been processed
+func (r *OperationRepository) Advance(ctx context.Context, id uuid.UUID, n int) error {
+	return dbFromContext(ctx, r.db).Model(&model.Operation{}).Where("id = ?", id).
+		Update("completed", gorm.Expr("completed + ?", n)).Error
+}
+
+// AppendError adds a message to the operation's list of partial errors
+func (r *OperationRepository) AppendError(ctx context.Context, id uuid.UUID, message string) error {
+	db := dbFromContext(ctx, r.db)
+	return db.Model(&model.Operation{}).Where("id = ?", id).Update("errors", appendedError(db, message)).Error
+}
+
+// Finish sets the final status of the operation and the board it produced, if any
+func (r *OperationRepository) Finish(ctx context.Context, id uuid.UUID, status string, resultBoardID *uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.Operation{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"status": status, "result_board_id": resultBoardID}).Error
+}
+
+// FailUnfinished marks pending and running operations as failed. The job queue lives in the
+// server process, so such operations cannot finish once the server has restarted.
+func (r *OperationRepository) FailUnfinished(ctx context.Context, reason string) (int64, error) {
+	db := dbFromContext(ctx, r.db)
+	result := db.Model(&model.Operation{}).
+		Where("status IN ?", []string{model.OperationPending, model.OperationRunning}).
+		Updates(map[string]interface{}{
+			"status": model.OperationFailed,
+			"errors": appendedError(db, reason),
+		})
+	return result.RowsAffected, result.Error
+}
+
+// appendedError returns the errors of an operation with the message added at the end
+func appendedError(db *gorm.DB, message string) clause.Expr {
+	if database.IsSQLite(db) {
+		// SQLite хранит JSON текстом, а json.RawMessage из Go записывается как BLOB
+		return gorm.Expr("json_insert(CAST(errors AS TEXT), '$[#]', ?)", message)
+	}
+	return gorm.Expr("errors || jsonb_build_array(?::text)", message)
+}
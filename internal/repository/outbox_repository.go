@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Add queues an event with the payload encoded as JSON. Called with a transaction's context,
+// the event is only queued when the transaction commits.
+func (r *OutboxRepository) Add(ctx context.Context, event string, payload interface{}) error {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return dbFromContext(ctx, r.db).Create(&model.OutboxEvent{
+		Event:         event,
+		Payload:       encoded,
+		NextAttemptAt: time.Now(),
+	}).Error
+}
+
+// ClaimPending locks up to limit events due for delivery that have been tried fewer than
+// maxAttempts times, oldest first. It must run in a transaction; events locked by other
+// dispatchers are skipped, so each event is handled by one dispatcher at a time.
+func (r *OutboxRepository) ClaimPending(ctx context.Context, limit, maxAttempts int) ([]model.OutboxEvent, error) {
+	var events []model.OutboxEvent
+	err := dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("delivered_at IS NULL AND next_attempt_at <= ? AND attempts < ?", time.Now(), maxAttempts).
+		Order("id").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// MarkDelivered records the delivery of an event
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64) error {
+	return dbFromContext(ctx, r.db).Model(&model.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"delivered_at": time.Now(), "attempts": gorm.Expr("attempts + 1")}).Error
+}
+
+// MarkFailed records a failed delivery and when to try again
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, deliveryErr error, retryAt time.Time) error {
+	return dbFromContext(ctx, r.db).Model(&model.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      deliveryErr.Error(),
+			"next_attempt_at": retryAt,
+		}).Error
+}
+
+// PruneDelivered deletes events delivered before the given time and returns how many were deleted
+func (r *OutboxRepository) PruneDelivered(ctx context.Context, before time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Where("delivered_at < ?", before).
+		Delete(&model.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
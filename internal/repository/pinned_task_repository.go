@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type PinnedTaskRepository struct {
+	db *gorm.DB
+}
+
+func NewPinnedTaskRepository(db *gorm.DB) *PinnedTaskRepository {
+	return &PinnedTaskRepository{db: db}
+}
+
+// Pin pins a task for a user; pinning an already pinned task is a no-op
+func (r *PinnedTaskRepository) Pin(ctx context.Context, userID, taskID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Exec(
+		"INSERT INTO pinned_tasks (user_id, task_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+		userID, taskID,
+	).Error
+}
+
+// Unpin removes a task from the user's pins
+func (r *PinnedTaskRepository) Unpin(ctx context.Context, userID, taskID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).
+		Where("user_id = ? AND task_id = ?", userID, taskID).
+		Delete(&model.PinnedTask{}).Error
+}
+
+// UnpinBoard removes the user's pins of tasks on the board
+func (r *PinnedTaskRepository) UnpinBoard(ctx context.Context, userID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Where("task_id IN (SELECT tasks.id FROM tasks JOIN columns ON columns.id = tasks.column_id WHERE columns.board_id = ?)", boardID).
+		Delete(&model.PinnedTask{}).Error
+}
+
+// CountByUserID returns how many tasks the user has pinned
+func (r *PinnedTaskRepository) CountByUserID(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.PinnedTask{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// GetByUserID retrieves the user's pins, newest first, with task, column and board loaded.
+// Pins on boards the user can no longer access are skipped.
+func (r *PinnedTaskRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]model.PinnedTask, error) {
+	var pins []model.PinnedTask
+	err := dbFromContext(ctx, r.db).
+		Preload("Task.Column.Board").
+		Joins("JOIN tasks ON tasks.id = pinned_tasks.task_id").
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Joins("JOIN boards ON boards.id = columns.board_id").
+		Where("pinned_tasks.user_id = ?", userID).
+		Where("(boards.owner_id = ? OR boards.id IN (SELECT board_id FROM board_shares WHERE user_id = ?))", userID, userID).
+		Order("pinned_tasks.created_at DESC").
+		Find(&pins).Error
+	return pins, err
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type ReadReceiptRepository struct {
+	db *gorm.DB
+}
+
+func NewReadReceiptRepository(db *gorm.DB) *ReadReceiptRepository {
+	return &ReadReceiptRepository{db: db}
+}
+
+// Record marks the board and the given columns of it as viewed by the user at the given time
+func (r *ReadReceiptRepository) Record(ctx context.Context, boardID, userID uuid.UUID, columnIDs []uuid.UUID, at time.Time) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(
+			"INSERT INTO view_receipts (board_id, user_id, viewed_at) VALUES (?, ?, ?) "+
+				"ON CONFLICT (board_id, user_id) WHERE column_id IS NULL DO UPDATE SET viewed_at = EXCLUDED.viewed_at",
+			boardID, userID, at,
+		).Error
+		if err != nil || len(columnIDs) == 0 {
+			return err
+		}
+
+		receipts := make([]model.ViewReceipt, len(columnIDs))
+		for i := range columnIDs {
+			receipts[i] = model.ViewReceipt{BoardID: boardID, ColumnID: &columnIDs[i], UserID: userID, ViewedAt: at}
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "column_id"}, {Name: "user_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "column_id IS NOT NULL"}}},
+			DoUpdates:   clause.AssignmentColumns([]string{"viewed_at"}),
+		}).Create(&receipts).Error
+	})
+}
+
+// readers selects the users counted in the board's read receipts: members with a read-only
+// role who did not opt out
+func (r *ReadReceiptRepository) readers(db *gorm.DB, boardID uuid.UUID) *gorm.DB {
+	return db.Table("board_shares").
+		Joins("JOIN users ON users.id = board_shares.user_id").
+		Where("board_shares.board_id = ? AND board_shares.role IN ? AND NOT users.hide_read_receipts", boardID, model.ReadReceiptRoles)
+}
+
+// CountReaders counts the users whose views are counted in the board's read receipts
+func (r *ReadReceiptRepository) CountReaders(ctx context.Context, boardID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.readers(dbFromContext(ctx, r.db), boardID).Count(&count).Error
+	return count, err
+}
+
+// GetByBoardID returns the board and column receipts of the users counted in the board's read receipts
+func (r *ReadReceiptRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.ViewReceipt, error) {
+	var receipts []model.ViewReceipt
+	err := r.readers(dbFromContext(ctx, r.db), boardID).
+		Select("view_receipts.*").
+		Joins("JOIN view_receipts ON view_receipts.board_id = board_shares.board_id AND view_receipts.user_id = board_shares.user_id").
+		Scan(&receipts).Error
+	return receipts, err
+}
+
+// DeleteByBoardID forgets all views of the board
+func (r *ReadReceiptRepository) DeleteByBoardID(ctx context.Context, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Delete(&model.ViewReceipt{}).Error
+}
+
+// DeleteByBoardAndUser forgets the views of the board and its columns by the user
+func (r *ReadReceiptRepository) DeleteByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Where("board_id = ? AND user_id = ?", boardID, userID).Delete(&model.ViewReceipt{}).Error
+}
+
+// DeleteByUserID forgets all views of the user
+func (r *ReadReceiptRepository) DeleteByUserID(ctx context.Context, userID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Where("user_id = ?", userID).Delete(&model.ViewReceipt{}).Error
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type replicaKey struct{}
+
+// ReadFromReplica marks ctx so that repository reads made with it go to the read replica
+// registered with RegisterReplica. Replicas lag behind the primary, so only requests that may
+// show slightly stale data, like listings and searches, should be marked.
+func ReadFromReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaKey{}, true)
+}
+
+// RegisterReplica makes db send the reads of contexts marked with ReadFromReplica to replica.
+// Writes, reads inside transactions, locking reads and raw statements other than SELECT stay on
+// the primary.
+func RegisterReplica(db *gorm.DB, replica gorm.ConnPool) error {
+	route := func(tx *gorm.DB) {
+		if onReplica, _ := tx.Statement.Context.Value(replicaKey{}).(bool); !onReplica {
+			return
+		}
+		if _, inTransaction := tx.Statement.ConnPool.(gorm.TxCommitter); inTransaction {
+			return
+		}
+		if _, locking := tx.Statement.Clauses["FOR"]; locking {
+			return
+		}
+		// Raw() уже собрал SQL; UPDATE ... RETURNING тоже читается через Scan
+		if raw := tx.Statement.SQL.String(); raw != "" && !isSelect(raw) {
+			return
+		}
+		tx.Statement.ConnPool = replica
+	}
+
+	if err := db.Callback().Query().Before("gorm:query").Register("replica:route", route); err != nil {
+		return err
+	}
+	return db.Callback().Row().Before("gorm:row").Register("replica:route", route)
+}
+
+func isSelect(sql string) bool {
+	return strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "SELECT")
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"kanban/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+)
+
+var errRecorded = errors.New("recorded")
+
+// recordingPool remembers which statements reached it and fails all of them
+type recordingPool struct {
+	statements []string
+}
+
+func (p *recordingPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return nil, errRecorded
+}
+
+func (p *recordingPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	p.statements = append(p.statements, query)
+	return nil, errRecorded
+}
+
+func (p *recordingPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	p.statements = append(p.statements, query)
+	return nil, errRecorded
+}
+
+func (p *recordingPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	p.statements = append(p.statements, query)
+	return nil
+}
+
+func TestRegisterReplica(t *testing.T) {
+	primary, replica := &recordingPool{}, &recordingPool{}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: primary}), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, RegisterReplica(db, replica))
+
+	ctx := ReadFromReplica(context.Background())
+	var boards []model.Board
+
+	db.WithContext(ctx).Find(&boards)
+	db.WithContext(ctx).Raw("SELECT 1").Scan(&boards)
+	assert.Len(t, replica.statements, 2)
+
+	// Записи, блокирующие чтения и запросы без пометки остаются на основной базе
+	db.WithContext(ctx).Create(&model.Board{Title: "Roadmap"})
+	db.WithContext(ctx).Raw("UPDATE boards SET title = 'x' RETURNING *").Scan(&boards)
+	db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Find(&boards)
+	db.WithContext(context.Background()).Find(&boards)
+	assert.Len(t, replica.statements, 2)
+	assert.Len(t, primary.statements, 4)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type SettingRepository struct {
+	db *gorm.DB
+}
+
+func NewSettingRepository(db *gorm.DB) *SettingRepository {
+	return &SettingRepository{db: db}
+}
+
+// List returns all overridden settings by key
+func (r *SettingRepository) List(ctx context.Context) ([]model.Setting, error) {
+	var settings []model.Setting
+	err := dbFromContext(ctx, r.db).Order("key").Find(&settings).Error
+	return settings, err
+}
+
+// Save creates or replaces the override of a setting
+func (r *SettingRepository) Save(ctx context.Context, setting *model.Setting) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at", "updated_by"}),
+	}).Create(setting).Error
+}
+
+// Delete removes the override of a setting, returning it to the default
+func (r *SettingRepository) Delete(ctx context.Context, key string) error {
+	return dbFromContext(ctx, r.db).Where("key = ?", key).Delete(&model.Setting{}).Error
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type ShareLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewShareLinkRepository(db *gorm.DB) *ShareLinkRepository {
+	return &ShareLinkRepository{db: db}
+}
+
+func (r *ShareLinkRepository) Create(ctx context.Context, link *model.ShareLink) error {
+	return dbFromContext(ctx, r.db).Create(link).Error
+}
+
+// GetByBoardID retrieves the links of a board, newest first
+func (r *ShareLinkRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.ShareLink, error) {
+	var links []model.ShareLink
+	err := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Order("created_at DESC").Find(&links).Error
+	return links, err
+}
+
+// Revoke disables a link of the board
+func (r *ShareLinkRepository) Revoke(ctx context.Context, boardID, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Model(&model.ShareLink{}).
+		Where("id = ? AND board_id = ?", id, boardID).
+		Update("revoked_at", gorm.Expr("COALESCE(revoked_at, ?)", time.Now()))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrShareLinkNotFound
+	}
+	return nil
+}
+
+// Redeem grants the user the link's role on its board and counts the use. The link row is locked,
+// so concurrent redemptions cannot exceed the usage cap. Users who already have the role or a
+// higher one keep their access unchanged and do not use up the link. It returns the link, the
+// user's resulting role and the role they had before, empty for users new to the board.
+func (r *ShareLinkRepository) Redeem(ctx context.Context, token string, userID uuid.UUID) (*model.ShareLink, string, string, error) {
+	var link model.ShareLink
+	var role, previous string
+
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("Board").
+			Where("token = ?", token).
+			First(&link).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrShareLinkNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if link.Board.OwnerID == userID {
+			role, previous = "owner", "owner"
+			return nil
+		}
+
+		var share model.BoardShare
+		err = tx.Where("board_id = ? AND user_id = ?", link.BoardID, userID).First(&share).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		previous = share.Role
+		if err == nil && model.RoleRank(share.Role) >= model.RoleRank(link.Role) {
+			role = share.Role
+			return nil
+		}
+
+		if !link.Active(time.Now()) {
+			return ErrShareLinkInactive
+		}
+
+		if err := tx.Model(&link).Update("uses", gorm.Expr("uses + 1")).Error; err != nil {
+			return err
+		}
+		link.Uses++
+
+		role = link.Role
+		if share.ID != uuid.Nil {
+			return tx.Model(&share).Update("role", link.Role).Error
+		}
+		return tx.Create(&model.BoardShare{BoardID: link.BoardID, UserID: userID, Role: link.Role}).Error
+	})
+	if err != nil {
+		return nil, "", "", err
+	}
+	return &link, role, previous, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type SprintRepository struct {
+	db *gorm.DB
+}
+
+func NewSprintRepository(db *gorm.DB) *SprintRepository {
+	return &SprintRepository{db: db}
+}
+
+// SprintStats sums up the tasks planned into a sprint
+type SprintStats struct {
+	SprintID        uuid.UUID
+	Tasks           int
+	CompletedTasks  int
+	Points          int
+	CompletedPoints int
+}
+
+// Create adds a sprint to its board
+func (r *SprintRepository) Create(ctx context.Context, sprint *model.Sprint) error {
+	return dbFromContext(ctx, r.db).Create(sprint).Error
+}
+
+// GetByID retrieves a sprint by its ID
+func (r *SprintRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Sprint, error) {
+	var sprint model.Sprint
+	if err := dbFromContext(ctx, r.db).First(&sprint, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSprintNotFound
+		}
+		return nil, err
+	}
+	return &sprint, nil
+}
+
+// GetByBoardID retrieves the sprints of a board, latest first
+func (r *SprintRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Sprint, error) {
+	var sprints []model.Sprint
+	err := dbFromContext(ctx, r.db).
+		Where("board_id = ?", boardID).
+		Order("start_date DESC, created_at DESC").
+		Find(&sprints).Error
+	return sprints, err
+}
+
+// Close marks the sprint as closed. Its open tasks stay in it until they are planned into another sprint.
+func (r *SprintRepository) Close(ctx context.Context, sprint *model.Sprint) error {
+	now := time.Now()
+	result := dbFromContext(ctx, r.db).Model(&model.Sprint{}).
+		Where("id = ? AND closed_at IS NULL", sprint.ID).
+		Update("closed_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrSprintClosed
+	}
+	sprint.ClosedAt = &now
+	return nil
+}
+
+// Stats sums up the tasks and story points of the sprints; sprints without tasks are left out
+func (r *SprintRepository) Stats(ctx context.Context, sprintIDs []uuid.UUID) (map[uuid.UUID]SprintStats, error) {
+	stats := make(map[uuid.UUID]SprintStats, len(sprintIDs))
+	if len(sprintIDs) == 0 {
+		return stats, nil
+	}
+
+	var rows []SprintStats
+	err := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Select("sprint_id, COUNT(*) AS tasks, "+
+			"COUNT(*) FILTER (WHERE completed_at IS NOT NULL) AS completed_tasks, "+
+			"COALESCE(SUM(estimate), 0) AS points, "+
+			"COALESCE(SUM(estimate) FILTER (WHERE completed_at IS NOT NULL), 0) AS completed_points").
+		Where("sprint_id IN ?", sprintIDs).
+		Group("sprint_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		stats[row.SprintID] = row
+	}
+	return stats, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type StatusPageRepository struct {
+	db *gorm.DB
+}
+
+func NewStatusPageRepository(db *gorm.DB) *StatusPageRepository {
+	return &StatusPageRepository{db: db}
+}
+
+// GetByBoardID retrieves the status page of a board
+func (r *StatusPageRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) (*model.StatusPage, error) {
+	return r.first(ctx, "board_id = ?", boardID)
+}
+
+// GetBySlug retrieves a status page by its public slug
+func (r *StatusPageRepository) GetBySlug(ctx context.Context, slug string) (*model.StatusPage, error) {
+	return r.first(ctx, "slug = ?", slug)
+}
+
+func (r *StatusPageRepository) first(ctx context.Context, condition string, value interface{}) (*model.StatusPage, error) {
+	var page model.StatusPage
+	if err := dbFromContext(ctx, r.db).Where(condition, value).First(&page).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStatusPageNotFound
+		}
+		return nil, err
+	}
+	return &page, nil
+}
+
+// Update saves the title and columns of the status page. The slug never changes.
+func (r *StatusPageRepository) Update(ctx context.Context, page *model.StatusPage) error {
+	return dbFromContext(ctx, r.db).Model(page).Select("Title", "ColumnIDs", "UpdatedAt").Updates(page).Error
+}
+
+// Create publishes a new status page
+func (r *StatusPageRepository) Create(ctx context.Context, page *model.StatusPage) error {
+	return dbFromContext(ctx, r.db).Create(page).Error
+}
+
+// DeleteByBoardID unpublishes the status page of a board
+func (r *StatusPageRepository) DeleteByBoardID(ctx context.Context, boardID uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Delete(&model.StatusPage{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrStatusPageNotFound
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type SwimlaneRepository struct {
+	db *gorm.DB
+}
+
+func NewSwimlaneRepository(db *gorm.DB) *SwimlaneRepository {
+	return &SwimlaneRepository{db: db}
+}
+
+// Create adds the swimlane after the last swimlane of its board
+func (r *SwimlaneRepository) Create(ctx context.Context, swimlane *model.Swimlane) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var maxPosition int
+		if err := tx.Model(&model.Swimlane{}).
+			Select("COALESCE(MAX(position), 0)").
+			Where("board_id = ?", swimlane.BoardID).
+			Scan(&maxPosition).Error; err != nil {
+			return err
+		}
+		swimlane.Position = maxPosition + 1
+		return tx.Create(swimlane).Error
+	})
+}
+
+// GetByID retrieves a swimlane by its ID
+func (r *SwimlaneRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Swimlane, error) {
+	var swimlane model.Swimlane
+	if err := dbFromContext(ctx, r.db).First(&swimlane, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSwimlaneNotFound
+		}
+		return nil, err
+	}
+	return &swimlane, nil
+}
+
+// GetByBoardID retrieves the swimlanes of a board ordered by position
+func (r *SwimlaneRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Swimlane, error) {
+	var swimlanes []model.Swimlane
+	err := dbFromContext(ctx, r.db).Where("board_id = ?", boardID).Order("position").Find(&swimlanes).Error
+	return swimlanes, err
+}
+
+// Update saves the swimlane title and moves it to the given 1-based position, shifting the
+// swimlanes in between. Positions out of range move it to the end.
+func (r *SwimlaneRepository) Update(ctx context.Context, swimlane *model.Swimlane, position int) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var count int
+		if err := tx.Model(&model.Swimlane{}).
+			Select("COUNT(*)").
+			Where("board_id = ?", swimlane.BoardID).
+			Scan(&count).Error; err != nil {
+			return err
+		}
+		if position < 1 || position > count {
+			position = count
+		}
+
+		lanes := tx.Model(&model.Swimlane{}).Where("board_id = ? AND id <> ?", swimlane.BoardID, swimlane.ID)
+		switch {
+		case position < swimlane.Position:
+			if err := lanes.Where("position >= ? AND position < ?", position, swimlane.Position).
+				Update("position", gorm.Expr("position + 1")).Error; err != nil {
+				return err
+			}
+		case position > swimlane.Position:
+			if err := lanes.Where("position > ? AND position <= ?", swimlane.Position, position).
+				Update("position", gorm.Expr("position - 1")).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Model(swimlane).Updates(map[string]interface{}{"title": swimlane.Title, "position": position})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSwimlaneNotFound
+		}
+		swimlane.Position = position
+		return nil
+	})
+}
+
+// Delete removes the swimlane and closes the gap in the positions of its board. Its tasks
+// move to the default lane.
+func (r *SwimlaneRepository) Delete(ctx context.Context, swimlane *model.Swimlane) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&model.Swimlane{}, "id = ?", swimlane.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSwimlaneNotFound
+		}
+
+		return tx.Model(&model.Swimlane{}).
+			Where("board_id = ? AND position > ?", swimlane.BoardID, swimlane.Position).
+			Update("position", gorm.Expr("position - 1")).Error
+	})
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/audit"
+	"kanban/internal/model"
+)
+
+type TaskChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskChangeRepository(db *gorm.DB) *TaskChangeRepository {
+	return &TaskChangeRepository{db: db}
+}
+
+// Record adds changes to the history of their tasks
+func (r *TaskChangeRepository) Record(ctx context.Context, changes []model.TaskChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return dbFromContext(ctx, r.db).Create(&changes).Error
+}
+
+// GetByTaskID retrieves the history of a task with the users who made the changes, latest first
+func (r *TaskChangeRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.TaskChange, error) {
+	var changes []model.TaskChange
+	err := dbFromContext(ctx, r.db).
+		Preload("User").
+		Where("task_id = ?", taskID).
+		Order("created_at DESC, field").
+		Find(&changes).Error
+	return changes, err
+}
+
+// GetAssignedSince retrieves the open tasks assigned to a user by someone else since the given
+// time and still assigned to the user, on boards the user owns or is a member of, with column
+// and board loaded
+func (r *TaskChangeRepository) GetAssignedSince(ctx context.Context, userID uuid.UUID, since time.Time) ([]model.Task, error) {
+	var tasks []model.Task
+	err := dbFromContext(ctx, r.db).
+		Joins("Column.Board").
+		Where("tasks.assigned_to = ? AND tasks.completed_at IS NULL", userID).
+		Where("EXISTS (SELECT 1 FROM task_changes WHERE task_changes.task_id = tasks.id AND task_changes.field = ? AND task_changes.new_value = ? AND task_changes.created_at >= ? AND task_changes.user_id IS DISTINCT FROM ?)",
+			model.TaskFieldAssignee, userID.String(), since, userID).
+		Where("\"Column__Board\".owner_id = ? OR EXISTS (SELECT 1 FROM board_shares WHERE board_shares.board_id = \"Column__Board\".id AND board_shares.user_id = ?)", userID, userID).
+		Order("\"Column__Board\".title, tasks.number").
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// ListLog retrieves up to limit changes of all tasks made in [from, to), oldest first, as entries
+// of the activity log. With after set only the changes following that entry are returned, so a
+// long range is read in pages. A zero from means since the beginning.
+func (r *TaskChangeRepository) ListLog(ctx context.Context, from, to time.Time, after *audit.Entry, limit int) ([]audit.Entry, error) {
+	query := dbFromContext(ctx, r.db).Table("task_changes").
+		Select("task_changes.id, task_changes.created_at AS changed_at, columns.board_id, task_changes.task_id, "+
+			"task_changes.user_id, users.email AS user_email, task_changes.field, task_changes.old_value, task_changes.new_value").
+		Joins("JOIN tasks ON tasks.id = task_changes.task_id").
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Joins("LEFT JOIN users ON users.id = task_changes.user_id").
+		Where("task_changes.created_at >= ? AND task_changes.created_at < ?", from, to)
+	if after != nil {
+		query = query.Where("(task_changes.created_at, task_changes.id) > (?, ?)", after.ChangedAt, after.ID)
+	}
+
+	var entries []audit.Entry
+	err := query.Order("task_changes.created_at, task_changes.id").Limit(limit).Scan(&entries).Error
+	return entries, err
+}
+
+// DeleteByIDs removes the changes with the given IDs and returns how many were removed
+func (r *TaskChangeRepository) DeleteByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := dbFromContext(ctx, r.db).Where("id IN ?", ids).Delete(&model.TaskChange{})
+	return result.RowsAffected, result.Error
+}
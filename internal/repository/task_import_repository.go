@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type TaskImportRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskImportRepository(db *gorm.DB) *TaskImportRepository {
+	return &TaskImportRepository{db: db}
+}
+
+// ImportPartSummary describes an uploaded part without its rows
+type ImportPartSummary struct {
+	PartNumber int
+	TaskCount  int
+}
+
+// Create starts a new import and drops the user's expired imports, which can no longer be committed
+func (r *TaskImportRepository) Create(ctx context.Context, taskImport *model.TaskImport) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("user_id = ? AND status = ? AND expires_at <= ?", taskImport.UserID, model.TaskImportUploading, time.Now()).
+		Delete(&model.TaskImport{}).Error; err != nil {
+		return err
+	}
+	return db.Create(taskImport).Error
+}
+
+func (r *TaskImportRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.TaskImport, error) {
+	var taskImport model.TaskImport
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&taskImport).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTaskImportNotFound
+		}
+		return nil, err
+	}
+	return &taskImport, nil
+}
+
+// CountOpen counts the user's imports that are being uploaded or imported
+func (r *TaskImportRepository) CountOpen(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.TaskImport{}).
+		Where("user_id = ? AND (status = ? AND expires_at > ? OR status = ?)",
+			userID, model.TaskImportUploading, time.Now(), model.TaskImportCommitted).
+		Count(&count).Error
+	return count, err
+}
+
+// SavePart stores an uploaded part, replacing an earlier upload with the same number
+func (r *TaskImportRepository) SavePart(ctx context.Context, part *model.TaskImportPart) error {
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "import_id"}, {Name: "part_number"}},
+		DoUpdates: clause.AssignmentColumns([]string{"tasks", "task_count", "created_at"}),
+	}).Create(part).Error
+}
+
+// GetPartSummaries lists the parts of an import waiting to be imported, by part number
+func (r *TaskImportRepository) GetPartSummaries(ctx context.Context, importID uuid.UUID) ([]ImportPartSummary, error) {
+	var parts []ImportPartSummary
+	err := dbFromContext(ctx, r.db).Model(&model.TaskImportPart{}).
+		Select("part_number, task_count").
+		Where("import_id = ?", importID).
+		Order("part_number").
+		Scan(&parts).Error
+	return parts, err
+}
+
+// GetPart retrieves a part with its rows
+func (r *TaskImportRepository) GetPart(ctx context.Context, importID uuid.UUID, partNumber int) (*model.TaskImportPart, error) {
+	var part model.TaskImportPart
+	err := dbFromContext(ctx, r.db).Where("import_id = ? AND part_number = ?", importID, partNumber).First(&part).Error
+	if err != nil {
+		return nil, err
+	}
+	return &part, nil
+}
+
+// Commit marks an uploading import as committed to the given operation and moves its expiry
+// to expiresAt, so the parts left over by a failed run can still be committed again. It reports
+// false if the import was committed meanwhile.
+func (r *TaskImportRepository) Commit(ctx context.Context, id, operationID uuid.UUID, expiresAt time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).Model(&model.TaskImport{}).
+		Where("id = ? AND status = ?", id, model.TaskImportUploading).
+		Updates(map[string]interface{}{
+			"status":       model.TaskImportCommitted,
+			"operation_id": operationID,
+			"expires_at":   expiresAt,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// Reopen returns a committed import whose operation did not finish to uploading, so the parts
+// that were not imported can be committed again
+func (r *TaskImportRepository) Reopen(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.TaskImport{}).
+		Where("id = ? AND status = ?", id, model.TaskImportCommitted).
+		Update("status", model.TaskImportUploading).Error
+}
+
+// ReopenInterrupted reopens all committed imports. Called at startup, when the operations
+// importing them were lost with the old process.
+func (r *TaskImportRepository) ReopenInterrupted(ctx context.Context) (int64, error) {
+	result := dbFromContext(ctx, r.db).Model(&model.TaskImport{}).
+		Where("status = ?", model.TaskImportCommitted).
+		Update("status", model.TaskImportUploading)
+	return result.RowsAffected, result.Error
+}
+
+// CompletePart removes an imported part and counts its imported tasks. Called in the
+// transaction that creates the tasks, a part is imported exactly once.
+func (r *TaskImportRepository) CompletePart(ctx context.Context, importID uuid.UUID, partNumber, imported int) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("import_id = ? AND part_number = ?", importID, partNumber).
+		Delete(&model.TaskImportPart{}).Error; err != nil {
+		return err
+	}
+	return db.Model(&model.TaskImport{}).Where("id = ?", importID).
+		Update("imported_tasks", gorm.Expr("imported_tasks + ?", imported)).Error
+}
+
+// Finish marks the import as imported once all its parts are
+func (r *TaskImportRepository) Finish(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.TaskImport{}).Where("id = ?", id).
+		Update("status", model.TaskImportImported).Error
+}
+
+// Delete discards an import with its parts
+func (r *TaskImportRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&model.TaskImport{}, "id = ?", id).Error
+}
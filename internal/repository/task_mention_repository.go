@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type TaskMentionRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskMentionRepository(db *gorm.DB) *TaskMentionRepository {
+	return &TaskMentionRepository{db: db}
+}
+
+// ReplaceForTask swaps the mentions of a task from the given origin for a new set. Users who
+// stay mentioned keep their existing record, so editing the text does not notify them again.
+// It returns the mentions that are new.
+func (r *TaskMentionRepository) ReplaceForTask(ctx context.Context, taskID uuid.UUID, origin string, mentions []model.TaskMention) ([]model.TaskMention, error) {
+	var created []model.TaskMention
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		userIDs := make([]uuid.UUID, len(mentions))
+		for i, m := range mentions {
+			userIDs[i] = m.UserID
+		}
+
+		stale := tx.Where("task_id = ? AND origin = ?", taskID, origin)
+		if len(userIDs) > 0 {
+			stale = stale.Where("user_id NOT IN ?", userIDs)
+		}
+		if err := stale.Delete(&model.TaskMention{}).Error; err != nil {
+			return err
+		}
+
+		if len(mentions) == 0 {
+			return nil
+		}
+
+		var existing []uuid.UUID
+		err := tx.Model(&model.TaskMention{}).
+			Where("task_id = ? AND origin = ?", taskID, origin).
+			Pluck("user_id", &existing).Error
+		if err != nil {
+			return err
+		}
+		for _, m := range mentions {
+			if !slices.Contains(existing, m.UserID) {
+				created = append(created, m)
+			}
+		}
+
+		if len(created) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&created).Error
+	})
+	return created, err
+}
+
+// GetByTaskID retrieves the mentions of a task with the mentioned users loaded
+func (r *TaskMentionRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.TaskMention, error) {
+	var mentions []model.TaskMention
+	err := dbFromContext(ctx, r.db).
+		Joins("User").
+		Where("task_mentions.task_id = ?", taskID).
+		Order("task_mentions.created_at").
+		Find(&mentions).Error
+	return mentions, err
+}
+
+// BoardFilter selects boards without listing all of them: every board except Boards, or only
+// Boards when AllBoards is false
+type BoardFilter struct {
+	AllBoards bool
+	Boards    []uuid.UUID
+}
+
+// GetForUser retrieves the mentions of a user by others on the boards of the filter, newest first,
+// with the task, its board and the author loaded. Mentions on boards the user can no longer access
+// are skipped.
+func (r *TaskMentionRepository) GetForUser(ctx context.Context, userID uuid.UUID, boards BoardFilter, unreadOnly bool, limit int) ([]model.TaskMention, error) {
+	query := dbFromContext(ctx, r.db).
+		Joins("Task").
+		Joins("Task.Column").
+		Joins("Task.Column.Board").
+		Joins("Author").
+		Where("task_mentions.user_id = ? AND task_mentions.mentioned_by <> ?", userID, userID).
+		Where("\"Task__Column__Board\".owner_id = ? OR EXISTS (SELECT 1 FROM board_shares WHERE board_shares.board_id = \"Task__Column__Board\".id AND board_shares.user_id = ?)", userID, userID)
+	switch {
+	case !boards.AllBoards && len(boards.Boards) == 0:
+		return []model.TaskMention{}, nil
+	case !boards.AllBoards:
+		query = query.Where("\"Task__Column__Board\".id IN ?", boards.Boards)
+	case len(boards.Boards) > 0:
+		query = query.Where("\"Task__Column__Board\".id NOT IN ?", boards.Boards)
+	}
+	if unreadOnly {
+		query = query.Where("task_mentions.read_at IS NULL")
+	}
+
+	var mentions []model.TaskMention
+	err := query.Order("task_mentions.created_at DESC").Limit(limit).Find(&mentions).Error
+	return mentions, err
+}
+
+// MarkRead marks a mention of the user as read and reports whether it exists
+func (r *TaskMentionRepository) MarkRead(ctx context.Context, id, userID uuid.UUID) (bool, error) {
+	var count int64
+	db := dbFromContext(ctx, r.db)
+	if err := db.Model(&model.TaskMention{}).Where("id = ? AND user_id = ?", id, userID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	err := db.Model(&model.TaskMention{}).
+		Where("id = ? AND read_at IS NULL", id).
+		Update("read_at", time.Now()).Error
+	return true, err
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type TaskReferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskReferenceRepository(db *gorm.DB) *TaskReferenceRepository {
+	return &TaskReferenceRepository{db: db}
+}
+
+// ReplaceForTask swaps the references of a task from the given origin for a new set
+func (r *TaskReferenceRepository) ReplaceForTask(ctx context.Context, taskID uuid.UUID, origin string, refs []model.TaskReference) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("task_id = ? AND origin = ?", taskID, origin).
+			Delete(&model.TaskReference{}).Error; err != nil {
+			return err
+		}
+
+		if len(refs) == 0 {
+			return nil
+		}
+		return tx.Create(&refs).Error
+	})
+}
+
+// GetByTaskID retrieves the references of a task with their target tasks loaded
+func (r *TaskReferenceRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.TaskReference, error) {
+	var refs []model.TaskReference
+	result := dbFromContext(ctx, r.db).
+		Preload("TargetTask").
+		Preload("TargetTask.Column").
+		Preload("TargetTask.Column.Board").
+		Where("task_id = ?", taskID).
+		Order("created_at").
+		Find(&refs)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return refs, nil
+}
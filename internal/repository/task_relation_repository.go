@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/database"
+	"kanban/internal/model"
+)
+
+type TaskRelationRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskRelationRepository(db *gorm.DB) *TaskRelationRepository {
+	return &TaskRelationRepository{db: db}
+}
+
+// Create links two tasks of the given board. Changes to the relations of one board are
+// serialized, so concurrent requests cannot together close a dependency cycle.
+func (r *TaskRelationRepository) Create(ctx context.Context, relation *model.TaskRelation, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Транзакции SQLite и так выполняются по одной
+		if !database.IsSQLite(tx) {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "task_relations:"+boardID.String()).Error; err != nil {
+				return err
+			}
+		}
+
+		var exists bool
+		err := tx.Raw(
+			"SELECT EXISTS (SELECT 1 FROM task_relations WHERE type = ? AND "+
+				"((task_id = ? AND related_task_id = ?) OR (? = ? AND task_id = ? AND related_task_id = ?)))",
+			relation.Type, relation.TaskID, relation.RelatedTaskID,
+			relation.Type, model.RelationRelatesTo, relation.RelatedTaskID, relation.TaskID,
+		).Scan(&exists).Error
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrRelationExists
+		}
+
+		if relation.Type == model.RelationBlocks {
+			// Цикл возникает, если блокируемая задача уже (транзитивно) блокирует исходную
+			var cycle bool
+			err := tx.Raw(
+				"WITH RECURSIVE blocked(id) AS ("+
+					"SELECT related_task_id FROM task_relations WHERE task_id = ? AND type = ? "+
+					"UNION SELECT r.related_task_id FROM task_relations r JOIN blocked ON r.task_id = blocked.id WHERE r.type = ?"+
+					") SELECT EXISTS (SELECT 1 FROM blocked WHERE id = ?)",
+				relation.RelatedTaskID, model.RelationBlocks, model.RelationBlocks, relation.TaskID,
+			).Scan(&cycle).Error
+			if err != nil {
+				return err
+			}
+			if cycle {
+				return ErrRelationCycle
+			}
+		}
+
+		return tx.Create(relation).Error
+	})
+}
+
+// GetByTaskID retrieves the relations a task takes part in on either side, with both tasks loaded
+func (r *TaskRelationRepository) GetByTaskID(ctx context.Context, taskID uuid.UUID) ([]model.TaskRelation, error) {
+	var relations []model.TaskRelation
+	err := dbFromContext(ctx, r.db).
+		Joins("Task").
+		Joins("RelatedTask").
+		Where("task_relations.task_id = ? OR task_relations.related_task_id = ?", taskID, taskID).
+		Order("task_relations.created_at").
+		Find(&relations).Error
+	return relations, err
+}
+
+// Delete removes a relation the task takes part in
+func (r *TaskRelationRepository) Delete(ctx context.Context, id, taskID uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).
+		Where("id = ? AND (task_id = ? OR related_task_id = ?)", id, taskID, taskID).
+		Delete(&model.TaskRelation{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRelationNotFound
+	}
+	return nil
+}
+
+// DeleteByTaskID removes all relations of the task, used when it leaves its board
+func (r *TaskRelationRepository) DeleteByTaskID(ctx context.Context, taskID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).
+		Where("task_id = ? OR related_task_id = ?", taskID, taskID).
+		Delete(&model.TaskRelation{}).Error
+}
+
+// DeleteAcrossColumn removes the relations between tasks of the column and tasks outside it,
+// used when the column leaves its board
+func (r *TaskRelationRepository) DeleteAcrossColumn(ctx context.Context, columnID uuid.UUID) error {
+	columnTasks := "SELECT id FROM tasks WHERE column_id = ?"
+	return dbFromContext(ctx, r.db).
+		Where("(task_id IN ("+columnTasks+")) <> (related_task_id IN ("+columnTasks+"))", columnID, columnID).
+		Delete(&model.TaskRelation{}).Error
+}
+
+// DeleteAcrossBoards removes the relations between tasks of the board and tasks of other boards,
+// left behind when tasks move between boards
+func (r *TaskRelationRepository) DeleteAcrossBoards(ctx context.Context, boardID uuid.UUID) error {
+	boardTasks := "SELECT tasks.id FROM tasks JOIN columns ON columns.id = tasks.column_id WHERE columns.board_id = ?"
+	return dbFromContext(ctx, r.db).
+		Where("(task_id IN ("+boardTasks+")) <> (related_task_id IN ("+boardTasks+"))", boardID, boardID).
+		Delete(&model.TaskRelation{}).Error
+}
+
+// OpenBlockerCounts returns, for each of the given tasks that is blocked, the number of
+// tasks blocking it that are not completed yet
+func (r *TaskRelationRepository) OpenBlockerCounts(ctx context.Context, taskIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int)
+	if len(taskIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		RelatedTaskID uuid.UUID
+		Count         int
+	}
+	err := dbFromContext(ctx, r.db).
+		Table("task_relations").
+		Select("task_relations.related_task_id, COUNT(*) AS count").
+		Joins("JOIN tasks ON tasks.id = task_relations.task_id").
+		Where("task_relations.type = ? AND task_relations.related_task_id IN ? AND tasks.completed_at IS NULL",
+			model.RelationBlocks, taskIDs).
+		Group("task_relations.related_task_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.RelatedTaskID] = row.Count
+	}
+	return counts, nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -18,19 +19,612 @@ type TaskRepository struct {
 	db *gorm.DB
 }
 
+type TaskRepositoryInterface interface {
+	Create(ctx context.Context, task *model.Task) error
+	CreateCopy(ctx context.Context, task *model.Task, labelIDs []uuid.UUID) error
+	Restore(ctx context.Context, task *model.Task, labelIDs []uuid.UUID, relations []model.TaskRelation) error
+	GetByNumber(ctx context.Context, boardID uuid.UUID, number int) (*model.Task, error)
+	GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Task, error)
+	GetByIDsOnBoard(ctx context.Context, boardID uuid.UUID, ids []uuid.UUID) ([]model.Task, error)
+	GetByCreator(ctx context.Context, userID uuid.UUID) ([]model.Task, error)
+	GetByAssignee(ctx context.Context, userID uuid.UUID) ([]model.Task, error)
+	GetAssigned(ctx context.Context, userID uuid.UUID, filter AssignedTaskFilter) ([]model.Task, error)
+	GetAccessibleByIDs(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) ([]TaskListItem, error)
+	RenumberForBoard(ctx context.Context, columnID, boardID uuid.UUID) (int64, error)
+	SettleOnBoard(ctx context.Context, taskID, boardID uuid.UUID) error
+	UnassignNonMembers(ctx context.Context, columnID, boardID uuid.UUID) error
+	RemapSwimlanes(ctx context.Context, columnID, boardID uuid.UUID) error
+	ClearSprints(ctx context.Context, columnID uuid.UUID) error
+	SetSprint(ctx context.Context, taskIDs []uuid.UUID, sprint *model.Sprint) (int64, error)
+	RemoveFromSprint(ctx context.Context, taskID, sprintID uuid.UUID) error
+	GetBySprintID(ctx context.Context, sprintID uuid.UUID) ([]model.Task, error)
+	CountBySwimlane(ctx context.Context, boardID uuid.UUID) ([]SwimlaneTaskCount, error)
+	SummarizeColumns(ctx context.Context, boardID uuid.UUID, now time.Time) ([]ColumnTaskSummary, error)
+	SetSwimlane(ctx context.Context, taskID uuid.UUID, swimlaneID *uuid.UUID) error
+	SetDescription(ctx context.Context, taskID uuid.UUID, description string) error
+	SuggestAssignees(ctx context.Context, task *model.Task, boardID uuid.UUID, since time.Time, limit int) ([]AssigneeSuggestion, error)
+	ReassignCreator(ctx context.Context, userID uuid.UUID) error
+	UnassignEverywhere(ctx context.Context, userID uuid.UUID) error
+	UnassignOnBoard(ctx context.Context, boardID, userID uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*model.Task, error)
+	GetWithBoard(ctx context.Context, id uuid.UUID) (*model.Task, error)
+	GetWithDetails(ctx context.Context, id uuid.UUID) (*model.Task, error)
+	GetByColumnID(ctx context.Context, columnID uuid.UUID) ([]model.Task, error)
+	CountByColumn(ctx context.Context, columnID uuid.UUID) (int64, error)
+	GetByColumnIDs(ctx context.Context, columnIDs []uuid.UUID) ([]model.Task, error)
+	GetTasksWithLabels(ctx context.Context, columnID uuid.UUID, opts TaskListOptions) ([]TaskListItem, error)
+	Update(ctx context.Context, task *model.Task) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	MoveTask(ctx context.Context, taskID uuid.UUID, columnID uuid.UUID, newPosition int) error
+	GetPositions(ctx context.Context, columnIDs []uuid.UUID) ([]model.Task, error)
+	CompactFragmentedColumns(ctx context.Context, limit int) (int, int64, error)
+	AddLabel(ctx context.Context, taskID, labelID uuid.UUID) error
+	RemoveLabel(ctx context.Context, taskID, labelID uuid.UUID) error
+	AssignUser(ctx context.Context, taskID, userID uuid.UUID) error
+	UnassignUser(ctx context.Context, taskID uuid.UUID) error
+}
+
+var _ TaskRepositoryInterface = (*TaskRepository)(nil)
+
 func NewTaskRepository(db *gorm.DB) *TaskRepository {
 	return &TaskRepository{db: db}
 }
 
-// Create adds a new task to the database
+// Create adds a new task to the database, assigning it the next number on its board.
+// Tasks created in a done column are completed right away.
 func (r *TaskRepository) Create(ctx context.Context, task *model.Task) error {
-	return r.db.WithContext(ctx).Create(task).Error
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var counter struct {
+			TaskCounter int
+			IsDone      bool
+		}
+		// SQLite не возвращает из RETURNING столбцы других таблиц, поэтому колонка читается подзапросом
+		err := tx.Raw(
+			"UPDATE boards SET task_counter = task_counter + 1 WHERE id = (SELECT board_id FROM columns WHERE id = @column) "+
+				"RETURNING task_counter, (SELECT is_done FROM columns WHERE id = @column) AS is_done",
+			map[string]interface{}{"column": task.ColumnID},
+		).Scan(&counter).Error
+		if err != nil {
+			return err
+		}
+
+		task.Number = counter.TaskCounter
+		now := time.Now()
+		if counter.IsDone {
+			task.CompletedAt = &now
+		}
+		if err := tx.Create(task).Error; err != nil {
+			return err
+		}
+		return enterColumn(tx, task.ID, task.ColumnID, now)
+	})
+}
+
+// CreateCopy inserts a task keeping its number and attaches the given labels. Unlike Create it
+// does not advance the board's task counter; copies of a board set the counter themselves.
+func (r *TaskRepository) CreateCopy(ctx context.Context, task *model.Task, labelIDs []uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(task).Error; err != nil {
+			return err
+		}
+
+		if err := enterColumn(tx, task.ID, task.ColumnID, time.Now()); err != nil {
+			return err
+		}
+
+		for _, labelID := range labelIDs {
+			if err := tx.Exec(
+				"INSERT INTO task_labels (task_id, label_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
+				task.ID, labelID,
+			).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Restore puts a deleted task back at its position in its column, keeping its ID and number,
+// and attaches its labels and relations again. Labels, swimlanes, sprints, assignees and
+// related tasks deleted in the meantime are left out.
+func (r *TaskRepository) Restore(ctx context.Context, task *model.Task, labelIDs []uuid.UUID, relations []model.TaskRelation) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		references := []struct {
+			table string
+			id    **uuid.UUID
+		}{
+			{"swimlanes", &task.SwimlaneID},
+			{"sprints", &task.SprintID},
+			{"users", &task.AssignedTo},
+		}
+		for _, ref := range references {
+			if *ref.id == nil {
+				continue
+			}
+			var exists bool
+			if err := tx.Raw("SELECT EXISTS (SELECT 1 FROM "+ref.table+" WHERE id = ?)", **ref.id).Scan(&exists).Error; err != nil {
+				return err
+			}
+			if !exists {
+				*ref.id = nil
+			}
+		}
+
+		if err := tx.Model(&model.Task{}).
+			Where("column_id = ? AND position >= ?", task.ColumnID, task.Position).
+			Update("position", gorm.Expr("position + 1")).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(task).Error; err != nil {
+			return err
+		}
+		if err := enterColumn(tx, task.ID, task.ColumnID, time.Now()); err != nil {
+			return err
+		}
+
+		if len(labelIDs) > 0 {
+			if err := tx.Exec(
+				"INSERT INTO task_labels (task_id, label_id) SELECT ?, id FROM labels WHERE id IN ?",
+				task.ID, labelIDs,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, relation := range relations {
+			if err := tx.Exec(
+				"INSERT INTO task_relations (task_id, related_task_id, type, created_by, created_at) "+
+					"SELECT ?, ?, ?, (SELECT id FROM users WHERE id = ?), ? "+
+					"WHERE (SELECT COUNT(*) FROM tasks WHERE id IN (?, ?)) = 2 ON CONFLICT DO NOTHING",
+				relation.TaskID, relation.RelatedTaskID, relation.Type, relation.CreatedBy, relation.CreatedAt,
+				relation.TaskID, relation.RelatedTaskID,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		_, err := compactTaskPositions(tx, []uuid.UUID{task.ColumnID})
+		return err
+	})
+}
+
+// GetByNumber retrieves a task by its per-board number
+func (r *TaskRepository) GetByNumber(ctx context.Context, boardID uuid.UUID, number int) (*model.Task, error) {
+	var task model.Task
+	result := dbFromContext(ctx, r.db).
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Where("columns.board_id = ? AND tasks.number = ?", boardID, number).
+		First(&task)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, result.Error
+	}
+	return &task, nil
+}
+
+// GetByBoardID retrieves all tasks of a board with their column, creator, assignee and labels,
+// ordered by column and position
+func (r *TaskRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.Task, error) {
+	var tasks []model.Task
+	err := dbFromContext(ctx, r.db).
+		Joins("Column").
+		Joins("Creator").
+		Joins("Assignee").
+		Where("\"Column\".board_id = ?", boardID).
+		Order("\"Column\".position, tasks.position").
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// GetByIDsOnBoard retrieves the tasks with the given IDs that are on the board, loaded like GetByBoardID
+func (r *TaskRepository) GetByIDsOnBoard(ctx context.Context, boardID uuid.UUID, ids []uuid.UUID) ([]model.Task, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var tasks []model.Task
+	err := dbFromContext(ctx, r.db).
+		Joins("Column").
+		Joins("Creator").
+		Joins("Assignee").
+		Where("\"Column\".board_id = ? AND tasks.id IN ?", boardID, ids).
+		Order("\"Column\".position, tasks.position").
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// GetByCreator retrieves the tasks a user created on any board, with column, board and labels
+func (r *TaskRepository) GetByCreator(ctx context.Context, userID uuid.UUID) ([]model.Task, error) {
+	return r.getForUser(ctx, "tasks.created_by = ?", userID)
+}
+
+// GetByAssignee retrieves the tasks assigned to a user on any board, with column, board and labels
+func (r *TaskRepository) GetByAssignee(ctx context.Context, userID uuid.UUID) ([]model.Task, error) {
+	return r.getForUser(ctx, "tasks.assigned_to = ?", userID)
+}
+
+func (r *TaskRepository) getForUser(ctx context.Context, condition string, userID uuid.UUID) ([]model.Task, error) {
+	var tasks []model.Task
+	err := dbFromContext(ctx, r.db).
+		Joins("Column.Board").
+		Where(condition, userID).
+		Order("tasks.number").
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// AssignedTaskFilter narrows the tasks assigned to a user. Nil fields do not filter; the due date
+// range [DueFrom, DueBefore) excludes tasks without a due date. Overdue keeps open tasks due
+// before it. Completed tasks are left out unless IncludeCompleted is set.
+type AssignedTaskFilter struct {
+	BoardID          *uuid.UUID
+	LabelID          *uuid.UUID
+	DueFrom          *time.Time
+	DueBefore        *time.Time
+	Overdue          *time.Time
+	IncludeCompleted bool
+}
+
+// GetAssigned retrieves the tasks assigned to a user on boards the user owns or is a member of,
+// with column, board, creator, assignee and labels, soonest due first and tasks without a due
+// date last. The filter is applied in the same joined query.
+func (r *TaskRepository) GetAssigned(ctx context.Context, userID uuid.UUID, filter AssignedTaskFilter) ([]model.Task, error) {
+	var tasks []model.Task
+	query := dbFromContext(ctx, r.db).
+		Joins("Column.Board").
+		Joins("Creator").
+		Joins("Assignee").
+		Where("tasks.assigned_to = ?", userID).
+		Where("\"Column__Board\".owner_id = ? OR EXISTS (SELECT 1 FROM board_shares WHERE board_shares.board_id = \"Column__Board\".id AND board_shares.user_id = ?)", userID, userID)
+	if filter.BoardID != nil {
+		query = query.Where("\"Column\".board_id = ?", *filter.BoardID)
+	}
+	if filter.LabelID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM task_labels WHERE task_labels.task_id = tasks.id AND task_labels.label_id = ?)", *filter.LabelID)
+	}
+	if filter.DueFrom != nil {
+		query = query.Where("tasks.due_date >= ?", *filter.DueFrom)
+	}
+	if filter.DueBefore != nil {
+		query = query.Where("tasks.due_date < ?", *filter.DueBefore)
+	}
+	if filter.Overdue != nil {
+		query = query.Where("tasks.due_date < ? AND tasks.completed_at IS NULL", *filter.Overdue)
+	}
+	if !filter.IncludeCompleted {
+		query = query.Where("tasks.completed_at IS NULL")
+	}
+
+	err := query.Order("tasks.due_date ASC NULLS LAST, \"Column__Board\".title, tasks.number").Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// GetAccessibleByIDs retrieves the given tasks on boards the user owns or is a member of,
+// with column, board, creator, assignee and labels. Other and unknown IDs are skipped.
+func (r *TaskRepository) GetAccessibleByIDs(ctx context.Context, ids []uuid.UUID, userID uuid.UUID) ([]TaskListItem, error) {
+	var tasks []TaskListItem
+	err := dbFromContext(ctx, r.db).
+		Table("tasks").
+		Select("tasks.*", dependencyBlockedSelect).
+		Joins("Column.Board").
+		Joins("Creator").
+		Joins("Assignee").
+		Where("tasks.id IN ?", ids).
+		Where("\"Column__Board\".owner_id = ? OR EXISTS (SELECT 1 FROM board_shares WHERE board_shares.board_id = \"Column__Board\".id AND board_shares.user_id = ?)", userID, userID).
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadItemLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// RenumberForBoard gives the tasks of a column that moved to the board the next numbers on
+// that board, keeping their order, and returns how many tasks were renumbered
+func (r *TaskRepository) RenumberForBoard(ctx context.Context, columnID, boardID uuid.UUID) (int64, error) {
+	var renumbered int64
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// SQLite не поддерживает UPDATE внутри WITH, поэтому счётчик доски сдвигается отдельно
+		var counter int
+		err := tx.Raw(
+			"UPDATE boards SET task_counter = task_counter + (SELECT COUNT(*) FROM tasks WHERE column_id = ?) "+
+				"WHERE id = ? RETURNING task_counter",
+			columnID, boardID,
+		).Scan(&counter).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Exec(
+			"WITH ranked AS ("+
+				"SELECT id, ROW_NUMBER() OVER (ORDER BY number, id) AS rn, COUNT(*) OVER () AS total FROM tasks WHERE column_id = ?"+
+				") UPDATE tasks SET number = ? - ranked.total + ranked.rn "+
+				"FROM ranked WHERE tasks.id = ranked.id",
+			columnID, counter,
+		)
+		renumbered = result.RowsAffected
+		return result.Error
+	})
+	return renumbered, err
+}
+
+// SettleOnBoard adjusts a task moved into a column of another board: it gets the next number of
+// the board and the board's swimlane with the title of its swimlane, or the default lane. It also
+// leaves its sprint and loses its assignee when they neither own the board nor are members of it.
+func (r *TaskRepository) SettleOnBoard(ctx context.Context, taskID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var counter int
+		err := tx.Raw("UPDATE boards SET task_counter = task_counter + 1 WHERE id = ? RETURNING task_counter", boardID).
+			Scan(&counter).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(
+			"UPDATE tasks SET number = ?, sprint_id = NULL, swimlane_id = ("+
+				"SELECT target.id FROM swimlanes target JOIN swimlanes source ON source.title = target.title "+
+				"WHERE source.id = tasks.swimlane_id AND target.board_id = ? ORDER BY target.position LIMIT 1"+
+				"), assigned_to = CASE WHEN assigned_to IN (SELECT owner_id FROM boards WHERE id = ?) "+
+				"OR assigned_to IN (SELECT user_id FROM board_shares WHERE board_id = ?) THEN assigned_to END "+
+				"WHERE id = ?",
+			counter, boardID, boardID, boardID, taskID,
+		).Error
+	})
+}
+
+// UnassignNonMembers clears the assignee of tasks in the column who neither owns the board
+// nor is a member of it
+func (r *TaskRepository) UnassignNonMembers(ctx context.Context, columnID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("column_id = ? AND assigned_to IS NOT NULL", columnID).
+		Where("assigned_to NOT IN (SELECT owner_id FROM boards WHERE id = ?)", boardID).
+		Where("assigned_to NOT IN (SELECT user_id FROM board_shares WHERE board_id = ?)", boardID).
+		Update("assigned_to", nil).Error
+}
+
+// RemapSwimlanes moves the tasks of a column that moved to the board into the board's
+// swimlanes with the same titles; tasks whose swimlane has no match go to the default lane
+func (r *TaskRepository) RemapSwimlanes(ctx context.Context, columnID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Exec(
+		"UPDATE tasks SET swimlane_id = ("+
+			"SELECT target.id FROM swimlanes target JOIN swimlanes source ON source.title = target.title "+
+			"WHERE source.id = tasks.swimlane_id AND target.board_id = ? ORDER BY target.position LIMIT 1"+
+			") WHERE column_id = ? AND swimlane_id IS NOT NULL",
+		boardID, columnID,
+	).Error
+}
+
+// ClearSprints removes the tasks of a column that moved to another board from their sprints,
+// which belong to the old board
+func (r *TaskRepository) ClearSprints(ctx context.Context, columnID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("column_id = ? AND sprint_id IS NOT NULL", columnID).
+		Update("sprint_id", nil).Error
+}
+
+// SetSprint plans the tasks into the sprint, skipping tasks of other boards, and returns
+// the number of tasks planned
+func (r *TaskRepository) SetSprint(ctx context.Context, taskIDs []uuid.UUID, sprint *model.Sprint) (int64, error) {
+	result := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("id IN ? AND column_id IN (SELECT id FROM columns WHERE board_id = ?)", taskIDs, sprint.BoardID).
+		Update("sprint_id", sprint.ID)
+	return result.RowsAffected, result.Error
+}
+
+// RemoveFromSprint takes a task out of the sprint; it returns ErrTaskNotFound if the task is not in it
+func (r *TaskRepository) RemoveFromSprint(ctx context.Context, taskID, sprintID uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("id = ? AND sprint_id = ?", taskID, sprintID).
+		Update("sprint_id", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+// GetBySprintID retrieves the estimates and completion times of the sprint's tasks
+func (r *TaskRepository) GetBySprintID(ctx context.Context, sprintID uuid.UUID) ([]model.Task, error) {
+	var tasks []model.Task
+	err := dbFromContext(ctx, r.db).
+		Select("id", "estimate", "completed_at").
+		Where("sprint_id = ?", sprintID).
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// SwimlaneTaskCount is the number of tasks of a column in one swimlane; SwimlaneID is nil
+// for the default lane
+type SwimlaneTaskCount struct {
+	ColumnID   uuid.UUID
+	SwimlaneID *uuid.UUID
+	Count      int
+}
+
+// CountBySwimlane counts the tasks of a board per column and swimlane. Empty cells are omitted.
+func (r *TaskRepository) CountBySwimlane(ctx context.Context, boardID uuid.UUID) ([]SwimlaneTaskCount, error) {
+	var counts []SwimlaneTaskCount
+	err := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Select("tasks.column_id, tasks.swimlane_id, COUNT(*) AS count").
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Where("columns.board_id = ?", boardID).
+		Group("tasks.column_id, tasks.swimlane_id").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// SetSwimlane moves a task to a swimlane, or to the default lane when swimlaneID is nil
+func (r *TaskRepository) SetSwimlane(ctx context.Context, taskID uuid.UUID, swimlaneID *uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("id = ?", taskID).
+		Update("swimlane_id", swimlaneID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+// Weights of the assignee suggestion score: a shared label says more about a member's area
+// than working in the same column
+const (
+	suggestionLabelWeight  = 3
+	suggestionColumnWeight = 1
+)
+
+// AssigneeSuggestion is a board member ranked for a task by their recent work on the board
+type AssigneeSuggestion struct {
+	UserID       uuid.UUID
+	Name         string
+	Email        string
+	Score        int
+	SharedLabels int
+	SameColumn   int
+	OpenTasks    int
+}
+
+// ColumnTaskSummary sums up the tasks of a column: how many there are, how many of them are
+// overdue and their total estimate
+type ColumnTaskSummary struct {
+	ColumnID uuid.UUID
+	Tasks    int
+	Overdue  int
+	Estimate int
+}
+
+// SummarizeColumns sums up the tasks of a board per column in one query, counting open tasks
+// due before now as overdue. Columns without tasks are omitted.
+func (r *TaskRepository) SummarizeColumns(ctx context.Context, boardID uuid.UUID, now time.Time) ([]ColumnTaskSummary, error) {
+	var summaries []ColumnTaskSummary
+	err := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Select(`tasks.column_id, COUNT(*) AS tasks,
+			COUNT(*) FILTER (WHERE tasks.completed_at IS NULL AND tasks.due_date < ?) AS overdue,
+			COALESCE(SUM(tasks.estimate), 0) AS estimate`, now).
+		Joins("JOIN columns ON columns.id = tasks.column_id").
+		Where("columns.board_id = ?", boardID).
+		Group("tasks.column_id").
+		Scan(&summaries).Error
+	return summaries, err
+}
+
+// SetDescription replaces the description of a task, leaving its other fields as they are
+func (r *TaskRepository) SetDescription(ctx context.Context, taskID uuid.UUID, description string) error {
+	result := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("id = ?", taskID).
+		Update("description", description)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskNotFound
+	}
+	return nil
+}
+
+// SuggestAssignees ranks the members of the board for the task by how often they were assigned
+// tasks with the same labels or in the same column. Only open tasks and tasks completed since the
+// given time count. Ties go to the member with fewer open tasks. The current assignee is skipped.
+func (r *TaskRepository) SuggestAssignees(ctx context.Context, task *model.Task, boardID uuid.UUID, since time.Time, limit int) ([]AssigneeSuggestion, error) {
+	var suggestions []AssigneeSuggestion
+	err := dbFromContext(ctx, r.db).Raw(
+		"WITH members AS ("+
+			"SELECT owner_id AS user_id FROM boards WHERE id = @board "+
+			"UNION SELECT user_id FROM board_shares WHERE board_id = @board"+
+			"), history AS ("+
+			"SELECT tasks.id, tasks.assigned_to, tasks.column_id, tasks.completed_at FROM tasks "+
+			"JOIN columns ON columns.id = tasks.column_id "+
+			"WHERE columns.board_id = @board AND tasks.id <> @task AND tasks.assigned_to IS NOT NULL "+
+			"AND (tasks.completed_at IS NULL OR tasks.completed_at >= @since)"+
+			"), counts AS ("+
+			"SELECT members.user_id, users.name, users.email, "+
+			"(SELECT COUNT(*) FROM history JOIN task_labels history_labels ON history_labels.task_id = history.id "+
+			"JOIN task_labels ON task_labels.label_id = history_labels.label_id AND task_labels.task_id = @task "+
+			"WHERE history.assigned_to = members.user_id) AS shared_labels, "+
+			"(SELECT COUNT(*) FROM history WHERE history.assigned_to = members.user_id AND history.column_id = @column) AS same_column, "+
+			"(SELECT COUNT(*) FROM history WHERE history.assigned_to = members.user_id AND history.completed_at IS NULL) AS open_tasks "+
+			"FROM members JOIN users ON users.id = members.user_id "+
+			"WHERE members.user_id IS DISTINCT FROM @assignee"+
+			") SELECT *, shared_labels * @labelWeight + same_column * @columnWeight AS score FROM counts "+
+			"ORDER BY score DESC, open_tasks, name LIMIT @limit",
+		map[string]interface{}{
+			"board":        boardID,
+			"task":         task.ID,
+			"column":       task.ColumnID,
+			"assignee":     task.AssignedTo,
+			"since":        since,
+			"labelWeight":  suggestionLabelWeight,
+			"columnWeight": suggestionColumnWeight,
+			"limit":        limit,
+		},
+	).Scan(&suggestions).Error
+	return suggestions, err
+}
+
+// ReassignCreator hands the tasks created by a user over to the owners of their boards
+func (r *TaskRepository) ReassignCreator(ctx context.Context, userID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Exec(
+		"UPDATE tasks SET created_by = boards.owner_id FROM columns, boards "+
+			"WHERE tasks.column_id = columns.id AND columns.board_id = boards.id AND tasks.created_by = ?",
+		userID,
+	).Error
+}
+
+// UnassignEverywhere removes a user from all tasks assigned to them
+func (r *TaskRepository) UnassignEverywhere(ctx context.Context, userID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("assigned_to = ?", userID).
+		Update("assigned_to", nil).Error
+}
+
+// UnassignOnBoard unassigns the user from all tasks of the board
+func (r *TaskRepository) UnassignOnBoard(ctx context.Context, boardID, userID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Where("assigned_to = ?", userID).
+		Where("column_id IN (SELECT id FROM columns WHERE board_id = ?)", boardID).
+		Update("assigned_to", nil).Error
 }
 
 // GetByID retrieves a task by its ID
 func (r *TaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Task, error) {
 	var task model.Task
-	result := r.db.WithContext(ctx).First(&task, "id = ?", id)
+	result := dbFromContext(ctx, r.db).First(&task, "id = ?", id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, ErrTaskNotFound
@@ -40,34 +634,212 @@ func (r *TaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.Task
 	return &task, nil
 }
 
+// GetWithBoard retrieves a task with its column and board
+func (r *TaskRepository) GetWithBoard(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	var task model.Task
+	result := dbFromContext(ctx, r.db).Joins("Column.Board").First(&task, "tasks.id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, result.Error
+	}
+	return &task, nil
+}
+
+// GetWithDetails retrieves a task with its creator, assignee and labels in two queries
+func (r *TaskRepository) GetWithDetails(ctx context.Context, id uuid.UUID) (*model.Task, error) {
+	var task model.Task
+	result := dbFromContext(ctx, r.db).
+		Joins("Creator").
+		Joins("Assignee").
+		First(&task, "tasks.id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, result.Error
+	}
+
+	tasks := []model.Task{task}
+	if err := r.loadLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return &tasks[0], nil
+}
+
 // GetByColumnID retrieves all tasks in a specific column
 func (r *TaskRepository) GetByColumnID(ctx context.Context, columnID uuid.UUID) ([]model.Task, error) {
 	var tasks []model.Task
-	result := r.db.WithContext(ctx).Where("column_id = ?", columnID).Order("position").Find(&tasks)
+	result := dbFromContext(ctx, r.db).Where("column_id = ?", columnID).Order("position").Find(&tasks)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return tasks, nil
 }
 
-// GetTasksWithLabels retrieves tasks with their associated labels
-func (r *TaskRepository) GetTasksWithLabels(ctx context.Context, columnID uuid.UUID) ([]model.Task, error) {
+// CountByColumn counts the tasks in a column
+func (r *TaskRepository) CountByColumn(ctx context.Context, columnID uuid.UUID) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.Task{}).Where("column_id = ?", columnID).Count(&count).Error
+	return count, err
+}
+
+// GetByColumnIDs retrieves the tasks of the given columns with their labels, in board order
+func (r *TaskRepository) GetByColumnIDs(ctx context.Context, columnIDs []uuid.UUID) ([]model.Task, error) {
 	var tasks []model.Task
-	result := r.db.WithContext(ctx).
-		Preload("Labels").
-		Where("column_id = ?", columnID).
-		Order("position").
-		Find(&tasks)
-	
-	if result.Error != nil {
-		return nil, result.Error
+	if len(columnIDs) == 0 {
+		return tasks, nil
+	}
+
+	err := dbFromContext(ctx, r.db).
+		Joins("Column").
+		Where("tasks.column_id IN ?", columnIDs).
+		Order("\"Column\".position, tasks.position").
+		Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadLabels(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// TaskListOptions filters and orders task listings. Empty Priorities means any priority;
+// SortBy is "position" (default) or "priority", most important first. A nil SwimlaneID
+// means any swimlane, uuid.Nil the tasks without one.
+type TaskListOptions struct {
+	Priorities []string
+	SortBy     string
+	SwimlaneID *uuid.UUID
+	// ViewerID, when set, keeps only tasks on a board the user owns or is a member of
+	ViewerID *uuid.UUID
+}
+
+// apply adds the filter and ordering to a tasks query
+func (o TaskListOptions) apply(db *gorm.DB) *gorm.DB {
+	if len(o.Priorities) > 0 {
+		db = db.Where("tasks.priority IN ?", o.Priorities)
+	}
+	if o.SwimlaneID != nil {
+		if *o.SwimlaneID == uuid.Nil {
+			db = db.Where("tasks.swimlane_id IS NULL")
+		} else {
+			db = db.Where("tasks.swimlane_id = ?", *o.SwimlaneID)
+		}
+	}
+	if o.SortBy == "priority" {
+		db = db.Order("CASE tasks.priority WHEN 'urgent' THEN 0 WHEN 'high' THEN 1 WHEN 'medium' THEN 2 ELSE 3 END")
+	}
+	return db.Order("tasks.position")
+}
+
+// TaskListItem is a listed task with whether a task blocking it is still open
+type TaskListItem struct {
+	model.Task
+	DependencyBlocked bool
+}
+
+// dependencyBlockedSelect selects whether an open task blocks the listed task, so listings
+// do not look up blocking tasks separately
+const dependencyBlockedSelect = "EXISTS (SELECT 1 FROM task_relations JOIN tasks AS blockers ON blockers.id = task_relations.task_id " +
+	"WHERE task_relations.type = '" + model.RelationBlocks + "' AND task_relations.related_task_id = tasks.id " +
+	"AND blockers.completed_at IS NULL) AS dependency_blocked"
+
+// GetTasksWithLabels retrieves the tasks of a column ordered by position, together with
+// their column, board, creator, assignee, labels and whether they wait for a blocking task.
+// It takes two queries however many tasks the column holds; the viewer's access is checked
+// in the first of them.
+func (r *TaskRepository) GetTasksWithLabels(ctx context.Context, columnID uuid.UUID, opts TaskListOptions) ([]TaskListItem, error) {
+	var tasks []TaskListItem
+	query := dbFromContext(ctx, r.db).
+		Table("tasks").
+		Select("tasks.*", dependencyBlockedSelect).
+		Joins("Column.Board").
+		Joins("Creator").
+		Joins("Assignee").
+		Where("tasks.column_id = ?", columnID)
+	if opts.ViewerID != nil {
+		query = query.Where("\"Column__Board\".owner_id = ? OR EXISTS (SELECT 1 FROM board_shares "+
+			"WHERE board_shares.board_id = \"Column__Board\".id AND board_shares.user_id = ?)", *opts.ViewerID, *opts.ViewerID)
+	}
+	err := opts.apply(query).Find(&tasks).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadItemLabels(ctx, tasks); err != nil {
+		return nil, err
 	}
 	return tasks, nil
 }
 
+// loadLabels fills the labels of the given tasks with a single query
+func (r *TaskRepository) loadLabels(ctx context.Context, tasks []model.Task) error {
+	byID := make(map[uuid.UUID]*model.Task, len(tasks))
+	for i := range tasks {
+		byID[tasks[i].ID] = &tasks[i]
+	}
+	return r.fillLabels(ctx, byID)
+}
+
+// loadItemLabels fills the labels of listed tasks like loadLabels
+func (r *TaskRepository) loadItemLabels(ctx context.Context, items []TaskListItem) error {
+	byID := make(map[uuid.UUID]*model.Task, len(items))
+	for i := range items {
+		byID[items[i].ID] = &items[i].Task
+	}
+	return r.fillLabels(ctx, byID)
+}
+
+// fillLabels fills the labels of the tasks keyed by their IDs
+func (r *TaskRepository) fillLabels(ctx context.Context, byID map[uuid.UUID]*model.Task) error {
+	if len(byID) == 0 {
+		return nil
+	}
+
+	taskIDs := make([]uuid.UUID, 0, len(byID))
+	for id := range byID {
+		taskIDs = append(taskIDs, id)
+	}
+
+	var rows []struct {
+		TaskID  uuid.UUID
+		LabelID uuid.UUID
+		BoardID *uuid.UUID
+		OwnerID *uuid.UUID
+		Name    string
+		Color   string
+	}
+	err := dbFromContext(ctx, r.db).
+		Table("task_labels").
+		Select("task_labels.task_id, labels.id AS label_id, labels.board_id, labels.owner_id, labels.name, labels.color").
+		Joins("JOIN labels ON labels.id = task_labels.label_id").
+		Where("task_labels.task_id IN ?", taskIDs).
+		Order("labels.name").
+		Scan(&rows).Error
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		task := byID[row.TaskID]
+		task.Labels = append(task.Labels, model.Label{
+			ID:      row.LabelID,
+			BoardID: row.BoardID,
+			OwnerID: row.OwnerID,
+			Name:    row.Name,
+			Color:   row.Color,
+		})
+	}
+	return nil
+}
+
 // Update updates an existing task
 func (r *TaskRepository) Update(ctx context.Context, task *model.Task) error {
-	result := r.db.WithContext(ctx).Save(task)
+	result := dbFromContext(ctx, r.db).Save(task)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -79,7 +851,7 @@ func (r *TaskRepository) Update(ctx context.Context, task *model.Task) error {
 
 // Delete removes a task by its ID
 func (r *TaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&model.Task{}, "id = ?", id)
+	result := dbFromContext(ctx, r.db).Delete(&model.Task{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -92,7 +864,7 @@ func (r *TaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
 // MoveTask updates the position and/or column of a task
 func (r *TaskRepository) MoveTask(ctx context.Context, taskID uuid.UUID, columnID uuid.UUID, newPosition int) error {
 	// Start a transaction
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		// Get the task
 		var task model.Task
 		if err := tx.First(&task, "id = ?", taskID).Error; err != nil {
@@ -124,6 +896,22 @@ func (r *TaskRepository) MoveTask(ctx context.Context, taskID uuid.UUID, columnI
 			// Update the task's column and position
 			task.ColumnID = columnID
 			task.Position = newPosition
+
+			now := time.Now()
+			if err := enterColumn(tx, task.ID, columnID, now); err != nil {
+				return err
+			}
+
+			// Moving into a done column completes the task, moving out of it reopens the task
+			var target model.Column
+			if err := tx.Select("is_done").First(&target, "id = ?", columnID).Error; err != nil {
+				return err
+			}
+			if !target.IsDone {
+				task.CompletedAt = nil
+			} else if task.CompletedAt == nil {
+				task.CompletedAt = &now
+			}
 		} else if oldPosition != newPosition {
 			// Moving within the same column
 			if oldPosition < newPosition {
@@ -147,13 +935,72 @@ func (r *TaskRepository) MoveTask(ctx context.Context, taskID uuid.UUID, columnI
 		}
 
 		// Save the updated task
-		return tx.Save(&task).Error
+		if err := tx.Save(&task).Error; err != nil {
+			return err
+		}
+
+		// Close any gaps left in the affected columns
+		_, err := compactTaskPositions(tx, []uuid.UUID{oldColumnID, columnID})
+		return err
 	})
 }
 
+// enterColumn closes the task's open column entry and opens one for the column it is now in
+func enterColumn(tx *gorm.DB, taskID, columnID uuid.UUID, at time.Time) error {
+	if err := tx.Model(&model.TaskColumnEntry{}).
+		Where("task_id = ? AND exited_at IS NULL", taskID).
+		Update("exited_at", at).Error; err != nil {
+		return err
+	}
+	return tx.Create(&model.TaskColumnEntry{TaskID: taskID, ColumnID: columnID, EnteredAt: at}).Error
+}
+
+// GetPositions returns the ID, column and position of every task in the given columns,
+// ordered by column and position
+func (r *TaskRepository) GetPositions(ctx context.Context, columnIDs []uuid.UUID) ([]model.Task, error) {
+	var tasks []model.Task
+	err := dbFromContext(ctx, r.db).
+		Select("id", "column_id", "position").
+		Where("column_id IN ?", columnIDs).
+		Order("column_id, position").
+		Find(&tasks).Error
+	return tasks, err
+}
+
+// CompactFragmentedColumns renumbers task positions to 0..n-1 in up to limit columns whose
+// tasks have gaps or duplicate positions. It returns the number of columns and tasks touched.
+func (r *TaskRepository) CompactFragmentedColumns(ctx context.Context, limit int) (int, int64, error) {
+	var columnIDs []uuid.UUID
+	err := dbFromContext(ctx, r.db).Model(&model.Task{}).
+		Select("column_id").
+		Group("column_id").
+		Having("MIN(position) <> 0 OR MAX(position) <> COUNT(*) - 1 OR COUNT(DISTINCT position) <> COUNT(*)").
+		Limit(limit).
+		Pluck("column_id", &columnIDs).Error
+	if err != nil || len(columnIDs) == 0 {
+		return 0, 0, err
+	}
+
+	updated, err := compactTaskPositions(dbFromContext(ctx, r.db), columnIDs)
+	return len(columnIDs), updated, err
+}
+
+// compactTaskPositions renumbers the tasks of the given columns to 0..n-1 keeping their order
+// and returns how many tasks changed position
+func compactTaskPositions(db *gorm.DB, columnIDs []uuid.UUID) (int64, error) {
+	result := db.Exec(
+		"UPDATE tasks SET position = ranked.rn - 1 FROM ("+
+			"SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id ORDER BY position, id) AS rn "+
+			"FROM tasks WHERE column_id IN ?"+
+			") AS ranked WHERE tasks.id = ranked.id AND tasks.position <> ranked.rn - 1",
+		columnIDs,
+	)
+	return result.RowsAffected, result.Error
+}
+
 // AddLabel adds a label to a task
 func (r *TaskRepository) AddLabel(ctx context.Context, taskID, labelID uuid.UUID) error {
-	return r.db.WithContext(ctx).Exec(
+	return dbFromContext(ctx, r.db).Exec(
 		"INSERT INTO task_labels (task_id, label_id) VALUES (?, ?) ON CONFLICT DO NOTHING",
 		taskID, labelID,
 	).Error
@@ -161,7 +1008,7 @@ func (r *TaskRepository) AddLabel(ctx context.Context, taskID, labelID uuid.UUID
 
 // RemoveLabel removes a label from a task
 func (r *TaskRepository) RemoveLabel(ctx context.Context, taskID, labelID uuid.UUID) error {
-	return r.db.WithContext(ctx).Exec(
+	return dbFromContext(ctx, r.db).Exec(
 		"DELETE FROM task_labels WHERE task_id = ? AND label_id = ?",
 		taskID, labelID,
 	).Error
@@ -169,7 +1016,7 @@ func (r *TaskRepository) RemoveLabel(ctx context.Context, taskID, labelID uuid.U
 
 // AssignUser assigns a user to a task
 func (r *TaskRepository) AssignUser(ctx context.Context, taskID, userID uuid.UUID) error {
-	result := r.db.WithContext(ctx).Model(&model.Task{}).
+	result := dbFromContext(ctx, r.db).Model(&model.Task{}).
 		Where("id = ?", taskID).
 		Update("assigned_to", userID)
 	
@@ -184,7 +1031,7 @@ func (r *TaskRepository) AssignUser(ctx context.Context, taskID, userID uuid.UUI
 
 // UnassignUser removes user assignment from a task
 func (r *TaskRepository) UnassignUser(ctx context.Context, taskID uuid.UUID) error {
-	result := r.db.WithContext(ctx).Model(&model.Task{}).
+	result := dbFromContext(ctx, r.db).Model(&model.Task{}).
 		Where("id = ?", taskID).
 		Update("assigned_to", nil)
 	
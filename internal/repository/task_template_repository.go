@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type TaskTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskTemplateRepository(db *gorm.DB) *TaskTemplateRepository {
+	return &TaskTemplateRepository{db: db}
+}
+
+// Create adds a task template to its board
+func (r *TaskTemplateRepository) Create(ctx context.Context, template *model.TaskTemplate) error {
+	return dbFromContext(ctx, r.db).Create(template).Error
+}
+
+// GetByID retrieves a task template by its ID
+func (r *TaskTemplateRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.TaskTemplate, error) {
+	var template model.TaskTemplate
+	if err := dbFromContext(ctx, r.db).First(&template, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTaskTemplateNotFound
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+// GetByBoardID retrieves the task templates of a board ordered by name
+func (r *TaskTemplateRepository) GetByBoardID(ctx context.Context, boardID uuid.UUID) ([]model.TaskTemplate, error) {
+	var templates []model.TaskTemplate
+	err := dbFromContext(ctx, r.db).
+		Where("board_id = ?", boardID).
+		Order("LOWER(name), created_at").
+		Find(&templates).Error
+	return templates, err
+}
+
+// CountByBoard counts the task templates of a board
+func (r *TaskTemplateRepository) CountByBoard(ctx context.Context, boardID uuid.UUID) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.TaskTemplate{}).Where("board_id = ?", boardID).Count(&count).Error
+	return count, err
+}
+
+// Update saves the editable fields of a task template
+func (r *TaskTemplateRepository) Update(ctx context.Context, template *model.TaskTemplate) error {
+	result := dbFromContext(ctx, r.db).Model(template).
+		Select("Name", "TitlePattern", "Description", "Priority", "LabelIDs", "Checklist", "UpdatedAt").
+		Updates(template)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskTemplateNotFound
+	}
+	return nil
+}
+
+// Delete removes a task template; tasks created from it are kept
+func (r *TaskTemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Delete(&model.TaskTemplate{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrTaskTemplateNotFound
+	}
+	return nil
+}
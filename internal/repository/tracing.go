@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const tracingSpanKey = "tracing:span"
+
+// RegisterTracing makes db record a span for every statement, as a child of the span carried by
+// the statement's context. The SQL text is recorded without the bound values, which may hold user
+// data.
+func RegisterTracing(db *gorm.DB, tracer trace.Tracer) error {
+	start := func(operation string) func(tx *gorm.DB) {
+		return func(tx *gorm.DB) {
+			ctx, span := tracer.Start(tx.Statement.Context, "db."+operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(semconv.DBSystemNamePostgreSQL, semconv.DBOperationName(operation)),
+			)
+			tx.Statement.Context = ctx
+			tx.InstanceSet(tracingSpanKey, span)
+		}
+	}
+	end := func(tx *gorm.DB) {
+		value, ok := tx.InstanceGet(tracingSpanKey)
+		if !ok {
+			return
+		}
+		span := value.(trace.Span)
+		defer span.End()
+
+		if tx.Statement.Table != "" {
+			span.SetAttributes(semconv.DBCollectionName(tx.Statement.Table))
+		}
+		span.SetAttributes(
+			semconv.DBQueryText(tx.Statement.SQL.String()),
+			attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+		)
+		if tx.Error != nil && !errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			span.RecordError(tx.Error)
+			span.SetStatus(codes.Error, tx.Error.Error())
+		}
+	}
+
+	type registrar interface {
+		Register(name string, fn func(*gorm.DB)) error
+	}
+	callbacks := db.Callback()
+	for _, hook := range []struct {
+		operation     string
+		before, after registrar
+	}{
+		{"create", callbacks.Create().Before("*"), callbacks.Create().After("*")},
+		{"query", callbacks.Query().Before("*"), callbacks.Query().After("*")},
+		{"update", callbacks.Update().Before("*"), callbacks.Update().After("*")},
+		{"delete", callbacks.Delete().Before("*"), callbacks.Delete().After("*")},
+		{"row", callbacks.Row().Before("*"), callbacks.Row().After("*")},
+		{"raw", callbacks.Raw().Before("*"), callbacks.Raw().After("*")},
+	} {
+		if err := hook.before.Register("tracing:start", start(hook.operation)); err != nil {
+			return err
+		}
+		if err := hook.after.Register("tracing:end", end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
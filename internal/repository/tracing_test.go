@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"kanban/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestRegisterTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: &recordingPool{}}), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, RegisterTracing(db, tracer))
+
+	ctx, parent := tracer.Start(context.Background(), "GET /boards")
+	var boards []model.Board
+	db.WithContext(ctx).Where("owner_id = ?", "secret").Find(&boards)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	query := spans[0]
+	assert.Equal(t, "db.query", query.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), query.Parent().SpanID())
+	assert.Contains(t, query.Attributes(), attribute.String("db.collection.name", "boards"))
+	assert.Contains(t, query.Attributes(), attribute.String("db.query.text", `SELECT * FROM "boards" WHERE owner_id = $1`))
+	// Ошибка запроса отмечается в span
+	assert.Equal(t, codes.Error, query.Status().Code)
+}
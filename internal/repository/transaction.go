@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+// TxManager composes calls to several repositories into one unit of work.
+// Repositories pick up the transaction from the context passed to them, so
+// none of them has to know whether it runs inside a transaction or not.
+type TxManager struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// WithinTransaction runs fn in a database transaction that is committed when fn returns nil
+// and rolled back otherwise. Repository calls made with the context handed to fn join the
+// transaction. Nested calls reuse the outer transaction.
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// WithinSnapshot runs fn in a read-only REPEATABLE READ transaction, so every read made with the
+// context handed to fn sees the same snapshot even while other requests keep writing.
+// Nested calls reuse the outer transaction.
+func (m *TxManager) WithinSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+}
+
+// dbFromContext returns the transaction stored in ctx by TxManager, or db when there is none
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+func TestTxManager_WithinTransaction(t *testing.T) {
+	db := testutil.OpenDB(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	txManager := repository.NewTxManager(db)
+	boardRepo := repository.NewBoardRepository(db)
+	columnRepo := repository.NewColumnRepository(db)
+	ctx := context.Background()
+	errAbort := errors.New("abort")
+
+	// createBoard создаёт доску с колонкой через два репозитория, вложенной транзакцией для колонки
+	createBoard := func(title string, fail bool) (*model.Board, *model.Column, error) {
+		board := &model.Board{Title: title, OwnerID: owner.ID}
+		column := &model.Column{Title: "To Do"}
+		err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := boardRepo.Create(ctx, board); err != nil {
+				return err
+			}
+			column.BoardID = board.ID
+			if err := txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+				return columnRepo.Create(ctx, column)
+			}); err != nil {
+				return err
+			}
+			if fail {
+				return errAbort
+			}
+			return nil
+		})
+		return board, column, err
+	}
+
+	board, column, err := createBoard("Committed", false)
+	require.NoError(t, err)
+	_, err = boardRepo.GetByID(ctx, board.ID)
+	assert.NoError(t, err)
+	stored, err := columnRepo.GetByID(ctx, column.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored)
+
+	// Ошибка откатывает обе записи, включая сделанную во вложенной транзакции
+	board, column, err = createBoard("Rolled back", true)
+	require.ErrorIs(t, err, errAbort)
+	_, err = boardRepo.GetByID(ctx, board.ID)
+	assert.ErrorIs(t, err, repository.ErrBoardNotFound)
+	stored, err = columnRepo.GetByID(ctx, column.ID)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"kanban/internal/model"
+)
+
+type UserBoardPrefsRepository struct {
+	db *gorm.DB
+}
+
+func NewUserBoardPrefsRepository(db *gorm.DB) *UserBoardPrefsRepository {
+	return &UserBoardPrefsRepository{db: db}
+}
+
+// Get returns the user's preferences of a board, or nil if the user has none
+func (r *UserBoardPrefsRepository) Get(ctx context.Context, userID, boardID uuid.UUID) (*model.UserBoardPrefs, error) {
+	var prefs model.UserBoardPrefs
+	err := dbFromContext(ctx, r.db).Where("user_id = ? AND board_id = ?", userID, boardID).First(&prefs).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &prefs, nil
+}
+
+// SetColumnCollapsed collapses or expands a column of the board for the user
+func (r *UserBoardPrefsRepository) SetColumnCollapsed(ctx context.Context, userID, boardID, columnID uuid.UUID, collapsed bool) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Строка создаётся заранее, чтобы одновременные изменения ждали её блокировку
+		prefs := model.UserBoardPrefs{UserID: userID, BoardID: boardID, CollapsedColumns: []uuid.UUID{}}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&prefs).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND board_id = ?", userID, boardID).
+			First(&prefs).Error; err != nil {
+			return err
+		}
+
+		prefs.CollapsedColumns = slices.DeleteFunc(prefs.CollapsedColumns, func(id uuid.UUID) bool {
+			return id == columnID
+		})
+		if collapsed {
+			prefs.CollapsedColumns = append(prefs.CollapsedColumns, columnID)
+		}
+		return tx.Save(&prefs).Error
+	})
+}
+
+// SetStarred stars or unstars the board for the user
+func (r *UserBoardPrefsRepository) SetStarred(ctx context.Context, userID, boardID uuid.UUID, starred bool) error {
+	prefs := model.UserBoardPrefs{UserID: userID, BoardID: boardID, CollapsedColumns: []uuid.UUID{}, Starred: starred}
+	return dbFromContext(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "board_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"starred", "updated_at"}),
+	}).Create(&prefs).Error
+}
+
+// SetBoardOrder replaces the order of the user's boards list: the boards come first in the given
+// order, the boards left out after them
+func (r *UserBoardPrefsRepository) SetBoardOrder(ctx context.Context, userID uuid.UUID, boardIDs []uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.UserBoardPrefs{}).
+			Where("user_id = ? AND sort_order IS NOT NULL", userID).
+			Update("sort_order", nil).Error; err != nil {
+			return err
+		}
+		if len(boardIDs) == 0 {
+			return nil
+		}
+
+		prefs := make([]model.UserBoardPrefs, len(boardIDs))
+		for i, boardID := range boardIDs {
+			order := i + 1
+			prefs[i] = model.UserBoardPrefs{UserID: userID, BoardID: boardID, CollapsedColumns: []uuid.UUID{}, SortOrder: &order}
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "board_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"sort_order", "updated_at"}),
+		}).Create(&prefs).Error
+	})
+}
+
+// BoardOrder returns the positions of the boards in the user's custom order, keyed by board ID
+func (r *UserBoardPrefsRepository) BoardOrder(ctx context.Context, userID uuid.UUID) (map[uuid.UUID]int, error) {
+	var prefs []model.UserBoardPrefs
+	err := dbFromContext(ctx, r.db).
+		Select("board_id", "sort_order").
+		Where("user_id = ? AND sort_order IS NOT NULL", userID).
+		Find(&prefs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	order := make(map[uuid.UUID]int, len(prefs))
+	for _, p := range prefs {
+		order[p.BoardID] = *p.SortOrder
+	}
+	return order, nil
+}
+
+// Delete removes the user's preferences of the board, including its star
+func (r *UserBoardPrefsRepository) Delete(ctx context.Context, userID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).
+		Where("user_id = ? AND board_id = ?", userID, boardID).
+		Delete(&model.UserBoardPrefs{}).Error
+}
+
+// StarredBoardIDs returns the IDs of the boards the user starred
+func (r *UserBoardPrefsRepository) StarredBoardIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := dbFromContext(ctx, r.db).Model(&model.UserBoardPrefs{}).
+		Where("user_id = ? AND starred", userID).
+		Pluck("board_id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+type UserIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityRepository(db *gorm.DB) *UserIdentityRepository {
+	return &UserIdentityRepository{db: db}
+}
+
+// FindUser returns the user linked to the provider account, or nil if it is not linked yet
+func (r *UserIdentityRepository) FindUser(ctx context.Context, provider, providerID string) (*model.User, error) {
+	var identity model.UserIdentity
+	err := dbFromContext(ctx, r.db).
+		Preload("User").
+		Where("provider = ? AND provider_id = ?", provider, providerID).
+		First(&identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &identity.User, nil
+}
+
+// Link connects a provider account to a user, creating the user first when it has no ID yet
+func (r *UserIdentityRepository) Link(ctx context.Context, user *model.User, provider, providerID string) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if user.ID == uuid.Nil {
+			if err := tx.Create(user).Error; err != nil {
+				return err
+			}
+		}
+
+		identity := model.UserIdentity{
+			UserID:     user.ID,
+			Provider:   provider,
+			ProviderID: providerID,
+		}
+		return tx.Create(&identity).Error
+	})
+}
+
+// GetByUserID returns the provider accounts linked to a user
+func (r *UserIdentityRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]model.UserIdentity, error) {
+	var identities []model.UserIdentity
+	err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Order("created_at").Find(&identities).Error
+	return identities, err
+}
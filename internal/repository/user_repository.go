@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
+	"kanban/internal/database"
 	"kanban/internal/model"
 
 	"gorm.io/gorm"
@@ -27,12 +30,12 @@ func NewUserRepository(db *gorm.DB) *UserRepository {
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *model.User) error {
-	return r.db.WithContext(ctx).Create(user).Error
+	return dbFromContext(ctx, r.db).Create(user).Error
 }
 
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user model.User
-	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	err := dbFromContext(ctx, r.db).Where("email = ?", email).First(&user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
@@ -41,9 +44,174 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*model.
 
 func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*model.User, error) {
 	var user model.User
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error
+	err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&user).Error
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
 	return &user, err
 }
+
+// GetByCalendarToken retrieves the user whose calendar feeds the token unlocks, or nil if none
+func (r *UserRepository) GetByCalendarToken(ctx context.Context, token string) (*model.User, error) {
+	var user model.User
+	err := dbFromContext(ctx, r.db).Where("calendar_token = ?", token).First(&user).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &user, err
+}
+
+// SetCalendarToken replaces the user's calendar feed token; nil disables the feeds
+func (r *UserRepository) SetCalendarToken(ctx context.Context, id uuid.UUID, token *string) error {
+	return dbFromContext(ctx, r.db).Model(&model.User{}).Where("id = ?", id).Update("calendar_token", token).Error
+}
+
+// SetDefaultBoard stores the board the user opens on start; nil clears it
+func (r *UserRepository) SetDefaultBoard(ctx context.Context, id uuid.UUID, boardID *uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.User{}).Where("id = ?", id).Update("default_board_id", boardID).Error
+}
+
+// SetHideReadReceipts sets whether the user's board views are left out of read receipts
+func (r *UserRepository) SetHideReadReceipts(ctx context.Context, id uuid.UUID, hide bool) error {
+	return dbFromContext(ctx, r.db).Model(&model.User{}).Where("id = ?", id).Update("hide_read_receipts", hide).Error
+}
+
+// SetLastBoard records the board the user opened last. The row is only written when it changes.
+func (r *UserRepository) SetLastBoard(ctx context.Context, id, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.User{}).
+		Where("id = ? AND last_board_id IS DISTINCT FROM ?", id, boardID).
+		Update("last_board_id", boardID).Error
+}
+
+// ForgetBoard clears the default and last opened board of the user where they are the given
+// board, as after the user left it
+func (r *UserRepository) ForgetBoard(ctx context.Context, id, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Model(&model.User{}).
+		Where("id = ? AND (default_board_id = ? OR last_board_id = ?)", id, boardID, boardID).
+		Updates(map[string]interface{}{
+			"default_board_id": gorm.Expr("CASE WHEN default_board_id = ? THEN NULL ELSE default_board_id END", boardID),
+			"last_board_id":    gorm.Expr("CASE WHEN last_board_id = ? THEN NULL ELSE last_board_id END", boardID),
+		}).Error
+}
+
+// MarkSampleBoardCreated records that the onboarding sample board was generated.
+// It reports false if it had already been recorded, so concurrent logins create only one board.
+func (r *UserRepository) MarkSampleBoardCreated(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := dbFromContext(ctx, r.db).Model(&model.User{}).
+		Where("id = ? AND sample_board_created_at IS NULL", id).
+		Update("sample_board_created_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// Lock takes a row lock on the user until the surrounding transaction ends,
+// serializing concurrent changes that check per-user limits
+func (r *UserRepository) Lock(ctx context.Context, id uuid.UUID) error {
+	db := dbFromContext(ctx, r.db)
+	// Транзакции SQLite и так держат блокировку записи всей базы с самого начала
+	if database.IsSQLite(db) {
+		return nil
+	}
+	return db.Exec("SELECT 1 FROM users WHERE id = ? FOR UPDATE", id).Error
+}
+
+// Delete removes a user. Boards, shares, pins, board views, linked identities and workspace
+// labels of the user are removed by the database; tasks the user created or is assigned to
+// must be handed over first.
+func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return dbFromContext(ctx, r.db).Delete(&model.User{}, "id = ?", id).Error
+}
+
+// likeEscaper escapes the wildcards of LIKE patterns
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// UserSummary is a user with the number of boards they own and are a member of
+type UserSummary struct {
+	model.User
+	OwnedBoards  int64
+	SharedBoards int64
+}
+
+// ListSummaries retrieves a page of users ordered by creation, with their board counts, and the
+// number of users in total. A non-empty search matches email addresses and names.
+func (r *UserRepository) ListSummaries(ctx context.Context, search string, limit, offset int) ([]UserSummary, int64, error) {
+	users := func() *gorm.DB {
+		query := dbFromContext(ctx, r.db).Model(&model.User{})
+		if search != "" {
+			pattern := "%" + likeEscaper.Replace(search) + "%"
+			if database.IsSQLite(query) {
+				// LIKE в SQLite не различает регистр латиницы, но по умолчанию не знает экранирования
+				query = query.Where(`users.email LIKE ? ESCAPE '\' OR users.name LIKE ? ESCAPE '\'`, pattern, pattern)
+			} else {
+				query = query.Where("users.email ILIKE ? OR users.name ILIKE ?", pattern, pattern)
+			}
+		}
+		return query
+	}
+
+	var total int64
+	if err := users().Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var summaries []UserSummary
+	err := users().
+		Select("users.*, " +
+			"(SELECT COUNT(*) FROM boards WHERE boards.owner_id = users.id) AS owned_boards, " +
+			"(SELECT COUNT(*) FROM board_shares WHERE board_shares.user_id = users.id) AS shared_boards").
+		Order("users.created_at, users.id").
+		Limit(limit).
+		Offset(offset).
+		Scan(&summaries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return summaries, total, nil
+}
+
+// SetDisabled disables or re-enables a user, reporting false if the user does not exist
+func (r *UserRepository) SetDisabled(ctx context.Context, id uuid.UUID, disabled bool) (bool, error) {
+	var disabledAt interface{}
+	if disabled {
+		disabledAt = gorm.Expr("COALESCE(disabled_at, ?)", time.Now())
+	}
+	result := dbFromContext(ctx, r.db).Model(&model.User{}).Where("id = ?", id).Update("disabled_at", disabledAt)
+	return result.RowsAffected == 1, result.Error
+}
+
+// SetLimits replaces the limit overrides of a user, where nil restores the instance default,
+// reporting false if the user does not exist
+func (r *UserRepository) SetLimits(ctx context.Context, id uuid.UUID, boards, columnsPerBoard, tasksPerColumn *int) (bool, error) {
+	result := dbFromContext(ctx, r.db).Model(&model.User{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"max_boards":            boards,
+		"max_columns_per_board": columnsPerBoard,
+		"max_tasks_per_column":  tasksPerColumn,
+	})
+	return result.RowsAffected == 1, result.Error
+}
+
+// SetAdmin grants or revokes the admin role of the user with the email, reporting false if there is none
+func (r *UserRepository) SetAdmin(ctx context.Context, email string, admin bool) (bool, error) {
+	result := dbFromContext(ctx, r.db).Model(&model.User{}).Where("email = ?", email).Update("is_admin", admin)
+	return result.RowsAffected == 1, result.Error
+}
+
+// IsAdmin reports whether the user is an administrator whose account is not disabled
+func (r *UserRepository) IsAdmin(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.User{}).
+		Where("id = ? AND is_admin AND disabled_at IS NULL", id).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// IsActive reports whether the user exists and is not disabled. Guest users are active while
+// their guest link is.
+func (r *UserRepository) IsActive(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&model.User{}).
+		Where("id = ? AND disabled_at IS NULL", id).
+		Where("NOT is_guest OR EXISTS (SELECT 1 FROM guest_links WHERE guest_links.user_id = users.id "+
+			"AND guest_links.revoked_at IS NULL AND guest_links.expires_at > ?)", time.Now()).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,61 @@
+// Package requestid carries the ID of the HTTP request that started some work through contexts,
+// so the logs of background jobs it queued can be traced back to the request.
+package requestid
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// Header carries a request ID supplied by the client or a proxy, and the ID of every response
+const Header = "X-Request-ID"
+
+// MaxLength limits the length of a supplied request ID
+const MaxLength = 128
+
+type contextKey struct{}
+
+// New generates a request ID
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid reports whether a supplied request ID can be used as is: 1 to MaxLength letters, digits
+// and "-_.:" characters, which keeps IDs safe to log and to echo in headers
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// WithID returns a copy of ctx carrying the request ID
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or "" if there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Logf logs a message with the request ID of ctx appended, if any
+func Logf(ctx context.Context, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if id := FromContext(ctx); id != "" {
+		message += " (request " + id + ")"
+	}
+	log.Print(message)
+}
@@ -0,0 +1,26 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid(New()))
+	assert.True(t, Valid("req_01H:edge.42"))
+
+	assert.False(t, Valid(""))
+	assert.False(t, Valid(strings.Repeat("a", MaxLength+1)))
+	// Пробелы и переводы строк могли бы подделать строки журнала
+	assert.False(t, Valid("abc def"))
+	assert.False(t, Valid("abc\nINFO forged"))
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", FromContext(ctx))
+	assert.Equal(t, "abc", FromContext(WithID(ctx, "abc")))
+}
@@ -0,0 +1,281 @@
+// Package seed fills a database with demo data described by a fixture file: users, and boards
+// with their labels, columns, tasks and members. It is meant for local development and demos.
+package seed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+
+	"kanban/internal/boardtemplate"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+)
+
+// Fixture is the content of a fixture file
+type Fixture struct {
+	Users  []User  `json:"users" yaml:"users"`
+	Boards []Board `json:"boards" yaml:"boards"`
+}
+
+// User is a user with a password to sign in with
+type User struct {
+	Email    string `json:"email" yaml:"email"`
+	Name     string `json:"name" yaml:"name"`
+	Password string `json:"password" yaml:"password"`
+	Admin    bool   `json:"admin" yaml:"admin"`
+}
+
+// Board is a board owned by the user with the email Owner
+type Board struct {
+	Owner       string   `json:"owner" yaml:"owner"`
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Labels      []Label  `json:"labels" yaml:"labels"`
+	Columns     []Column `json:"columns" yaml:"columns"`
+	Shares      []Share  `json:"shares" yaml:"shares"`
+}
+
+type Label struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color" yaml:"color"`
+}
+
+// Column holds its tasks in order; tasks of a done column are created as completed
+type Column struct {
+	Title string `json:"title" yaml:"title"`
+	Done  bool   `json:"done" yaml:"done"`
+	Tasks []Task `json:"tasks" yaml:"tasks"`
+}
+
+// Task refers to labels of its board by name. DueInDays makes the due date relative to the day
+// the fixture is seeded.
+type Task struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description" yaml:"description"`
+	Labels      []string `json:"labels" yaml:"labels"`
+	DueInDays   *int     `json:"due_in_days" yaml:"due_in_days"`
+}
+
+// Share makes the user with the email User a member of the board
+type Share struct {
+	User string `json:"user" yaml:"user"`
+	Role string `json:"role" yaml:"role"`
+}
+
+// Load reads a fixture file, YAML or JSON by its extension
+func Load(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixture Fixture
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&fixture)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&fixture)
+	default:
+		return nil, fmt.Errorf("fixture %s is neither YAML nor JSON", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	if err := fixture.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	return &fixture, nil
+}
+
+// Validate checks that the fixture refers only to users and labels it defines and uses valid roles
+func (f *Fixture) Validate() error {
+	users := make(map[string]bool, len(f.Users))
+	for _, user := range f.Users {
+		if user.Email == "" || user.Name == "" || user.Password == "" {
+			return errors.New("users need an email, a name and a password")
+		}
+		if users[user.Email] {
+			return fmt.Errorf("user %s is defined twice", user.Email)
+		}
+		users[user.Email] = true
+	}
+
+	for _, board := range f.Boards {
+		if board.Title == "" {
+			return errors.New("boards need a title")
+		}
+		if !users[board.Owner] {
+			return fmt.Errorf("board %q: owner %s is not a user of the fixture", board.Title, board.Owner)
+		}
+		labels := make(map[string]bool, len(board.Labels))
+		for _, label := range board.Labels {
+			labels[label.Name] = true
+		}
+		for _, column := range board.Columns {
+			for _, task := range column.Tasks {
+				for _, label := range task.Labels {
+					if !labels[label] {
+						return fmt.Errorf("board %q: task %q has unknown label %q", board.Title, task.Title, label)
+					}
+				}
+			}
+		}
+		for _, share := range board.Shares {
+			if !users[share.User] {
+				return fmt.Errorf("board %q: member %s is not a user of the fixture", board.Title, share.User)
+			}
+			if share.User == board.Owner {
+				return fmt.Errorf("board %q: the owner can't be a member", board.Title)
+			}
+			if model.RoleRank(share.Role) == 0 {
+				return fmt.Errorf("board %q: unknown role %q", board.Title, share.Role)
+			}
+		}
+	}
+	return nil
+}
+
+// template returns the blueprint the board is instantiated from
+func (b Board) template() boardtemplate.Template {
+	tpl := boardtemplate.Template{Title: b.Title, Description: b.Description}
+	for _, label := range b.Labels {
+		tpl.Labels = append(tpl.Labels, boardtemplate.Label{Name: label.Name, Color: label.Color})
+	}
+	for _, column := range b.Columns {
+		tplColumn := boardtemplate.Column{Title: column.Title, Done: column.Done}
+		for _, task := range column.Tasks {
+			tplColumn.Tasks = append(tplColumn.Tasks, boardtemplate.Task{
+				Title:       task.Title,
+				Description: task.Description,
+				Labels:      task.Labels,
+				DueInDays:   task.DueInDays,
+			})
+		}
+		tpl.Columns = append(tpl.Columns, tplColumn)
+	}
+	return tpl
+}
+
+// Result counts what Seeder.Apply created
+type Result struct {
+	Users  int
+	Boards int
+}
+
+// Seeder writes fixtures to the database
+type Seeder struct {
+	userRepo          *repository.UserRepository
+	boardRepo         repository.BoardRepositoryInterface
+	boardShareRepo    repository.BoardShareRepositoryInterface
+	boardTemplateRepo *repository.BoardTemplateRepository
+	txManager         *repository.TxManager
+}
+
+func NewSeeder(
+	userRepo *repository.UserRepository,
+	boardRepo repository.BoardRepositoryInterface,
+	boardShareRepo repository.BoardShareRepositoryInterface,
+	boardTemplateRepo *repository.BoardTemplateRepository,
+	txManager *repository.TxManager,
+) *Seeder {
+	return &Seeder{
+		userRepo:          userRepo,
+		boardRepo:         boardRepo,
+		boardShareRepo:    boardShareRepo,
+		boardTemplateRepo: boardTemplateRepo,
+		txManager:         txManager,
+	}
+}
+
+// Apply creates the fixture in a single transaction. Users that already exist by email and
+// boards their owner already has by title are kept as they are, so seeding again is harmless.
+func (s *Seeder) Apply(ctx context.Context, fixture *Fixture) (Result, error) {
+	var result Result
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		result = Result{}
+		users := make(map[string]*model.User, len(fixture.Users))
+		for _, u := range fixture.Users {
+			user, created, err := s.user(ctx, u)
+			if err != nil {
+				return fmt.Errorf("failed to create user %s: %w", u.Email, err)
+			}
+			if created {
+				result.Users++
+			}
+			users[u.Email] = user
+		}
+
+		for _, b := range fixture.Boards {
+			owner := users[b.Owner]
+			owned, err := s.boardRepo.GetOwned(ctx, owner.ID)
+			if err != nil {
+				return err
+			}
+			if hasTitle(owned, b.Title) {
+				continue
+			}
+
+			board, err := s.boardTemplateRepo.Instantiate(ctx, b.template(), owner.ID)
+			if err != nil {
+				return fmt.Errorf("failed to create board %q: %w", b.Title, err)
+			}
+			for _, share := range b.Shares {
+				if err := s.boardShareRepo.ShareBoard(ctx, board.ID, users[share.User].ID, share.Role); err != nil {
+					return fmt.Errorf("failed to share board %q: %w", b.Title, err)
+				}
+			}
+			result.Boards++
+		}
+		return nil
+	})
+	return result, err
+}
+
+// user returns the user with the email, creating it unless it exists
+func (s *Seeder) user(ctx context.Context, u User) (*model.User, bool, error) {
+	existing, err := s.userRepo.FindByEmail(ctx, u.Email)
+	if err != nil || existing != nil {
+		return existing, false, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, false, err
+	}
+	// Демо-пользователям хватает досок из фикстуры, онбординг не нужен
+	now := time.Now()
+	user := &model.User{
+		Email:                u.Email,
+		Name:                 u.Name,
+		HashedPassword:       string(hashedPassword),
+		IsAdmin:              u.Admin,
+		SampleBoardCreatedAt: &now,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, false, err
+	}
+	return user, true, nil
+}
+
+func hasTitle(boards []model.Board, title string) bool {
+	for _, board := range boards {
+		if board.Title == title {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package seed
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_DemoFixture(t *testing.T) {
+	fixture, err := Load("../../fixtures/demo.yaml")
+	require.NoError(t, err)
+
+	assert.Len(t, fixture.Users, 3)
+	require.NotEmpty(t, fixture.Boards)
+
+	tpl := fixture.Boards[0].template()
+	assert.Equal(t, "Product Launch", tpl.Title)
+	assert.True(t, tpl.Columns[len(tpl.Columns)-1].Done)
+}
+
+func TestLoad_JSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"users": [{"email": "a@example.com", "name": "A", "password": "secret"}],
+		"boards": [{"owner": "a@example.com", "title": "Board", "columns": [{"title": "To Do"}]}]
+	}`), 0o600))
+
+	fixture, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "Board", fixture.Boards[0].Title)
+}
+
+func TestValidate(t *testing.T) {
+	users := []User{
+		{Email: "a@example.com", Name: "A", Password: "secret"},
+		{Email: "b@example.com", Name: "B", Password: "secret"},
+	}
+
+	tests := map[string]Board{
+		"unknown owner": {Owner: "x@example.com", Title: "Board"},
+		"unknown label": {Owner: "a@example.com", Title: "Board", Columns: []Column{
+			{Title: "To Do", Tasks: []Task{{Title: "Task", Labels: []string{"Bug"}}}},
+		}},
+		"unknown member":  {Owner: "a@example.com", Title: "Board", Shares: []Share{{User: "x@example.com", Role: "editor"}}},
+		"owner as member": {Owner: "a@example.com", Title: "Board", Shares: []Share{{User: "a@example.com", Role: "editor"}}},
+		"unknown role":    {Owner: "a@example.com", Title: "Board", Shares: []Share{{User: "b@example.com", Role: "owner"}}},
+	}
+	for name, board := range tests {
+		fixture := Fixture{Users: users, Boards: []Board{board}}
+		assert.Error(t, fixture.Validate(), name)
+	}
+
+	// Опечатка в поле фикстуры не проходит молча
+	path := filepath.Join(t.TempDir(), "fixture.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("users:\n  - email: a@example.com\n    nmae: A\n"), 0o600))
+	_, err := Load(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,1473 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"kanban/internal/config"
+	"kanban/internal/jobs"
+	"kanban/internal/model"
+	"kanban/internal/repository"
+	"kanban/internal/server"
+	"kanban/internal/testutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const e2eJWTSecret = "e2e-secret"
+
+func TestMain(m *testing.M) {
+	testutil.Main(m)
+}
+
+type idResponse struct {
+	ID string `json:"id"`
+}
+
+type taskResponse struct {
+	ID          string  `json:"id"`
+	ColumnID    string  `json:"column_id"`
+	Position    int     `json:"position"`
+	CompletedAt *string `json:"completed_at"`
+}
+
+type boardResponse struct {
+	ID       string  `json:"id"`
+	FrozenAt *string `json:"frozen_at"`
+}
+
+// newE2EServer initializes the whole server against the test database, as it runs in production
+// but without rate limits and alerts, and returns it with the database
+func newE2EServer(t *testing.T) (*testutil.API, *gorm.DB) {
+	t.Helper()
+
+	database := testutil.TestDatabase(t)
+	db := testutil.Open(t, database)
+
+	cfg, err := config.Load()
+	require.NoError(t, err)
+	cfg.DBDriver, cfg.DBPath = database.Driver, database.Path
+	cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName =
+		database.Host, database.Port, database.User, database.Password, database.Name
+	cfg.DBReplicaDSN = ""
+	cfg.JWTSecret = e2eJWTSecret
+	cfg.RateLimitEnabled = false
+	cfg.AlertsEnabled = false
+
+	gin.SetMode(gin.TestMode)
+	s, err := server.Init(cfg)
+	require.NoError(t, err)
+	sqlDB, err := s.DB.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	// Фоновые задания выполняются, как при запуске сервера
+	go s.Queue.Run(context.Background())
+	t.Cleanup(func() { s.Queue.Shutdown(context.Background()) })
+
+	return testutil.NewAPI(t, s.Engine, e2eJWTSecret), db
+}
+
+// newBoard creates a board of the owner through the API with columns of the given titles, the
+// last of them a done column, and returns the IDs of the board and the columns
+func newBoard(api *testutil.API, owner uuid.UUID, columns ...string) (string, []string) {
+	var board idResponse
+	api.Expect(http.StatusCreated, &board, owner, http.MethodPost, "/v1/boards", gin.H{"title": "E2E board"})
+
+	columnIDs := make([]string, len(columns))
+	for i, title := range columns {
+		var column idResponse
+		api.Expect(http.StatusCreated, &column, owner, http.MethodPost, "/v1/columns", gin.H{
+			"board_id": board.ID,
+			"title":    title,
+			"position": i + 1,
+			"is_done":  i == len(columns)-1,
+		})
+		columnIDs[i] = column.ID
+	}
+	return board.ID, columnIDs
+}
+
+func newTask(api *testutil.API, user uuid.UUID, columnID, title string) string {
+	var task idResponse
+	api.Expect(http.StatusCreated, &task, user, http.MethodPost, "/v1/tasks", gin.H{"column_id": columnID, "title": title})
+	return task.ID
+}
+
+func columnTasks(api *testutil.API, user uuid.UUID, columnID string) []taskResponse {
+	var tasks []taskResponse
+	api.Expect(http.StatusOK, &tasks, user, http.MethodGet, "/v1/columns/"+columnID+"/tasks", nil)
+	return tasks
+}
+
+func TestE2E_MoveTask(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	_, columns := newBoard(api, owner.ID, "To Do", "Done")
+	todo, done := columns[0], columns[1]
+	first := newTask(api, owner.ID, todo, "First")
+	second := newTask(api, owner.ID, todo, "Second")
+	third := newTask(api, owner.ID, todo, "Third")
+	shipped := newTask(api, owner.ID, done, "Shipped")
+
+	// Внутри колонки: первая задача встаёт в конец, остальные сдвигаются вверх
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+first+"/move",
+		gin.H{"column_id": todo, "position": 2})
+	positions := map[string]int{}
+	for _, task := range columnTasks(api, owner.ID, todo) {
+		positions[task.ID] = task.Position
+	}
+	assert.Equal(t, map[string]int{second: 0, third: 1, first: 2}, positions)
+
+	// В колонку Done: задача завершается, позиции обеих колонок пересчитываются
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+third+"/move",
+		gin.H{"column_id": done, "position": 1})
+	positions = map[string]int{}
+	for _, task := range columnTasks(api, owner.ID, todo) {
+		positions[task.ID] = task.Position
+	}
+	assert.Equal(t, map[string]int{second: 0, first: 1}, positions)
+
+	doneTasks := columnTasks(api, owner.ID, done)
+	require.Len(t, doneTasks, 2)
+	assert.Equal(t, shipped, doneTasks[0].ID)
+	assert.Equal(t, third, doneTasks[1].ID)
+	assert.Equal(t, 1, doneTasks[1].Position)
+	assert.NotNil(t, doneTasks[1].CompletedAt)
+
+	// Обратно: задача снова открыта
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+third+"/move",
+		gin.H{"column_id": todo, "position": 1})
+	var task taskResponse
+	api.Expect(http.StatusOK, &task, owner.ID, http.MethodGet, "/v1/tasks/"+third, nil)
+	assert.Equal(t, todo, task.ColumnID)
+	assert.Nil(t, task.CompletedAt)
+
+	// Чужая колонка не подходит, даже своему владельцу
+	_, otherColumns := newBoard(api, owner.ID, "Elsewhere")
+	w := api.Do(owner.ID, http.MethodPost, "/v1/tasks/"+third+"/move", gin.H{"column_id": otherColumns[0], "position": 1})
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+}
+
+func TestE2E_Sharing(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+	stranger := testutil.CreateUser(t, db, "stranger")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	task := newTask(api, owner.ID, columns[0], "Shared task")
+	move := gin.H{"column_id": columns[1], "position": 1}
+
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+uuid.NewString(), nil).Code)
+
+	// Без доступа доска и её задачи закрыты
+	assert.Equal(t, http.StatusForbidden, api.Do(stranger.ID, http.MethodGet, "/v1/boards/"+board, nil).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(stranger.ID, http.MethodGet, "/v1/columns/"+columns[0]+"/tasks", nil).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(stranger.ID, http.MethodGet, "/v1/boards/"+board+"/full", nil).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/tasks/"+task+"/move", move).Code)
+
+	// Читатель видит доску, но не двигает задачи
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "viewer"})
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodGet, "/v1/boards/"+board, nil)
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodGet, "/v1/boards/"+board+"/full", nil)
+	assert.Len(t, columnTasks(api, member.ID, columns[0]), 1)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/tasks/"+task+"/move", move).Code)
+
+	// Повторный шаринг меняет роль: редактор двигает задачи
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "editor"})
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodPost, "/v1/tasks/"+task+"/move", move)
+
+	var shares []struct {
+		UserID string `json:"user_id"`
+		Role   string `json:"role"`
+	}
+	api.Expect(http.StatusOK, &shares, owner.ID, http.MethodGet, "/v1/boards/"+board+"/share", nil)
+	roles := map[string]string{}
+	for _, share := range shares {
+		roles[share.UserID] = share.Role
+	}
+	assert.Equal(t, "editor", roles[member.ID.String()])
+
+	// Участник не может раздавать доступ; после отзыва доступа доска снова закрыта
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": stranger.Email, "role": "viewer"}).Code)
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/boards/"+board+"/share/"+member.ID.String(), nil)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodGet, "/v1/boards/"+board, nil).Code)
+}
+
+func TestE2E_DeleteBoard(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	task := newTask(api, owner.ID, columns[0], "Task")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "viewer"})
+
+	// Удалить или передать доску может только владелец и только редактору
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodDelete, "/v1/boards/"+board, nil).Code)
+	assert.Equal(t, http.StatusBadRequest,
+		api.Do(owner.ID, http.MethodDelete, "/v1/boards/"+board+"?transfer_to="+member.ID.String(), nil).Code)
+
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "editor"})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/boards/"+board+"?transfer_to="+member.ID.String(), nil)
+	assert.Equal(t, http.StatusForbidden, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+board, nil).Code)
+
+	// Новый владелец удаляет доску вместе с содержимым
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodDelete, "/v1/boards/"+board, nil)
+	assert.Equal(t, http.StatusNotFound, api.Do(member.ID, http.MethodDelete, "/v1/boards/"+board, nil).Code)
+	var remaining int64
+	require.NoError(t, db.Model(&model.Task{}).Where("id = ?", task).Count(&remaining).Error)
+	assert.Zero(t, remaining)
+}
+
+func TestE2E_LeaveBoard(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	task := newTask(api, owner.ID, columns[0], "Assigned task")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "editor"})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/assign",
+		gin.H{"user_id": member.ID.String()})
+
+	// Настройки уведомлений, просмотры и выбор доски участника касаются покидаемой доски
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodPut, "/v1/me/notification-settings", gin.H{
+		"events": []gin.H{{"event": "mention", "in_app": true, "email": false}},
+		"boards": []gin.H{{"board_id": board, "muted": true, "events": []gin.H{{"event": "mention", "in_app": false, "email": true}}}},
+		"digest": "off",
+	})
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodPut, "/v1/me/preferences", gin.H{"default_board_id": board})
+	// Просмотры редакторов не учитываются, а последняя доска запоминается в фоне, поэтому они записываются напрямую
+	require.NoError(t, db.Create(&model.ViewReceipt{BoardID: uuid.MustParse(board), UserID: member.ID, ViewedAt: time.Now()}).Error)
+	require.NoError(t, db.Model(&model.User{}).Where("id = ?", member.ID).Update("last_board_id", board).Error)
+
+	// Владелец не может покинуть свою доску
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+board+"/leave", nil).Code)
+
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodPost, "/v1/boards/"+board+"/leave", nil)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodGet, "/v1/boards/"+board, nil).Code)
+	assert.Equal(t, http.StatusBadRequest, api.Do(member.ID, http.MethodPost, "/v1/boards/"+board+"/leave", nil).Code)
+
+	var stored model.Task
+	require.NoError(t, db.First(&stored, "id = ?", task).Error)
+	assert.Nil(t, stored.AssignedTo)
+
+	// Настройки уведомлений доски удалены, общие сохранены
+	var settings []model.NotificationSetting
+	require.NoError(t, db.Where("user_id = ?", member.ID).Find(&settings).Error)
+	require.Len(t, settings, 1)
+	assert.Nil(t, settings[0].BoardID)
+	var mutes, receipts int64
+	require.NoError(t, db.Model(&model.NotificationMute{}).Where("user_id = ?", member.ID).Count(&mutes).Error)
+	assert.Zero(t, mutes)
+	require.NoError(t, db.Model(&model.ViewReceipt{}).Where("user_id = ?", member.ID).Count(&receipts).Error)
+	assert.Zero(t, receipts)
+
+	var user model.User
+	require.NoError(t, db.First(&user, "id = ?", member.ID).Error)
+	assert.Nil(t, user.DefaultBoardID)
+	assert.Nil(t, user.LastBoardID)
+}
+
+func TestE2E_FreezeBoard(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	editor := testutil.CreateUser(t, db, "editor")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	task := newTask(api, owner.ID, columns[0], "Frozen task")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": editor.Email, "role": "editor"})
+
+	// Заморозить доску может только владелец
+	assert.Equal(t, http.StatusForbidden, api.Do(editor.ID, http.MethodPut, "/v1/boards/"+board+"/freeze",
+		gin.H{"frozen": true}).Code)
+
+	var frozen boardResponse
+	api.Expect(http.StatusOK, &frozen, owner.ID, http.MethodPut, "/v1/boards/"+board+"/freeze", gin.H{"frozen": true})
+	require.NotNil(t, frozen.FrozenAt)
+
+	assert.Equal(t, http.StatusLocked, api.Do(editor.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Changed", "column_id": columns[0]}).Code)
+	assert.Equal(t, http.StatusLocked, api.Do(owner.ID, http.MethodPost, "/v1/tasks/"+task+"/move",
+		gin.H{"column_id": columns[1], "position": 0}).Code)
+	assert.Equal(t, http.StatusOK, api.Do(editor.ID, http.MethodGet, "/v1/tasks/"+task, nil).Code)
+
+	var unfrozen boardResponse
+	api.Expect(http.StatusOK, &unfrozen, owner.ID, http.MethodPut, "/v1/boards/"+board+"/freeze", gin.H{"frozen": false})
+	assert.Nil(t, unfrozen.FrozenAt)
+	api.Expect(http.StatusOK, nil, editor.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Changed", "column_id": columns[0]})
+}
+
+func TestE2E_GetTaskByNumber(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	stranger := testutil.CreateUser(t, db, "stranger")
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	newTask(api, owner.ID, columns[0], "First")
+	second := newTask(api, owner.ID, columns[0], "Second")
+
+	var stored model.Board
+	require.NoError(t, db.First(&stored, "id = ?", board).Error)
+
+	// Номер принимается как отдельно, так и в составе ключа задачи
+	for _, num := range []string{"2", stored.Key + "-2", strings.ToLower(stored.Key) + "-2"} {
+		var task idResponse
+		api.Expect(http.StatusOK, &task, owner.ID, http.MethodGet, "/v1/boards/"+board+"/tasks/by-number/"+num, nil)
+		assert.Equal(t, second, task.ID, num)
+	}
+
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+board+"/tasks/by-number/3", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+board+"/tasks/by-number/abc", nil).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(stranger.ID, http.MethodGet, "/v1/boards/"+board+"/tasks/by-number/2", nil).Code)
+}
+
+func TestE2E_QuickAdd(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "anna")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "editor"})
+	api.Expect(http.StatusCreated, nil, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": board, "name": "Backend", "color": "#ff0000"})
+
+	var created struct {
+		Task struct {
+			ID         string  `json:"id"`
+			Title      string  `json:"title"`
+			ColumnID   string  `json:"column_id"`
+			Priority   string  `json:"priority"`
+			AssignedTo *string `json:"assigned_to"`
+			DueDate    *string `json:"due_date"`
+			Labels     []struct {
+				Name string `json:"name"`
+			} `json:"labels"`
+		} `json:"task"`
+		Unmatched []string `json:"unmatched"`
+	}
+	api.Expect(http.StatusCreated, &created, owner.ID, http.MethodPost, "/v1/boards/"+board+"/quick-add",
+		gin.H{"text": "Fix login bug #backend #frontend @anna !high due:2030-01-15"})
+
+	assert.Equal(t, "Fix login bug", created.Task.Title)
+	assert.Equal(t, columns[0], created.Task.ColumnID)
+	assert.Equal(t, "high", created.Task.Priority)
+	require.NotNil(t, created.Task.AssignedTo)
+	assert.Equal(t, member.ID.String(), *created.Task.AssignedTo)
+	require.NotNil(t, created.Task.DueDate)
+	require.Len(t, created.Task.Labels, 1)
+	assert.Equal(t, "Backend", created.Task.Labels[0].Name)
+	assert.Equal(t, []string{"#frontend"}, created.Unmatched)
+
+	// Без названия задача не создаётся
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+board+"/quick-add",
+		gin.H{"text": "#backend !low"}).Code)
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+board+"/quick-add",
+		gin.H{"text": "Task", "column_id": uuid.NewString()}).Code)
+}
+
+func TestE2E_TaskTemplates(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	viewer := testutil.CreateUser(t, db, "viewer")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": viewer.Email, "role": "viewer"})
+	var label idResponse
+	api.Expect(http.StatusCreated, &label, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": board, "name": "bug", "color": "#ff0000"})
+
+	var template idResponse
+	api.Expect(http.StatusCreated, &template, owner.ID, http.MethodPost, "/v1/boards/"+board+"/task-templates", gin.H{
+		"name":          "Bug report",
+		"title_pattern": "Bug from {user}",
+		"description":   "Steps to reproduce:",
+		"priority":      "high",
+		"label_ids":     []string{label.ID},
+		"checklist":     []string{"Reproduce", "Fix"},
+	})
+
+	// Метки чужой доски в шаблон не попадают, а зрители шаблоны не создают
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+board+"/task-templates",
+		gin.H{"name": "Other", "title_pattern": "Other", "label_ids": []string{uuid.NewString()}}).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(viewer.ID, http.MethodPost, "/v1/boards/"+board+"/task-templates",
+		gin.H{"name": "Other", "title_pattern": "Other"}).Code)
+
+	var created struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		ColumnID    string `json:"column_id"`
+		Priority    string `json:"priority"`
+		Labels      []struct {
+			ID string `json:"id"`
+		} `json:"labels"`
+	}
+	api.Expect(http.StatusCreated, &created, owner.ID, http.MethodPost, "/v1/tasks/from-template/"+template.ID, nil)
+	assert.Equal(t, "Bug from owner", created.Title)
+	assert.Equal(t, "Steps to reproduce:\n\n- [ ] Reproduce\n- [ ] Fix", created.Description)
+	assert.Equal(t, columns[0], created.ColumnID)
+	assert.Equal(t, "high", created.Priority)
+	require.Len(t, created.Labels, 1)
+	assert.Equal(t, label.ID, created.Labels[0].ID)
+
+	api.Expect(http.StatusCreated, &created, owner.ID, http.MethodPost, "/v1/tasks/from-template/"+template.ID,
+		gin.H{"column_id": columns[1], "title": "Login fails"})
+	assert.Equal(t, "Login fails", created.Title)
+	assert.Equal(t, columns[1], created.ColumnID)
+
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/task-templates/"+template.ID, nil)
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/task-templates/"+template.ID, nil).Code)
+}
+
+func TestE2E_TaskLock(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	editor := testutil.CreateUser(t, db, "editor")
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	task := newTask(api, owner.ID, columns[0], "Locked task")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": editor.Email, "role": "editor"})
+
+	var lock struct {
+		UserID    string `json:"user_id"`
+		ExpiresAt string `json:"expires_at"`
+	}
+	api.Expect(http.StatusOK, &lock, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil)
+	assert.Equal(t, owner.ID.String(), lock.UserID)
+	// Владелец блокировки может её продлить, другой редактор получает конфликт
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil)
+	assert.Equal(t, http.StatusConflict, api.Do(editor.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil).Code)
+
+	// Пока задача заблокирована, описание меняет только владелец блокировки
+	assert.Equal(t, http.StatusLocked, api.Do(editor.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Locked task", "column_id": columns[0], "description": "Mine"}).Code)
+	api.Expect(http.StatusOK, nil, editor.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Renamed", "column_id": columns[0]})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Renamed", "column_id": columns[0], "description": "Owner's text"})
+
+	assert.Equal(t, http.StatusNotFound, api.Do(editor.ID, http.MethodDelete, "/v1/tasks/"+task+"/lock", nil).Code)
+	api.Expect(http.StatusNoContent, nil, owner.ID, http.MethodDelete, "/v1/tasks/"+task+"/lock", nil)
+	api.Expect(http.StatusOK, nil, editor.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil)
+}
+
+func TestE2E_CollaborativeDescription(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	editor := testutil.CreateUser(t, db, "editor")
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	task := newTask(api, owner.ID, columns[0], "Shared task")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": editor.Email, "role": "editor"})
+
+	type docResponse struct {
+		Version int64   `json:"version"`
+		Content *string `json:"content"`
+		Ops     []struct {
+			Version int64           `json:"version"`
+			Ops     json.RawMessage `json:"ops"`
+		} `json:"ops"`
+	}
+	var doc docResponse
+	api.Expect(http.StatusOK, &doc, owner.ID, http.MethodGet, "/v1/tasks/"+task+"/description", nil)
+	require.NotNil(t, doc.Content)
+	assert.Equal(t, int64(0), doc.Version)
+	assert.Equal(t, "", *doc.Content)
+
+	// Обе правки сделаны на версии 0; вторая сдвигается за первую
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/description/ops",
+		gin.H{"version": 0, "ops": []gin.H{{"insert": "Hello"}}})
+	var applied struct {
+		Version int64           `json:"version"`
+		Ops     json.RawMessage `json:"ops"`
+	}
+	api.Expect(http.StatusOK, &applied, editor.ID, http.MethodPost, "/v1/tasks/"+task+"/description/ops",
+		gin.H{"version": 0, "ops": []gin.H{{"insert": " world"}}})
+	assert.Equal(t, int64(2), applied.Version)
+	assert.JSONEq(t, `[{"retain":5},{"insert":" world"}]`, string(applied.Ops))
+
+	var edits docResponse
+	api.Expect(http.StatusOK, &edits, editor.ID, http.MethodGet, "/v1/tasks/"+task+"/description?since=1", nil)
+	assert.Equal(t, int64(2), edits.Version)
+	assert.Nil(t, edits.Content)
+	require.Len(t, edits.Ops, 1)
+
+	api.Expect(http.StatusOK, &doc, editor.ID, http.MethodGet, "/v1/tasks/"+task+"/description", nil)
+	assert.Equal(t, "Hello world", *doc.Content)
+
+	assert.Equal(t, http.StatusConflict, api.Do(editor.ID, http.MethodPost, "/v1/tasks/"+task+"/description/ops",
+		gin.H{"version": 7, "ops": []gin.H{{"retain": 11}}}).Code)
+	assert.Equal(t, http.StatusBadRequest, api.Do(editor.ID, http.MethodPost, "/v1/tasks/"+task+"/description/ops",
+		gin.H{"version": 2, "ops": []gin.H{{"retain": 3}}}).Code)
+
+	// Замена описания целиком становится правкой журнала
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Shared task", "column_id": columns[0], "description": "Rewritten"})
+	var rewritten docResponse
+	api.Expect(http.StatusOK, &rewritten, editor.ID, http.MethodGet, "/v1/tasks/"+task+"/description?since=2", nil)
+	assert.Equal(t, int64(3), rewritten.Version)
+	require.Len(t, rewritten.Ops, 1)
+	assert.JSONEq(t, `[{"delete":11},{"insert":"Rewritten"}]`, string(rewritten.Ops[0].Ops))
+}
+
+func TestE2E_MergeLabels(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	var bug, duplicate idResponse
+	api.Expect(http.StatusCreated, &bug, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": board, "name": "bug", "color": "#ff0000"})
+	api.Expect(http.StatusCreated, &duplicate, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": board, "name": "Bug", "color": "#ee0000"})
+
+	// Одна задача с обеими метками, другая только с дубликатом
+	both := newTask(api, owner.ID, columns[0], "Both")
+	single := newTask(api, owner.ID, columns[0], "Duplicate only")
+	for _, path := range []string{
+		"/v1/tasks/" + both + "/labels/" + bug.ID,
+		"/v1/tasks/" + both + "/labels/" + duplicate.ID,
+		"/v1/tasks/" + single + "/labels/" + duplicate.ID,
+	} {
+		api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, path, nil)
+	}
+
+	var merged struct {
+		Label        idResponse `json:"label"`
+		TasksUpdated int64      `json:"tasks_updated"`
+	}
+	api.Expect(http.StatusOK, &merged, owner.ID, http.MethodPost, "/v1/labels/"+duplicate.ID+"/merge",
+		gin.H{"target_id": bug.ID})
+	assert.Equal(t, bug.ID, merged.Label.ID)
+	assert.Equal(t, int64(2), merged.TasksUpdated)
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/labels/"+duplicate.ID, nil).Code)
+
+	for _, task := range []string{both, single} {
+		var labels []idResponse
+		api.Expect(http.StatusOK, &labels, owner.ID, http.MethodGet, "/v1/tasks/"+task+"/labels", nil)
+		require.Len(t, labels, 1)
+		assert.Equal(t, bug.ID, labels[0].ID)
+	}
+}
+
+func TestE2E_CopyLabels(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	other := testutil.CreateUser(t, db, "other")
+
+	source, _ := newBoard(api, owner.ID, "To Do")
+	target, _ := newBoard(api, owner.ID, "To Do")
+	for _, name := range []string{"bug", "feature"} {
+		api.Expect(http.StatusCreated, nil, owner.ID, http.MethodPost, "/v1/labels",
+			gin.H{"board_id": source, "name": name, "color": "#ff0000"})
+	}
+	api.Expect(http.StatusCreated, nil, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": target, "name": "BUG", "color": "#00ff00"})
+
+	// Метка с тем же именем на целевой доске не копируется
+	var copied struct {
+		Labels  []struct{ Name string } `json:"labels"`
+		Skipped int                     `json:"skipped"`
+	}
+	api.Expect(http.StatusCreated, &copied, owner.ID, http.MethodPost, "/v1/boards/"+target+"/labels/copy",
+		gin.H{"source_board_id": source})
+	require.Len(t, copied.Labels, 1)
+	assert.Equal(t, "feature", copied.Labels[0].Name)
+	assert.Equal(t, 1, copied.Skipped)
+
+	// Копировать можно только со своих досок
+	foreign, _ := newBoard(api, other.ID, "To Do")
+	assert.Equal(t, http.StatusForbidden, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+target+"/labels/copy",
+		gin.H{"source_board_id": foreign}).Code)
+}
+
+func TestE2E_MoveTaskToBoard(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	other := testutil.CreateUser(t, db, "other")
+
+	source, sourceColumns := newBoard(api, owner.ID, "To Do", "Done")
+	target, targetColumns := newBoard(api, owner.ID, "Backlog", "Done")
+
+	var bug, ops, targetBug idResponse
+	api.Expect(http.StatusCreated, &bug, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": source, "name": "bug", "color": "#ff0000"})
+	api.Expect(http.StatusCreated, &ops, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": source, "name": "ops", "color": "#0000ff"})
+	api.Expect(http.StatusCreated, &targetBug, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": target, "name": "Bug", "color": "#ee0000"})
+
+	task := newTask(api, owner.ID, sourceColumns[0], "Moving")
+	for _, label := range []string{bug.ID, ops.ID} {
+		api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/labels/"+label, nil)
+	}
+
+	// Внутри доски перенос делается через /move
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPost, "/v1/tasks/"+task+"/move-to-board",
+		gin.H{"column_id": sourceColumns[1]}).Code)
+
+	// Без прав на целевую доску перенос запрещён
+	_, foreignColumns := newBoard(api, other.ID, "To Do")
+	assert.Equal(t, http.StatusForbidden, api.Do(owner.ID, http.MethodPost, "/v1/tasks/"+task+"/move-to-board",
+		gin.H{"column_id": foreignColumns[0]}).Code)
+
+	var moved struct {
+		Task           taskResponse `json:"task"`
+		LabelsRemapped int          `json:"labels_remapped"`
+		LabelsDropped  int          `json:"labels_dropped"`
+	}
+	api.Expect(http.StatusOK, &moved, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/move-to-board",
+		gin.H{"column_id": targetColumns[0]})
+	assert.Equal(t, targetColumns[0], moved.Task.ColumnID)
+	assert.Equal(t, 1, moved.LabelsRemapped)
+	assert.Equal(t, 1, moved.LabelsDropped)
+	assert.Empty(t, columnTasks(api, owner.ID, sourceColumns[0]))
+
+	// Метка заменена одноимённой меткой целевой доски, метка без пары снята
+	var labels []idResponse
+	api.Expect(http.StatusOK, &labels, owner.ID, http.MethodGet, "/v1/tasks/"+task+"/labels", nil)
+	require.Len(t, labels, 1)
+	assert.Equal(t, targetBug.ID, labels[0].ID)
+
+	var history []struct {
+		Field    string  `json:"field"`
+		OldValue *string `json:"old_value"`
+		NewValue *string `json:"new_value"`
+	}
+	api.Expect(http.StatusOK, &history, owner.ID, http.MethodGet, "/v1/tasks/"+task+"/history", nil)
+	var boardChange bool
+	for _, change := range history {
+		if change.Field == "board" {
+			boardChange = true
+			assert.Equal(t, source, *change.OldValue)
+			assert.Equal(t, target, *change.NewValue)
+		}
+	}
+	assert.True(t, boardChange)
+}
+
+func TestE2E_CopyColumnToBoard(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	source, sourceColumns := newBoard(api, owner.ID, "To Do", "Done")
+	target, targetColumns := newBoard(api, owner.ID, "Backlog", "Done")
+
+	var bug idResponse
+	api.Expect(http.StatusCreated, &bug, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": source, "name": "bug", "color": "#ff0000"})
+	first := newTask(api, owner.ID, sourceColumns[0], "First")
+	newTask(api, owner.ID, sourceColumns[0], "Second")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+first+"/labels/"+bug.ID, nil)
+
+	var copied struct {
+		Column struct {
+			ID       string `json:"id"`
+			Position int    `json:"position"`
+		} `json:"column"`
+		TasksCopied   int64 `json:"tasks_copied"`
+		LabelsCreated int   `json:"labels_created"`
+	}
+	api.Expect(http.StatusCreated, &copied, owner.ID, http.MethodPost, "/v1/columns/"+sourceColumns[0]+"/copy-to-board",
+		gin.H{"board_id": target, "position": 2})
+	assert.Equal(t, 2, copied.Column.Position)
+	assert.Equal(t, int64(2), copied.TasksCopied)
+	assert.Equal(t, 1, copied.LabelsCreated)
+
+	// Исходная колонка не меняется, колонки целевой доски сдвигаются
+	assert.Len(t, columnTasks(api, owner.ID, sourceColumns[0]), 2)
+	var columns []struct {
+		ID       string `json:"id"`
+		Position int    `json:"position"`
+	}
+	api.Expect(http.StatusOK, &columns, owner.ID, http.MethodGet, "/v1/boards/"+target+"/columns", nil)
+	require.Len(t, columns, 3)
+	assert.Equal(t, []string{targetColumns[0], copied.Column.ID, targetColumns[1]},
+		[]string{columns[0].ID, columns[1].ID, columns[2].ID})
+
+	// Копия задачи получила метку целевой доски
+	tasks := columnTasks(api, owner.ID, copied.Column.ID)
+	require.Len(t, tasks, 2)
+	var labels []struct {
+		ID      string `json:"id"`
+		BoardID string `json:"board_id"`
+	}
+	api.Expect(http.StatusOK, &labels, owner.ID, http.MethodGet, "/v1/tasks/"+tasks[0].ID+"/labels", nil)
+	require.Len(t, labels, 1)
+	assert.Equal(t, target, labels[0].BoardID)
+}
+
+func TestE2E_BoardOrder(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	other := testutil.CreateUser(t, db, "other")
+
+	first, _ := newBoard(api, owner.ID)
+	second, _ := newBoard(api, owner.ID)
+	third, _ := newBoard(api, owner.ID)
+	foreign, _ := newBoard(api, other.ID)
+
+	boardIDs := func() []string {
+		var boards []idResponse
+		api.Expect(http.StatusOK, &boards, owner.ID, http.MethodGet, "/v1/boards", nil)
+		ids := make([]string, len(boards))
+		for i, board := range boards {
+			ids[i] = board.ID
+		}
+		return ids
+	}
+
+	// Доски вне списка идут после упорядоченных
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/me/board-order", gin.H{"board_ids": []string{third, first}})
+	ids := boardIDs()
+	require.Len(t, ids, 3)
+	assert.Equal(t, []string{third, first}, ids[:2])
+
+	assert.Equal(t, http.StatusForbidden, api.Do(owner.ID, http.MethodPut, "/v1/me/board-order",
+		gin.H{"board_ids": []string{foreign}}).Code)
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPut, "/v1/me/board-order",
+		gin.H{"board_ids": []string{first, first}}).Code)
+
+	// Новый порядок заменяет прежний
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/me/board-order", gin.H{"board_ids": []string{second}})
+	assert.Equal(t, second, boardIDs()[0])
+}
+
+func TestE2E_ExportActivityLog(t *testing.T) {
+	api, db := newE2EServer(t)
+	admin := testutil.CreateUser(t, db, "admin")
+	owner := testutil.CreateUser(t, db, "owner")
+	require.NoError(t, db.Model(&model.User{}).Where("id = ?", admin.ID).Update("is_admin", true).Error)
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	task := newTask(api, owner.ID, columns[0], "Draft")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"title": "Final", "column_id": columns[0]})
+
+	// Экспорт доступен только администраторам
+	assert.Equal(t, http.StatusForbidden, api.Do(owner.ID, http.MethodGet, "/v1/admin/audit-log?from=2020-01-01", nil).Code)
+	assert.Equal(t, http.StatusBadRequest, api.Do(admin.ID, http.MethodGet, "/v1/admin/audit-log", nil).Code)
+
+	w := api.Do(admin.ID, http.MethodGet, "/v1/admin/audit-log?from=2020-01-01", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+
+	var titleChange map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(w.Body.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["task_id"] == task && entry["field"] == "title" {
+			titleChange = entry
+		}
+	}
+	require.NotNil(t, titleChange)
+	assert.Equal(t, board, titleChange["board_id"])
+	assert.Equal(t, "Draft", titleChange["old_value"])
+	assert.Equal(t, "Final", titleChange["new_value"])
+	assert.Equal(t, owner.Email, titleChange["user_email"])
+
+	w = api.Do(admin.ID, http.MethodGet, "/v1/admin/audit-log?from=2020-01-01&format=csv", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Body.String(), "id,changed_at,board_id,"))
+	assert.Contains(t, w.Body.String(), ",title,Draft,Final")
+
+	// Записи вне диапазона не попадают в экспорт
+	w = api.Do(admin.ID, http.MethodGet, "/v1/admin/audit-log?from=2020-01-01&to=2020-12-31", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestE2E_GuestEditing(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	task := newTask(api, owner.ID, columns[0], "Shared with a guest")
+
+	var link struct {
+		Token string `json:"token"`
+	}
+	api.Expect(http.StatusCreated, &link, owner.ID, http.MethodPost, "/v1/boards/"+board+"/guest-links", gin.H{"name": "Contractor"})
+	var session struct {
+		Token string `json:"token"`
+	}
+	api.Expect(http.StatusOK, &session, uuid.Nil, http.MethodPost, "/v1/guest-links/"+link.Token+"/session", nil)
+
+	// Гость блокирует задачу, как и участник доски
+	w := api.DoWithToken(session.Token, http.MethodPost, "/v1/tasks/"+task+"/lock", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, http.StatusConflict, api.Do(owner.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil).Code)
+
+	w = api.DoWithToken(session.Token, http.MethodDelete, "/v1/tasks/"+task+"/lock", nil)
+	require.Equal(t, http.StatusNoContent, w.Code, w.Body.String())
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil)
+	api.Expect(http.StatusNoContent, nil, owner.ID, http.MethodDelete, "/v1/tasks/"+task+"/lock", nil)
+
+	// Описание гость правит операциями, а не заменой целиком
+	w = api.DoWithToken(session.Token, http.MethodPost, "/v1/tasks/"+task+"/description/ops",
+		gin.H{"version": 0, "ops": []gin.H{{"insert": "Guest notes"}}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	w = api.DoWithToken(session.Token, http.MethodGet, "/v1/tasks/"+task+"/description", nil)
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var doc struct {
+		Version int64  `json:"version"`
+		Content string `json:"content"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+	assert.Equal(t, int64(1), doc.Version)
+	assert.Equal(t, "Guest notes", doc.Content)
+}
+
+func TestE2E_EstimateRollups(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	outsider := testutil.CreateUser(t, db, "outsider")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	estimated := func(column, title string, estimate int) string {
+		var task idResponse
+		api.Expect(http.StatusCreated, &task, owner.ID, http.MethodPost, "/v1/tasks",
+			gin.H{"column_id": column, "title": title, "estimate": estimate})
+		return task.ID
+	}
+	mine := estimated(columns[0], "Mine", 3)
+	estimated(columns[0], "Unassigned", 5)
+	shipped := estimated(columns[1], "Shipped", 8)
+	newTask(api, owner.ID, columns[0], "Not estimated")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+mine+"/assign", gin.H{"user_id": owner.ID.String()})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+shipped+"/assign", gin.H{"user_id": owner.ID.String()})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+shipped+"/complete", nil)
+
+	type sums struct {
+		Tasks          int `json:"tasks"`
+		EstimatedTasks int `json:"estimated_tasks"`
+		Estimate       int `json:"estimate"`
+		OpenEstimate   int `json:"open_estimate"`
+	}
+	var rollup struct {
+		Total   sums `json:"total"`
+		Columns []struct {
+			ColumnID string `json:"column_id"`
+			sums
+		} `json:"columns"`
+		Assignees []struct {
+			UserID *string `json:"user_id"`
+			sums
+		} `json:"assignees"`
+	}
+	api.Expect(http.StatusOK, &rollup, owner.ID, http.MethodGet, "/v1/boards/"+board+"/estimates", nil)
+
+	assert.Equal(t, sums{Tasks: 4, EstimatedTasks: 3, Estimate: 16, OpenEstimate: 8}, rollup.Total)
+	require.Len(t, rollup.Columns, 2)
+	assert.Equal(t, columns[0], rollup.Columns[0].ColumnID)
+	assert.Equal(t, sums{Tasks: 3, EstimatedTasks: 2, Estimate: 8, OpenEstimate: 8}, rollup.Columns[0].sums)
+	assert.Equal(t, sums{Tasks: 1, EstimatedTasks: 1, Estimate: 8}, rollup.Columns[1].sums)
+
+	require.Len(t, rollup.Assignees, 2)
+	require.NotNil(t, rollup.Assignees[0].UserID)
+	assert.Equal(t, owner.ID.String(), *rollup.Assignees[0].UserID)
+	assert.Equal(t, sums{Tasks: 2, EstimatedTasks: 2, Estimate: 11, OpenEstimate: 3}, rollup.Assignees[0].sums)
+	assert.Nil(t, rollup.Assignees[1].UserID)
+	assert.Equal(t, sums{Tasks: 2, EstimatedTasks: 1, Estimate: 5, OpenEstimate: 5}, rollup.Assignees[1].sums)
+
+	assert.Equal(t, http.StatusForbidden, api.Do(outsider.ID, http.MethodGet, "/v1/boards/"+board+"/estimates", nil).Code)
+}
+
+func TestE2E_BoardChanges(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	board, columns := newBoard(api, owner.ID, "To Do")
+	first := newTask(api, owner.ID, columns[0], "First")
+
+	type changesResponse struct {
+		Cursor         string       `json:"cursor"`
+		Tasks          []idResponse `json:"tasks"`
+		DeletedTaskIDs []string     `json:"deleted_task_ids"`
+		Columns        []idResponse `json:"columns"`
+	}
+	changes := func(since string) changesResponse {
+		t.Helper()
+		var response changesResponse
+		api.Expect(http.StatusOK, &response, owner.ID, http.MethodGet, "/v1/boards/"+board+"/changes?since="+since, nil)
+		return response
+	}
+	taskIDs := func(response changesResponse) []string {
+		ids := make([]string, len(response.Tasks))
+		for i, task := range response.Tasks {
+			ids[i] = task.ID
+		}
+		return ids
+	}
+
+	// С момента до создания доски видны все ее колонки и задачи
+	initial := changes(time.Now().Add(-time.Minute).UTC().Format(time.RFC3339))
+	assert.Equal(t, []string{first}, taskIDs(initial))
+	assert.Len(t, initial.Columns, 1)
+	require.NotEmpty(t, initial.Cursor)
+
+	// По курсору приходят только изменения после него
+	second := newTask(api, owner.ID, columns[0], "Second")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/tasks/"+first,
+		gin.H{"title": "First changed", "column_id": columns[0]})
+	updated := changes(initial.Cursor)
+	assert.ElementsMatch(t, []string{first, second}, taskIDs(updated))
+	assert.Empty(t, updated.Columns)
+
+	unchanged := changes(updated.Cursor)
+	assert.Empty(t, unchanged.Tasks)
+
+	// Изменения видны только участникам доски
+	stranger := testutil.CreateUser(t, db, "stranger")
+	assert.Equal(t, http.StatusForbidden, api.Do(stranger.ID, http.MethodGet,
+		"/v1/boards/"+board+"/changes?since="+unchanged.Cursor, nil).Code)
+
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/tasks/"+second, nil)
+	deleted := changes(unchanged.Cursor)
+	assert.Empty(t, deleted.Tasks)
+	assert.Equal(t, []string{second}, deleted.DeletedTaskIDs)
+}
+
+func TestE2E_DependencyBlocked(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	_, columns := newBoard(api, owner.ID, "To Do", "Done")
+	blocker := newTask(api, owner.ID, columns[0], "Blocker")
+	blocked := newTask(api, owner.ID, columns[0], "Blocked")
+	api.Expect(http.StatusCreated, nil, owner.ID, http.MethodPost, "/v1/tasks/"+blocker+"/relations",
+		gin.H{"type": "blocks", "task_id": blocked})
+
+	type listedTask struct {
+		ID                string `json:"id"`
+		DependencyBlocked bool   `json:"dependency_blocked"`
+	}
+	listed := func() map[string]bool {
+		t.Helper()
+		var tasks []listedTask
+		api.Expect(http.StatusOK, &tasks, owner.ID, http.MethodGet, "/v1/columns/"+columns[0]+"/tasks", nil)
+		var batch struct {
+			Tasks []listedTask `json:"tasks"`
+		}
+		api.Expect(http.StatusOK, &batch, owner.ID, http.MethodPost, "/v1/tasks/batch-get", gin.H{"ids": []string{blocker, blocked}})
+		assert.ElementsMatch(t, tasks, batch.Tasks)
+
+		flags := map[string]bool{}
+		for _, task := range tasks {
+			flags[task.ID] = task.DependencyBlocked
+		}
+		return flags
+	}
+
+	// Задача заблокирована, пока блокирующая задача открыта
+	assert.Equal(t, map[string]bool{blocker: false, blocked: true}, listed())
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+blocker+"/complete", nil)
+	assert.Equal(t, map[string]bool{blocker: false, blocked: false}, listed())
+}
+
+func TestE2E_LastBoard(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	newBoard(api, owner.ID, "To Do")
+	second, _ := newBoard(api, owner.ID, "To Do")
+
+	// Открытая доска запоминается фоновым заданием и возвращается в /bootstrap
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodGet, "/v1/boards/"+second, nil)
+	assert.Eventually(t, func() bool {
+		var bootstrap struct {
+			LastBoardID *string `json:"last_board_id"`
+		}
+		api.Expect(http.StatusOK, &bootstrap, owner.ID, http.MethodGet, "/v1/bootstrap", nil)
+		return bootstrap.LastBoardID != nil && *bootstrap.LastBoardID == second
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestE2E_SprintAccess(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, _ := newBoard(api, owner.ID, "To Do")
+	var sprint idResponse
+	api.Expect(http.StatusCreated, &sprint, owner.ID, http.MethodPost, "/v1/boards/"+board+"/sprints",
+		gin.H{"name": "Sprint 1", "start_date": "2024-06-03", "end_date": "2024-06-14"})
+
+	// Доступ проверяется через кэш прав, который сбрасывается при смене участников
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodGet, "/v1/boards/"+board+"/sprints", nil).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodGet, "/v1/sprints/"+sprint.ID, nil).Code)
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "viewer"})
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodGet, "/v1/boards/"+board+"/sprints", nil)
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodGet, "/v1/sprints/"+sprint.ID, nil)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/boards/"+board+"/sprints",
+		gin.H{"name": "Sprint 2", "start_date": "2024-06-17", "end_date": "2024-06-28"}).Code)
+
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+uuid.NewString()+"/sprints", nil).Code)
+}
+
+func TestE2E_TaskArchive(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TASK_ARCHIVE_DIR", dir)
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	var label idResponse
+	api.Expect(http.StatusCreated, &label, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": board, "name": "bug", "color": "#ff0000"})
+	task := newTask(api, owner.ID, columns[0], "Old task")
+	open := newTask(api, owner.ID, columns[0], "Open task")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/labels/"+label.ID, nil)
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/complete", nil)
+
+	// Задача завершена два года назад и уходит в архив, открытая задача остается
+	completed := time.Now().AddDate(-2, 0, 0)
+	require.NoError(t, db.Model(&model.Task{}).Where("id = ?", task).Update("completed_at", completed).Error)
+	archiver, err := jobs.NewTaskArchiver(repository.NewArchivedTaskRepository(db), jobs.TaskArchiveConfig{
+		After: 365 * 24 * time.Hour, Dir: dir, BatchSize: 10,
+	})
+	require.NoError(t, err)
+	require.NoError(t, archiver.RunOnce(context.Background(), time.Now()))
+
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/tasks/"+task, nil).Code)
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodGet, "/v1/tasks/"+open, nil)
+	var archived []idResponse
+	api.Expect(http.StatusOK, &archived, owner.ID, http.MethodGet, "/v1/boards/"+board+"/archived-tasks", nil)
+	require.Len(t, archived, 1)
+	assert.Equal(t, task, archived[0].ID)
+
+	// Восстановленная задача возвращается с метками и историей и больше не архивируется
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/archived-tasks/"+task+"/restore", nil)
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodPost, "/v1/archived-tasks/"+task+"/restore", nil).Code)
+	var labels []idResponse
+	api.Expect(http.StatusOK, &labels, owner.ID, http.MethodGet, "/v1/tasks/"+task+"/labels", nil)
+	require.Len(t, labels, 1)
+	assert.Equal(t, label.ID, labels[0].ID)
+	var history []struct {
+		Field string `json:"field"`
+	}
+	api.Expect(http.StatusOK, &history, owner.ID, http.MethodGet, "/v1/tasks/"+task+"/history", nil)
+	assert.NotEmpty(t, history)
+
+	require.NoError(t, archiver.RunOnce(context.Background(), time.Now()))
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodGet, "/v1/tasks/"+task, nil)
+	api.Expect(http.StatusOK, &archived, owner.ID, http.MethodGet, "/v1/boards/"+board+"/archived-tasks", nil)
+	assert.Empty(t, archived)
+}
+
+func TestE2E_OutboxBoardEvents(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, _ := newBoard(api, owner.ID, "To Do")
+	var label idResponse
+	api.Expect(http.StatusCreated, &label, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": board, "name": "bug", "color": "#ff0000"})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/labels/"+label.ID, gin.H{"name": "defect", "color": "#ff0000"})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/labels/"+label.ID, nil)
+
+	// Повторная выдача той же роли ничего не меняет и события не порождает
+	for _, role := range []string{"viewer", "editor", "editor"} {
+		api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+			gin.H{"email": member.Email, "role": role})
+	}
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/boards/"+board+"/share/"+member.ID.String(), nil)
+
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/boards/"+board, gin.H{"title": "Renamed"})
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/boards/"+board+"/freeze", gin.H{"frozen": true})
+
+	var events []model.OutboxEvent
+	require.NoError(t, db.Order("id").Find(&events).Error)
+	type payload struct {
+		BoardID      string  `json:"board_id"`
+		Name         string  `json:"name"`
+		PreviousName string  `json:"previous_name"`
+		Role         string  `json:"role"`
+		PreviousRole string  `json:"previous_role"`
+		Title        string  `json:"title"`
+		FrozenAt     *string `json:"frozen_at"`
+	}
+	var names []string
+	var payloads []payload
+	for _, event := range events {
+		var p payload
+		require.NoError(t, json.Unmarshal(event.Payload, &p))
+		if p.BoardID == board {
+			names = append(names, event.Event)
+			payloads = append(payloads, p)
+		}
+	}
+	require.Equal(t, []string{
+		model.EventLabelCreated, model.EventLabelUpdated, model.EventLabelDeleted,
+		model.EventShareGranted, model.EventShareRoleChanged, model.EventShareRevoked,
+		model.EventBoardUpdated, model.EventBoardUpdated,
+	}, names)
+	assert.Equal(t, "bug", payloads[1].PreviousName)
+	assert.Equal(t, "defect", payloads[1].Name)
+	assert.Equal(t, "viewer", payloads[3].Role)
+	assert.Equal(t, "viewer", payloads[4].PreviousRole)
+	assert.Equal(t, "editor", payloads[4].Role)
+	assert.Equal(t, "editor", payloads[5].Role)
+	assert.Equal(t, "Renamed", payloads[6].Title)
+	assert.Nil(t, payloads[6].FrozenAt)
+	assert.NotNil(t, payloads[7].FrozenAt)
+}
+
+// streamEvent is an event read from a server-sent event stream
+type streamEvent struct {
+	name string
+	id   string
+	data string
+}
+
+// readStreamEvent reads the next event with data from the stream, skipping comments
+func readStreamEvent(reader *bufio.Reader) (streamEvent, error) {
+	var event streamEvent
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return event, err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			if event.data != "" {
+				return event, nil
+			}
+		case strings.HasPrefix(line, "event: "):
+			event.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func TestE2E_BoardEvents(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "viewer"})
+
+	type changes struct {
+		Tasks []struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"tasks"`
+	}
+	open := func(user uuid.UUID, header http.Header) *bufio.Reader {
+		resp := api.Stream(user, "/v1/boards/"+board+"/events", header)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		// Зависший поток не держит тест дольше нескольких секунд
+		timer := time.AfterFunc(10*time.Second, func() { resp.Body.Close() })
+		t.Cleanup(func() { timer.Stop() })
+		return bufio.NewReader(resp.Body)
+	}
+
+	// Поток без курсора начинается с текущего момента и присылает задачу, созданную после подключения
+	stream := open(member.ID, nil)
+	task := newTask(api, owner.ID, columns[0], "Streamed")
+	event, err := readStreamEvent(stream)
+	require.NoError(t, err)
+	assert.Equal(t, "changes", event.name)
+	require.NotEmpty(t, event.id)
+	var got changes
+	require.NoError(t, json.Unmarshal([]byte(event.data), &got))
+	require.Len(t, got.Tasks, 1)
+	assert.Equal(t, task, got.Tasks[0].ID)
+
+	// Переподключение с последним ID события продолжает поток без повторов
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPut, "/v1/tasks/"+task,
+		gin.H{"column_id": columns[0], "title": "Renamed"})
+	resumed := open(member.ID, http.Header{"Last-Event-ID": {event.id}})
+	event, err = readStreamEvent(resumed)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(event.data), &got))
+	require.Len(t, got.Tasks, 1)
+	assert.Equal(t, "Renamed", got.Tasks[0].Title)
+
+	// После отзыва доступа поток завершается
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodDelete, "/v1/boards/"+board+"/share/"+member.ID.String(), nil)
+	for err == nil {
+		_, err = readStreamEvent(resumed)
+	}
+	assert.ErrorIs(t, err, io.EOF)
+
+	// Истекший курсор и чужая доска отклоняются до начала потока
+	expired := "1." + strconv.FormatInt(time.Now().Add(-30*24*time.Hour).Unix(), 10)
+	assert.Equal(t, http.StatusGone, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+board+"/events?since="+expired, nil).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodGet, "/v1/boards/"+board+"/events", nil).Code)
+}
+
+func TestE2E_BoardEventsLocks(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	task := newTask(api, owner.ID, columns[0], "Locked")
+
+	type locks struct {
+		Locks []struct {
+			TaskID string `json:"task_id"`
+			UserID string `json:"user_id"`
+		} `json:"locks"`
+	}
+	open := func() *bufio.Reader {
+		resp := api.Stream(owner.ID, "/v1/boards/"+board+"/events", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		timer := time.AfterFunc(10*time.Second, func() { resp.Body.Close() })
+		t.Cleanup(func() { timer.Stop() })
+		return bufio.NewReader(resp.Body)
+	}
+
+	// Взятая блокировка приходит событием
+	stream := open()
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task+"/lock", nil)
+	event, err := readStreamEvent(stream)
+	require.NoError(t, err)
+	var got locks
+	require.NoError(t, json.Unmarshal([]byte(event.data), &got))
+	require.Len(t, got.Locks, 1)
+	assert.Equal(t, task, got.Locks[0].TaskID)
+	assert.Equal(t, owner.ID.String(), got.Locks[0].UserID)
+
+	// Истечение блокировки не попадает в журнал изменений, но поток сообщает о нем сам
+	require.NoError(t, db.Model(&model.TaskLock{}).Where("task_id = ?", task).
+		Update("expires_at", time.Now().Add(time.Second)).Error)
+	stream = open()
+	event, err = readStreamEvent(stream)
+	require.NoError(t, err)
+	got = locks{}
+	require.NoError(t, json.Unmarshal([]byte(event.data), &got))
+	require.NotNil(t, got.Locks)
+	assert.Empty(t, got.Locks)
+}
+
+func TestE2E_ShareLinkRefreshesAccess(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Done")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "viewer"})
+
+	// Отказ зрителю кэширует его доступ к доске
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/tasks",
+		gin.H{"column_id": columns[0], "title": "Viewer task"}).Code)
+
+	var link struct {
+		Token string `json:"token"`
+		Role  string `json:"role"`
+	}
+	api.Expect(http.StatusCreated, &link, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share-links",
+		gin.H{"role": "editor", "max_uses": 1})
+	assert.Equal(t, "editor", link.Role)
+
+	var accepted struct {
+		Role string `json:"role"`
+	}
+	api.Expect(http.StatusOK, &accepted, member.ID, http.MethodPost, "/v1/share-links/"+link.Token+"/accept", nil)
+	assert.Equal(t, "editor", accepted.Role)
+
+	// Новая роль действует сразу, а не после истечения кэша
+	newTask(api, member.ID, columns[0], "Editor task")
+}
+
+// waitOperation polls an operation until it is finished and returns its result board
+func waitOperation(t *testing.T, api *testutil.API, user uuid.UUID, id string) string {
+	t.Helper()
+
+	var op struct {
+		Status        string   `json:"status"`
+		Errors        []string `json:"errors"`
+		ResultBoardID *string  `json:"result_board_id"`
+	}
+	require.Eventually(t, func() bool {
+		api.Expect(http.StatusOK, &op, user, http.MethodGet, "/v1/operations/"+id, nil)
+		return op.Status == model.OperationSucceeded || op.Status == model.OperationFailed
+	}, 10*time.Second, 20*time.Millisecond)
+	require.Equal(t, model.OperationSucceeded, op.Status, op.Errors)
+	require.NotNil(t, op.ResultBoardID)
+	return *op.ResultBoardID
+}
+
+type restructurePreview struct {
+	Columns       int   `json:"columns"`
+	Tasks         int64 `json:"tasks"`
+	LabelsCreated int   `json:"labels_created"`
+	SharesChanged int   `json:"shares_changed"`
+}
+
+func TestE2E_BoardSplit(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	board, columns := newBoard(api, owner.ID, "To Do", "Doing", "Done")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+board+"/share",
+		gin.H{"email": member.Email, "role": "editor"})
+	single := newTask(api, owner.ID, columns[0], "Single")
+	newTask(api, owner.ID, columns[0], "Stays")
+	newTask(api, owner.ID, columns[1], "First")
+	newTask(api, owner.ID, columns[1], "Second")
+
+	// Предпросмотр считает перенос колонки Doing и отдельной задачи, но ничего не меняет
+	split := gin.H{"title": "Split", "column_ids": []string{columns[1]}, "task_ids": []string{single}}
+	var preview restructurePreview
+	api.Expect(http.StatusOK, &preview, owner.ID, http.MethodPost, "/v1/boards/"+board+"/split",
+		gin.H{"title": "Split", "column_ids": []string{columns[1]}, "task_ids": []string{single}, "dry_run": true})
+	assert.Equal(t, restructurePreview{Columns: 2, Tasks: 3, SharesChanged: 1}, preview)
+	assert.Len(t, columnTasks(api, owner.ID, columns[0]), 2)
+	var boards []idResponse
+	api.Expect(http.StatusOK, &boards, owner.ID, http.MethodGet, "/v1/boards", nil)
+	assert.Len(t, boards, 1)
+
+	// Задачи другой доски выбрать нельзя
+	_, otherColumns := newBoard(api, owner.ID, "Elsewhere")
+	foreign := newTask(api, owner.ID, otherColumns[0], "Foreign")
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+board+"/split",
+		gin.H{"title": "Split", "task_ids": []string{foreign}, "dry_run": true}).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/boards/"+board+"/split", split).Code)
+	assert.Len(t, columnTasks(api, owner.ID, otherColumns[0]), 1)
+
+	var op idResponse
+	api.Expect(http.StatusAccepted, &op, owner.ID, http.MethodPost, "/v1/boards/"+board+"/split", split)
+	created := waitOperation(t, api, owner.ID, op.ID)
+
+	// Колонка Doing переехала целиком, отдельная задача - в новую колонку To Do
+	var moved []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	}
+	api.Expect(http.StatusOK, &moved, owner.ID, http.MethodGet, "/v1/boards/"+created+"/columns", nil)
+	require.Len(t, moved, 2)
+	assert.Equal(t, columns[1], moved[0].ID)
+	assert.Equal(t, "To Do", moved[1].Title)
+	assert.Len(t, columnTasks(api, owner.ID, columns[1]), 2)
+	tasks := columnTasks(api, owner.ID, moved[1].ID)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, single, tasks[0].ID)
+	assert.Len(t, columnTasks(api, owner.ID, columns[0]), 1)
+
+	// Участники исходной доски получили тот же доступ к новой
+	newTask(api, member.ID, columns[1], "Member task")
+}
+
+func TestE2E_BoardMerge(t *testing.T) {
+	api, db := newE2EServer(t)
+	owner := testutil.CreateUser(t, db, "owner")
+	member := testutil.CreateUser(t, db, "member")
+
+	target, targetColumns := newBoard(api, owner.ID, "To Do", "Done")
+	source, sourceColumns := newBoard(api, owner.ID, "Backlog", "Shipped")
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/boards/"+source+"/share",
+		gin.H{"email": member.Email, "role": "editor"})
+
+	var targetBug idResponse
+	api.Expect(http.StatusCreated, &targetBug, owner.ID, http.MethodPost, "/v1/labels",
+		gin.H{"board_id": target, "name": "bug", "color": "#ff0000"})
+	var sourceLabels []idResponse
+	for _, name := range []string{"bug", "ops"} {
+		var label idResponse
+		api.Expect(http.StatusCreated, &label, owner.ID, http.MethodPost, "/v1/labels",
+			gin.H{"board_id": source, "name": name, "color": "#00ff00"})
+		sourceLabels = append(sourceLabels, label)
+	}
+
+	// Задача из шаблона с чек-листом и обеими метками исходной доски
+	var template idResponse
+	api.Expect(http.StatusCreated, &template, owner.ID, http.MethodPost, "/v1/boards/"+source+"/task-templates",
+		gin.H{"name": "Bug", "title_pattern": "Bug: ", "checklist": []string{"Reproduce", "Fix"}})
+	var task idResponse
+	api.Expect(http.StatusCreated, &task, owner.ID, http.MethodPost, "/v1/tasks/from-template/"+template.ID,
+		gin.H{"column_id": sourceColumns[0], "title": "Bug: login"})
+	for _, label := range sourceLabels {
+		api.Expect(http.StatusOK, nil, owner.ID, http.MethodPost, "/v1/tasks/"+task.ID+"/labels/"+label.ID, nil)
+	}
+
+	// Предпросмотр: метка bug совпадает по названию, ops создается, участник получает доступ
+	var preview restructurePreview
+	api.Expect(http.StatusOK, &preview, owner.ID, http.MethodPost, "/v1/boards/"+target+"/merge",
+		gin.H{"source_board_id": source, "dry_run": true})
+	assert.Equal(t, restructurePreview{Columns: 2, Tasks: 1, LabelsCreated: 1, SharesChanged: 1}, preview)
+	api.Expect(http.StatusOK, nil, owner.ID, http.MethodGet, "/v1/boards/"+source, nil)
+	assert.Len(t, columnTasks(api, owner.ID, sourceColumns[0]), 1)
+
+	// Доску нельзя слить с ней самой или с доской другого владельца
+	assert.Equal(t, http.StatusBadRequest, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+target+"/merge",
+		gin.H{"source_board_id": target}).Code)
+	foreign, _ := newBoard(api, member.ID, "Foreign")
+	assert.Equal(t, http.StatusForbidden, api.Do(owner.ID, http.MethodPost, "/v1/boards/"+target+"/merge",
+		gin.H{"source_board_id": foreign}).Code)
+	assert.Equal(t, http.StatusForbidden, api.Do(member.ID, http.MethodPost, "/v1/boards/"+foreign+"/merge",
+		gin.H{"source_board_id": source}).Code)
+	api.Expect(http.StatusOK, nil, member.ID, http.MethodGet, "/v1/boards/"+foreign, nil)
+
+	var op idResponse
+	api.Expect(http.StatusAccepted, &op, owner.ID, http.MethodPost, "/v1/boards/"+target+"/merge",
+		gin.H{"source_board_id": source})
+	assert.Equal(t, target, waitOperation(t, api, owner.ID, op.ID))
+	assert.Equal(t, http.StatusNotFound, api.Do(owner.ID, http.MethodGet, "/v1/boards/"+source, nil).Code)
+
+	var columns []idResponse
+	api.Expect(http.StatusOK, &columns, owner.ID, http.MethodGet, "/v1/boards/"+target+"/columns", nil)
+	assert.Equal(t, []idResponse{{targetColumns[0]}, {targetColumns[1]}, {sourceColumns[0]}, {sourceColumns[1]}}, columns)
+
+	// Метки задачи - метки целевой доски, чек-лист остался в описании
+	var merged struct {
+		Description string `json:"description"`
+		Labels      []struct {
+			ID      string  `json:"id"`
+			Name    string  `json:"name"`
+			BoardID *string `json:"board_id"`
+		} `json:"labels"`
+	}
+	api.Expect(http.StatusOK, &merged, owner.ID, http.MethodGet, "/v1/tasks/"+task.ID, nil)
+	assert.Contains(t, merged.Description, "- [ ] Reproduce\n- [ ] Fix")
+	labels := map[string]string{}
+	for _, label := range merged.Labels {
+		require.NotNil(t, label.BoardID)
+		assert.Equal(t, target, *label.BoardID)
+		labels[label.Name] = label.ID
+	}
+	require.Len(t, labels, 2)
+	assert.Equal(t, targetBug.ID, labels["bug"])
+	assert.NotEqual(t, sourceLabels[1].ID, labels["ops"])
+
+	// Участник исходной доски получил доступ к целевой
+	newTask(api, member.ID, sourceColumns[0], "Member task")
+}
@@ -2,45 +2,308 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
+	"kanban/docs"
+	"kanban/internal/apiversion"
 	"kanban/internal/config"
+	"kanban/internal/database"
+	"kanban/internal/errorreport"
 	"kanban/internal/handler"
+	"kanban/internal/idgen"
+	"kanban/internal/jobs"
+	"kanban/internal/limits"
+	"kanban/internal/mail"
 	"kanban/internal/middleware"
+	"kanban/internal/migration"
+	"kanban/internal/model"
+	"kanban/internal/monitor"
+	"kanban/internal/oauth"
+	"kanban/internal/openapi"
+	"kanban/internal/permission"
+	"kanban/internal/ratelimit"
+	"kanban/internal/report"
 	"kanban/internal/repository"
+	"kanban/internal/seed"
+	"kanban/internal/settings"
+	"kanban/internal/tracing"
 )
 
+// LegacyRoutesDeprecatedAt is when the unversioned routes were deprecated in favour of /v1
+var LegacyRoutesDeprecatedAt = time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+
 type Server struct {
 	Engine *gin.Engine
 	DB     *gorm.DB
 	Config *config.Config
+	// Compactor is nil when background position compaction is disabled
+	Compactor *jobs.PositionCompactor
+	// MetricsRollup is nil when the nightly metrics roll-up is disabled
+	MetricsRollup *jobs.MetricsRollup
+	ChangePruner  *jobs.ChangePruner
+	// AuditRetention is nil when the activity log is kept forever
+	AuditRetention *jobs.AuditRetention
+	// TaskArchiver is nil when completed tasks are not archived
+	TaskArchiver *jobs.TaskArchiver
+	Outbox        *jobs.OutboxDispatcher
+	// Digests is nil when email digests are disabled
+	Digests      *jobs.DigestSender
+	Descriptions *jobs.DescriptionSaver
+	Queue         *jobs.Queue
+	// Monitor is nil when anomaly alerts are disabled
+	Monitor *monitor.Monitor
+	// Tracing is nil when tracing is disabled
+	Tracing *sdktrace.TracerProvider
+	// Sentry is nil when errors are only reported to the log
+	Sentry   *errorreport.SentryReporter
+	Settings *settings.Store
+	Streams  *handler.EventStreams
 }
 
-func Init(cfg *config.Config) (*Server, error) {
-	// Setup GORM
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName,
-	)
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+func openDB(cfg *config.Config) (*gorm.DB, error) {
+	dsn := cfg.DatabaseDSN()
+	if cfg.DBDriver == database.DriverSQLite {
+		dsn = cfg.DBPath
+	}
+	dialector, err := database.Open(cfg.DBDriver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("❌ invalid database configuration: %w", err)
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{})
 	if err != nil {
 		return nil, fmt.Errorf("❌ failed to connect to DB: %w", err)
 	}
+	if err := idgen.Register(db, cfg.IDStrategy); err != nil {
+		return nil, fmt.Errorf("❌ failed to set up ID generation: %w", err)
+	}
 	log.Println("✅ Connected to database")
+	return db, nil
+}
+
+// Migrate runs a migrate subcommand (up, down [N], version, force V) against the configured database
+func Migrate(cfg *config.Config, args []string) error {
+	db, err := openDB(cfg)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("❌ failed to access DB connection: %w", err)
+	}
+	defer sqlDB.Close()
+
+	return migration.Run(sqlDB, cfg.DBDriver, args)
+}
+
+// Admin grants or revokes the administrator role of a user by email: admin grant|revoke EMAIL
+func Admin(cfg *config.Config, args []string) error {
+	if len(args) != 2 || (args[0] != "grant" && args[0] != "revoke") {
+		return errors.New("usage: admin grant|revoke EMAIL")
+	}
+
+	db, err := openDB(cfg)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("❌ failed to access DB connection: %w", err)
+	}
+	defer sqlDB.Close()
+
+	found, err := repository.NewUserRepository(db).SetAdmin(context.Background(), args[1], args[0] == "grant")
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("no user with email %s", args[1])
+	}
+	return nil
+}
+
+// Seed creates the users and boards of a fixture file for development and demos: seed FILE
+func Seed(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: seed FILE")
+	}
+	fixture, err := seed.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	db, err := openDB(cfg)
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("❌ failed to access DB connection: %w", err)
+	}
+	defer sqlDB.Close()
+
+	seeder := seed.NewSeeder(
+		repository.NewUserRepository(db),
+		repository.NewBoardRepository(db),
+		repository.NewBoardShareRepository(db),
+		repository.NewBoardTemplateRepository(db),
+		repository.NewTxManager(db),
+	)
+	result, err := seeder.Apply(context.Background(), fixture)
+	if err != nil {
+		return err
+	}
+	log.Printf("✅ Seeded %d users and %d boards from %s", result.Users, result.Boards, args[0])
+	return nil
+}
+
+func Init(cfg *config.Config) (*Server, error) {
+	// Setup tracing before anything makes outbound calls
+	var tracerProvider *sdktrace.TracerProvider
+	if cfg.TracingEnabled {
+		var err error
+		tracerProvider, err = tracing.Setup(context.Background(), tracing.Config{
+			ServiceName: cfg.TracingServiceName,
+			Endpoint:    cfg.TracingEndpoint,
+			SampleRatio: float64(cfg.TracingSamplePercent) / 100,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("❌ failed to set up tracing: %w", err)
+		}
+		log.Printf("✅ Exporting traces to %s", cfg.TracingEndpoint)
+	}
+
+	// Setup GORM
+	db, err := openDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.TracingEnabled {
+		if err := repository.RegisterTracing(db, tracing.Tracer("kanban/repository")); err != nil {
+			return nil, fmt.Errorf("❌ failed to set up query tracing: %w", err)
+		}
+	}
+
+	// Listings marked with replicaReads are served by the read replica when one is configured
+	replicaReads := func(c *gin.Context) { c.Next() }
+	if cfg.DBReplicaDSN != "" {
+		if database.IsSQLite(db) {
+			return nil, errors.New("❌ DB_REPLICA_DSN needs a PostgreSQL database")
+		}
+		replica, err := gorm.Open(postgres.Open(cfg.DBReplicaDSN), &gorm.Config{})
+		if err != nil {
+			return nil, fmt.Errorf("❌ failed to connect to DB replica: %w", err)
+		}
+		if err := repository.RegisterReplica(db, replica.ConnPool); err != nil {
+			return nil, fmt.Errorf("❌ failed to set up DB replica: %w", err)
+		}
+		replicaReads = func(c *gin.Context) {
+			c.Request = c.Request.WithContext(repository.ReadFromReplica(c.Request.Context()))
+			c.Next()
+		}
+		log.Println("✅ Connected to database replica")
+	}
+
+	if cfg.MigrateOnStartup {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, fmt.Errorf("❌ failed to access DB connection: %w", err)
+		}
+		if err := migration.Up(sqlDB, cfg.DBDriver); err != nil {
+			return nil, fmt.Errorf("❌ failed to apply migrations: %w", err)
+		}
+	}
+
+	// Setup error reporting
+	var reporter errorreport.Reporter = errorreport.LogReporter{}
+	var sentryReporter *errorreport.SentryReporter
+	if cfg.ErrorReportingDSN != "" {
+		sentryReporter, err = errorreport.NewSentryReporter(cfg.ErrorReportingDSN, cfg.ErrorReportingEnvironment)
+		if err != nil {
+			return nil, fmt.Errorf("❌ failed to set up error reporting: %w", err)
+		}
+		reporter = sentryReporter
+	}
 
 	// Setup Gin
-	r := gin.Default()
+	r := gin.New()
+	// Клиентский IP, по которому работают лимиты, берётся из X-Forwarded-For только от доверенных прокси
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("❌ invalid TRUSTED_PROXIES: %w", err)
+	}
+	r.Use(gin.Logger())
+	r.Use(middleware.RequestID())
+	if cfg.TracingEnabled {
+		r.Use(middleware.Trace(tracing.Tracer("kanban/http")))
+	}
+	corsConfig := middleware.CORSConfig{
+		AllowedOrigins:   cfg.CORSAllowedOrigins,
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   cfg.CORSAllowedHeaders,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		MaxAge:           cfg.CORSMaxAge,
+	}
+	if err := corsConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("❌ invalid CORS configuration: %w", err)
+	}
+	r.Use(middleware.CORSMiddleware(corsConfig))
+	if cfg.CompressionEnabled {
+		r.Use(middleware.Compress(middleware.CompressionConfig{
+			MinSize:      cfg.CompressionMinBytes,
+			ContentTypes: cfg.CompressionContentTypes,
+		}))
+	}
+
+	// Setup anomaly alerts
+	counters := monitor.NewCounters()
+	var anomalyMonitor *monitor.Monitor
+	if cfg.AlertsEnabled && cfg.AlertIntervalSec > 0 {
+		var notifier monitor.Notifier = monitor.LogNotifier{}
+		if cfg.AlertSlackWebhookURL != "" {
+			notifier = monitor.NewSlackNotifier(cfg.AlertSlackWebhookURL)
+		}
+		anomalyMonitor = monitor.NewMonitor(counters, notifier, monitor.Thresholds{
+			ErrorRate:     float64(cfg.AlertErrorRatePercent) / 100,
+			MinRequests:   int64(cfg.AlertMinRequests),
+			LoginFailures: int64(cfg.AlertLoginFailures),
+		}, time.Duration(cfg.AlertIntervalSec)*time.Second, time.Duration(cfg.AlertCooldownMin)*time.Minute)
+		r.Use(middleware.MonitorResponses(counters))
+	}
+	// Inside the middleware above, so that recovered panics are traced and counted as 500s
+	r.Use(middleware.Recover(reporter))
+
+	// Request deadlines and body limits; the routes in the handler tables get their own
+	requestTimeout := middleware.Timeout(time.Duration(cfg.RequestTimeoutSec)*time.Second, handler.RequestTimeouts)
+	bodyLimit := middleware.LimitBody(cfg.MaxRequestBodyBytes, handler.BodyLimits)
+
+	// Setup request validation against the API document generated from the handler annotations
+	apiDoc := []byte(docs.SwaggerInfo.ReadDoc())
+	validateRequests := func(c *gin.Context) { c.Next() }
+	if cfg.RequestValidationEnabled {
+		spec, err := openapi.Load(apiDoc)
+		if err != nil {
+			return nil, fmt.Errorf("❌ failed to load API document: %w", err)
+		}
+		validateRequests = middleware.ValidateRequest(spec)
+	}
 
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
@@ -49,72 +312,583 @@ func Init(cfg *config.Config) (*Server, error) {
 	columnRepo := repository.NewColumnRepository(db)
 	taskRepo := repository.NewTaskRepository(db)
 	labelRepo := repository.NewLabelRepository(db)
+	taskRefRepo := repository.NewTaskReferenceRepository(db)
+	pinRepo := repository.NewPinnedTaskRepository(db)
+	boardTemplateRepo := repository.NewBoardTemplateRepository(db)
+	identityRepo := repository.NewUserIdentityRepository(db)
+	boardViewRepo := repository.NewBoardViewRepository(db)
+	prefsRepo := repository.NewUserBoardPrefsRepository(db)
+	operationRepo := repository.NewOperationRepository(db)
+	mentionRepo := repository.NewTaskMentionRepository(db)
+	notificationSettingRepo := repository.NewNotificationSettingRepository(db)
+	relationRepo := repository.NewTaskRelationRepository(db)
+	shareLinkRepo := repository.NewShareLinkRepository(db)
+	guestLinkRepo := repository.NewGuestLinkRepository(db)
+	swimlaneRepo := repository.NewSwimlaneRepository(db)
+	sprintRepo := repository.NewSprintRepository(db)
+	taskTemplateRepo := repository.NewTaskTemplateRepository(db)
+	taskLockRepo := repository.NewTaskLockRepository(db)
+	descriptionDocRepo := repository.NewDescriptionDocRepository(db)
+	analyticsRepo := repository.NewAnalyticsRepository(db)
+	statusPageRepo := repository.NewStatusPageRepository(db)
+	importRepo := repository.NewTaskImportRepository(db)
+	receiptRepo := repository.NewReadReceiptRepository(db)
+	githubRepo := repository.NewGitHubRepository(db)
+	changeRepo := repository.NewTaskChangeRepository(db)
+	actionRepo := repository.NewActionRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	boardChangeRepo := repository.NewBoardChangeRepository(db)
+
+	txManager := repository.NewTxManager(db)
+
+	// Redis backs rate limiting and the permission cache when configured for them
+	var redisClient *redis.Client
+	if cfg.RateLimitBackend == "redis" || cfg.PermissionCacheBackend == "redis" {
+		redisClient = redis.NewClient(&redis.Options{
+			Addr:     cfg.RedisAddr,
+			Password: cfg.RedisPassword,
+			DB:       cfg.RedisDB,
+		})
+	}
+
+	// Setup the permission cache of task requests
+	var permissionCache permission.Cache
+	switch cfg.PermissionCacheBackend {
+	case "redis":
+		permissionCache = permission.NewRedisCache(redisClient, "permission:")
+	case "memory":
+		permissionCache = permission.NewMemoryCache()
+	case "off":
+	default:
+		return nil, fmt.Errorf("❌ unknown permission cache backend %q", cfg.PermissionCacheBackend)
+	}
+	perms := permission.NewService(
+		boardRepo, columnRepo, boardShareRepo, userRepo, permissionCache, time.Duration(cfg.PermissionCacheTTLSec)*time.Second,
+	)
+
+	// Runtime settings start from the configuration and apply the overrides of administrators
+	defaultFlags := make(map[string]bool, len(cfg.FeatureFlags))
+	for _, flag := range cfg.FeatureFlags {
+		defaultFlags[flag] = true
+	}
+	rateLimits := settings.RateLimits{
+		Auth:      ratelimit.Limit{PerMinute: cfg.RateLimitAuthPerMin, Burst: cfg.RateLimitAuthBurst},
+		API:       ratelimit.Limit{PerMinute: cfg.RateLimitAPIPerMin, Burst: cfg.RateLimitAPIBurst},
+		Export:    ratelimit.Limit{PerHour: cfg.RateLimitExportPerHour, Burst: cfg.RateLimitExportBurst},
+		Clone:     ratelimit.Limit{PerHour: cfg.RateLimitClonePerHour, Burst: cfg.RateLimitCloneBurst},
+		Analytics: ratelimit.Limit{PerMinute: cfg.RateLimitAnalyticsPerMin, Burst: cfg.RateLimitAnalyticsBurst},
+	}
+	if cfg.RateLimitEnabled {
+		// Отключать лимиты нужно через RATE_LIMIT_ENABLED, а не нулевыми значениями
+		if err := rateLimits.Validate(); err != nil {
+			return nil, fmt.Errorf("❌ invalid rate limit configuration: %w", err)
+		}
+	}
+	settingsStore := settings.NewStore(settings.Settings{
+		RateLimits: rateLimits,
+		Limits: limits.Limits{
+			Boards:          cfg.MaxBoardsPerUser,
+			ColumnsPerBoard: cfg.MaxColumnsPerBoard,
+			TasksPerColumn:  cfg.MaxTasksPerColumn,
+		},
+		FeatureFlags:  defaultFlags,
+		DefaultLabels: []settings.DefaultLabel{},
+	}, repository.NewSettingRepository(db), time.Duration(cfg.SettingsRefreshSec)*time.Second)
+	if err := settingsStore.Reload(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to load runtime settings, using the configured ones: %v", err)
+	}
+
+	limitService := limits.NewReloadableService(func() limits.Limits {
+		return settingsStore.Get().Limits
+	}, userRepo)
 
 	// Initialize handlers
-	userHandler := handler.NewUserHandler(userRepo)
-	boardHandler := handler.NewBoardHandler(boardRepo, boardShareRepo)
-	boardShareHandler := handler.NewBoardShareHandler(boardRepo, userRepo, boardShareRepo)
-	columnHandler := handler.NewColumnHandler(columnRepo, boardRepo, boardShareRepo)
-	taskHandler := handler.NewTaskHandler(taskRepo, columnRepo, boardRepo, boardShareRepo, userRepo)
-	labelHandler := handler.NewLabelHandler(labelRepo, boardRepo, boardShareRepo)
+	userHandler := handler.NewUserHandler(userRepo, boardRepo, boardTemplateRepo, txManager, limitService, cfg.OnboardingSampleBoard, cfg.JWTSecret)
+	queue := jobs.NewQueue(cfg.JobWorkers, cfg.JobQueueSize)
+	boardHandler := handler.NewBoardHandler(boardRepo, boardShareRepo, columnRepo, labelRepo, boardViewRepo, prefsRepo, userRepo, txManager, outboxRepo, perms, limitService, queue, func() []model.Label {
+		defaults := settingsStore.Get().DefaultLabels
+		labels := make([]model.Label, len(defaults))
+		for i, label := range defaults {
+			labels[i] = model.Label{Name: label.Name, Color: label.Color}
+		}
+		return labels
+	})
+	boardShareHandler := handler.NewBoardShareHandler(boardRepo, userRepo, boardShareRepo, taskRepo, pinRepo, boardViewRepo, prefsRepo, notificationSettingRepo, receiptRepo, txManager, perms, outboxRepo)
+	shareLinkHandler := handler.NewShareLinkHandler(boardRepo, shareLinkRepo, outboxRepo, txManager, perms)
+	guestLinkHandler := handler.NewGuestLinkHandler(boardRepo, userRepo, boardShareRepo, guestLinkRepo, txManager, perms, outboxRepo, cfg.JWTSecret)
+	archivedTaskRepo := repository.NewArchivedTaskRepository(db)
+	var taskArchiver *jobs.TaskArchiver
+	if cfg.TaskArchiveDir != "" {
+		var err error
+		taskArchiver, err = jobs.NewTaskArchiver(archivedTaskRepo, jobs.TaskArchiveConfig{
+			After:     time.Duration(cfg.TaskArchiveAfterDays) * 24 * time.Hour,
+			Dir:       cfg.TaskArchiveDir,
+			BatchSize: cfg.TaskArchiveBatchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("❌ invalid task archive: %w", err)
+		}
+	}
+	columnHandler := handler.NewColumnHandler(columnRepo, boardRepo, boardShareRepo, taskRepo, labelRepo, relationRepo, prefsRepo, txManager, perms, limitService)
+	taskHandler := handler.NewTaskHandler(taskRepo, columnRepo, boardRepo, boardShareRepo, userRepo, labelRepo, taskRefRepo, mentionRepo, relationRepo, githubRepo, changeRepo, boardChangeRepo, actionRepo, outboxRepo, txManager, taskTemplateRepo, taskLockRepo, descriptionDocRepo, perms, limitService, archivedTaskRepo, taskArchiver)
+	labelHandler := handler.NewLabelHandler(labelRepo, boardRepo, boardShareRepo, perms, outboxRepo, txManager)
+	mentionHandler := handler.NewMentionHandler(mentionRepo, notificationSettingRepo)
+	taskRelationHandler := handler.NewTaskRelationHandler(relationRepo, taskRepo, boardShareRepo, perms)
+	changeRetention := time.Duration(cfg.BoardChangeRetentionHours) * time.Hour
+	eventStreams := handler.NewEventStreams()
+	boardChangeHandler := handler.NewBoardChangeHandler(
+		boardChangeRepo, boardShareRepo, columnRepo, taskRepo, labelRepo, relationRepo, prefsRepo, taskLockRepo, txManager, perms,
+		eventStreams, changeRetention,
+	)
+	sprintHandler := handler.NewSprintHandler(sprintRepo, taskRepo, perms)
+	taskTemplateHandler := handler.NewTaskTemplateHandler(taskTemplateRepo, labelRepo, perms)
+	swimlaneHandler := handler.NewSwimlaneHandler(swimlaneRepo, columnRepo, taskRepo, relationRepo, prefsRepo, boardChangeRepo, perms)
+	pinnedTaskHandler := handler.NewPinnedTaskHandler(pinRepo, taskRepo, columnRepo, boardShareRepo)
+	statusPageHandler := handler.NewStatusPageHandler(statusPageRepo, boardRepo, columnRepo, taskRepo, boardChangeRepo)
+	calendarHandler := handler.NewCalendarHandler(userRepo, boardRepo, boardShareRepo, taskRepo)
+	githubHandler := handler.NewGitHubHandler(githubRepo, boardRepo, columnRepo, taskRepo)
+	readReceiptHandler := handler.NewReadReceiptHandler(receiptRepo, boardRepo, boardShareRepo, columnRepo, userRepo, outboxRepo, txManager, perms)
+	notificationSettingsHandler := handler.NewNotificationSettingsHandler(notificationSettingRepo, boardRepo)
+	adminHandler := handler.NewAdminHandler(userRepo, boardRepo, boardShareRepo, columnRepo, labelRepo, txManager, perms)
+	limitsHandler := handler.NewLimitsHandler(limitService, boardRepo, columnRepo, taskRepo, perms)
+	settingsHandler := handler.NewSettingsHandler(settingsStore)
+	auditHandler := handler.NewAuditHandler(changeRepo)
+
+	// Setup OAuth providers
+	var oauthProviders []oauth.Provider
+	if cfg.GoogleClientID != "" {
+		oauthProviders = append(oauthProviders, oauth.NewGoogle(
+			cfg.GoogleClientID, cfg.GoogleClientSecret, cfg.OAuthRedirectBaseURL+"/auth/oauth/google/callback",
+		))
+	}
+	if cfg.GitHubClientID != "" {
+		oauthProviders = append(oauthProviders, oauth.NewGitHub(
+			cfg.GitHubClientID, cfg.GitHubClientSecret, cfg.OAuthRedirectBaseURL+"/auth/oauth/github/callback",
+		))
+	}
+	accountHandler := handler.NewAccountHandler(
+		userRepo, identityRepo, boardRepo, boardShareRepo, boardViewRepo,
+		columnRepo, taskRepo, labelRepo, pinRepo, txManager, perms, limitService,
+	)
+	standupHandler := handler.NewStandupHandler(taskRepo, columnRepo, boardRepo, boardShareRepo)
+	var pdfRenderer report.PDFRenderer
+	if cfg.ReportPDFRendererURL != "" {
+		pdfRenderer = report.NewHTTPRenderer(cfg.ReportPDFRendererURL)
+	}
+	reportHandler := handler.NewReportHandler(taskRepo, columnRepo, boardRepo, boardShareRepo, pdfRenderer)
+	analyticsHandler := handler.NewAnalyticsHandler(analyticsRepo, columnRepo, boardRepo, boardShareRepo)
+	// Operations queued before a restart were lost with the old process
+	if failed, err := operationRepo.FailUnfinished(context.Background(), "Interrupted by server restart"); err != nil {
+		log.Printf("⚠️  Failed to close unfinished operations: %v", err)
+	} else if failed > 0 {
+		log.Printf("⚠️  Marked %d unfinished operations as failed", failed)
+	}
+	// Their imports keep the parts not imported yet and can be committed again
+	if reopened, err := importRepo.ReopenInterrupted(context.Background()); err != nil {
+		log.Printf("⚠️  Failed to reopen interrupted imports: %v", err)
+	} else if reopened > 0 {
+		log.Printf("⚠️  Reopened %d interrupted imports", reopened)
+	}
+	duplicator := jobs.NewBoardDuplicator(
+		boardRepo, columnRepo, taskRepo, labelRepo, userRepo, operationRepo, txManager, limitService,
+	)
+	restructurer := jobs.NewBoardRestructurer(
+		boardRepo, boardShareRepo, columnRepo, taskRepo, labelRepo, relationRepo, userRepo, operationRepo, txManager, perms, limitService,
+	)
+	importer := jobs.NewTaskImporter(importRepo, boardRepo, columnRepo, labelRepo, taskRepo, operationRepo, txManager, limitService)
+	features := func() map[string]bool {
+		features := map[string]bool{
+			"sample_board": cfg.OnboardingSampleBoard,
+			"oauth_google": cfg.GoogleClientID != "",
+			"oauth_github": cfg.GitHubClientID != "",
+		}
+		for flag, enabled := range settingsStore.Get().FeatureFlags {
+			features[flag] = enabled
+		}
+		return features
+	}
+	bootstrapHandler := handler.NewBootstrapHandler(userRepo, boardRepo, boardShareRepo, features)
+	operationHandler := handler.NewOperationHandler(operationRepo, boardRepo, boardShareRepo, duplicator, restructurer, queue, limitService)
+	importHandler := handler.NewImportHandler(importRepo, boardRepo, boardShareRepo, columnRepo, labelRepo, operationRepo, taskRepo, userRepo, txManager, importer, queue, limitService)
+	oauthHandler := handler.NewOAuthHandler(userRepo, identityRepo, userHandler, oauthProviders, cfg.OAuthSuccessRedirectURL)
+
+	// Setup background jobs
+	var compactor *jobs.PositionCompactor
+	if cfg.CompactionEnabled && cfg.CompactionIntervalMin > 0 {
+		compactor = jobs.NewPositionCompactor(taskRepo, columnRepo, jobs.CompactionConfig{
+			Interval:        time.Duration(cfg.CompactionIntervalMin) * time.Minute,
+			WindowStartHour: cfg.CompactionWindowStart,
+			WindowEndHour:   cfg.CompactionWindowEnd,
+			BatchSize:       cfg.CompactionBatchSize,
+		})
+	}
+	var metricsRollup *jobs.MetricsRollup
+	if cfg.MetricsRollupEnabled && cfg.MetricsRollupIntervalMin > 0 {
+		metricsRollup = jobs.NewMetricsRollup(analyticsRepo, txManager, jobs.MetricsRollupConfig{
+			Interval:        time.Duration(cfg.MetricsRollupIntervalMin) * time.Minute,
+			WindowStartHour: cfg.MetricsWindowStart,
+			WindowEndHour:   cfg.MetricsWindowEnd,
+			BackfillDays:    cfg.MetricsBackfillDays,
+		})
+	}
+
+	changePruner := jobs.NewChangePruner(boardChangeRepo, changeRetention)
+	var auditRetention *jobs.AuditRetention
+	if cfg.AuditRetentionDays > 0 {
+		var err error
+		auditRetention, err = jobs.NewAuditRetention(changeRepo, jobs.AuditRetentionConfig{
+			Retention:  time.Duration(cfg.AuditRetentionDays) * 24 * time.Hour,
+			ArchiveDir: cfg.AuditArchiveDir,
+			BatchSize:  cfg.AuditRetentionBatchSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("❌ invalid activity log retention: %w", err)
+		}
+	}
+	descriptionSaver := jobs.NewDescriptionSaver(descriptionDocRepo, taskRepo, changeRepo, txManager, jobs.DescriptionSaverConfig{
+		Interval:  time.Duration(cfg.DescriptionSaveIntervalSec) * time.Second,
+		IdleAfter: handler.DescriptionSaveInterval,
+		BatchSize: cfg.DescriptionSaveBatchSize,
+	})
+
+	// Setup delivery of outbox events
+	var publisher jobs.Publisher = jobs.LogPublisher{}
+	if cfg.OutboxWebhookURL != "" {
+		publisher = jobs.NewWebhookPublisher(cfg.OutboxWebhookURL, cfg.OutboxWebhookSecret)
+	}
+	outbox := jobs.NewOutboxDispatcher(outboxRepo, txManager, publisher, jobs.OutboxConfig{
+		Interval:  time.Duration(cfg.OutboxIntervalSec) * time.Second,
+		BatchSize: cfg.OutboxBatchSize,
+	})
+
+	// Setup email digests
+	var digests *jobs.DigestSender
+	if cfg.DigestEnabled && cfg.DigestIntervalMin > 0 {
+		var mailer mail.Mailer = mail.LogMailer{}
+		if cfg.SMTPAddr != "" {
+			mailer = mail.NewSMTPMailer(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom)
+		}
+		digests = jobs.NewDigestSender(notificationSettingRepo, taskRepo, changeRepo, mentionRepo, mailer, jobs.DigestConfig{
+			Interval:  time.Duration(cfg.DigestIntervalMin) * time.Minute,
+			Hour:      cfg.DigestHour,
+			BatchSize: cfg.DigestBatchSize,
+		})
+	}
+
+	// Setup rate limiting; limiters follow changes of the runtime settings
+	var newLimiter func(limit ratelimit.Limit, prefix string) ratelimit.Limiter
+	switch cfg.RateLimitBackend {
+	case "redis":
+		newLimiter = func(limit ratelimit.Limit, prefix string) ratelimit.Limiter {
+			return ratelimit.NewRedisLimiter(redisClient, limit, prefix)
+		}
+	case "memory":
+		newLimiter = func(limit ratelimit.Limit, _ string) ratelimit.Limiter {
+			return ratelimit.NewMemoryLimiter(limit)
+		}
+	default:
+		return nil, fmt.Errorf("❌ unknown rate limit backend %q", cfg.RateLimitBackend)
+	}
+	reloadableLimiter := func(limit func(settings.RateLimits) ratelimit.Limit, prefix string) *ratelimit.Reloadable {
+		return ratelimit.NewReloadable(func() ratelimit.Limit {
+			return limit(settingsStore.Get().RateLimits)
+		}, func(limit ratelimit.Limit) ratelimit.Limiter {
+			return newLimiter(limit, prefix)
+		})
+	}
+	authLimit := func(l settings.RateLimits) ratelimit.Limit { return l.Auth }
+	apiLimit := func(l settings.RateLimits) ratelimit.Limit { return l.API }
+	authLimiter := reloadableLimiter(authLimit, "ratelimit:auth:")
+	apiLimiter := reloadableLimiter(apiLimit, "ratelimit:api:")
+	statusLimiter := reloadableLimiter(apiLimit, "ratelimit:status:")
+	feedLimiter := reloadableLimiter(apiLimit, "ratelimit:feed:")
+	webhookLimiter := reloadableLimiter(apiLimit, "ratelimit:webhook:")
+
+	// Expensive operations get their own, tighter per-user buckets on top of the API limit
+	operationLimit := func(operation string, limit func(settings.RateLimits) ratelimit.Limit) gin.HandlerFunc {
+		if !cfg.RateLimitEnabled {
+			return func(c *gin.Context) { c.Next() }
+		}
+		limiter := reloadableLimiter(limit, "ratelimit:"+operation+":")
+		return middleware.RateLimitOperation(limiter, operation, limiter.Limit)
+	}
+	exportLimit := operationLimit("export", func(l settings.RateLimits) ratelimit.Limit { return l.Export })
+	cloneLimit := operationLimit("clone", func(l settings.RateLimits) ratelimit.Limit { return l.Clone })
+	// Accepting invitation links is throttled like logins to make token guessing pointless
+	inviteLimit := operationLimit("invite", authLimit)
+	analyticsLimit := operationLimit("analytics", func(l settings.RateLimits) ratelimit.Limit { return l.Analytics })
 
 	// Setup Swagger
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	r.GET("/openapi.json", func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", apiDoc)
+	})
+
+	// Routes are registered once under /v1 and once more without a prefix for clients from before
+	// versioning. A future /v2 gets its own group here; the older versions then map its responses
+	// back to their shape with apiversion mappers, so handlers are not forked.
+	registerRoutes := func(api *gin.RouterGroup) {
+		// Public routes
+		public := api.Group("/")
+		if cfg.RateLimitEnabled {
+			public.Use(middleware.RateLimitByIP(authLimiter))
+		}
+		public.POST("/register", userHandler.Register)
+		public.POST("/login", middleware.CountLoginFailures(counters), userHandler.Login)
+		public.GET("/auth/oauth/:provider", oauthHandler.Login)
+		public.GET("/auth/oauth/:provider/callback", oauthHandler.Callback)
+		public.POST("/guest-links/:token/session", guestLinkHandler.StartSession)
+
+		// Public status pages are read by anonymous visitors and get the API limit per IP
+		status := api.Group("/status")
+		if cfg.RateLimitEnabled {
+			status.Use(middleware.RateLimitByIP(statusLimiter))
+		}
+		status.GET("/:slug", statusPageHandler.GetPublic)
+		status.GET("/:slug/html", statusPageHandler.GetPublicHTML)
+
+		// Calendar feeds authenticate with the token in their URL since calendar apps cannot log in
+		feeds := api.Group("/")
+		if cfg.RateLimitEnabled {
+			feeds.Use(middleware.RateLimitByIP(feedLimiter))
+		}
+		feeds.GET("/boards/:id/calendar.ics", calendarHandler.GetBoardFeed)
+		feeds.GET("/me/calendar.ics", calendarHandler.GetMyFeed)
+
+		// GitHub webhooks authenticate with the signature of the payload
+		webhooks := api.Group("/webhooks")
+		if cfg.RateLimitEnabled {
+			webhooks.Use(middleware.RateLimitByIP(webhookLimiter))
+		}
+		webhooks.POST("/github/:board_id", githubHandler.Webhook)
 
-	// Public routes
-	r.POST("/register", userHandler.Register)
-	r.POST("/login", userHandler.Login)
-
-	// Protected routes - require authentication
-	authorized := r.Group("/")
-	authorized.Use(middleware.JWTAuthMiddleware(cfg.JWTSecret))
-	{
-		// Board routes
-		authorized.POST("/boards", boardHandler.Create)
-		authorized.GET("/boards", boardHandler.GetAll)
-		authorized.GET("/boards/:id", boardHandler.GetByID)
-		authorized.PUT("/boards/:id", boardHandler.Update)
+		// Protected routes - require authentication
+		authorized := api.Group("/")
+		authorized.Use(middleware.JWTAuthMiddleware(cfg.JWTSecret))
+		authorized.Use(middleware.RequireActiveUser(perms.UserActive))
+		// Guest link sessions only reach the routes for working on their board
+		authorized.Use(middleware.RestrictGuests(handler.GuestRoutes))
+		if cfg.RateLimitEnabled {
+			authorized.Use(middleware.RateLimitByUser(apiLimiter))
+		}
+		{
+			// Board routes
+			authorized.POST("/boards", boardHandler.Create)
+			authorized.GET("/boards", replicaReads, boardHandler.GetAll)
+			authorized.GET("/boards/:id", boardHandler.GetByID)
+			authorized.PUT("/boards/:id", boardHandler.Update)
+			authorized.DELETE("/boards/:id", boardHandler.Delete)
+			authorized.PUT("/boards/:id/freeze", boardHandler.Freeze)
+			authorized.POST("/boards/:id/star", boardHandler.Star)
+			authorized.DELETE("/boards/:id/star", boardHandler.Unstar)
+			authorized.GET("/boards/:id/standup", replicaReads, analyticsLimit, standupHandler.GetStandup)
+			authorized.GET("/boards/:id/report", replicaReads, analyticsLimit, reportHandler.GetReport)
+			authorized.GET("/boards/:id/analytics", replicaReads, analyticsLimit, analyticsHandler.GetAnalytics)
+			authorized.GET("/boards/:id/estimates", replicaReads, analyticsHandler.GetEstimates)
+			authorized.POST("/boards/:id/duplicate", cloneLimit, operationHandler.DuplicateBoard)
+			authorized.POST("/boards/:id/split", cloneLimit, operationHandler.SplitBoard)
+			authorized.POST("/boards/:id/merge", cloneLimit, operationHandler.MergeBoard)
+			authorized.POST("/boards/:id/imports", importHandler.Create)
+			authorized.GET("/boards/:id/view", boardHandler.GetView)
+			authorized.PUT("/boards/:id/view", boardHandler.SaveView)
+			authorized.DELETE("/boards/:id/view", boardHandler.ResetView)
+			authorized.GET("/boards/:id/card-layout", boardHandler.GetCardLayout)
+			authorized.PUT("/boards/:id/card-layout", boardHandler.UpdateCardLayout)
+			authorized.POST("/boards/:id/views", readReceiptHandler.RecordView)
+			authorized.GET("/boards/:id/read-receipts", readReceiptHandler.GetReadReceipts)
+			authorized.PUT("/boards/:id/read-receipts", readReceiptHandler.UpdateSettings)
+			authorized.GET("/boards/:id/github", githubHandler.GetIntegration)
+			authorized.PUT("/boards/:id/github", githubHandler.SaveIntegration)
+			authorized.DELETE("/boards/:id/github", githubHandler.DeleteIntegration)
 		
-		// Board sharing routes
-		authorized.POST("/boards/:id/share", boardShareHandler.ShareBoard)
-		authorized.DELETE("/boards/:id/share/:user_id", boardShareHandler.RemoveShare)
-		authorized.GET("/boards/:id/share", boardShareHandler.GetBoardShares)
-		authorized.GET("/shared-boards", boardShareHandler.GetSharedBoards)
-
-		// Column routes
-		authorized.POST("/columns", columnHandler.Create)
-		authorized.GET("/boards/:id/columns", columnHandler.GetAll)
-		authorized.GET("/columns/:id", columnHandler.GetByID)
-		authorized.PUT("/columns/:id", columnHandler.Update)
-		authorized.DELETE("/columns/:id", columnHandler.Delete)
-		authorized.POST("/boards/:id/columns/reorder", columnHandler.ReorderColumns)
-
-		// Task routes
-		authorized.POST("/tasks", taskHandler.Create)
-		authorized.GET("/tasks/:id", taskHandler.GetByID)
-		authorized.GET("/columns/:id/tasks", taskHandler.GetByColumnID)
-		authorized.PUT("/tasks/:id", taskHandler.Update)
-		authorized.DELETE("/tasks/:id", taskHandler.Delete)
-		authorized.POST("/tasks/:id/move", taskHandler.MoveTask)
-		authorized.POST("/tasks/:id/assign", taskHandler.AssignUser)
-		authorized.DELETE("/tasks/:id/assign", taskHandler.UnassignUser)
-		authorized.POST("/tasks/:id/labels/:label_id", taskHandler.AddLabel)
-		authorized.DELETE("/tasks/:id/labels/:label_id", taskHandler.RemoveLabel)
-		authorized.GET("/tasks/:id/labels", taskHandler.GetTaskLabels)
-		authorized.POST("/tasks/:id/due-date", taskHandler.SetDueDate)
+			// Board sharing routes
+			authorized.POST("/boards/:id/share", boardShareHandler.ShareBoard)
+			authorized.DELETE("/boards/:id/share/:user_id", boardShareHandler.RemoveShare)
+			authorized.GET("/boards/:id/share", boardShareHandler.GetBoardShares)
+			authorized.POST("/boards/:id/leave", boardShareHandler.Leave)
+			authorized.GET("/shared-boards", replicaReads, boardShareHandler.GetSharedBoards)
+			authorized.POST("/boards/:id/share-links", shareLinkHandler.Create)
+			authorized.GET("/boards/:id/share-links", shareLinkHandler.GetByBoardID)
+			authorized.DELETE("/boards/:id/share-links/:link_id", shareLinkHandler.Revoke)
+			authorized.POST("/share-links/:token/accept", inviteLimit, shareLinkHandler.Accept)
+			authorized.POST("/boards/:id/guest-links", guestLinkHandler.Create)
+			authorized.GET("/boards/:id/guest-links", guestLinkHandler.GetByBoardID)
+			authorized.DELETE("/boards/:id/guest-links/:link_id", guestLinkHandler.Revoke)
+			authorized.GET("/boards/:id/status-page", statusPageHandler.Get)
+			authorized.PUT("/boards/:id/status-page", statusPageHandler.Publish)
+			authorized.DELETE("/boards/:id/status-page", statusPageHandler.Unpublish)
+
+			// Column routes
+			authorized.POST("/columns", columnHandler.Create)
+			authorized.GET("/boards/:id/columns", replicaReads, columnHandler.GetAll)
+			authorized.GET("/columns/:id", columnHandler.GetByID)
+			authorized.PUT("/columns/:id", columnHandler.Update)
+			authorized.PUT("/columns/:id/collapsed", columnHandler.SetCollapsed)
+			authorized.DELETE("/columns/:id", columnHandler.Delete)
+			authorized.POST("/boards/:id/columns/reorder", columnHandler.ReorderColumns)
+			authorized.POST("/columns/:id/move-to-board", columnHandler.MoveToBoard)
+			authorized.POST("/columns/:id/copy-to-board", columnHandler.CopyToBoard)
+
+			// Task routes
+			authorized.POST("/tasks", taskHandler.Create)
+			authorized.POST("/boards/:id/quick-add", taskHandler.QuickAdd)
+			authorized.POST("/tasks/batch-get", taskHandler.BatchGet)
+			authorized.GET("/tasks/:id", taskHandler.GetByID)
+			authorized.GET("/boards/:id/tasks/by-number/:num", taskHandler.GetByNumber)
+			authorized.GET("/columns/:id/tasks", replicaReads, taskHandler.GetByColumnID)
+			authorized.PUT("/tasks/:id", taskHandler.Update)
+			authorized.DELETE("/tasks/:id", taskHandler.Delete)
+			authorized.POST("/tasks/:id/move", taskHandler.MoveTask)
+			authorized.POST("/tasks/:id/move-to-board", taskHandler.MoveToBoard)
+			authorized.POST("/tasks/:id/assign", taskHandler.AssignUser)
+			authorized.DELETE("/tasks/:id/assign", taskHandler.UnassignUser)
+			authorized.GET("/tasks/:id/assignee-suggestions", taskHandler.GetAssigneeSuggestions)
+			authorized.POST("/tasks/:id/labels/:label_id", taskHandler.AddLabel)
+			authorized.DELETE("/tasks/:id/labels/:label_id", taskHandler.RemoveLabel)
+			authorized.GET("/tasks/:id/labels", taskHandler.GetTaskLabels)
+			authorized.POST("/tasks/:id/due-date", taskHandler.SetDueDate)
+			authorized.POST("/tasks/:id/blocked", taskHandler.SetBlocked)
+			authorized.PUT("/tasks/:id/cover", taskHandler.SetCover)
+			authorized.POST("/tasks/:id/lock", taskHandler.Lock)
+			authorized.DELETE("/tasks/:id/lock", taskHandler.Unlock)
+			authorized.GET("/tasks/:id/description", taskHandler.GetDescription)
+			authorized.POST("/tasks/:id/description/ops", taskHandler.EditDescription)
+			authorized.POST("/tasks/:id/complete", taskHandler.Complete)
+			authorized.DELETE("/tasks/:id/complete", taskHandler.Reopen)
+			authorized.GET("/tasks/:id/history", replicaReads, taskHandler.GetHistory)
+			authorized.GET("/actions", taskHandler.GetActions)
+			authorized.POST("/actions/:id/undo", taskHandler.Undo)
+			authorized.GET("/boards/:id/archived-tasks", replicaReads, taskHandler.GetArchivedTasks)
+			authorized.POST("/archived-tasks/:id/restore", taskHandler.RestoreArchivedTask)
+
+			// Pinned task routes
+			authorized.POST("/tasks/:id/pin", pinnedTaskHandler.Pin)
+			authorized.DELETE("/tasks/:id/pin", pinnedTaskHandler.Unpin)
+			authorized.GET("/me/pinned-tasks", replicaReads, pinnedTaskHandler.GetPinned)
+			authorized.GET("/me/tasks", replicaReads, taskHandler.GetMine)
+
+			// Task relation routes
+			authorized.GET("/tasks/:id/relations", taskRelationHandler.GetByTaskID)
+			authorized.POST("/tasks/:id/relations", taskRelationHandler.Create)
+			authorized.DELETE("/tasks/:id/relations/:relation_id", taskRelationHandler.Delete)
+
+			// Swimlane routes
+			authorized.GET("/boards/:id/swimlanes", replicaReads, swimlaneHandler.GetByBoardID)
+			authorized.POST("/boards/:id/swimlanes", swimlaneHandler.Create)
+			authorized.PUT("/swimlanes/:id", swimlaneHandler.Update)
+			authorized.DELETE("/swimlanes/:id", swimlaneHandler.Delete)
+			authorized.PUT("/tasks/:id/swimlane", swimlaneHandler.SetTaskSwimlane)
+			authorized.GET("/boards/:id/full", replicaReads, swimlaneHandler.GetFullBoard)
+			authorized.GET("/boards/:id/changes", boardChangeHandler.GetChanges)
+			authorized.GET("/boards/:id/events", boardChangeHandler.StreamEvents)
+
+			// Sprint routes
+			authorized.GET("/boards/:id/sprints", replicaReads, sprintHandler.GetByBoardID)
+			authorized.POST("/boards/:id/sprints", sprintHandler.Create)
+			authorized.GET("/sprints/:id", sprintHandler.GetByID)
+			authorized.POST("/sprints/:id/close", sprintHandler.Close)
+			authorized.POST("/sprints/:id/tasks", sprintHandler.AddTasks)
+			authorized.DELETE("/sprints/:id/tasks/:task_id", sprintHandler.RemoveTask)
+			authorized.GET("/sprints/:id/burndown", sprintHandler.GetBurndown)
+
+			// Task template routes
+			authorized.GET("/boards/:id/task-templates", taskTemplateHandler.GetByBoardID)
+			authorized.POST("/boards/:id/task-templates", taskTemplateHandler.Create)
+			authorized.GET("/task-templates/:id", taskTemplateHandler.GetByID)
+			authorized.PUT("/task-templates/:id", taskTemplateHandler.Update)
+			authorized.DELETE("/task-templates/:id", taskTemplateHandler.Delete)
+			authorized.POST("/tasks/from-template/:template_id", taskHandler.CreateFromTemplate)
+
+			// Bootstrap routes
+			authorized.GET("/bootstrap", bootstrapHandler.Get)
+			authorized.PUT("/me/preferences", bootstrapHandler.UpdatePreferences)
+			authorized.PUT("/me/board-order", boardHandler.SetOrder)
+
+			// Limits routes
+			authorized.GET("/limits", limitsHandler.Get)
+
+			// Calendar routes
+			authorized.POST("/me/calendar-token", calendarHandler.CreateToken)
+			authorized.DELETE("/me/calendar-token", calendarHandler.RevokeToken)
+
+			// Read receipt routes
+			authorized.PUT("/me/read-receipts", readReceiptHandler.UpdatePreference)
+
+			// Mention routes
+			authorized.GET("/me/mentions", replicaReads, mentionHandler.GetMine)
+			authorized.POST("/me/mentions/:id/read", mentionHandler.MarkRead)
+			authorized.GET("/me/notification-settings", notificationSettingsHandler.GetSettings)
+			authorized.PUT("/me/notification-settings", notificationSettingsHandler.UpdateSettings)
+
+			// Operation routes
+			authorized.GET("/operations/:id", operationHandler.GetByID)
+
+			// Task import routes
+			authorized.GET("/imports/:id", importHandler.GetByID)
+			authorized.PUT("/imports/:id/parts/:number", importHandler.UploadPart)
+			authorized.POST("/imports/:id/commit", cloneLimit, importHandler.Commit)
+			authorized.POST("/columns/:id/tasks/import", cloneLimit, importHandler.ImportCSV)
+			authorized.DELETE("/imports/:id", importHandler.Delete)
+
+			// Account routes
+			authorized.POST("/me/export", exportLimit, accountHandler.Export)
+			authorized.DELETE("/me", accountHandler.Delete)
+
+			// Onboarding routes
+			authorized.POST("/me/sample-board", userHandler.CreateSampleBoard)
 		
-		// Label routes
-		authorized.POST("/labels", labelHandler.Create)
-		authorized.GET("/labels/:id", labelHandler.GetByID)
-		authorized.GET("/boards/:id/labels", labelHandler.GetByBoardID)
-		authorized.PUT("/labels/:id", labelHandler.Update)
-		authorized.DELETE("/labels/:id", labelHandler.Delete)
-		authorized.GET("/labels/:id/tasks", labelHandler.GetTasksWithLabel)
+			// Label routes
+			authorized.POST("/labels", labelHandler.Create)
+			authorized.GET("/labels/:id", labelHandler.GetByID)
+			authorized.GET("/boards/:id/labels", replicaReads, labelHandler.GetByBoardID)
+			authorized.POST("/boards/:id/labels/copy", labelHandler.CopyFromBoard)
+			authorized.PUT("/labels/:id", labelHandler.Update)
+			authorized.DELETE("/labels/:id", labelHandler.Delete)
+			authorized.POST("/labels/:id/merge", labelHandler.Merge)
+			authorized.GET("/labels/:id/tasks", replicaReads, labelHandler.GetTasksWithLabel)
+			authorized.POST("/workspace/labels", labelHandler.CreateWorkspaceLabel)
+			authorized.GET("/workspace/labels", replicaReads, labelHandler.GetWorkspaceLabels)
+		}
+
+		// Admin routes - require an instance administrator
+		admin := authorized.Group("/admin")
+		admin.Use(middleware.RequireAdmin(userRepo.IsAdmin))
+		{
+			admin.GET("/users", replicaReads, adminHandler.ListUsers)
+			admin.POST("/users/:id/disable", adminHandler.DisableUser)
+			admin.POST("/users/:id/enable", adminHandler.EnableUser)
+			admin.PUT("/users/:id/limits", adminHandler.SetUserLimits)
+			admin.DELETE("/boards/:id", adminHandler.DeleteBoard)
+			admin.PUT("/boards/:id/owner", adminHandler.ReassignBoard)
+			admin.GET("/settings", settingsHandler.GetSettings)
+			admin.PUT("/settings/:key", settingsHandler.SetSetting)
+			admin.DELETE("/settings/:key", settingsHandler.ResetSetting)
+			admin.GET("/audit-log", replicaReads, auditHandler.ExportLog)
+		}
 	}
+
+	v1 := apiversion.Version{Name: "v1"}
+	registerRoutes(r.Group(v1.Prefix(), apiversion.Middleware(v1), requestTimeout, bodyLimit, validateRequests))
+	registerRoutes(r.Group("/", apiversion.Alias(v1, LegacyRoutesDeprecatedAt), requestTimeout, bodyLimit, validateRequests))
+
 	return &Server{
-		Engine: r,
-		DB:     db,
-		Config: cfg,
+		Engine:        r,
+		DB:            db,
+		Config:        cfg,
+		Compactor:     compactor,
+		MetricsRollup: metricsRollup,
+		ChangePruner:  changePruner,
+		AuditRetention: auditRetention,
+		TaskArchiver:   taskArchiver,
+		Outbox:        outbox,
+		Digests:       digests,
+		Descriptions:  descriptionSaver,
+		Queue:         queue,
+		Monitor:       anomalyMonitor,
+		Tracing:       tracerProvider,
+		Sentry:        sentryReporter,
+		Settings:      settingsStore,
+		Streams:       eventStreams,
 	}, nil
 }
 
@@ -122,6 +896,62 @@ func (s *Server) Run() {
 	srv := &http.Server{
 		Addr:    ":" + s.Config.ServerPort,
 		Handler: s.Engine,
+		// Request bodies are bounded by the request timeout middleware
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	// Event streams would hold the shutdown until the end of the grace period
+	srv.RegisterOnShutdown(s.Streams.Close)
+
+	// Periodic workers stop with workersCtx at shutdown; their passes are transactional and are
+	// simply run again after the restart. Queued jobs keep running with queueCtx until the grace
+	// period is over.
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	startWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
+	}
+	if s.Compactor != nil {
+		startWorker(s.Compactor.Run)
+	}
+	if s.MetricsRollup != nil {
+		startWorker(s.MetricsRollup.Run)
+	}
+	startWorker(s.ChangePruner.Run)
+	if s.AuditRetention != nil {
+		startWorker(s.AuditRetention.Run)
+	}
+	if s.TaskArchiver != nil {
+		startWorker(s.TaskArchiver.Run)
+	}
+	startWorker(s.Outbox.Run)
+	if s.Digests != nil {
+		startWorker(s.Digests.Run)
+	}
+	startWorker(s.Descriptions.Run)
+	startWorker(s.Settings.Run)
+	if s.Monitor != nil {
+		startWorker(s.Monitor.Run)
+	}
+	queueCtx, abortQueue := context.WithCancel(context.Background())
+	defer abortQueue()
+	if s.Queue != nil {
+		go s.Queue.Run(queueCtx)
+	}
+
+	var debugSrv *http.Server
+	if s.Config.DebugAddr != "" {
+		debugSrv = &http.Server{Addr: s.Config.DebugAddr, Handler: debugHandler()}
+		go func() {
+			log.Printf("🔧 Debug endpoints available at http://%s/debug/pprof/\n", s.Config.DebugAddr)
+			if err := debugSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Printf("⚠️  Failed to serve debug endpoints: %s", err)
+			}
+		}()
 	}
 
 	go func() {
@@ -137,10 +967,48 @@ func (s *Server) Run() {
 	<-quit
 	log.Println("🛑 Shutting down server...")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	grace := time.Duration(s.Config.ShutdownGracePeriodSec) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
 	defer cancel()
+
+	// Requests in flight finish first, since they may still queue jobs and outbox events
 	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatalf("❌ Server forced to shutdown: %s", err)
+		log.Printf("⚠️  Requests still running after %s were cut off: %s", grace, err)
+	}
+	if debugSrv != nil {
+		debugSrv.Close()
+	}
+
+	stopWorkers()
+	workers.Wait()
+	if s.Queue != nil {
+		if err := s.Queue.Shutdown(ctx); err != nil {
+			log.Printf("⚠️  Background jobs still running after %s were cancelled", grace)
+			abortQueue()
+			// Aborted jobs get a moment to roll back before the database is closed
+			abortCtx, cancelAbort := context.WithTimeout(context.Background(), 5*time.Second)
+			s.Queue.Shutdown(abortCtx)
+			cancelAbort()
+		}
+	}
+	// Events of the last requests are delivered now rather than after the restart
+	if err := s.Outbox.RunOnce(ctx); err != nil {
+		log.Printf("⚠️  Failed to deliver outbox events: %v", err)
+	}
+
+	// Telemetry gets a few seconds of its own, even when the grace period ran out
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFlush()
+	if s.Sentry != nil {
+		s.Sentry.Flush(2 * time.Second)
+	}
+	if s.Tracing != nil {
+		if err := s.Tracing.Shutdown(flushCtx); err != nil {
+			log.Printf("⚠️  Failed to flush traces: %v", err)
+		}
+	}
+	if sqlDB, err := s.DB.DB(); err == nil {
+		sqlDB.Close()
 	}
 
 	log.Println("✅ Server exited properly")
@@ -0,0 +1,240 @@
+// Package settings holds the runtime settings of the instance: rate limits, board limits and
+// feature flags. The configuration provides their defaults; administrators override them in the
+// settings table without a restart. Every instance reloads the overrides periodically, and the
+// one that changed them at once.
+package settings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban/internal/limits"
+	"kanban/internal/model"
+	"kanban/internal/palette"
+	"kanban/internal/ratelimit"
+	"kanban/internal/repository"
+)
+
+// Keys of the settings
+const (
+	KeyRateLimits    = "rate_limits"
+	KeyLimits        = "limits"
+	KeyFeatureFlags  = "feature_flags"
+	KeyDefaultLabels = "default_labels"
+)
+
+// Keys lists the keys of all settings
+var Keys = []string{KeyRateLimits, KeyLimits, KeyFeatureFlags, KeyDefaultLabels}
+
+// MaxDefaultLabels limits the labels every new board starts with
+const MaxDefaultLabels = 50
+
+// ErrUnknownKey is returned for keys that aren't settings
+var ErrUnknownKey = errors.New("unknown setting")
+
+// ErrInvalidValue is returned for values that don't fit their setting
+var ErrInvalidValue = errors.New("invalid setting value")
+
+// RateLimits are the token buckets of the rate limiters
+type RateLimits struct {
+	Auth      ratelimit.Limit `json:"auth"`
+	API       ratelimit.Limit `json:"api"`
+	Export    ratelimit.Limit `json:"export"`
+	Clone     ratelimit.Limit `json:"clone"`
+	Analytics ratelimit.Limit `json:"analytics"`
+}
+
+// Settings are the runtime settings
+type Settings struct {
+	RateLimits RateLimits    `json:"rate_limits"`
+	Limits     limits.Limits `json:"limits"`
+	// FeatureFlags are the client feature flags reported by GET /bootstrap
+	FeatureFlags map[string]bool `json:"feature_flags"`
+	// DefaultLabels are the labels new boards start with
+	DefaultLabels []DefaultLabel `json:"default_labels"`
+}
+
+// DefaultLabel is a label every new board starts with
+type DefaultLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// Apply returns the settings with the setting key overridden by value. Fields value leaves out
+// keep their current values, so an override only needs the fields that differ.
+func (s Settings) Apply(key string, value []byte) (Settings, error) {
+	var err error
+	switch key {
+	case KeyRateLimits:
+		if err = decodeStrict(value, &s.RateLimits); err == nil {
+			err = s.RateLimits.Validate()
+		}
+	case KeyLimits:
+		if err = decodeStrict(value, &s.Limits); err == nil && (s.Limits.Boards < 0 || s.Limits.ColumnsPerBoard < 0 || s.Limits.TasksPerColumn < 0) {
+			err = errors.New("limits can't be negative")
+		}
+	case KeyFeatureFlags:
+		flags := maps.Clone(s.FeatureFlags)
+		if flags == nil {
+			flags = make(map[string]bool)
+		}
+		err = decodeStrict(value, &flags)
+		s.FeatureFlags = flags
+	case KeyDefaultLabels:
+		// Список меток заменяется целиком, а не дополняется
+		var labels []DefaultLabel
+		if err = decodeStrict(value, &labels); err == nil {
+			err = validateDefaultLabels(labels)
+		}
+		s.DefaultLabels = labels
+	default:
+		return s, fmt.Errorf("%w %q", ErrUnknownKey, key)
+	}
+	if err != nil {
+		return s, fmt.Errorf("%w for %s: %v", ErrInvalidValue, key, err)
+	}
+	return s, nil
+}
+
+// Validate checks that every bucket holds tokens and refills. The configured defaults go through
+// it at startup, since environment variables like RATE_LIMIT_API_PER_MINUTE=0 would otherwise
+// reach the limiters.
+func (l RateLimits) Validate() error {
+	for _, limit := range []struct {
+		name  string
+		limit ratelimit.Limit
+	}{{"auth", l.Auth}, {"api", l.API}, {"export", l.Export}, {"clone", l.Clone}, {"analytics", l.Analytics}} {
+		if !limit.limit.Valid() {
+			return fmt.Errorf("rate limit %s needs a positive burst and a positive per_minute or per_hour", limit.name)
+		}
+	}
+	return nil
+}
+
+func validateDefaultLabels(labels []DefaultLabel) error {
+	if len(labels) > MaxDefaultLabels {
+		return fmt.Errorf("at most %d default labels are allowed", MaxDefaultLabels)
+	}
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		name := strings.ToLower(strings.TrimSpace(label.Name))
+		if name == "" {
+			return errors.New("default labels need a name")
+		}
+		if !palette.IsHex(label.Color) {
+			return fmt.Errorf("color of label %q must be a hex color like #ff0000", label.Name)
+		}
+		if seen[name] {
+			return fmt.Errorf("label %q is listed twice", label.Name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+func decodeStrict(value []byte, target interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
+
+// Store keeps the current settings in memory, so reading them costs nothing on the request path
+type Store struct {
+	defaults Settings
+	repo     *repository.SettingRepository
+	interval time.Duration
+	current  atomic.Pointer[Settings]
+}
+
+// NewStore starts out with the defaults until Reload reads the overrides; interval is how often
+// Run reloads them to pick up changes made through other instances
+func NewStore(defaults Settings, repo *repository.SettingRepository, interval time.Duration) *Store {
+	s := &Store{defaults: defaults, repo: repo, interval: interval}
+	s.current.Store(&defaults)
+	return s
+}
+
+// Get returns the current settings. They are shared and must not be modified.
+func (s *Store) Get() Settings {
+	return *s.current.Load()
+}
+
+// Defaults returns the configured settings, without overrides
+func (s *Store) Defaults() Settings {
+	return s.defaults
+}
+
+// Overrides returns the overridden settings
+func (s *Store) Overrides(ctx context.Context) ([]model.Setting, error) {
+	return s.repo.List(ctx)
+}
+
+// Reload reads the overrides and applies them to the defaults. Overrides that no longer fit,
+// say of a setting removed since, are skipped.
+func (s *Store) Reload(ctx context.Context) error {
+	overrides, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+	current := s.defaults
+	for _, override := range overrides {
+		applied, err := current.Apply(override.Key, override.Value)
+		if err != nil {
+			log.Printf("⚠️  Skipping setting override: %v", err)
+			continue
+		}
+		current = applied
+	}
+	s.current.Store(&current)
+	return nil
+}
+
+// Run reloads the settings every interval until ctx is cancelled
+func (s *Store) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Reload(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("⚠️  Reloading settings failed: %v", err)
+			}
+		}
+	}
+}
+
+// Set overrides the setting key with value on behalf of an administrator and takes effect at once
+func (s *Store) Set(ctx context.Context, key string, value json.RawMessage, userID uuid.UUID) error {
+	if _, err := s.defaults.Apply(key, value); err != nil {
+		return err
+	}
+	if err := s.repo.Save(ctx, &model.Setting{Key: key, Value: value, UpdatedBy: &userID}); err != nil {
+		return err
+	}
+	return s.Reload(ctx)
+}
+
+// Reset removes the override of the setting key, returning it to the default
+func (s *Store) Reset(ctx context.Context, key string) error {
+	if !slices.Contains(Keys, key) {
+		return fmt.Errorf("%w %q", ErrUnknownKey, key)
+	}
+	if err := s.repo.Delete(ctx, key); err != nil {
+		return err
+	}
+	return s.Reload(ctx)
+}
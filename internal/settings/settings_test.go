@@ -0,0 +1,93 @@
+package settings
+
+import (
+	"errors"
+	"testing"
+
+	"kanban/internal/limits"
+	"kanban/internal/ratelimit"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func defaults() Settings {
+	limit := ratelimit.Limit{PerMinute: 60, Burst: 10}
+	return Settings{
+		RateLimits:   RateLimits{Auth: limit, API: limit, Export: limit, Clone: limit, Analytics: limit},
+		Limits:       limits.Limits{Boards: 5},
+		FeatureFlags: map[string]bool{"beta": true},
+	}
+}
+
+func TestApply_MergesIntoDefaults(t *testing.T) {
+	base := defaults()
+
+	// Переопределяются только переданные поля
+	applied, err := base.Apply(KeyRateLimits, []byte(`{"export": {"per_minute": 0, "per_hour": 4, "burst": 2}}`))
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Limit{PerHour: 4, Burst: 2}, applied.RateLimits.Export)
+	assert.Equal(t, base.RateLimits.API, applied.RateLimits.API)
+
+	applied, err = applied.Apply(KeyLimits, []byte(`{"columns_per_board": 20}`))
+	require.NoError(t, err)
+	assert.Equal(t, limits.Limits{Boards: 5, ColumnsPerBoard: 20}, applied.Limits)
+
+	applied, err = applied.Apply(KeyFeatureFlags, []byte(`{"beta": false, "gantt": true}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"beta": false, "gantt": true}, applied.FeatureFlags)
+
+	// Исходные флаги не меняются: их разделяют все читатели
+	assert.Equal(t, map[string]bool{"beta": true}, base.FeatureFlags)
+
+	// Набор меток заменяется целиком
+	applied, err = applied.Apply(KeyDefaultLabels, []byte(`[{"name": "bug", "color": "#d73a4a"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []DefaultLabel{{Name: "bug", Color: "#d73a4a"}}, applied.DefaultLabels)
+}
+
+func TestApply_Rejects(t *testing.T) {
+	base := defaults()
+
+	_, err := base.Apply("port", []byte(`8080`))
+	assert.True(t, errors.Is(err, ErrUnknownKey))
+
+	for key, value := range map[string]string{
+		KeyRateLimits:    `{"api": {"per_minute": 0, "burst": 5}}`,
+		KeyLimits:        `{"boards": -1}`,
+		KeyFeatureFlags:  `["beta"]`,
+		KeyDefaultLabels: `[{"name": "bug", "color": "red"}]`,
+	} {
+		_, err := base.Apply(key, []byte(value))
+		assert.True(t, errors.Is(err, ErrInvalidValue), key)
+	}
+
+	// Опечатки в именах полей не проходят молча
+	_, err = base.Apply(KeyLimits, []byte(`{"board": 10}`))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	// Имена меток по умолчанию не повторяются без учёта регистра
+	_, err = base.Apply(KeyDefaultLabels, []byte(`[{"name": "Bug", "color": "#d73a4a"}, {"name": "bug", "color": "#000000"}]`))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestRateLimits_Validate(t *testing.T) {
+	assert.NoError(t, defaults().RateLimits.Validate())
+
+	// Медленные корзины задают только per_hour
+	limits := defaults().RateLimits
+	limits.Export = ratelimit.Limit{PerHour: 4, Burst: 2}
+	assert.NoError(t, limits.Validate())
+
+	for name, limit := range map[string]ratelimit.Limit{
+		"zero rate":      {PerMinute: 0, Burst: 5},
+		"negative rate":  {PerMinute: -10, Burst: 5},
+		"negative hour":  {PerMinute: 10, PerHour: -1, Burst: 5},
+		"zero burst":     {PerMinute: 10, Burst: 0},
+		"negative burst": {PerMinute: 10, Burst: -1},
+	} {
+		limits := defaults().RateLimits
+		limits.API = limit
+		assert.Error(t, limits.Validate(), name)
+	}
+}
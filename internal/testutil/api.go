@@ -0,0 +1,140 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"kanban/internal/model"
+)
+
+// Token returns a bearer token of the user signed with secret, like the tokens issued at login
+func Token(t testing.TB, userID uuid.UUID, secret string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID.String(),
+		"exp":     time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+// API performs JSON requests against a handler, usually the engine of the whole server,
+// authenticated as a user
+type API struct {
+	t       testing.TB
+	handler http.Handler
+	secret  string
+}
+
+// NewAPI sends requests to handler, signing tokens with the JWT secret the handler checks
+func NewAPI(t testing.TB, handler http.Handler, jwtSecret string) *API {
+	return &API{t: t, handler: handler, secret: jwtSecret}
+}
+
+// Do performs a request as the user, or anonymously for uuid.Nil. A body other than nil is
+// sent as JSON.
+func (a *API) Do(user uuid.UUID, method, path string, body interface{}) *httptest.ResponseRecorder {
+	a.t.Helper()
+
+	token := ""
+	if user != uuid.Nil {
+		token = Token(a.t, user, a.secret)
+	}
+	return a.DoWithToken(token, method, path, body)
+}
+
+// DoWithToken performs a request like Do with a bearer token issued by the server, like the
+// tokens of guest sessions, or anonymously for an empty token
+func (a *API) DoWithToken(token, method, path string, body interface{}) *httptest.ResponseRecorder {
+	a.t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			a.t.Fatalf("failed to encode request body: %v", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	a.handler.ServeHTTP(w, req)
+	return w
+}
+
+// Stream opens a GET request as the user on a real connection and returns the response as soon
+// as its headers arrive, for streams that the recorder of Do would only return once they end.
+// The stream is closed at the end of the test.
+func (a *API) Stream(user uuid.UUID, path string, header http.Header) *http.Response {
+	a.t.Helper()
+
+	srv := httptest.NewServer(a.handler)
+	a.t.Cleanup(srv.Close)
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+	if err != nil {
+		a.t.Fatalf("failed to build request: %v", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Authorization", "Bearer "+Token(a.t, user, a.secret))
+
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		a.t.Fatalf("GET %s: %v", path, err)
+	}
+	a.t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+// Expect performs a request like Do, fails the test unless it is answered with status and decodes
+// the response into out, unless out is nil
+func (a *API) Expect(status int, out interface{}, user uuid.UUID, method, path string, body interface{}) {
+	a.t.Helper()
+
+	w := a.Do(user, method, path, body)
+	if w.Code != status {
+		a.t.Fatalf("%s %s: expected status %d, got %d: %s", method, path, status, w.Code, w.Body.String())
+	}
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			a.t.Fatalf("%s %s: failed to decode response: %v: %s", method, path, err, w.Body.String())
+		}
+	}
+}
+
+// CreateUser creates a user with a unique email, deleted with the boards they own after the test
+func CreateUser(t testing.TB, db *gorm.DB, name string) model.User {
+	t.Helper()
+
+	user := model.User{Email: name + "-" + uuid.NewString() + "@example.com", Name: name, HashedPassword: "x"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Where("owner_id = ?", user.ID).Delete(&model.Board{})
+		db.Where("id = ?", user.ID).Delete(&model.User{})
+	})
+	return user
+}
@@ -0,0 +1,67 @@
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"kanban/internal/database"
+	"kanban/internal/migration"
+)
+
+// DatabaseURLEnv names the variable holding the DSN of the database used by integration tests
+const DatabaseURLEnv = "TEST_DATABASE_URL"
+
+// DatabaseDriverEnv names the variable selecting the driver integration tests run against:
+// postgres, the default, or sqlite
+const DatabaseDriverEnv = "TEST_DB_DRIVER"
+
+// TestDatabase returns the database the test runs against: with TEST_DB_DRIVER=sqlite a new
+// SQLite file in the temporary directory of the test, otherwise the one returned by Postgres
+func TestDatabase(t testing.TB) Database {
+	t.Helper()
+
+	if os.Getenv(DatabaseDriverEnv) == database.DriverSQLite {
+		return Database{Driver: database.DriverSQLite, Path: filepath.Join(t.TempDir(), "kanban.db")}
+	}
+	return Postgres(t)
+}
+
+// OpenDB connects to the database returned by TestDatabase and applies all migrations
+func OpenDB(t testing.TB) *gorm.DB {
+	t.Helper()
+	return Open(t, TestDatabase(t))
+}
+
+// Open connects to the test database and applies all migrations
+func Open(t testing.TB, testDB Database) *gorm.DB {
+	t.Helper()
+
+	dsn := testDB.DSN()
+	if testDB.Driver == database.DriverSQLite {
+		dsn = testDB.Path
+	}
+	dialector, err := database.Open(testDB.Driver, dsn)
+	if err != nil {
+		t.Fatalf("invalid test database: %v", err)
+	}
+	db, err := gorm.Open(dialector, &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to access test database connection: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	if err := migration.Up(sqlDB, testDB.Driver); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+
+	return db
+}
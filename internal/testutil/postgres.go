@@ -0,0 +1,167 @@
+package testutil
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	"kanban/internal/database"
+)
+
+// PostgresImageEnv names the variable that overrides the image of the throwaway database
+const PostgresImageEnv = "TEST_POSTGRES_IMAGE"
+
+// DefaultPostgresImage matches the database of docker-compose.yml
+const DefaultPostgresImage = "postgres:15"
+
+// Database describes the connection to the test database
+type Database struct {
+	// Driver is database.DriverPostgres or database.DriverSQLite, whose file is at Path
+	Driver   string
+	Path     string
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Name     string
+}
+
+// DSN returns the PostgreSQL connection string of the database
+func (d Database) DSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+		d.Host, d.Port, d.User, d.Password, d.Name)
+}
+
+// throwaway is the database container started for the tests of a package, shared by all of them
+var throwaway struct {
+	once        sync.Once
+	containerID string
+	db          Database
+	err         error
+}
+
+// Postgres returns the test database: the one TEST_DATABASE_URL points to or, without it, a
+// throwaway Postgres container started with the docker CLI on first use. The test is skipped
+// when neither is available. Packages that may start the container call Main from TestMain,
+// which removes it after the tests.
+func Postgres(t testing.TB) Database {
+	t.Helper()
+
+	if dsn := os.Getenv(DatabaseURLEnv); dsn != "" {
+		cfg, err := pgconn.ParseConfig(dsn)
+		if err != nil {
+			t.Fatalf("invalid %s: %v", DatabaseURLEnv, err)
+		}
+		return Database{
+			Driver:   database.DriverPostgres,
+			Host:     cfg.Host,
+			Port:     fmt.Sprint(cfg.Port),
+			User:     cfg.User,
+			Password: cfg.Password,
+			Name:     cfg.Database,
+		}
+	}
+
+	if _, err := exec.LookPath("docker"); err != nil {
+		t.Skipf("%s is not set and docker is not installed, skipping database test", DatabaseURLEnv)
+	}
+	throwaway.once.Do(func() {
+		throwaway.containerID, throwaway.db, throwaway.err = startPostgres()
+	})
+	if throwaway.err != nil {
+		t.Skipf("%s is not set and no throwaway database could be started, skipping database test: %v",
+			DatabaseURLEnv, throwaway.err)
+	}
+	return throwaway.db
+}
+
+// Main runs the tests of a package and removes the throwaway database they started, if any
+func Main(m *testing.M) {
+	code := m.Run()
+	if throwaway.containerID != "" {
+		if out, err := exec.Command("docker", "rm", "--force", throwaway.containerID).CombinedOutput(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to remove test database container %s: %v: %s\n", throwaway.containerID, err, out)
+		}
+	}
+	os.Exit(code)
+}
+
+// startPostgres runs a Postgres container listening on a random local port and waits until it
+// accepts connections
+func startPostgres() (string, Database, error) {
+	image := os.Getenv(PostgresImageEnv)
+	if image == "" {
+		image = DefaultPostgresImage
+	}
+	db := Database{Driver: database.DriverPostgres, Host: "127.0.0.1", User: "kanban", Password: "kanban", Name: "kanban_test"}
+
+	out, err := exec.Command("docker", "run", "--detach", "--rm",
+		"--label", "kanban-test=true",
+		"--env", "POSTGRES_USER="+db.User,
+		"--env", "POSTGRES_PASSWORD="+db.Password,
+		"--env", "POSTGRES_DB="+db.Name,
+		"--publish", "127.0.0.1::5432",
+		image,
+		// Данные тестов не нужно переживать сбой, без fsync база заметно быстрее
+		"-c", "fsync=off", "-c", "synchronous_commit=off", "-c", "full_page_writes=off",
+	).Output()
+	if err != nil {
+		return "", db, fmt.Errorf("docker run: %w%s", err, stderr(err))
+	}
+	containerID := strings.TrimSpace(string(out))
+
+	out, err = exec.Command("docker", "port", containerID, "5432/tcp").Output()
+	if err != nil {
+		exec.Command("docker", "rm", "--force", containerID).Run()
+		return "", db, fmt.Errorf("docker port: %w%s", err, stderr(err))
+	}
+	// "127.0.0.1:49153", возможно несколькими строками
+	address := strings.TrimSpace(strings.SplitN(string(out), "\n", 2)[0])
+	db.Port = address[strings.LastIndex(address, ":")+1:]
+
+	if err := waitForPostgres(db.DSN(), time.Minute); err != nil {
+		exec.Command("docker", "rm", "--force", containerID).Run()
+		return "", db, err
+	}
+	return containerID, db, nil
+}
+
+// waitForPostgres pings the database until it answers. The image restarts the server once after
+// initializing the database, and only listens on TCP after that.
+func waitForPostgres(dsn string, timeout time.Duration) error {
+	sqlDB, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	deadline := time.Now().Add(timeout)
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		err = sqlDB.PingContext(ctx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("test database did not start within %s: %w", timeout, err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+func stderr(err error) string {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return ": " + strings.TrimSpace(string(exitErr.Stderr))
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+// Package testutil contains helpers shared by tests that talk to the database.
+package testutil
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// QueryCounter is a GORM logger that records every SQL statement run through a session,
+// so tests can put a budget on the number of queries a code path issues.
+type QueryCounter struct {
+	logger.Interface
+
+	mu         sync.Mutex
+	statements []string
+}
+
+func NewQueryCounter() *QueryCounter {
+	return &QueryCounter{Interface: logger.Discard}
+}
+
+// Attach returns a session of db whose statements are recorded by the counter
+func (q *QueryCounter) Attach(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{Logger: q})
+}
+
+func (q *QueryCounter) LogMode(logger.LogLevel) logger.Interface {
+	return q
+}
+
+func (q *QueryCounter) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	sql, _ := fc()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.statements = append(q.statements, sql)
+}
+
+// Reset forgets the statements recorded so far
+func (q *QueryCounter) Reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.statements = nil
+}
+
+// Statements returns the statements recorded since the last Reset
+func (q *QueryCounter) Statements() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return append([]string(nil), q.statements...)
+}
+
+// Count returns the number of statements recorded since the last Reset
+func (q *QueryCounter) Count() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.statements)
+}
+
+// AssertBudget fails the test when more than budget statements were recorded since the
+// last Reset and lists them, which usually makes the offending N+1 pattern obvious.
+func (q *QueryCounter) AssertBudget(t testing.TB, name string, budget int) bool {
+	t.Helper()
+
+	statements := q.Statements()
+	if len(statements) <= budget {
+		return true
+	}
+
+	t.Errorf("%s: %d queries exceed the budget of %d:\n  %s",
+		name, len(statements), budget, strings.Join(statements, "\n  "))
+	return false
+}
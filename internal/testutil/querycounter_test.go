@@ -0,0 +1,54 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"kanban/internal/model"
+	"kanban/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB строит SQL без подключения к базе
+func dryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	return db
+}
+
+func TestQueryCounter_CountsStatements(t *testing.T) {
+	counter := testutil.NewQueryCounter()
+	db := counter.Attach(dryRunDB(t))
+
+	var boards []model.Board
+	db.Where("owner_id = ?", "x").Find(&boards)
+	db.Model(&model.Board{}).Where("id = ?", "x").Update("title", "New title")
+
+	assert.Equal(t, 2, counter.Count())
+	assert.Contains(t, counter.Statements()[0], `FROM "boards"`)
+	assert.True(t, counter.AssertBudget(t, "two statements", 2))
+
+	counter.Reset()
+	assert.Zero(t, counter.Count())
+}
+
+func TestQueryCounter_AssertBudgetFails(t *testing.T) {
+	counter := testutil.NewQueryCounter()
+	db := counter.Attach(dryRunDB(t))
+
+	var tasks []model.Task
+	for i := 0; i < 3; i++ {
+		db.Find(&tasks)
+	}
+
+	inner := &testing.T{}
+	assert.False(t, counter.AssertBudget(inner, "three statements", 2))
+	assert.True(t, inner.Failed())
+}
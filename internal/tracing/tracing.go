@@ -0,0 +1,62 @@
+// Package tracing sets up OpenTelemetry tracing: spans of HTTP requests, database queries and
+// outbound calls are exported over OTLP, and trace context is propagated in W3C traceparent headers.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Config controls where spans are exported and how many traces are kept
+type Config struct {
+	ServiceName string
+	// Endpoint is the URL of the OTLP/HTTP collector, e.g. http://localhost:4318
+	Endpoint string
+	// SampleRatio is the share of traces started here that are recorded, from 0 to 1. Traces
+	// continued from a caller follow the caller's decision.
+	SampleRatio float64
+}
+
+// Setup installs a tracer provider exporting to cfg.Endpoint as the global one. The provider must
+// be shut down on exit to flush the spans still buffered.
+func Setup(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider, nil
+}
+
+// Tracer returns a tracer of the global provider. Until Setup is called, and when tracing is
+// disabled, its spans are not recorded.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// Transport wraps base so that outbound requests get client spans and carry the trace context
+// of their request context to the called service
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
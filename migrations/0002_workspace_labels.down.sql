@@ -0,0 +1,6 @@
+DELETE FROM labels WHERE owner_id IS NOT NULL;
+DROP INDEX IF EXISTS idx_labels_owner_name;
+DROP INDEX IF EXISTS idx_labels_owner_id;
+ALTER TABLE labels DROP CONSTRAINT IF EXISTS labels_scope_check;
+ALTER TABLE labels DROP COLUMN IF EXISTS owner_id;
+ALTER TABLE labels ALTER COLUMN board_id SET NOT NULL;
@@ -0,0 +1,7 @@
+-- Workspace labels: a label belongs either to a board or to a user's workspace
+ALTER TABLE labels ALTER COLUMN board_id DROP NOT NULL;
+ALTER TABLE labels ADD COLUMN owner_id UUID REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE labels ADD CONSTRAINT labels_scope_check CHECK ((board_id IS NULL) <> (owner_id IS NULL));
+
+CREATE INDEX idx_labels_owner_id ON labels(owner_id);
+CREATE UNIQUE INDEX idx_labels_owner_name ON labels(owner_id, LOWER(name)) WHERE owner_id IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS task_references;
+DROP INDEX IF EXISTS idx_boards_key;
+ALTER TABLE tasks DROP COLUMN IF EXISTS number;
+ALTER TABLE boards DROP COLUMN IF EXISTS task_counter;
+ALTER TABLE boards DROP COLUMN IF EXISTS key;
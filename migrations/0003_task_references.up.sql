@@ -0,0 +1,42 @@
+-- Per-board task numbering
+ALTER TABLE boards ADD COLUMN key TEXT NOT NULL DEFAULT 'TASK';
+ALTER TABLE boards ADD COLUMN task_counter INT NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN number INT NOT NULL DEFAULT 0;
+
+UPDATE boards
+SET key = UPPER(LEFT(REGEXP_REPLACE(title, '[^A-Za-z]', '', 'g'), 4))
+WHERE LENGTH(REGEXP_REPLACE(title, '[^A-Za-z]', '', 'g')) >= 2;
+
+UPDATE tasks
+SET number = numbered.rn
+FROM (
+    SELECT t.id, ROW_NUMBER() OVER (PARTITION BY c.board_id ORDER BY c.position, t.position) AS rn
+    FROM tasks t
+    JOIN columns c ON c.id = t.column_id
+) AS numbered
+WHERE tasks.id = numbered.id;
+
+UPDATE boards
+SET task_counter = counts.total
+FROM (
+    SELECT c.board_id, COUNT(*) AS total
+    FROM tasks t
+    JOIN columns c ON c.id = t.column_id
+    GROUP BY c.board_id
+) AS counts
+WHERE boards.id = counts.board_id;
+
+CREATE INDEX idx_boards_key ON boards(key);
+
+-- Task → Task references found in text
+CREATE TABLE task_references (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    target_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    origin TEXT NOT NULL,
+    token TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_references_task_id ON task_references(task_id);
+CREATE INDEX idx_task_references_target_task_id ON task_references(target_task_id);
@@ -0,0 +1,7 @@
+-- Per-user pinned tasks
+CREATE TABLE pinned_tasks (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (user_id, task_id)
+);
@@ -0,0 +1 @@
+ALTER TABLE users DROP COLUMN IF EXISTS sample_board_created_at;
@@ -0,0 +1,4 @@
+-- Tracks whether the onboarding sample board was generated for a user.
+-- Existing users are considered onboarded already.
+ALTER TABLE users ADD COLUMN sample_board_created_at TIMESTAMPTZ;
+UPDATE users SET sample_board_created_at = NOW();
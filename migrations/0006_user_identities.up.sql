@@ -0,0 +1,11 @@
+-- External OAuth identities
+CREATE TABLE user_identities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider TEXT NOT NULL,
+    provider_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (provider, provider_id)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_tasks_completed_at;
+
+ALTER TABLE tasks DROP COLUMN IF EXISTS blocked_reason;
+ALTER TABLE tasks DROP COLUMN IF EXISTS blocked;
+ALTER TABLE tasks DROP COLUMN IF EXISTS completed_at;
+
+ALTER TABLE columns DROP COLUMN IF EXISTS is_done;
@@ -0,0 +1,8 @@
+-- Columns marked as done complete the tasks they hold
+ALTER TABLE columns ADD COLUMN is_done BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE tasks ADD COLUMN completed_at TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN blocked BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE tasks ADD COLUMN blocked_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX idx_tasks_completed_at ON tasks(completed_at);
@@ -0,0 +1,11 @@
+-- Per-user view settings (sort, filters, grouping) of a board
+CREATE TABLE board_views (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    sort_by VARCHAR(32) NOT NULL DEFAULT 'position',
+    sort_order VARCHAR(4) NOT NULL DEFAULT 'asc',
+    group_by VARCHAR(32) NOT NULL DEFAULT '',
+    filters JSONB NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (user_id, board_id)
+);
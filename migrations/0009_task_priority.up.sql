@@ -0,0 +1,4 @@
+ALTER TABLE tasks ADD COLUMN priority TEXT NOT NULL DEFAULT 'medium'
+    CHECK (priority IN ('low', 'medium', 'high', 'urgent'));
+
+CREATE INDEX idx_tasks_column_priority ON tasks(column_id, priority);
@@ -0,0 +1,16 @@
+-- Long-running operations (e.g. board duplication) executed by the background job queue
+CREATE TABLE operations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'succeeded', 'failed')),
+    total INT NOT NULL DEFAULT 0,
+    completed INT NOT NULL DEFAULT 0,
+    errors JSONB NOT NULL DEFAULT '[]',
+    result_board_id UUID REFERENCES boards(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_operations_user_id ON operations(user_id);
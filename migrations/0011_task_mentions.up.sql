@@ -0,0 +1,14 @@
+-- Users mentioned in task text; unread mentions form the user's notification inbox
+CREATE TABLE task_mentions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mentioned_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    origin TEXT NOT NULL,
+    token TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    read_at TIMESTAMPTZ,
+    UNIQUE (task_id, origin, user_id)
+);
+
+CREATE INDEX idx_task_mentions_user_id ON task_mentions(user_id, created_at);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS share_links;
+
+UPDATE board_shares SET role = 'viewer' WHERE role = 'commenter';
+ALTER TABLE board_shares DROP CONSTRAINT IF EXISTS board_shares_role_check;
+ALTER TABLE board_shares ADD CONSTRAINT board_shares_role_check CHECK (role IN ('viewer', 'editor'));
@@ -0,0 +1,19 @@
+-- Commenters can read a board and comment but not edit it
+ALTER TABLE board_shares DROP CONSTRAINT IF EXISTS board_shares_role_check;
+ALTER TABLE board_shares ADD CONSTRAINT board_shares_role_check CHECK (role IN ('viewer', 'commenter', 'editor'));
+
+-- Invitation links granting board access to any authenticated user who opens them
+CREATE TABLE share_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'commenter')),
+    max_uses INT NOT NULL CHECK (max_uses > 0),
+    uses INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_share_links_board_id ON share_links(board_id);
@@ -0,0 +1,13 @@
+-- Links between tasks: task_id blocks related_task_id, or the two tasks relate to each other
+CREATE TABLE task_relations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    related_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('blocks', 'relates_to')),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    CHECK (task_id <> related_task_id),
+    UNIQUE (task_id, related_task_id, type)
+);
+
+CREATE INDEX idx_task_relations_related_task_id ON task_relations(related_task_id);
@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS last_board_id,
+    DROP COLUMN IF EXISTS default_board_id;
@@ -0,0 +1,4 @@
+-- Board opened on start: the user's chosen default board and the board they opened last
+ALTER TABLE users
+    ADD COLUMN default_board_id UUID REFERENCES boards(id) ON DELETE SET NULL,
+    ADD COLUMN last_board_id UUID REFERENCES boards(id) ON DELETE SET NULL;
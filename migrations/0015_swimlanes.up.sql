@@ -0,0 +1,15 @@
+-- Horizontal groupings of a board's tasks, e.g. per team or class of service
+CREATE TABLE swimlanes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_swimlanes_board_id ON swimlanes(board_id);
+
+-- Tasks without a swimlane are shown in the default lane
+ALTER TABLE tasks ADD COLUMN swimlane_id UUID REFERENCES swimlanes(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_tasks_swimlane_id ON tasks(swimlane_id);
@@ -0,0 +1,15 @@
+-- When a task entered and left each column, the basis of cycle time and flow analytics
+CREATE TABLE task_column_entries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    column_id UUID NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    entered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    exited_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_task_column_entries_task_id ON task_column_entries(task_id);
+CREATE INDEX idx_task_column_entries_column_id ON task_column_entries(column_id, entered_at);
+
+-- Existing tasks have no history: open tasks enter their column now, completed ones when they were completed
+INSERT INTO task_column_entries (task_id, column_id, entered_at)
+SELECT id, column_id, COALESCE(completed_at, NOW()) FROM tasks;
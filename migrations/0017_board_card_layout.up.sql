@@ -0,0 +1,2 @@
+-- Fields shown on task cards, in display order; NULL keeps the default layout
+ALTER TABLE boards ADD COLUMN card_fields JSONB;
@@ -0,0 +1,11 @@
+-- Public read-only status pages publishing selected columns of a board
+CREATE TABLE status_pages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL UNIQUE REFERENCES boards(id) ON DELETE CASCADE,
+    slug TEXT NOT NULL UNIQUE,
+    title TEXT NOT NULL,
+    column_ids JSONB NOT NULL DEFAULT '[]',
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+-- Secret token in the iCal feed URLs of a user, since calendar apps cannot log in
+ALTER TABLE users ADD COLUMN calendar_token TEXT UNIQUE;
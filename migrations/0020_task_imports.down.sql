@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_import_parts;
+DROP TABLE IF EXISTS task_imports;
@@ -0,0 +1,24 @@
+-- Chunked task imports: parts are uploaded and validated one by one, then committed together
+CREATE TABLE task_imports (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(16) NOT NULL DEFAULT 'uploading' CHECK (status IN ('uploading', 'committed', 'imported')),
+    operation_id UUID REFERENCES operations(id) ON DELETE SET NULL,
+    imported_tasks INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_imports_user_id ON task_imports(user_id);
+
+-- Parts waiting to be imported; a part is deleted in the transaction that imports its tasks
+CREATE TABLE task_import_parts (
+    import_id UUID NOT NULL REFERENCES task_imports(id) ON DELETE CASCADE,
+    part_number INT NOT NULL CHECK (part_number > 0),
+    tasks JSONB NOT NULL,
+    task_count INT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (import_id, part_number)
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS view_receipts;
+DROP TRIGGER IF EXISTS tasks_touch_columns_on_update ON tasks;
+DROP TRIGGER IF EXISTS tasks_touch_columns_on_write ON tasks;
+DROP FUNCTION IF EXISTS touch_task_columns();
+ALTER TABLE columns DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE users DROP COLUMN IF EXISTS hide_read_receipts;
+ALTER TABLE boards DROP COLUMN IF EXISTS read_receipts;
@@ -0,0 +1,50 @@
+-- Read receipts let editors of stakeholder boards see how many viewers have seen the latest changes.
+-- Boards opt in; users can opt out of being counted on any board.
+ALTER TABLE boards ADD COLUMN read_receipts BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN hide_read_receipts BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- When a column or its tasks last changed. Task changes touch their columns through triggers,
+-- so every write path is covered; reordering tasks alone does not count as a change.
+ALTER TABLE columns ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE FUNCTION touch_task_columns() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        UPDATE columns SET updated_at = NOW() WHERE id = OLD.column_id;
+    END IF;
+    IF TG_OP = 'INSERT' THEN
+        UPDATE columns SET updated_at = NOW() WHERE id = NEW.column_id;
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF NEW.column_id <> OLD.column_id THEN
+            UPDATE columns SET updated_at = NOW() WHERE id = NEW.column_id;
+        END IF;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_touch_columns_on_write
+    AFTER INSERT OR DELETE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION touch_task_columns();
+
+CREATE TRIGGER tasks_touch_columns_on_update
+    AFTER UPDATE ON tasks
+    FOR EACH ROW
+    WHEN ((OLD.column_id, OLD.title, OLD.description, OLD.assigned_to, OLD.due_date, OLD.completed_at,
+           OLD.blocked, OLD.blocked_reason, OLD.priority, OLD.swimlane_id)
+          IS DISTINCT FROM
+          (NEW.column_id, NEW.title, NEW.description, NEW.assigned_to, NEW.due_date, NEW.completed_at,
+           NEW.blocked, NEW.blocked_reason, NEW.priority, NEW.swimlane_id))
+    EXECUTE FUNCTION touch_task_columns();
+
+-- When viewers last opened a board (column_id NULL) or one of its columns
+CREATE TABLE view_receipts (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    column_id UUID REFERENCES columns(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_view_receipts_board_id ON view_receipts(board_id);
+CREATE UNIQUE INDEX idx_view_receipts_board_user ON view_receipts(board_id, user_id) WHERE column_id IS NULL;
+CREATE UNIQUE INDEX idx_view_receipts_column_user ON view_receipts(column_id, user_id) WHERE column_id IS NOT NULL;
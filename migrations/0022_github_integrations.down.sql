@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_github_links;
+DROP TABLE IF EXISTS github_integrations;
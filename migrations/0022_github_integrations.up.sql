@@ -0,0 +1,28 @@
+-- GitHub webhooks of a board: commits and pull requests mentioning task keys are linked to the tasks
+CREATE TABLE github_integrations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL UNIQUE REFERENCES boards(id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    review_column_id UUID REFERENCES columns(id) ON DELETE SET NULL,
+    done_column_id UUID REFERENCES columns(id) ON DELETE SET NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE task_github_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('commit', 'pull_request')),
+    repository TEXT NOT NULL,
+    -- Commit SHA or pull request number
+    ref TEXT NOT NULL,
+    url TEXT NOT NULL,
+    title TEXT NOT NULL,
+    -- open, closed or merged for pull requests
+    state VARCHAR(16) NOT NULL DEFAULT '',
+    author TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (task_id, kind, repository, ref)
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_task_column_entries_exited_at;
+DROP INDEX IF EXISTS idx_task_column_entries_entered_at;
+DROP TABLE IF EXISTS metrics_rollups;
+DROP TABLE IF EXISTS column_daily_counts;
+DROP TABLE IF EXISTS board_daily_metrics;
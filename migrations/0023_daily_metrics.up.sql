@@ -0,0 +1,30 @@
+-- Nightly roll-ups of board activity per UTC day, so analytics don't scan the whole history
+CREATE TABLE board_daily_metrics (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    created INTEGER NOT NULL DEFAULT 0,
+    completed INTEGER NOT NULL DEFAULT 0,
+    moved INTEGER NOT NULL DEFAULT 0,
+    -- Cycle time in days of the tasks completed on the day, NULL without any
+    cycle_median DOUBLE PRECISION,
+    cycle_p85 DOUBLE PRECISION,
+    PRIMARY KEY (board_id, day)
+);
+
+-- Number of tasks in each column at the end of a day, the cumulative flow diagram
+CREATE TABLE column_daily_counts (
+    column_id UUID NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    tasks INTEGER NOT NULL,
+    PRIMARY KEY (column_id, day)
+);
+
+-- Days rolled up so far; they form one contiguous range
+CREATE TABLE metrics_rollups (
+    day DATE PRIMARY KEY,
+    rolled_up_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A day is rolled up from the entries made on it and the entries still open at its end
+CREATE INDEX idx_task_column_entries_entered_at ON task_column_entries(entered_at);
+CREATE INDEX idx_task_column_entries_exited_at ON task_column_entries(exited_at);
@@ -0,0 +1,21 @@
+-- Which channels deliver each kind of notification to a user. Rows without a board are the user's
+-- defaults for all boards; board rows override them.
+CREATE TABLE notification_settings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID REFERENCES boards(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    in_app BOOLEAN NOT NULL,
+    email BOOLEAN NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_notification_settings_default ON notification_settings(user_id, event) WHERE board_id IS NULL;
+CREATE UNIQUE INDEX idx_notification_settings_board ON notification_settings(user_id, board_id, event) WHERE board_id IS NOT NULL;
+
+-- Boards a user gets no notifications from at all
+CREATE TABLE notification_mutes (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, board_id)
+);
@@ -0,0 +1,2 @@
+-- The request that started an operation, to find the logs of the job running it
+ALTER TABLE operations ADD COLUMN request_id VARCHAR(128) NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+-- Instance administrators manage all users and boards through the admin API.
+-- Disabled users can no longer sign in or use their tokens.
+ALTER TABLE users
+    ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN disabled_at TIMESTAMPTZ;
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS max_tasks_per_column,
+    DROP COLUMN IF EXISTS max_columns_per_board,
+    DROP COLUMN IF EXISTS max_boards;
@@ -0,0 +1,6 @@
+-- Per-user overrides of the instance limits on boards, columns per board and tasks per column.
+-- NULL keeps the instance default, 0 removes the limit.
+ALTER TABLE users
+    ADD COLUMN max_boards INTEGER CHECK (max_boards >= 0),
+    ADD COLUMN max_columns_per_board INTEGER CHECK (max_columns_per_board >= 0),
+    ADD COLUMN max_tasks_per_column INTEGER CHECK (max_tasks_per_column >= 0);
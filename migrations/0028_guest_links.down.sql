@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS guest_links;
+DELETE FROM users WHERE is_guest;
+ALTER TABLE users DROP COLUMN IF EXISTS is_guest;
@@ -0,0 +1,21 @@
+-- Guests have no account of their own: each guest link gets a user without a password that
+-- edits the link's board. Guest users can only sign in through their link.
+ALTER TABLE users ADD COLUMN is_guest BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Guest editor links let people without an account edit one board until the owner revokes
+-- the link or it expires
+CREATE TABLE guest_links (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMPTZ,
+    sessions INT NOT NULL DEFAULT 0,
+    last_session_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_guest_links_board_id ON guest_links(board_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS user_board_prefs;
+
+ALTER TABLE columns DROP COLUMN IF EXISTS description;
+ALTER TABLE columns DROP COLUMN IF EXISTS color;
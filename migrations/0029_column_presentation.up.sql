@@ -0,0 +1,12 @@
+-- Columns can be colored and described to explain what belongs in them
+ALTER TABLE columns ADD COLUMN color TEXT NOT NULL DEFAULT '';
+ALTER TABLE columns ADD COLUMN description TEXT NOT NULL DEFAULT '';
+
+-- Per-user presentation preferences of a board, such as the columns the user keeps collapsed
+CREATE TABLE user_board_prefs (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    collapsed_columns JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (user_id, board_id)
+);
@@ -0,0 +1,2 @@
+-- Tasks can have a cover color that boards render on the task card
+ALTER TABLE tasks ADD COLUMN cover_color TEXT NOT NULL DEFAULT '';
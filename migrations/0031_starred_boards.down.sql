@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_board_prefs_starred;
+
+ALTER TABLE user_board_prefs DROP COLUMN IF EXISTS starred;
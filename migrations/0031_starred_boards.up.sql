@@ -0,0 +1,4 @@
+-- Users star the boards they use most to find them among many shared boards
+ALTER TABLE user_board_prefs ADD COLUMN starred BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_user_board_prefs_starred ON user_board_prefs(user_id) WHERE starred;
@@ -0,0 +1,2 @@
+-- Tasks can have a start date in addition to the due date, for planning work that spans days
+ALTER TABLE tasks ADD COLUMN start_date TIMESTAMPTZ;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_sprint_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS sprint_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS estimate;
+
+DROP TABLE IF EXISTS sprints;
@@ -0,0 +1,20 @@
+-- Sprints are time boxes of a board that scrum teams plan tasks into
+CREATE TABLE sprints (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    goal TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX idx_sprints_board_id ON sprints(board_id);
+
+-- Story point estimates and the sprint a task is planned into
+ALTER TABLE tasks ADD COLUMN estimate INT CHECK (estimate >= 0);
+ALTER TABLE tasks ADD COLUMN sprint_id UUID REFERENCES sprints(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_tasks_sprint_id ON tasks(sprint_id) WHERE sprint_id IS NOT NULL;
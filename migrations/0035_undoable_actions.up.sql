@@ -0,0 +1,12 @@
+-- Destructive actions of users, with what is needed to revert them for a short while
+CREATE TABLE actions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    undone_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_actions_user_id ON actions(user_id, created_at);
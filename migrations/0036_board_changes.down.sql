@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS board_shares_log_changes ON board_shares;
+DROP TRIGGER IF EXISTS labels_log_changes ON labels;
+DROP TRIGGER IF EXISTS columns_log_tasks_delete ON columns;
+DROP TRIGGER IF EXISTS columns_log_changes ON columns;
+DROP TRIGGER IF EXISTS task_relations_log_changes ON task_relations;
+DROP TRIGGER IF EXISTS task_labels_log_changes ON task_labels;
+DROP TRIGGER IF EXISTS tasks_log_changes ON tasks;
+
+DROP FUNCTION IF EXISTS log_share_change();
+DROP FUNCTION IF EXISTS log_label_change();
+DROP FUNCTION IF EXISTS log_column_tasks_delete();
+DROP FUNCTION IF EXISTS log_column_change();
+DROP FUNCTION IF EXISTS log_task_link_change();
+DROP FUNCTION IF EXISTS log_task_change();
+
+DROP TABLE IF EXISTS board_changes;
@@ -0,0 +1,131 @@
+-- Change feed of boards for polling clients. Triggers log every write to tasks, columns, labels
+-- and shares, so all write paths are covered. Rows hold the ID of the writing transaction: a
+-- client that saw every change of transactions before some ID only needs the rows from that ID on.
+-- There is no foreign key to boards: rows are logged while boards are being deleted, and old rows
+-- are pruned by a background job.
+CREATE TABLE board_changes (
+    id BIGSERIAL PRIMARY KEY,
+    board_id UUID NOT NULL,
+    entity TEXT NOT NULL CHECK (entity IN ('task', 'column', 'label', 'share')),
+    entity_id UUID NOT NULL,
+    txid BIGINT NOT NULL DEFAULT txid_current(),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_board_changes_board_txid ON board_changes(board_id, txid);
+CREATE INDEX idx_board_changes_changed_at ON board_changes(changed_at);
+
+CREATE FUNCTION log_task_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO board_changes (board_id, entity, entity_id)
+        SELECT board_id, 'task', OLD.id FROM columns WHERE id = OLD.column_id;
+    END IF;
+    IF TG_OP = 'INSERT' OR (TG_OP = 'UPDATE' AND NEW.column_id <> OLD.column_id) THEN
+        INSERT INTO board_changes (board_id, entity, entity_id)
+        SELECT board_id, 'task', NEW.id FROM columns WHERE id = NEW.column_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_log_changes
+    AFTER INSERT OR UPDATE OR DELETE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION log_task_change();
+
+-- Labels and relations are part of their tasks
+CREATE FUNCTION log_task_link_change() RETURNS TRIGGER AS $$
+DECLARE
+    link_task_ids UUID[];
+BEGIN
+    IF TG_TABLE_NAME = 'task_labels' THEN
+        link_task_ids := ARRAY[COALESCE(NEW.task_id, OLD.task_id)];
+    ELSE
+        link_task_ids := ARRAY[COALESCE(NEW.task_id, OLD.task_id), COALESCE(NEW.related_task_id, OLD.related_task_id)];
+    END IF;
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'task', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = ANY (link_task_ids);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER task_labels_log_changes
+    AFTER INSERT OR DELETE ON task_labels
+    FOR EACH ROW EXECUTE FUNCTION log_task_link_change();
+
+CREATE TRIGGER task_relations_log_changes
+    AFTER INSERT OR UPDATE OR DELETE ON task_relations
+    FOR EACH ROW EXECUTE FUNCTION log_task_link_change();
+
+-- Touching updated_at alone, as task writes do, is not a change of the column itself
+CREATE FUNCTION log_column_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND (to_jsonb(OLD) - 'updated_at') = (to_jsonb(NEW) - 'updated_at') THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP <> 'INSERT' THEN
+        INSERT INTO board_changes (board_id, entity, entity_id) VALUES (OLD.board_id, 'column', OLD.id);
+    END IF;
+    IF TG_OP = 'INSERT' OR (TG_OP = 'UPDATE' AND NEW.board_id <> OLD.board_id) THEN
+        INSERT INTO board_changes (board_id, entity, entity_id) VALUES (NEW.board_id, 'column', NEW.id);
+    END IF;
+    -- Tasks go along with a column moved to another board
+    IF TG_OP = 'UPDATE' AND NEW.board_id <> OLD.board_id THEN
+        INSERT INTO board_changes (board_id, entity, entity_id)
+        SELECT board_id, 'task', tasks.id FROM tasks, (VALUES (OLD.board_id), (NEW.board_id)) AS boards(board_id)
+        WHERE tasks.column_id = NEW.id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER columns_log_changes
+    AFTER INSERT OR UPDATE OR DELETE ON columns
+    FOR EACH ROW EXECUTE FUNCTION log_column_change();
+
+-- Tasks deleted along with their column are gone by the time AFTER triggers of the column run
+CREATE FUNCTION log_column_tasks_delete() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT OLD.board_id, 'task', id FROM tasks WHERE column_id = OLD.id;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER columns_log_tasks_delete
+    BEFORE DELETE ON columns
+    FOR EACH ROW EXECUTE FUNCTION log_column_tasks_delete();
+
+-- Workspace labels are part of every board of their owner
+CREATE FUNCTION log_label_change() RETURNS TRIGGER AS $$
+DECLARE
+    label labels%ROWTYPE;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        label := OLD;
+    ELSE
+        label := NEW;
+    END IF;
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT id, 'label', label.id FROM boards WHERE id = label.board_id OR owner_id = label.owner_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER labels_log_changes
+    AFTER INSERT OR UPDATE OR DELETE ON labels
+    FOR EACH ROW EXECUTE FUNCTION log_label_change();
+
+CREATE FUNCTION log_share_change() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    VALUES (COALESCE(NEW.board_id, OLD.board_id), 'share', COALESCE(NEW.id, OLD.id));
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER board_shares_log_changes
+    AFTER INSERT OR UPDATE OR DELETE ON board_shares
+    FOR EACH ROW EXECUTE FUNCTION log_share_change();
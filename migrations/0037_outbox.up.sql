@@ -0,0 +1,14 @@
+-- Events for delivery outside the database, written in the transaction of the change they describe
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    event TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events(next_attempt_at) WHERE delivered_at IS NULL;
+CREATE INDEX idx_outbox_events_delivered_at ON outbox_events(delivered_at) WHERE delivered_at IS NOT NULL;
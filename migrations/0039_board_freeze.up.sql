@@ -0,0 +1,2 @@
+-- Owners freeze a board to stop all changes to its content, e.g. during a retrospective or a migration
+ALTER TABLE boards ADD COLUMN frozen_at TIMESTAMPTZ;
@@ -0,0 +1,16 @@
+-- Task templates pre-fill recurring kinds of tasks of a board, e.g. bug reports or release checklists
+CREATE TABLE task_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    title_pattern TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    priority TEXT NOT NULL DEFAULT 'medium',
+    label_ids JSONB NOT NULL DEFAULT '[]',
+    checklist JSONB NOT NULL DEFAULT '[]',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_templates_board_id ON task_templates(board_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_task_changes_assignee;
+DROP TABLE IF EXISTS notification_digests;
@@ -0,0 +1,11 @@
+-- Users who get a daily or weekly email digest of their overdue tasks, new assignments and mentions
+CREATE TABLE notification_digests (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(10) NOT NULL,
+    last_sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_notification_digests_due ON notification_digests(frequency, last_sent_at);
+
+-- Digests look up the tasks assigned to a user since the last digest
+CREATE INDEX idx_task_changes_assignee ON task_changes(new_value, created_at) WHERE field = 'assignee';
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS task_locks;
+DROP FUNCTION IF EXISTS log_task_lock_change();
+DELETE FROM board_changes WHERE entity = 'lock';
+ALTER TABLE board_changes DROP CONSTRAINT IF EXISTS board_changes_entity_check;
+ALTER TABLE board_changes ADD CONSTRAINT board_changes_entity_check
+    CHECK (entity IN ('task', 'column', 'label', 'share'));
@@ -0,0 +1,29 @@
+-- Soft locks editors take on a task while they edit its description. A lock is active until it
+-- expires; its holder renews it while editing.
+CREATE TABLE task_locks (
+    task_id UUID PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_locks_user_id ON task_locks(user_id);
+
+-- Locks are published in the change feed of the task's board
+ALTER TABLE board_changes DROP CONSTRAINT board_changes_entity_check;
+ALTER TABLE board_changes ADD CONSTRAINT board_changes_entity_check
+    CHECK (entity IN ('task', 'column', 'label', 'share', 'lock'));
+
+CREATE FUNCTION log_task_lock_change() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'lock', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = COALESCE(NEW.task_id, OLD.task_id);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER task_locks_log_changes
+    AFTER INSERT OR UPDATE OR DELETE ON task_locks
+    FOR EACH ROW EXECUTE FUNCTION log_task_lock_change();
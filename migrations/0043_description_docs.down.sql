@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS description_ops;
+DROP TABLE IF EXISTS description_docs;
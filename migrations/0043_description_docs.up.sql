@@ -0,0 +1,24 @@
+-- Collaborative editing of task descriptions. The live text of a description being edited is
+-- kept in its document, which is saved to the task periodically; every edit is an operation
+-- appended to the document's log, which editors poll to follow each other.
+CREATE TABLE description_docs (
+    task_id UUID PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    version BIGINT NOT NULL DEFAULT 0,
+    content TEXT NOT NULL DEFAULT '',
+    -- saved_version is the version last saved to the task's description
+    saved_version BIGINT NOT NULL DEFAULT 0,
+    saved_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_description_docs_unsaved ON description_docs(updated_at) WHERE version > saved_version;
+
+CREATE TABLE description_ops (
+    task_id UUID NOT NULL REFERENCES description_docs(task_id) ON DELETE CASCADE,
+    version BIGINT NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    ops JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, version)
+);
@@ -0,0 +1 @@
+ALTER TABLE user_board_prefs DROP COLUMN IF EXISTS sort_order;
@@ -0,0 +1,2 @@
+-- Users order their boards list as they work; boards without a position follow the ordered ones
+ALTER TABLE user_board_prefs ADD COLUMN sort_order INT;
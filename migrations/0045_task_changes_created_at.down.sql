@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_task_changes_created_at;
@@ -0,0 +1,2 @@
+-- Audit exports and the retention job read the task history of the whole instance by time
+CREATE INDEX idx_task_changes_created_at ON task_changes(created_at, id);
@@ -0,0 +1,16 @@
+-- Tasks completed long ago are moved with their history to gzipped NDJSON files in the task
+-- archive directory. What lists an archived task and finds it in its file stays here.
+CREATE TABLE archived_tasks (
+    id UUID PRIMARY KEY,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL,
+    -- archive is the name of the file in the task archive directory that holds the task
+    archive TEXT NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- a restored task stays on its board and is not archived again
+    restored_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_archived_tasks_board_id ON archived_tasks(board_id, number);
@@ -0,0 +1,3 @@
+-- Share links may grant edit access too
+ALTER TABLE share_links DROP CONSTRAINT IF EXISTS share_links_role_check;
+ALTER TABLE share_links ADD CONSTRAINT share_links_role_check CHECK (role IN ('viewer', 'commenter', 'editor'));
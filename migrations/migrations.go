@@ -0,0 +1,15 @@
+// Package migrations embeds the versioned SQL schema migrations so they ship
+// inside the server binary.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
+
+// SQLite holds the migrations of SQLite databases in its sqlite directory. They begin with the
+// whole schema of version 45; every later migration needs a SQLite counterpart of the same
+// version.
+//
+//go:embed sqlite/*.sql
+var SQLite embed.FS
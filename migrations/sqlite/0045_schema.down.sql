@@ -0,0 +1,42 @@
+-- Children are dropped before the tables they reference, which keeps foreign keys satisfied
+DROP TABLE IF EXISTS description_ops;
+DROP TABLE IF EXISTS description_docs;
+DROP TABLE IF EXISTS task_locks;
+DROP TABLE IF EXISTS notification_digests;
+DROP TABLE IF EXISTS task_templates;
+DROP TABLE IF EXISTS settings;
+DROP TABLE IF EXISTS outbox_events;
+DROP TABLE IF EXISTS actions;
+DROP TABLE IF EXISTS task_changes;
+DROP TABLE IF EXISTS user_board_prefs;
+DROP TABLE IF EXISTS guest_links;
+DROP TABLE IF EXISTS notification_mutes;
+DROP TABLE IF EXISTS notification_settings;
+DROP TABLE IF EXISTS metrics_rollups;
+DROP TABLE IF EXISTS column_daily_counts;
+DROP TABLE IF EXISTS board_daily_metrics;
+DROP TABLE IF EXISTS task_github_links;
+DROP TABLE IF EXISTS github_integrations;
+DROP TABLE IF EXISTS view_receipts;
+DROP TABLE IF EXISTS task_import_parts;
+DROP TABLE IF EXISTS task_imports;
+DROP TABLE IF EXISTS status_pages;
+DROP TABLE IF EXISTS task_column_entries;
+DROP TABLE IF EXISTS task_relations;
+DROP TABLE IF EXISTS share_links;
+DROP TABLE IF EXISTS task_mentions;
+DROP TABLE IF EXISTS operations;
+DROP TABLE IF EXISTS board_views;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS pinned_tasks;
+DROP TABLE IF EXISTS task_references;
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
+DROP TABLE IF EXISTS tasks;
+DROP TABLE IF EXISTS sprints;
+DROP TABLE IF EXISTS swimlanes;
+DROP TABLE IF EXISTS columns;
+DROP TABLE IF EXISTS board_shares;
+DROP TABLE IF EXISTS boards;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS board_changes;
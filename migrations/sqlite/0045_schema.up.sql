@@ -0,0 +1,696 @@
+-- Schema of SQLite databases, equal to the PostgreSQL schema of version 45. SQLite has no UUID,
+-- JSON or time zone types: UUIDs and JSON documents are kept as text, and times as text in UTC,
+-- in the format the SQLite drivers of the server write them in. Triggers log the change feed and
+-- touch columns like the PL/pgSQL functions do; as SQLite commits one transaction at a time, the
+-- change feed is ordered by the ID of its rows alone.
+
+-- Users
+CREATE TABLE users (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    email TEXT NOT NULL UNIQUE,
+    hashed_password TEXT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    sample_board_created_at TIMESTAMP,
+    default_board_id TEXT REFERENCES boards(id) ON DELETE SET NULL,
+    last_board_id TEXT REFERENCES boards(id) ON DELETE SET NULL,
+    calendar_token TEXT UNIQUE,
+    hide_read_receipts BOOLEAN NOT NULL DEFAULT FALSE,
+    is_admin BOOLEAN NOT NULL DEFAULT FALSE,
+    disabled_at TIMESTAMP,
+    max_boards INTEGER CHECK (max_boards >= 0),
+    max_columns_per_board INTEGER CHECK (max_columns_per_board >= 0),
+    max_tasks_per_column INTEGER CHECK (max_tasks_per_column >= 0),
+    is_guest BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Boards
+CREATE TABLE boards (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    title TEXT NOT NULL,
+    description TEXT,
+    owner_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    key TEXT NOT NULL DEFAULT 'TASK',
+    task_counter INTEGER NOT NULL DEFAULT 0,
+    card_fields TEXT,
+    read_receipts BOOLEAN NOT NULL DEFAULT FALSE,
+    frozen_at TIMESTAMP
+);
+
+CREATE INDEX idx_boards_key ON boards(key);
+
+CREATE TABLE board_shares (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'commenter', 'editor')),
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    UNIQUE (board_id, user_id)
+);
+
+CREATE TABLE columns (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    is_done BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    color TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE swimlanes (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_swimlanes_board_id ON swimlanes(board_id);
+
+CREATE TABLE sprints (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    goal TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX idx_sprints_board_id ON sprints(board_id);
+
+CREATE TABLE tasks (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT,
+    assigned_to TEXT REFERENCES users(id),
+    created_by TEXT NOT NULL REFERENCES users(id),
+    due_date TIMESTAMP,
+    position INTEGER NOT NULL,
+    number INTEGER NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP,
+    blocked BOOLEAN NOT NULL DEFAULT FALSE,
+    blocked_reason TEXT NOT NULL DEFAULT '',
+    priority TEXT NOT NULL DEFAULT 'medium' CHECK (priority IN ('low', 'medium', 'high', 'urgent')),
+    swimlane_id TEXT REFERENCES swimlanes(id) ON DELETE SET NULL,
+    cover_color TEXT NOT NULL DEFAULT '',
+    start_date TIMESTAMP,
+    estimate INTEGER CHECK (estimate >= 0),
+    sprint_id TEXT REFERENCES sprints(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_tasks_completed_at ON tasks(completed_at);
+CREATE INDEX idx_tasks_column_priority ON tasks(column_id, priority);
+CREATE INDEX idx_tasks_swimlane_id ON tasks(swimlane_id);
+CREATE INDEX idx_tasks_sprint_id ON tasks(sprint_id) WHERE sprint_id IS NOT NULL;
+
+-- A label belongs either to a board or to a user's workspace
+CREATE TABLE labels (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL,
+    owner_id TEXT REFERENCES users(id) ON DELETE CASCADE,
+    CHECK ((board_id IS NULL) <> (owner_id IS NULL))
+);
+
+CREATE INDEX idx_labels_owner_id ON labels(owner_id);
+CREATE UNIQUE INDEX idx_labels_owner_name ON labels(owner_id, LOWER(name)) WHERE owner_id IS NOT NULL;
+
+CREATE TABLE task_labels (
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    label_id TEXT NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    PRIMARY KEY (task_id, label_id)
+);
+
+CREATE TABLE task_references (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    target_task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    origin TEXT NOT NULL,
+    token TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_task_references_task_id ON task_references(task_id);
+CREATE INDEX idx_task_references_target_task_id ON task_references(target_task_id);
+
+CREATE TABLE pinned_tasks (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (user_id, task_id)
+);
+
+CREATE TABLE user_identities (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider TEXT NOT NULL,
+    provider_id TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    UNIQUE (provider, provider_id)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
+
+CREATE TABLE board_views (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    sort_by VARCHAR(32) NOT NULL DEFAULT 'position',
+    sort_order VARCHAR(4) NOT NULL DEFAULT 'asc',
+    group_by VARCHAR(32) NOT NULL DEFAULT '',
+    filters TEXT NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE TABLE operations (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'running', 'succeeded', 'failed')),
+    total INTEGER NOT NULL DEFAULT 0,
+    completed INTEGER NOT NULL DEFAULT 0,
+    errors TEXT NOT NULL DEFAULT '[]',
+    result_board_id TEXT REFERENCES boards(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    request_id VARCHAR(128) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_operations_user_id ON operations(user_id);
+
+CREATE TABLE task_mentions (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    mentioned_by TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    origin TEXT NOT NULL,
+    token TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    read_at TIMESTAMP,
+    UNIQUE (task_id, origin, user_id)
+);
+
+CREATE INDEX idx_task_mentions_user_id ON task_mentions(user_id, created_at);
+
+CREATE TABLE share_links (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'commenter')),
+    max_uses INTEGER NOT NULL CHECK (max_uses > 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_by TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_share_links_board_id ON share_links(board_id);
+
+CREATE TABLE task_relations (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    related_task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('blocks', 'relates_to')),
+    created_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    CHECK (task_id <> related_task_id),
+    UNIQUE (task_id, related_task_id, type)
+);
+
+CREATE INDEX idx_task_relations_related_task_id ON task_relations(related_task_id);
+
+CREATE TABLE task_column_entries (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    entered_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    exited_at TIMESTAMP
+);
+
+CREATE INDEX idx_task_column_entries_task_id ON task_column_entries(task_id);
+CREATE INDEX idx_task_column_entries_column_id ON task_column_entries(column_id, entered_at);
+CREATE INDEX idx_task_column_entries_entered_at ON task_column_entries(entered_at);
+CREATE INDEX idx_task_column_entries_exited_at ON task_column_entries(exited_at);
+
+CREATE TABLE status_pages (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL UNIQUE REFERENCES boards(id) ON DELETE CASCADE,
+    slug TEXT NOT NULL UNIQUE,
+    title TEXT NOT NULL,
+    column_ids TEXT NOT NULL DEFAULT '[]',
+    created_by TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE task_imports (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(16) NOT NULL DEFAULT 'uploading' CHECK (status IN ('uploading', 'committed', 'imported')),
+    operation_id TEXT REFERENCES operations(id) ON DELETE SET NULL,
+    imported_tasks INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_task_imports_user_id ON task_imports(user_id);
+
+CREATE TABLE task_import_parts (
+    import_id TEXT NOT NULL REFERENCES task_imports(id) ON DELETE CASCADE,
+    part_number INTEGER NOT NULL CHECK (part_number > 0),
+    tasks TEXT NOT NULL,
+    task_count INTEGER NOT NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (import_id, part_number)
+);
+
+CREATE TABLE view_receipts (
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    column_id TEXT REFERENCES columns(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_view_receipts_board_id ON view_receipts(board_id);
+CREATE UNIQUE INDEX idx_view_receipts_board_user ON view_receipts(board_id, user_id) WHERE column_id IS NULL;
+CREATE UNIQUE INDEX idx_view_receipts_column_user ON view_receipts(column_id, user_id) WHERE column_id IS NOT NULL;
+
+CREATE TABLE github_integrations (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL UNIQUE REFERENCES boards(id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    review_column_id TEXT REFERENCES columns(id) ON DELETE SET NULL,
+    done_column_id TEXT REFERENCES columns(id) ON DELETE SET NULL,
+    created_by TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE task_github_links (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('commit', 'pull_request')),
+    repository TEXT NOT NULL,
+    ref TEXT NOT NULL,
+    url TEXT NOT NULL,
+    title TEXT NOT NULL,
+    state VARCHAR(16) NOT NULL DEFAULT '',
+    author TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    UNIQUE (task_id, kind, repository, ref)
+);
+
+CREATE TABLE board_daily_metrics (
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    created INTEGER NOT NULL DEFAULT 0,
+    completed INTEGER NOT NULL DEFAULT 0,
+    moved INTEGER NOT NULL DEFAULT 0,
+    cycle_median REAL,
+    cycle_p85 REAL,
+    PRIMARY KEY (board_id, day)
+);
+
+CREATE TABLE column_daily_counts (
+    column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    tasks INTEGER NOT NULL,
+    PRIMARY KEY (column_id, day)
+);
+
+CREATE TABLE metrics_rollups (
+    day DATE PRIMARY KEY,
+    rolled_up_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE TABLE notification_settings (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id TEXT REFERENCES boards(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    in_app BOOLEAN NOT NULL,
+    email BOOLEAN NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_notification_settings_default ON notification_settings(user_id, event) WHERE board_id IS NULL;
+CREATE UNIQUE INDEX idx_notification_settings_board ON notification_settings(user_id, board_id, event) WHERE board_id IS NOT NULL;
+
+CREATE TABLE notification_mutes (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE TABLE guest_links (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_by TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMP,
+    sessions INTEGER NOT NULL DEFAULT 0,
+    last_session_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_guest_links_board_id ON guest_links(board_id);
+
+CREATE TABLE user_board_prefs (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    collapsed_columns TEXT NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    starred BOOLEAN NOT NULL DEFAULT FALSE,
+    sort_order INTEGER,
+    PRIMARY KEY (user_id, board_id)
+);
+
+CREATE INDEX idx_user_board_prefs_starred ON user_board_prefs(user_id) WHERE starred;
+
+CREATE TABLE task_changes (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    task_id TEXT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+    field TEXT NOT NULL,
+    old_value TEXT,
+    new_value TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_task_changes_task_id ON task_changes(task_id, created_at);
+CREATE INDEX idx_task_changes_assignee ON task_changes(new_value, created_at) WHERE field = 'assignee';
+CREATE INDEX idx_task_changes_created_at ON task_changes(created_at, id);
+
+CREATE TABLE actions (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    undone_at TIMESTAMP
+);
+
+CREATE INDEX idx_actions_user_id ON actions(user_id, created_at);
+
+CREATE TABLE outbox_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    event TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    last_error TEXT,
+    delivered_at TIMESTAMP
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events(next_attempt_at) WHERE delivered_at IS NULL;
+CREATE INDEX idx_outbox_events_delivered_at ON outbox_events(delivered_at) WHERE delivered_at IS NOT NULL;
+
+CREATE TABLE settings (
+    key TEXT PRIMARY KEY,
+    value TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_by TEXT REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE task_templates (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    title_pattern TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    priority TEXT NOT NULL DEFAULT 'medium',
+    label_ids TEXT NOT NULL DEFAULT '[]',
+    checklist TEXT NOT NULL DEFAULT '[]',
+    created_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_task_templates_board_id ON task_templates(board_id);
+
+CREATE TABLE notification_digests (
+    user_id TEXT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(10) NOT NULL,
+    last_sent_at TIMESTAMP
+);
+
+CREATE INDEX idx_notification_digests_due ON notification_digests(frequency, last_sent_at);
+
+CREATE TABLE task_locks (
+    task_id TEXT PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_task_locks_user_id ON task_locks(user_id);
+
+CREATE TABLE description_docs (
+    task_id TEXT PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL DEFAULT 0,
+    content TEXT NOT NULL DEFAULT '',
+    saved_version INTEGER NOT NULL DEFAULT 0,
+    saved_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    updated_by TEXT REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_description_docs_unsaved ON description_docs(updated_at) WHERE version > saved_version;
+
+CREATE TABLE description_ops (
+    task_id TEXT NOT NULL REFERENCES description_docs(task_id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    user_id TEXT REFERENCES users(id) ON DELETE SET NULL,
+    ops TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    PRIMARY KEY (task_id, version)
+);
+
+-- When a column or its tasks last changed; reordering tasks alone does not count as a change
+CREATE TRIGGER tasks_touch_columns_on_insert
+    AFTER INSERT ON tasks
+BEGIN
+    UPDATE columns SET updated_at = (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')) WHERE id = NEW.column_id;
+END;
+
+CREATE TRIGGER tasks_touch_columns_on_delete
+    AFTER DELETE ON tasks
+BEGIN
+    UPDATE columns SET updated_at = (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')) WHERE id = OLD.column_id;
+END;
+
+CREATE TRIGGER tasks_touch_columns_on_update
+    AFTER UPDATE ON tasks
+    WHEN OLD.column_id IS NOT NEW.column_id OR OLD.title IS NOT NEW.title
+        OR OLD.description IS NOT NEW.description OR OLD.assigned_to IS NOT NEW.assigned_to
+        OR OLD.due_date IS NOT NEW.due_date OR OLD.completed_at IS NOT NEW.completed_at
+        OR OLD.blocked IS NOT NEW.blocked OR OLD.blocked_reason IS NOT NEW.blocked_reason
+        OR OLD.priority IS NOT NEW.priority OR OLD.swimlane_id IS NOT NEW.swimlane_id
+BEGIN
+    UPDATE columns SET updated_at = (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')) WHERE id IN (OLD.column_id, NEW.column_id);
+END;
+
+-- Change feed of boards for polling clients
+CREATE TABLE board_changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    board_id TEXT NOT NULL,
+    entity TEXT NOT NULL CHECK (entity IN ('task', 'column', 'label', 'share', 'lock')),
+    entity_id TEXT NOT NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+CREATE INDEX idx_board_changes_board_id ON board_changes(board_id, id);
+CREATE INDEX idx_board_changes_changed_at ON board_changes(changed_at);
+
+CREATE TRIGGER tasks_log_insert
+    AFTER INSERT ON tasks
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT board_id, 'task', NEW.id FROM columns WHERE id = NEW.column_id;
+END;
+
+CREATE TRIGGER tasks_log_update
+    AFTER UPDATE ON tasks
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT board_id, 'task', OLD.id FROM columns WHERE id = OLD.column_id;
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT board_id, 'task', NEW.id FROM columns WHERE id = NEW.column_id AND NEW.column_id <> OLD.column_id;
+END;
+
+CREATE TRIGGER tasks_log_delete
+    AFTER DELETE ON tasks
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT board_id, 'task', OLD.id FROM columns WHERE id = OLD.column_id;
+END;
+
+-- Labels and relations are part of their tasks
+CREATE TRIGGER task_labels_log_insert
+    AFTER INSERT ON task_labels
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'task', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = NEW.task_id;
+END;
+
+CREATE TRIGGER task_labels_log_delete
+    AFTER DELETE ON task_labels
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'task', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = OLD.task_id;
+END;
+
+CREATE TRIGGER task_relations_log_insert
+    AFTER INSERT ON task_relations
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'task', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id IN (NEW.task_id, NEW.related_task_id);
+END;
+
+CREATE TRIGGER task_relations_log_update
+    AFTER UPDATE ON task_relations
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'task', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id IN (NEW.task_id, NEW.related_task_id);
+END;
+
+CREATE TRIGGER task_relations_log_delete
+    AFTER DELETE ON task_relations
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'task', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id IN (OLD.task_id, OLD.related_task_id);
+END;
+
+CREATE TRIGGER columns_log_insert
+    AFTER INSERT ON columns
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id) VALUES (NEW.board_id, 'column', NEW.id);
+END;
+
+-- Touching updated_at alone, as task writes do, is not a change of the column itself
+CREATE TRIGGER columns_log_update
+    AFTER UPDATE ON columns
+    WHEN OLD.board_id IS NOT NEW.board_id OR OLD.title IS NOT NEW.title
+        OR OLD.position IS NOT NEW.position OR OLD.is_done IS NOT NEW.is_done
+        OR OLD.color IS NOT NEW.color OR OLD.description IS NOT NEW.description
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id) VALUES (OLD.board_id, 'column', OLD.id);
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT NEW.board_id, 'column', NEW.id WHERE NEW.board_id <> OLD.board_id;
+    -- Tasks go along with a column moved to another board
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT boards.board_id, 'task', tasks.id
+    FROM tasks, (SELECT OLD.board_id AS board_id UNION ALL SELECT NEW.board_id) AS boards
+    WHERE tasks.column_id = NEW.id AND NEW.board_id <> OLD.board_id;
+END;
+
+CREATE TRIGGER columns_log_delete
+    AFTER DELETE ON columns
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id) VALUES (OLD.board_id, 'column', OLD.id);
+END;
+
+-- Tasks deleted along with their column can't find its board any more
+CREATE TRIGGER columns_log_tasks_delete
+    BEFORE DELETE ON columns
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT OLD.board_id, 'task', id FROM tasks WHERE column_id = OLD.id;
+END;
+
+-- Workspace labels are part of every board of their owner
+CREATE TRIGGER labels_log_insert
+    AFTER INSERT ON labels
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT id, 'label', NEW.id FROM boards WHERE id = NEW.board_id OR owner_id = NEW.owner_id;
+END;
+
+CREATE TRIGGER labels_log_update
+    AFTER UPDATE ON labels
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT id, 'label', NEW.id FROM boards WHERE id = NEW.board_id OR owner_id = NEW.owner_id;
+END;
+
+CREATE TRIGGER labels_log_delete
+    AFTER DELETE ON labels
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT id, 'label', OLD.id FROM boards WHERE id = OLD.board_id OR owner_id = OLD.owner_id;
+END;
+
+CREATE TRIGGER board_shares_log_insert
+    AFTER INSERT ON board_shares
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id) VALUES (NEW.board_id, 'share', NEW.id);
+END;
+
+CREATE TRIGGER board_shares_log_update
+    AFTER UPDATE ON board_shares
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id) VALUES (NEW.board_id, 'share', NEW.id);
+END;
+
+CREATE TRIGGER board_shares_log_delete
+    AFTER DELETE ON board_shares
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id) VALUES (OLD.board_id, 'share', OLD.id);
+END;
+
+-- Locks are published in the change feed of the task's board
+CREATE TRIGGER task_locks_log_insert
+    AFTER INSERT ON task_locks
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'lock', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = NEW.task_id;
+END;
+
+CREATE TRIGGER task_locks_log_update
+    AFTER UPDATE ON task_locks
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'lock', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = NEW.task_id;
+END;
+
+CREATE TRIGGER task_locks_log_delete
+    AFTER DELETE ON task_locks
+BEGIN
+    INSERT INTO board_changes (board_id, entity, entity_id)
+    SELECT columns.board_id, 'lock', tasks.id
+    FROM tasks JOIN columns ON columns.id = tasks.column_id
+    WHERE tasks.id = OLD.task_id;
+END;
@@ -0,0 +1,12 @@
+CREATE TABLE archived_tasks (
+    id TEXT PRIMARY KEY,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    number INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    completed_at TIMESTAMP NOT NULL,
+    archive TEXT NOT NULL,
+    archived_at TIMESTAMP NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now')),
+    restored_at TIMESTAMP
+);
+
+CREATE INDEX idx_archived_tasks_board_id ON archived_tasks(board_id, number);
@@ -0,0 +1,18 @@
+CREATE TABLE share_links_old (
+    id TEXT PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' || substr('89AB', 1 + abs(random() % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))),
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    token TEXT NOT NULL UNIQUE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'commenter')),
+    max_uses INTEGER NOT NULL CHECK (max_uses > 0),
+    uses INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_by TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT (strftime('%Y-%m-%d %H:%M:%f+00:00', 'now'))
+);
+
+INSERT INTO share_links_old SELECT id, board_id, token, role, max_uses, uses, expires_at, created_by, revoked_at, created_at FROM share_links WHERE role <> 'editor';
+DROP TABLE share_links;
+ALTER TABLE share_links_old RENAME TO share_links;
+
+CREATE INDEX idx_share_links_board_id ON share_links(board_id);